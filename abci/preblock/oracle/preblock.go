@@ -1,6 +1,7 @@
 package oracle
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
 	"time"
@@ -41,6 +42,7 @@ type PreBlockHandler struct { //golint:ignore
 
 // NewOraclePreBlockHandler returns a new PreBlockHandler. The handler
 // is responsible for writing oracle data included in vote extensions to state.
+// The given options are used to configure the underlying vote aggregator.
 func NewOraclePreBlockHandler(
 	logger log.Logger,
 	aggregateFn aggregator.AggregateFnFromContext[string, map[connecttypes.CurrencyPair]*big.Int],
@@ -49,11 +51,13 @@ func NewOraclePreBlockHandler(
 	strategy currencypair.CurrencyPairStrategy,
 	veCodec codec.VoteExtensionCodec,
 	ecCodec codec.ExtendedCommitCodec,
+	opts ...abciaggregator.VoteAggregatorOption,
 ) *PreBlockHandler {
 	va := abciaggregator.NewDefaultVoteAggregator(
 		logger,
 		aggregateFn,
 		strategy,
+		opts...,
 	)
 	pa := abciaggregator.NewOraclePriceApplier(
 		va,
@@ -63,6 +67,18 @@ func NewOraclePreBlockHandler(
 		logger,
 	)
 
+	return NewOraclePreBlockHandlerFromPriceApplier(logger, pa, oracleKeeper, metrics)
+}
+
+// NewOraclePreBlockHandlerFromPriceApplier returns a new PreBlockHandler that writes the prices applied by the
+// given PriceApplier to state. This allows the PriceApplier to be configured, e.g. with the TxInjection used to
+// locate the extended commit info in the proposal.
+func NewOraclePreBlockHandlerFromPriceApplier(
+	logger log.Logger,
+	pa abciaggregator.PriceApplier,
+	oracleKeeper connectabcitypes.OracleKeeper,
+	metrics servicemetrics.Metrics,
+) *PreBlockHandler {
 	return &PreBlockHandler{
 		logger:  logger,
 		keeper:  oracleKeeper,
@@ -93,7 +109,11 @@ func (h *PreBlockHandler) WrappedPreBlocker(mm *module.Manager) sdk.PreBlocker {
 		}
 
 		start := time.Now()
-		var prices map[connecttypes.CurrencyPair]*big.Int
+		var (
+			prices map[connecttypes.CurrencyPair]*big.Int
+			// noPriceUpdateErr is reported in place of a successful status if the block does not update prices.
+			noPriceUpdateErr error
+		)
 		defer func() {
 			// only measure latency in Finalize
 			if ctx.ExecMode() == sdk.ExecModeFinalize {
@@ -103,7 +123,11 @@ func (h *PreBlockHandler) WrappedPreBlocker(mm *module.Manager) sdk.PreBlocker {
 					"height", ctx.BlockHeight(),
 					"latency (seconds)", latency.Seconds(),
 				)
-				connectabcitypes.RecordLatencyAndStatus(h.metrics, latency, err, servicemetrics.PreBlock)
+				status := err
+				if status == nil {
+					status = noPriceUpdateErr
+				}
+				connectabcitypes.RecordLatencyAndStatus(h.metrics, latency, status, servicemetrics.PreBlock)
 
 				// record prices + ticker metrics per validator (only do so if there was no error writing the prices)
 				if err == nil && prices != nil {
@@ -134,6 +158,20 @@ func (h *PreBlockHandler) WrappedPreBlocker(mm *module.Manager) sdk.PreBlocker {
 		// decode vote-extensions + apply prices to state
 		prices, err = h.pa.ApplyPricesFromVoteExtensions(ctx, req)
 		if err != nil {
+			// blocks that were accepted with invalid oracle data do not update prices
+			var noPriceUpdate connectabcitypes.NoPriceUpdateError
+			if errors.As(err, &noPriceUpdate) {
+				h.logger.Info(
+					"skipping price update",
+					"height", req.Height,
+					"reason", noPriceUpdate.Err,
+				)
+
+				noPriceUpdateErr = noPriceUpdate
+				emitNoPriceUpdateEvent(ctx, noPriceUpdate)
+				return response, nil
+			}
+
 			h.logger.Error(
 				"failed to apply prices from vote extensions",
 				"height", req.Height,
@@ -165,7 +203,11 @@ func (h *PreBlockHandler) PreBlocker() sdk.PreBlocker {
 		}
 
 		start := time.Now()
-		var prices map[connecttypes.CurrencyPair]*big.Int
+		var (
+			prices map[connecttypes.CurrencyPair]*big.Int
+			// noPriceUpdateErr is reported in place of a successful status if the block does not update prices.
+			noPriceUpdateErr error
+		)
 		defer func() {
 			// only measure latency in Finalize
 			if ctx.ExecMode() == sdk.ExecModeFinalize {
@@ -175,7 +217,11 @@ func (h *PreBlockHandler) PreBlocker() sdk.PreBlocker {
 					"height", ctx.BlockHeight(),
 					"latency (seconds)", latency.Seconds(),
 				)
-				connectabcitypes.RecordLatencyAndStatus(h.metrics, latency, err, servicemetrics.PreBlock)
+				status := err
+				if status == nil {
+					status = noPriceUpdateErr
+				}
+				connectabcitypes.RecordLatencyAndStatus(h.metrics, latency, status, servicemetrics.PreBlock)
 
 				// record prices + ticker metrics per validator (only do so if there was no error writing the prices)
 				if err == nil && prices != nil {
@@ -206,6 +252,20 @@ func (h *PreBlockHandler) PreBlocker() sdk.PreBlocker {
 		// decode vote-extensions + apply prices to state
 		prices, err = h.pa.ApplyPricesFromVoteExtensions(ctx, req)
 		if err != nil {
+			// blocks that were accepted with invalid oracle data do not update prices
+			var noPriceUpdate connectabcitypes.NoPriceUpdateError
+			if errors.As(err, &noPriceUpdate) {
+				h.logger.Info(
+					"skipping price update",
+					"height", req.Height,
+					"reason", noPriceUpdate.Err,
+				)
+
+				noPriceUpdateErr = noPriceUpdate
+				emitNoPriceUpdateEvent(ctx, noPriceUpdate)
+				return &sdk.ResponsePreBlock{}, nil
+			}
+
 			h.logger.Error(
 				"failed to apply prices from vote extensions",
 				"height", req.Height,
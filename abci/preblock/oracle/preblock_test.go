@@ -2,8 +2,10 @@ package oracle_test
 
 import (
 	"context"
+	"fmt"
 	"math/big"
 	"testing"
+	"time"
 
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/log"
@@ -18,6 +20,9 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	preblock "github.com/skip-mev/connect/v2/abci/preblock/oracle"
+	"github.com/skip-mev/connect/v2/abci/proposals"
+	abciaggregator "github.com/skip-mev/connect/v2/abci/strategies/aggregator"
+	abciaggregatormocks "github.com/skip-mev/connect/v2/abci/strategies/aggregator/mocks"
 	compression "github.com/skip-mev/connect/v2/abci/strategies/codec"
 	codecmock "github.com/skip-mev/connect/v2/abci/strategies/codec/mocks"
 	"github.com/skip-mev/connect/v2/abci/strategies/currencypair"
@@ -25,13 +30,16 @@ import (
 	"github.com/skip-mev/connect/v2/abci/testutils"
 	"github.com/skip-mev/connect/v2/abci/types"
 	connectabcimocks "github.com/skip-mev/connect/v2/abci/types/mocks"
+	"github.com/skip-mev/connect/v2/abci/ve"
 	vetypes "github.com/skip-mev/connect/v2/abci/ve/types"
 	"github.com/skip-mev/connect/v2/aggregator"
 	"github.com/skip-mev/connect/v2/pkg/math/voteweighted"
 	voteweightedmocks "github.com/skip-mev/connect/v2/pkg/math/voteweighted/mocks"
 	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	oracleclientmocks "github.com/skip-mev/connect/v2/service/clients/oracle/mocks"
 	servicemetrics "github.com/skip-mev/connect/v2/service/metrics"
 	metricmock "github.com/skip-mev/connect/v2/service/metrics/mocks"
+	servicetypes "github.com/skip-mev/connect/v2/service/servers/oracle/types"
 	"github.com/skip-mev/connect/v2/x/oracle/keeper"
 	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
 )
@@ -519,6 +527,38 @@ func (s *PreBlockTestSuite) TestPreBlockStatus() {
 		s.Require().Error(err, expErr)
 	})
 
+	s.Run("invalid oracle data skips the price update", func() {
+		metrics := metricmock.NewMetrics(s.T())
+		pa := abciaggregatormocks.NewPriceApplier(s.T())
+		handler := preblock.NewOraclePreBlockHandlerFromPriceApplier(
+			log.NewTestLogger(s.T()),
+			pa,
+			nil,
+			metrics,
+		)
+
+		expErr := types.NoPriceUpdateError{
+			Err: types.CodecError{Err: fmt.Errorf("invalid")},
+		}
+		metrics.On("ObserveABCIMethodLatency", servicemetrics.PreBlock, mock.Anything).Return()
+		metrics.On("AddABCIRequest", servicemetrics.PreBlock, expErr).Return()
+
+		// make ves enabled
+		s.ctx = testutils.UpdateContextWithVEHeight(s.ctx, 2)
+		s.ctx = s.ctx.WithBlockHeight(4).WithEventManager(sdk.NewEventManager())
+		pa.On("ApplyPricesFromVoteExtensions", s.ctx, mock.Anything).Return(nil, expErr)
+
+		// run preblocker
+		_, err := handler.WrappedPreBlocker(s.mm)(s.ctx, &cometabci.RequestFinalizeBlock{
+			Txs: [][]byte{[]byte("abc")},
+		})
+		s.Require().NoError(err)
+
+		events := s.ctx.EventManager().Events()
+		s.Require().Len(events, 1)
+		s.Require().Equal(types.EventTypeNoPriceUpdate, events[0].Type)
+	})
+
 	s.Run("too many price bytes causes no errors in aggregating currency pairs", func() {
 		metrics := metricmock.NewMetrics(s.T())
 		extCodec := codecmock.NewExtendedCommitCodec(s.T())
@@ -749,3 +789,118 @@ func (s *PreBlockTestSuite) TestValidatorReports() {
 		s.Require().NoError(err)
 	})
 }
+
+func (s *PreBlockTestSuite) TestExtendVoteAndPreBlockSkipAcceptedInvalidOracleData() {
+	s.SetupSubTest()
+
+	btcUSD := s.currencyPairs[1]
+	s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, btcUSD, oracletypes.QuotePrice{
+		Price: math.NewInt(90),
+	}))
+
+	// the vote extensions of the proposal fail validation, which is performed once per block
+	validations := 0
+	proposalHandler := proposals.NewProposalHandler(
+		log.NewTestLogger(s.T()),
+		nil,
+		func(_ sdk.Context, _ *cometabci.RequestProcessProposal) (*cometabci.ResponseProcessProposal, error) {
+			return &cometabci.ResponseProcessProposal{Status: cometabci.ResponseProcessProposal_ACCEPT}, nil
+		},
+		func(_ sdk.Context, _ cometabci.ExtendedCommitInfo) error {
+			validations++
+			return fmt.Errorf("vote extensions do not comprise a super-majority")
+		},
+		s.veCodec,
+		s.commitCodec,
+		currencypair.NewDeltaCurrencyPairStrategy(&s.oracleKeeper),
+		servicemetrics.NewNopMetrics(),
+		proposals.AcceptInvalidOracleData(),
+	)
+
+	// the prices of the proposal must be neither aggregated nor applied
+	newPriceApplier := func() abciaggregator.PriceApplier {
+		return abciaggregator.NewOraclePriceApplier(
+			abciaggregatormocks.NewVoteAggregator(s.T()),
+			&s.oracleKeeper,
+			s.veCodec,
+			s.commitCodec,
+			log.NewTestLogger(s.T()),
+			abciaggregator.WithOracleDataValidation(proposalHandler.ValidateOracleData),
+		)
+	}
+
+	extCommitBz, err := s.commitCodec.Encode(cometabci.ExtendedCommitInfo{
+		Votes: []cometabci.ExtendedVoteInfo{
+			{VoteExtension: []byte("invalid")},
+		},
+	})
+	s.Require().NoError(err)
+
+	txs := [][]byte{extCommitBz, []byte("tx")}
+	hash := []byte("block")
+	ctx := testutils.UpdateContextWithVEHeight(s.ctx, 2).WithBlockHeight(3)
+
+	// process the proposal, accepting it with invalid oracle data
+	resp, err := proposalHandler.ProcessProposalHandler()(ctx, &cometabci.RequestProcessProposal{
+		Txs:    txs,
+		Height: 3,
+		Hash:   hash,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(cometabci.ResponseProcessProposal_ACCEPT, resp.Status)
+
+	// extend the vote, which has no comet info, against the current on-chain prices
+	oracleClient := oracleclientmocks.NewOracleClient(s.T())
+	oracleClient.On("Prices", mock.Anything, mock.Anything).Return(&servicetypes.QueryPricesResponse{
+		Prices: map[string]string{btcUSD.String(): "100"},
+	}, nil)
+
+	veHandler := ve.NewVoteExtensionHandler(
+		log.NewTestLogger(s.T()),
+		oracleClient,
+		time.Second,
+		currencypair.NewDeltaCurrencyPairStrategy(&s.oracleKeeper),
+		s.veCodec,
+		newPriceApplier(),
+		servicemetrics.NewNopMetrics(),
+	)
+
+	veResp, err := veHandler.ExtendVoteHandler()(ctx, &cometabci.RequestExtendVote{
+		Txs:    txs,
+		Height: 3,
+		Hash:   hash,
+	})
+	s.Require().NoError(err)
+
+	voteExtension, err := s.veCodec.Decode(veResp.VoteExtension)
+	s.Require().NoError(err)
+
+	delta := new(big.Int)
+	s.Require().NoError(delta.GobDecode(voteExtension.Prices[1]))
+	s.Require().Equal(big.NewInt(10), delta)
+
+	// finalize the block without a price update
+	preBlockHandler := preblock.NewOraclePreBlockHandlerFromPriceApplier(
+		log.NewTestLogger(s.T()),
+		newPriceApplier(),
+		&s.oracleKeeper,
+		servicemetrics.NewNopMetrics(),
+	)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = preBlockHandler.WrappedPreBlocker(s.mm)(ctx, &cometabci.RequestFinalizeBlock{
+		Txs:    txs,
+		Height: 3,
+		Hash:   hash,
+	})
+	s.Require().NoError(err)
+
+	events := ctx.EventManager().Events()
+	s.Require().Len(events, 1)
+	s.Require().Equal(types.EventTypeNoPriceUpdate, events[0].Type)
+
+	price, err := s.oracleKeeper.GetPriceForCurrencyPair(ctx, btcUSD)
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(90), price.Price)
+	s.Require().Equal(1, validations)
+}
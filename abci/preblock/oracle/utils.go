@@ -2,11 +2,13 @@ package oracle
 
 import (
 	"math/big"
+	"strconv"
 
 	cometabci "github.com/cometbft/cometbft/abci/types"
 	cometproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	connectabcitypes "github.com/skip-mev/connect/v2/abci/types"
 	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
 	servicemetrics "github.com/skip-mev/connect/v2/service/metrics"
 )
@@ -56,3 +58,12 @@ func (h *PreBlockHandler) recordValidatorReports(ctx sdk.Context, decidedCommit
 		}
 	}
 }
+
+// emitNoPriceUpdateEvent emits an event signalling that the block does not update prices, along with the reason.
+func emitNoPriceUpdateEvent(ctx sdk.Context, err connectabcitypes.NoPriceUpdateError) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		connectabcitypes.EventTypeNoPriceUpdate,
+		sdk.NewAttribute(connectabcitypes.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		sdk.NewAttribute(connectabcitypes.AttributeKeyReason, err.Err.Error()),
+	))
+}
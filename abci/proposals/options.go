@@ -1,5 +1,9 @@
 package proposals
 
+import (
+	connectabci "github.com/skip-mev/connect/v2/abci/types"
+)
+
 // Option is a function that enables optional configuration of the ProposalHandler.
 type Option func(*ProposalHandler)
 
@@ -10,3 +14,29 @@ func RetainOracleDataInWrappedProposalHandler() Option {
 		p.retainOracleDataInWrappedHandler = true
 	}
 }
+
+// WithTxInjection returns an Option that configures where the ProposalHandler injects the extended commit
+// info into the proposal, and how it is located when processing a proposal. By default, the extended commit
+// info is injected as, and expected to be, the first tx of the proposal.
+func WithTxInjection(injection connectabci.TxInjection) Option {
+	return func(p *ProposalHandler) {
+		if err := injection.ValidateBasic(); err != nil {
+			panic(err)
+		}
+
+		p.injection = injection
+	}
+}
+
+// AcceptInvalidOracleData returns an Option that configures the ProposalHandler to accept proposals
+// whose injected extended commit info fails to decode or validate, rather than reject them. Such blocks
+// do not update prices. This favours liveness over the freshness of prices, and requires the PriceAppliers
+// of the VoteExtensionHandler and of the PreBlockHandler to be configured with the ValidateOracleData method
+// of the ProposalHandler, so that the invalid oracle data is skipped both when the vote is extended and when
+// the block is finalized. Proposals that do not contain the extended commit info are
+// still rejected.
+func AcceptInvalidOracleData() Option {
+	return func(p *ProposalHandler) {
+		p.acceptInvalidOracleData = true
+	}
+}
@@ -57,6 +57,18 @@ type ProposalHandler struct {
 	// proposal handler should pass the injected extended commit info to the
 	// wrapped proposal handler.
 	retainOracleDataInWrappedHandler bool
+
+	// acceptInvalidOracleData is a flag that determines whether the proposal handler should accept
+	// proposals whose oracle data fails validation, rather than reject them.
+	acceptInvalidOracleData bool
+
+	// injection determines where the extended commit info is injected into the proposal, and
+	// how it is located.
+	injection connectabci.TxInjection
+
+	// oracleDataValidations records the validation of the oracle data of the processed proposals, so that
+	// the decision to skip the price update of a block is shared by ExtendVote and PreBlock.
+	oracleDataValidations *oracleDataValidations
 }
 
 // NewProposalHandler returns a new ProposalHandler.
@@ -80,6 +92,8 @@ func NewProposalHandler(
 		extendedCommitCodec:      extendedCommitInfoCodec,
 		currencyPairStrategy:     currencyPairStrategy,
 		metrics:                  metrics,
+		injection:                connectabci.DefaultTxInjection(),
+		oracleDataValidations:    &oracleDataValidations{},
 	}
 
 	// apply options
@@ -155,7 +169,7 @@ func (h *ProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
 
 			// Create the vote extension injection data which will be injected into the proposal. These contain the
 			// oracle data for the current block which will be committed to state in PreBlock.
-			extInfoBz, err = h.extendedCommitCodec.Encode(extInfo)
+			extInfoBz, err = codec.ExtendedCommitCodecForHeight(h.extendedCommitCodec, req.Height).Encode(extInfo)
 			if err != nil {
 				h.logger.Error(
 					"failed to extended commit info",
@@ -168,6 +182,8 @@ func (h *ProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
 
 				return &cometabci.ResponsePrepareProposal{Txs: make([][]byte, 0)}, err
 			}
+			extInfoBz = h.injection.Wrap(extInfoBz)
+
 			// Adjust req.MaxTxBytes to account for extInfoBzSize so that the wrapped-proposal handler does not reap too many txs from the mempool
 			extInfoBzSize := int64(len(extInfoBz))
 			if extInfoBzSize <= req.MaxTxBytes {
@@ -183,7 +199,7 @@ func (h *ProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
 
 			// determine whether the wrapped prepare proposal handler should retain the extended commit info
 			if h.retainOracleDataInWrappedHandler {
-				req.Txs = append([][]byte{extInfoBz}, req.Txs...) // prepend the VE Tx
+				req.Txs = h.injection.Insert(req.Txs, extInfoBz) // inject the VE Tx
 			}
 		}
 
@@ -215,7 +231,7 @@ func (h *ProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
 	}
 }
 
-// injectAndResize returns a tx array containing the injectTx at the beginning followed by appTxs.
+// injectAndResize returns a tx array containing the appTxs with the injectTx inserted at the injection index.
 // The returned transaction array is bounded by maxSizeBytes, and the function is idempotent meaning the
 // injectTx will only appear once regardless of how many times you attempt to inject it.
 // If injectTx is large enough, all originalTxs may end up being excluded from the returned tx array.
@@ -226,25 +242,35 @@ func (h *ProposalHandler) injectAndResize(appTxs [][]byte, injectTx []byte, maxS
 		consumedBytes int64
 	)
 
-	// If VEs are enabled and our VE Tx isn't already in the appTxs, inject it here
-	if len(injectTx) != 0 && (len(appTxs) < 1 || !bytes.Equal(appTxs[0], injectTx)) {
+	// If VEs are enabled, reserve space for our VE Tx. The VE Tx may already be in the appTxs if the wrapped
+	// handler retained it, in which case it is moved to the injection index.
+	injected := false
+	if len(injectTx) != 0 {
 		injectBytes := int64(len(injectTx))
 		// Ensure the VE Tx is in the response if we have room.
 		// We may want to be more aggressive in the future about dedicating block space for application-specific Txs.
 		// However, the VE Tx size should be relatively stable so MaxTxBytes should be set w/ plenty of headroom.
 		if injectBytes <= maxSizeBytes {
 			consumedBytes += injectBytes
-			returnedTxs = append(returnedTxs, injectTx)
+			injected = true
 		}
 	}
 	// Add as many appTxs to the returned proposal as possible given our maxSizeBytes constraint
 	for _, tx := range appTxs {
+		if len(injectTx) != 0 && bytes.Equal(tx, injectTx) {
+			continue
+		}
+
 		consumedBytes += int64(len(tx))
 		if consumedBytes > maxSizeBytes {
-			return returnedTxs
+			break
 		}
 		returnedTxs = append(returnedTxs, tx)
 	}
+
+	if injected {
+		returnedTxs = h.injection.Insert(returnedTxs, injectTx)
+	}
 	return returnedTxs
 }
 
@@ -255,7 +281,12 @@ func (h *ProposalHandler) injectAndResize(appTxs [][]byte, injectTx []byte, maxS
 func (h *ProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
 	return func(ctx sdk.Context, req *cometabci.RequestProcessProposal) (resp *cometabci.ResponseProcessProposal, err error) {
 		start := time.Now()
-		var wrappedProcessProposalLatency time.Duration
+		var (
+			wrappedProcessProposalLatency time.Duration
+			// noPriceUpdateErr is reported in place of a successful status if the proposal is accepted
+			// with invalid oracle data.
+			noPriceUpdateErr error
+		)
 
 		// Defer a function to record the total time it took to process the proposal.
 		defer func() {
@@ -267,7 +298,11 @@ func (h *ProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
 				"wrapped prepare proposal latency", wrappedProcessProposalLatency.Seconds(),
 				"connect prepare proposal latency", (totalLatency - wrappedProcessProposalLatency).Seconds(),
 			)
-			connectabci.RecordLatencyAndStatus(h.metrics, totalLatency-wrappedProcessProposalLatency, err, servicemetrics.ProcessProposal)
+			status := err
+			if status == nil {
+				status = noPriceUpdateErr
+			}
+			connectabci.RecordLatencyAndStatus(h.metrics, totalLatency-wrappedProcessProposalLatency, status, servicemetrics.ProcessProposal)
 		}()
 
 		// this should never happen, but just in case
@@ -288,45 +323,39 @@ func (h *ProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
 			"vote_extensions_enabled", voteExtensionsEnabled,
 		)
 
-		// we save the injected tx so we can re-add it to the txs in case it is removed in a wrapped proposal handler.
-		var injectedTx []byte
+		// we save the proposal so we can restore the injected tx in case it is removed in a wrapped proposal handler.
+		var proposalTxs [][]byte
 
 		if voteExtensionsEnabled {
 			// Ensure that the commit info was correctly injected into the proposal.
-			if len(req.Txs) < connectabci.NumInjectedTxs {
+			injectedIndex, extCommitBz, locateErr := h.injection.Locate(req.Txs)
+			if locateErr != nil {
 				h.logger.Error("failed to process proposal: missing commit info", "num_txs", len(req.Txs))
-				err = connectabci.MissingCommitInfoError{}
+				err = locateErr
 				return &cometabci.ResponseProcessProposal{Status: cometabci.ResponseProcessProposal_REJECT},
 					err
 			}
 
-			extCommitBz := req.Txs[connectabci.OracleInfoIndex]
-
-			// Validate the vote extensions included in the proposal.
-			var extInfo cometabci.ExtendedCommitInfo
-			extInfo, err = h.extendedCommitCodec.Decode(extCommitBz)
-			if err != nil {
-				h.logger.Error("failed to unmarshal commit info", "err", err)
-				err = connectabci.CodecError{
-					Err: err,
+			// Validate the vote extensions included in the proposal, and record the result for the
+			// PriceAppliers of ExtendVote and PreBlock.
+			validateErr := h.validateOracleData(ctx, req.Height, extCommitBz)
+			h.oracleDataValidations.set(req.Height, req.Hash, validateErr)
+			if validateErr != nil {
+				if !h.acceptInvalidOracleData {
+					err = validateErr
+					return &cometabci.ResponseProcessProposal{Status: cometabci.ResponseProcessProposal_REJECT},
+						err
 				}
-				return &cometabci.ResponseProcessProposal{Status: cometabci.ResponseProcessProposal_REJECT},
-					err
-			}
 
-			if err := h.ValidateExtendedCommitInfo(ctx, req.Height, extInfo); err != nil {
-				h.logger.Error(
-					"failed to validate vote extensions",
+				// accept the proposal, the invalid oracle data is skipped when the block is finalized
+				h.logger.Info(
+					"accepting proposal with invalid oracle data; prices will not be updated",
 					"height", req.Height,
-					"commit_info", extInfo,
-					"err", err,
+					"err", validateErr,
 				)
-				err = InvalidExtendedCommitInfoError{
-					Err: err,
+				noPriceUpdateErr = connectabci.NoPriceUpdateError{
+					Err: validateErr,
 				}
-
-				return &cometabci.ResponseProcessProposal{Status: cometabci.ResponseProcessProposal_REJECT},
-					err
 			}
 
 			// observe the size of the extended commit info
@@ -334,8 +363,8 @@ func (h *ProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
 
 			// Remove the extended commit info from the proposal if required
 			if !h.retainOracleDataInWrappedHandler {
-				injectedTx = req.Txs[connectabci.OracleInfoIndex]
-				req.Txs = req.Txs[connectabci.NumInjectedTxs:]
+				proposalTxs = req.Txs
+				req.Txs = h.injection.Remove(req.Txs, injectedIndex)
 			}
 		}
 
@@ -349,9 +378,9 @@ func (h *ProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
 			}
 		}
 
-		if !h.retainOracleDataInWrappedHandler && injectedTx != nil {
-			// Re-inject the extended commit info back into the response if it was removed
-			req.Txs = append([][]byte{injectedTx}, req.Txs...)
+		if !h.retainOracleDataInWrappedHandler && proposalTxs != nil {
+			// Re-inject the extended commit info back into the request if it was removed
+			req.Txs = proposalTxs
 		}
 
 		wrappedProcessProposalLatency = time.Since(wrappedProcessProposalStartTime)
@@ -23,6 +23,7 @@ import (
 	"github.com/skip-mev/connect/v2/abci/testutils"
 	"github.com/skip-mev/connect/v2/abci/types"
 	"github.com/skip-mev/connect/v2/abci/ve"
+	vetypes "github.com/skip-mev/connect/v2/abci/ve/types"
 	servicemetrics "github.com/skip-mev/connect/v2/service/metrics"
 	servicemetricsmocks "github.com/skip-mev/connect/v2/service/metrics/mocks"
 )
@@ -567,6 +568,89 @@ func (s *ProposalsTestSuite) TestPrepareProposalRetainOracleData() {
 	})
 }
 
+func (s *ProposalsTestSuite) TestTxInjection() {
+	exCodec := codec.NewDefaultExtendedCommitCodec()
+	veCodec := codec.NewDefaultVoteExtensionCodec()
+
+	emptyVote, err := testutils.CreateExtendedVoteInfo(val1, map[uint64][]byte{}, veCodec)
+	s.Require().NoError(err)
+
+	extendedCommit := cometabci.ExtendedCommitInfo{
+		Round: 1,
+		Votes: []cometabci.ExtendedVoteInfo{
+			emptyVote,
+		},
+	}
+	bz, err := exCodec.Encode(extendedCommit)
+	s.Require().NoError(err)
+
+	oracleInjection := types.NewMarkerTxInjection([]byte("oracle:"), 1)
+	otherInjection := types.NewMarkerTxInjection([]byte("other:"), 0)
+	otherTx := otherInjection.Wrap([]byte("other data"))
+
+	registry := types.NewInjectionRegistry()
+	s.Require().NoError(registry.Register("oracle", oracleInjection))
+	s.Require().NoError(registry.Register("other", otherInjection))
+
+	newHandler := func() *proposals.ProposalHandler {
+		cpStrategy := currencypairmocks.NewCurrencyPairStrategy(s.T())
+		cpStrategy.On("GetMaxNumCP", mock.Anything).Return(uint64(0), nil).Maybe()
+
+		return proposals.NewProposalHandler(
+			log.NewNopLogger(),
+			func(_ sdk.Context, rpp *cometabci.RequestPrepareProposal) (*cometabci.ResponsePrepareProposal, error) {
+				// another vote-extension consumer injects its tx at the start of the proposal
+				return &cometabci.ResponsePrepareProposal{Txs: append([][]byte{otherTx}, rpp.Txs...)}, nil
+			},
+			func(_ sdk.Context, rpp *cometabci.RequestProcessProposal) (*cometabci.ResponseProcessProposal, error) {
+				// the oracle data is removed, and the other consumer's tx is retained
+				s.Require().Equal([][]byte{otherTx, []byte("tx")}, rpp.Txs)
+				s.Require().Equal([][]byte{[]byte("tx")}, registry.RemoveInjected(rpp.Txs))
+				return &cometabci.ResponseProcessProposal{Status: cometabci.ResponseProcessProposal_ACCEPT}, nil
+			},
+			ve.NoOpValidateVoteExtensions,
+			veCodec,
+			exCodec,
+			cpStrategy,
+			servicemetrics.NewNopMetrics(),
+			proposals.WithTxInjection(oracleInjection),
+		)
+	}
+
+	// enable VE
+	s.ctx = testutils.CreateBaseSDKContext(s.T())
+	s.ctx = testutils.UpdateContextWithVEHeight(s.ctx, 3)
+	s.ctx = s.ctx.WithBlockHeight(4)
+
+	s.Run("injects the marked extended commit at the configured index", func() {
+		req := s.createRequestPrepareProposal(extendedCommit, [][]byte{[]byte("tx")}, 4)
+
+		resp, err := newHandler().PrepareProposalHandler()(s.ctx, req)
+		s.Require().NoError(err)
+		s.Require().Equal([][]byte{otherTx, oracleInjection.Wrap(bz), []byte("tx")}, resp.Txs)
+	})
+
+	s.Run("locates the marked extended commit at any index", func() {
+		proposal := [][]byte{otherTx, []byte("tx"), oracleInjection.Wrap(bz)}
+		req := s.createRequestProcessProposal(proposal, cometabci.CommitInfo{Round: 1}, 4)
+
+		resp, err := newHandler().ProcessProposalHandler()(s.ctx, req)
+		s.Require().NoError(err)
+		s.Require().Equal(cometabci.ResponseProcessProposal_ACCEPT, resp.Status)
+
+		// the injected tx is restored
+		s.Require().Equal(proposal, req.Txs)
+	})
+
+	s.Run("rejects a proposal without the marked extended commit", func() {
+		req := s.createRequestProcessProposal([][]byte{bz, otherTx}, cometabci.CommitInfo{Round: 1}, 4)
+
+		resp, err := newHandler().ProcessProposalHandler()(s.ctx, req)
+		s.Require().ErrorIs(err, types.MissingCommitInfoError{})
+		s.Require().Equal(cometabci.ResponseProcessProposal_REJECT, resp.Status)
+	})
+}
+
 func (s *ProposalsTestSuite) TestProcessProposal() {
 	testCases := []struct {
 		name                 string
@@ -1427,6 +1511,46 @@ func (s *ProposalsTestSuite) TestProcessProposalStatus() {
 		_, err := propHandler.ProcessProposalHandler()(s.ctx, &cometabci.RequestProcessProposal{})
 		s.Require().Error(err, expErr)
 	})
+	// test accepting invalid oracle data
+	s.Run("test invalid oracle data is accepted w/o a price update", func() {
+		metricsMocks := servicemetricsmocks.NewMetrics(s.T())
+		codecErr := fmt.Errorf("error in codec")
+		c := codecmocks.NewExtendedCommitCodec(s.T())
+		propHandler := proposals.NewProposalHandler(
+			log.NewTestLogger(s.T()),
+			nil,
+			func(_ sdk.Context, rpp *cometabci.RequestProcessProposal) (*cometabci.ResponseProcessProposal, error) {
+				// the invalid oracle data is removed from the proposal
+				s.Require().Equal([][]byte{{4, 5, 6}}, rpp.Txs)
+				return &cometabci.ResponseProcessProposal{Status: cometabci.ResponseProcessProposal_ACCEPT}, nil
+			},
+			nil,
+			nil,
+			c,
+			nil,
+			metricsMocks,
+			proposals.AcceptInvalidOracleData(),
+		)
+		expErr := types.NoPriceUpdateError{
+			Err: types.CodecError{
+				Err: codecErr,
+			},
+		}
+		metricsMocks.On("AddABCIRequest", servicemetrics.ProcessProposal, expErr).Once()
+		metricsMocks.On("ObserveABCIMethodLatency", servicemetrics.ProcessProposal, mock.Anything).Return()
+		metricsMocks.On("ObserveMessageSize", servicemetrics.ExtendedCommit, 3).Once()
+
+		c.On("Decode", mock.Anything).Return(cometabci.ExtendedCommitInfo{}, codecErr)
+
+		s.ctx = testutils.UpdateContextWithVEHeight(s.ctx, 2)
+		s.ctx = s.ctx.WithBlockHeight(3)
+
+		resp, err := propHandler.ProcessProposalHandler()(s.ctx, &cometabci.RequestProcessProposal{
+			Txs: [][]byte{{1, 2, 3}, {4, 5, 6}},
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(cometabci.ResponseProcessProposal_ACCEPT, resp.Status)
+	})
 	// test codec failure
 	s.Run("test codec failure", func() {
 		metricsMocks := servicemetricsmocks.NewMetrics(s.T())
@@ -1461,6 +1585,91 @@ func (s *ProposalsTestSuite) TestProcessProposalStatus() {
 		})
 		s.Require().Error(err, expErr)
 	})
+	// test vote extension codec mismatch
+	s.Run("test vote extension codec mismatch", func() {
+		metricsMocks := servicemetricsmocks.NewMetrics(s.T())
+		codecErr := codec.CodecMismatchError{
+			Height: 2,
+			Errs:   []error{fmt.Errorf("error in codec")},
+		}
+		c := codecmocks.NewExtendedCommitCodec(s.T())
+		veCodec := codecmocks.NewVoteExtensionCodec(s.T())
+		propHandler := proposals.NewProposalHandler(
+			log.NewTestLogger(s.T()),
+			nil,
+			func(_ sdk.Context, _ *cometabci.RequestProcessProposal) (*cometabci.ResponseProcessProposal, error) {
+				return nil, nil
+			},
+			func(_ sdk.Context, _ cometabci.ExtendedCommitInfo) error {
+				return nil
+			},
+			veCodec,
+			c,
+			nil,
+			metricsMocks,
+		)
+		expErr := types.CodecError{
+			Err: codecErr,
+		}
+		metricsMocks.On("AddABCIRequest", servicemetrics.ProcessProposal, expErr).Once()
+		metricsMocks.On("ObserveABCIMethodLatency", servicemetrics.ProcessProposal, mock.Anything).Return()
+		c.On("Decode", mock.Anything).Return(cometabci.ExtendedCommitInfo{
+			Votes: []cometabci.ExtendedVoteInfo{
+				{VoteExtension: []byte{1, 2, 3}},
+			},
+		}, nil)
+		veCodec.On("Decode", []byte{1, 2, 3}).Return(vetypes.OracleVoteExtension{}, codecErr)
+
+		// make vote-extensions enabled
+		s.ctx = testutils.UpdateContextWithVEHeight(s.ctx, 2)
+		s.ctx = s.ctx.WithBlockHeight(3)
+
+		_, err := propHandler.ProcessProposalHandler()(s.ctx, &cometabci.RequestProcessProposal{
+			Txs: [][]byte{{1, 2, 3}},
+		})
+		s.Require().Error(err, expErr)
+	})
+	// test vote extension decode failure
+	s.Run("test vote extension decode failure", func() {
+		metricsMocks := servicemetricsmocks.NewMetrics(s.T())
+		codecErr := fmt.Errorf("error in codec")
+		c := codecmocks.NewExtendedCommitCodec(s.T())
+		veCodec := codecmocks.NewVoteExtensionCodec(s.T())
+		propHandler := proposals.NewProposalHandler(
+			log.NewTestLogger(s.T()),
+			nil,
+			func(_ sdk.Context, _ *cometabci.RequestProcessProposal) (*cometabci.ResponseProcessProposal, error) {
+				return nil, nil
+			},
+			func(_ sdk.Context, _ cometabci.ExtendedCommitInfo) error {
+				return nil
+			},
+			veCodec,
+			c,
+			nil,
+			metricsMocks,
+		)
+		expErr := proposals.InvalidExtendedCommitInfoError{
+			Err: codecErr,
+		}
+		metricsMocks.On("AddABCIRequest", servicemetrics.ProcessProposal, expErr).Once()
+		metricsMocks.On("ObserveABCIMethodLatency", servicemetrics.ProcessProposal, mock.Anything).Return()
+		c.On("Decode", mock.Anything).Return(cometabci.ExtendedCommitInfo{
+			Votes: []cometabci.ExtendedVoteInfo{
+				{VoteExtension: []byte{1, 2, 3}},
+			},
+		}, nil)
+		veCodec.On("Decode", []byte{1, 2, 3}).Return(vetypes.OracleVoteExtension{}, codecErr)
+
+		// make vote-extensions enabled
+		s.ctx = testutils.UpdateContextWithVEHeight(s.ctx, 2)
+		s.ctx = s.ctx.WithBlockHeight(3)
+
+		_, err := propHandler.ProcessProposalHandler()(s.ctx, &cometabci.RequestProcessProposal{
+			Txs: [][]byte{{1, 2, 3}},
+		})
+		s.Require().Error(err, expErr)
+	})
 	// test invalid extended commit
 	s.Run("test invalid extended commit", func() {
 		metricsMocks := servicemetricsmocks.NewMetrics(s.T())
@@ -1538,6 +1747,75 @@ func (s *ProposalsTestSuite) TestExtendedCommitSize() {
 	s.Require().NoError(err)
 }
 
+func (s *ProposalsTestSuite) TestValidateOracleData() {
+	validateErr := fmt.Errorf("invalid vote extensions")
+	newHandler := func(validations *int) *proposals.ProposalHandler {
+		return proposals.NewProposalHandler(
+			log.NewTestLogger(s.T()),
+			nil,
+			func(_ sdk.Context, _ *cometabci.RequestProcessProposal) (*cometabci.ResponseProcessProposal, error) {
+				return &cometabci.ResponseProcessProposal{Status: cometabci.ResponseProcessProposal_ACCEPT}, nil
+			},
+			func(_ sdk.Context, _ cometabci.ExtendedCommitInfo) error {
+				*validations++
+				return validateErr
+			},
+			s.codec,
+			s.extCommitCodec,
+			nil,
+			servicemetrics.NewNopMetrics(),
+			proposals.AcceptInvalidOracleData(),
+		)
+	}
+
+	extCommitBz, err := s.extCommitCodec.Encode(cometabci.ExtendedCommitInfo{})
+	s.Require().NoError(err)
+	req := &cometabci.RequestFinalizeBlock{
+		Txs:    [][]byte{extCommitBz},
+		Height: 3,
+		Hash:   []byte("block"),
+	}
+
+	s.ctx = testutils.UpdateContextWithVEHeight(s.ctx, 2).WithBlockHeight(3)
+
+	s.Run("the decision made in ProcessProposal is reused", func() {
+		validations := 0
+		handler := newHandler(&validations)
+
+		_, err := handler.ProcessProposalHandler()(s.ctx, &cometabci.RequestProcessProposal{
+			Txs:    req.Txs,
+			Height: req.Height,
+			Hash:   req.Hash,
+		})
+		s.Require().NoError(err)
+
+		err = handler.ValidateOracleData(s.ctx, req)
+		s.Require().ErrorIs(err, proposals.InvalidExtendedCommitInfoError{Err: validateErr})
+		s.Require().Equal(1, validations)
+	})
+
+	s.Run("blocks that were not processed cannot be validated without the comet info", func() {
+		validations := 0
+		handler := newHandler(&validations)
+
+		err := handler.ValidateOracleData(s.ctx, req)
+		s.Require().ErrorIs(err, types.OracleDataNotValidatedError{Height: 3})
+		s.Require().Equal(0, validations)
+	})
+
+	s.Run("blocks that were not processed are validated once with the comet info", func() {
+		validations := 0
+		handler := newHandler(&validations)
+
+		ctx := s.ctx.WithCometInfo(baseapp.NewBlockInfo(nil, nil, nil, cometabci.CommitInfo{}))
+		for i := 0; i < 2; i++ {
+			err := handler.ValidateOracleData(ctx, req)
+			s.Require().ErrorIs(err, proposals.InvalidExtendedCommitInfoError{Err: validateErr})
+		}
+		s.Require().Equal(1, validations)
+	})
+}
+
 func (s *ProposalsTestSuite) TestValidateExtendedCommitInfoProcess() {
 	s.Run("should fail for nil request", func() {
 	})
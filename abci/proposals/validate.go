@@ -1,15 +1,121 @@
 package proposals
 
 import (
+	"errors"
+	"sync"
+
 	cometabci "github.com/cometbft/cometbft/abci/types"
 	cometproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/skip-mev/connect/v2/abci/strategies/codec"
 	"github.com/skip-mev/connect/v2/abci/strategies/currencypair"
+	connectabci "github.com/skip-mev/connect/v2/abci/types"
 	"github.com/skip-mev/connect/v2/abci/ve"
 )
 
+// ValidateOracleData validates the oracle data injected into the given block as the ProcessProposalHandler does.
+// It returns a MissingCommitInfoError if the block does not contain the extended commit info, a CodecError if
+// the extended commit info fails to decode or a vote extension was encoded with a codec that is not scheduled
+// at its height, and an InvalidExtendedCommitInfoError otherwise. It may be given to the PriceAppliers of the
+// VoteExtensionHandler and of the PreBlockHandler to skip the price update of blocks accepted with invalid
+// oracle data, see AcceptInvalidOracleData.
+//
+// The decision is made once per block: the result recorded when the block was processed in ProcessProposal is
+// returned if there is one. Otherwise, the oracle data is validated and the result recorded, which requires the
+// comet info of the block, i.e. a PreBlock context. If neither is available, e.g. in ExtendVote, an
+// OracleDataNotValidatedError is returned.
+func (h *ProposalHandler) ValidateOracleData(ctx sdk.Context, req *cometabci.RequestFinalizeBlock) error {
+	if ok, err := h.oracleDataValidations.get(req.Height, req.Hash); ok {
+		return err
+	}
+
+	if ctx.CometInfo() == nil {
+		return connectabci.OracleDataNotValidatedError{
+			Height: req.Height,
+		}
+	}
+
+	_, extCommitBz, err := h.injection.Locate(req.Txs)
+	if err == nil {
+		err = h.validateOracleData(ctx, req.Height, extCommitBz)
+	}
+
+	h.oracleDataValidations.set(req.Height, req.Hash, err)
+	return err
+}
+
+// oracleDataValidations records the result of the validation of the oracle data of each block, by height and
+// block hash. Only the results of the latest height are retained.
+type oracleDataValidations struct {
+	mtx     sync.Mutex
+	height  int64
+	results map[string]error
+}
+
+// set records the result of the validation of the oracle data of the given block, discarding the results of
+// previous heights.
+func (v *oracleDataValidations) set(height int64, hash []byte, err error) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+
+	if height < v.height {
+		return
+	}
+
+	if height > v.height || v.results == nil {
+		v.height = height
+		v.results = make(map[string]error)
+	}
+
+	v.results[string(hash)] = err
+}
+
+// get returns whether the validation of the oracle data of the given block was recorded, and its result.
+func (v *oracleDataValidations) get(height int64, hash []byte) (bool, error) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+
+	if height != v.height {
+		return false, nil
+	}
+
+	err, ok := v.results[string(hash)]
+	return ok, err
+}
+
+// validateOracleData decodes and validates the given extended commit info.
+func (h *ProposalHandler) validateOracleData(ctx sdk.Context, height int64, extCommitBz []byte) error {
+	extInfo, err := codec.ExtendedCommitCodecForHeight(h.extendedCommitCodec, height).Decode(extCommitBz)
+	if err != nil {
+		h.logger.Error("failed to unmarshal commit info", "err", err)
+		return connectabci.CodecError{
+			Err: err,
+		}
+	}
+
+	if err := h.ValidateExtendedCommitInfo(ctx, height, extInfo); err != nil {
+		h.logger.Error(
+			"failed to validate vote extensions",
+			"height", height,
+			"commit_info", extInfo,
+			"err", err,
+		)
+
+		// vote extensions encoded with a codec that is not scheduled at the height are reported as codec errors
+		var codecErr connectabci.CodecError
+		if !errors.As(err, &codecErr) {
+			err = InvalidExtendedCommitInfoError{
+				Err: err,
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
 // ValidateExtendedCommitInfo validates the extended commit info for a block. It first
 // ensures that the vote extensions compose a super-majority of the signatures and
 // voting power for the block. Then, it ensures that oracle vote extensions are correctly
@@ -29,11 +135,12 @@ func (h *ProposalHandler) ValidateExtendedCommitInfo(
 		return err
 	}
 
-	// Validate all oracle vote extensions.
+	// Validate all oracle vote extensions. The vote extensions were created at the previous height.
+	veCodec := codec.VoteExtensionCodecForHeight(h.voteExtensionCodec, height-1)
 	for _, vote := range extendedCommitInfo.Votes {
 		address := sdk.ConsAddress(vote.Validator.Address)
 		// The vote extension are from the previous block.
-		if err := validateVoteExtension(ctx, vote, h.voteExtensionCodec, h.currencyPairStrategy); err != nil {
+		if err := validateVoteExtension(ctx, vote, veCodec, h.currencyPairStrategy); err != nil {
 			h.logger.Error(
 				"failed to validate oracle vote extension",
 				"height", height,
@@ -54,10 +161,11 @@ func (h *ProposalHandler) ValidateExtendedCommitInfo(
 func (h *ProposalHandler) PruneAndValidateExtendedCommitInfo(
 	ctx sdk.Context, extendedCommitInfo cometabci.ExtendedCommitInfo,
 ) (cometabci.ExtendedCommitInfo, error) {
-	// Validate all oracle vote extensions.
+	// Validate all oracle vote extensions. The vote extensions were created at the previous height.
+	veCodec := codec.VoteExtensionCodecForHeight(h.voteExtensionCodec, ctx.BlockHeight()-1)
 	for i, vote := range extendedCommitInfo.Votes {
 		// validate the vote-extension
-		if err := validateVoteExtension(ctx, vote, h.voteExtensionCodec, h.currencyPairStrategy); err != nil {
+		if err := validateVoteExtension(ctx, vote, veCodec, h.currencyPairStrategy); err != nil {
 			h.logger.Info(
 				"failed to validate vote extension - pruning vote",
 				"err", err,
@@ -98,6 +206,14 @@ func validateVoteExtension(
 
 	voteExt, err := voteExtensionCodec.Decode(vote.VoteExtension)
 	if err != nil {
+		// only vote extensions encoded with a codec that is not scheduled at the height are reported as codec
+		// errors, other decoding failures are invalid vote extensions
+		if errors.As(err, &codec.CodecMismatchError{}) {
+			return connectabci.CodecError{
+				Err: err,
+			}
+		}
+
 		return err
 	}
 
@@ -0,0 +1,92 @@
+package replay
+
+import (
+	"math/big"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+
+	"github.com/skip-mev/connect/v2/abci/strategies/codec"
+	"github.com/skip-mev/connect/v2/abci/strategies/currencypair"
+	"github.com/skip-mev/connect/v2/aggregator"
+	"github.com/skip-mev/connect/v2/pkg/math/voteweighted"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+)
+
+// AggregateFnConstructor constructs the aggregation function used to compute the final prices from a
+// ValidatorStore backed by the snapshot. Its signature matches the voteweighted constructors, i.e.
+// voteweighted.MedianFromContext and voteweighted.EqualWeightMedianFromContext.
+type AggregateFnConstructor func(
+	logger log.Logger,
+	validatorStore voteweighted.ValidatorStore,
+	threshold math.LegacyDec,
+	opts ...voteweighted.Option,
+) aggregator.AggregateFnFromContext[string, map[connecttypes.CurrencyPair]*big.Int]
+
+// Option is a function that enables optional configuration of a replay.
+type Option func(*config)
+
+// config defines the configuration of a replay.
+type config struct {
+	veCodec        codec.VoteExtensionCodec
+	extCommitCodec codec.ExtendedCommitCodec
+	strategyFn     func(currencypair.OracleKeeper) currencypair.CurrencyPairStrategy
+	threshold      math.LegacyDec
+	aggregateFn    AggregateFnConstructor
+	aggregateOpts  []voteweighted.Option
+}
+
+// WithCodecs sets the codecs used to decode the extended commit and the vote extensions. By default, the
+// uncompressed default codecs are used.
+func WithCodecs(veCodec codec.VoteExtensionCodec, extCommitCodec codec.ExtendedCommitCodec) Option {
+	return func(c *config) {
+		if veCodec == nil || extCommitCodec == nil {
+			panic("codecs cannot be nil")
+		}
+
+		c.veCodec = veCodec
+		c.extCommitCodec = extCommitCodec
+	}
+}
+
+// WithCurrencyPairStrategy sets the constructor of the currency pair strategy used to decode prices. The
+// strategy is constructed with an OracleKeeper backed by the snapshot. By default, the
+// DefaultCurrencyPairStrategy is used.
+func WithCurrencyPairStrategy(strategyFn func(currencypair.OracleKeeper) currencypair.CurrencyPairStrategy) Option {
+	return func(c *config) {
+		if strategyFn == nil {
+			panic("currency pair strategy cannot be nil")
+		}
+
+		c.strategyFn = strategyFn
+	}
+}
+
+// WithPowerThreshold sets the voting power threshold a currency pair must meet to be assigned a price. By
+// default, voteweighted.DefaultPowerThreshold is used.
+func WithPowerThreshold(threshold math.LegacyDec) Option {
+	return func(c *config) {
+		c.threshold = threshold
+	}
+}
+
+// WithAggregateFn sets the constructor of the aggregation function used to compute the final prices. The
+// same aggregation function as the chain must be configured. By default, voteweighted.MedianFromContext is
+// used.
+func WithAggregateFn(aggregateFn AggregateFnConstructor) Option {
+	return func(c *config) {
+		if aggregateFn == nil {
+			panic("aggregate function cannot be nil")
+		}
+
+		c.aggregateFn = aggregateFn
+	}
+}
+
+// WithAggregationOptions sets the options passed to the aggregation function, i.e.
+// voteweighted.WithPowerThresholdStore to apply per-market voting power thresholds.
+func WithAggregationOptions(opts ...voteweighted.Option) Option {
+	return func(c *config) {
+		c.aggregateOpts = append(c.aggregateOpts, opts...)
+	}
+}
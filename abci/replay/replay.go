@@ -0,0 +1,242 @@
+package replay
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/connect/v2/abci/strategies/aggregator"
+	"github.com/skip-mev/connect/v2/abci/strategies/codec"
+	"github.com/skip-mev/connect/v2/abci/strategies/currencypair"
+	"github.com/skip-mev/connect/v2/abci/ve"
+	"github.com/skip-mev/connect/v2/pkg/math/voteweighted"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+)
+
+// Result holds the intermediate values of each step of a replay.
+type Result struct {
+	// Round is the round of the extended commit.
+	Round int32
+
+	// Votes are the votes included in the extended commit, in order.
+	Votes []VoteResult
+
+	// CurrencyPairs are the aggregation inputs and outputs of each currency pair reported by at least
+	// one validator, sorted by currency pair.
+	CurrencyPairs []CurrencyPairResult
+
+	// Prices are the aggregated prices that would be written to state.
+	Prices map[connecttypes.CurrencyPair]*big.Int
+}
+
+// VoteResult is the outcome of decoding and validating a single vote extension.
+type VoteResult struct {
+	// Validator is the validator that submitted the vote.
+	Validator sdk.ConsAddress
+
+	// Power is the validator's voting power. It is nil if the validator is not in the validator set.
+	Power *math.Int
+
+	// Size is the size of the encoded vote extension.
+	Size int
+
+	// DecodeErr is set if the vote extension failed to decode.
+	DecodeErr error
+
+	// ValidateErr is set if the vote extension failed ValidateOracleVoteExtension. Such a vote extension would
+	// have caused the proposal to be rejected, so it is excluded from the aggregation.
+	ValidateErr error
+
+	// NumPrices is the number of prices in the vote extension.
+	NumPrices int
+
+	// Prices are the prices of the validator as decoded by the currency pair strategy.
+	Prices map[connecttypes.CurrencyPair]*big.Int
+}
+
+// Included returns true if the vote was included in the aggregation.
+func (v VoteResult) Included() bool {
+	return v.NumPrices > 0 && v.DecodeErr == nil && v.ValidateErr == nil
+}
+
+// CurrencyPairResult holds the aggregation inputs and output of a single currency pair.
+type CurrencyPairResult struct {
+	CurrencyPair connecttypes.CurrencyPair
+
+	// Reports are the prices reported by validators in the validator set, sorted by price.
+	Reports []PriceReport
+
+	// SubmittedPower is the total voting power that reported a price.
+	SubmittedPower math.Int
+
+	// TotalPower is the total voting power of the validator set.
+	TotalPower math.Int
+
+	// PercentSubmitted is SubmittedPower / TotalPower.
+	PercentSubmitted math.LegacyDec
+
+	// Threshold is the voting power threshold the currency pair must meet to be assigned a price, i.e. the
+	// per-market threshold if one is configured through the aggregation options.
+	Threshold math.LegacyDec
+
+	// Price is the aggregated price, or nil if the threshold is not met.
+	Price *big.Int
+}
+
+// PriceReport is a price reported by a validator, along with the cumulative voting power of the prices lower
+// than or equal to it.
+type PriceReport struct {
+	Validator       sdk.ConsAddress
+	Price           *big.Int
+	Power           math.Int
+	CumulativePower math.Int
+}
+
+// Replay reruns the decoding, validation and aggregation of the given extended commit, as
+// captured from the first transaction of a block, entirely in memory against the given snapshot. It mirrors the
+// ProcessProposal and PreBlock handlers, so that the price written to state for any currency pair can be
+// reproduced without a full node.
+func Replay(logger log.Logger, extendedCommit []byte, snapshot Snapshot, opts ...Option) (Result, error) {
+	cfg := config{
+		veCodec:        codec.NewDefaultVoteExtensionCodec(),
+		extCommitCodec: codec.NewDefaultExtendedCommitCodec(),
+		strategyFn: func(keeper currencypair.OracleKeeper) currencypair.CurrencyPairStrategy {
+			return currencypair.NewDefaultCurrencyPairStrategy(keeper)
+		},
+		threshold:   voteweighted.DefaultPowerThreshold,
+		aggregateFn: voteweighted.MedianFromContext,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	keeper := NewOracleKeeper(snapshot)
+	validatorStore := NewValidatorStore(snapshot)
+	strategy := cfg.strategyFn(keeper)
+
+	ctx := sdk.Context{}.
+		WithContext(context.Background()).
+		WithLogger(logger).
+		WithBlockHeight(snapshot.Height)
+
+	// Step 1: decode the extended commit included in the proposal.
+	extInfo, err := codec.ExtendedCommitCodecForHeight(cfg.extCommitCodec, snapshot.Height).Decode(extendedCommit)
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to decode extended commit: %w", err)
+	}
+
+	result := Result{
+		Round: extInfo.Round,
+		Votes: make([]VoteResult, 0, len(extInfo.Votes)),
+	}
+
+	// Step 2: decode and validate each vote extension as in ProcessProposal. The vote extensions were created
+	// at the previous height.
+	veCodec := codec.VoteExtensionCodecForHeight(cfg.veCodec, snapshot.Height-1)
+	validateCtx := ctx.WithExecMode(sdk.ExecModeProcessProposal)
+
+	votes := make([]aggregator.Vote, 0, len(extInfo.Votes))
+	for _, vote := range extInfo.Votes {
+		vr := VoteResult{
+			Validator: vote.Validator.Address,
+			Size:      len(vote.VoteExtension),
+		}
+		if power, ok := validatorStore.Power(vr.Validator); ok {
+			vr.Power = &power
+		}
+
+		voteExt, err := veCodec.Decode(vote.VoteExtension)
+		if err != nil {
+			vr.DecodeErr = err
+			result.Votes = append(result.Votes, vr)
+			continue
+		}
+		vr.NumPrices = len(voteExt.Prices)
+
+		if vr.ValidateErr = ve.ValidateOracleVoteExtension(validateCtx, voteExt, strategy); vr.ValidateErr == nil {
+			votes = append(votes, aggregator.Vote{
+				ConsAddress:         vr.Validator,
+				OracleVoteExtension: voteExt,
+			})
+		}
+
+		result.Votes = append(result.Votes, vr)
+	}
+
+	// Step 3: aggregate the prices as in PreBlock.
+	finalizeCtx := ctx.WithExecMode(sdk.ExecModeFinalize)
+	voteAggregator := aggregator.NewDefaultVoteAggregator(
+		logger,
+		cfg.aggregateFn(logger, validatorStore, cfg.threshold, cfg.aggregateOpts...),
+		strategy,
+	)
+
+	result.Prices, err = voteAggregator.AggregateOracleVotes(finalizeCtx, votes)
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to aggregate votes: %w", err)
+	}
+
+	// Step 4: collect the aggregation inputs of each currency pair.
+	reports := make(map[connecttypes.CurrencyPair][]PriceReport)
+	for i, vr := range result.Votes {
+		if !vr.Included() {
+			continue
+		}
+
+		result.Votes[i].Prices = voteAggregator.GetPriceForValidator(vr.Validator)
+		if vr.Power == nil {
+			continue
+		}
+
+		for cp, price := range result.Votes[i].Prices {
+			reports[cp] = append(reports[cp], PriceReport{
+				Validator: vr.Validator,
+				Price:     price,
+				Power:     *vr.Power,
+			})
+		}
+	}
+
+	totalPower, _ := validatorStore.TotalBondedTokens(finalizeCtx)
+	for cp, cpReports := range reports {
+		sort.SliceStable(cpReports, func(i, j int) bool {
+			return cpReports[i].Price.Cmp(cpReports[j].Price) < 0
+		})
+
+		submitted := math.ZeroInt()
+		for i := range cpReports {
+			submitted = submitted.Add(cpReports[i].Power)
+			cpReports[i].CumulativePower = submitted
+		}
+
+		threshold, err := voteweighted.GetPowerThreshold(finalizeCtx, cp, cfg.threshold, cfg.aggregateOpts...)
+		if err != nil {
+			return Result{}, fmt.Errorf("failed to get power threshold for %s: %w", cp, err)
+		}
+
+		cpResult := CurrencyPairResult{
+			CurrencyPair:     cp,
+			Reports:          cpReports,
+			SubmittedPower:   submitted,
+			TotalPower:       totalPower,
+			PercentSubmitted: math.LegacyZeroDec(),
+			Threshold:        threshold,
+			Price:            result.Prices[cp],
+		}
+		if totalPower.IsPositive() {
+			cpResult.PercentSubmitted = math.LegacyNewDecFromInt(submitted).Quo(math.LegacyNewDecFromInt(totalPower))
+		}
+
+		result.CurrencyPairs = append(result.CurrencyPairs, cpResult)
+	}
+	sort.Slice(result.CurrencyPairs, func(i, j int) bool {
+		return result.CurrencyPairs[i].CurrencyPair.String() < result.CurrencyPairs[j].CurrencyPair.String()
+	})
+
+	return result, nil
+}
@@ -0,0 +1,204 @@
+package replay_test
+
+import (
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	cometabci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/abci/replay"
+	"github.com/skip-mev/connect/v2/abci/strategies/codec"
+	"github.com/skip-mev/connect/v2/abci/strategies/currencypair"
+	"github.com/skip-mev/connect/v2/abci/testutils"
+	"github.com/skip-mev/connect/v2/aggregator"
+	"github.com/skip-mev/connect/v2/pkg/math/voteweighted"
+	"github.com/skip-mev/connect/v2/pkg/math/voteweighted/mocks"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+)
+
+var (
+	btcUSD = connecttypes.NewCurrencyPair("BTC", "USD")
+	ethUSD = connecttypes.NewCurrencyPair("ETH", "USD")
+
+	val1 = sdk.ConsAddress("val1")
+	val2 = sdk.ConsAddress("val2")
+	val3 = sdk.ConsAddress("val3")
+	val4 = sdk.ConsAddress("val4")
+)
+
+func encodePrice(t *testing.T, price int64) []byte {
+	t.Helper()
+
+	bz, err := big.NewInt(price).GobEncode()
+	require.NoError(t, err)
+
+	return bz
+}
+
+func snapshot() replay.Snapshot {
+	return replay.Snapshot{
+		Height: 10,
+		Validators: []replay.Validator{
+			{ConsAddress: val1, Power: math.NewInt(40)},
+			{ConsAddress: val2, Power: math.NewInt(30)},
+			{ConsAddress: val3, Power: math.NewInt(20)},
+			{ConsAddress: val4, Power: math.NewInt(10)},
+		},
+		CurrencyPairs: map[uint64]connecttypes.CurrencyPair{
+			0: btcUSD,
+			1: ethUSD,
+		},
+	}
+}
+
+func TestReplay(t *testing.T) {
+	veCodec := codec.NewDefaultVoteExtensionCodec()
+	extCommitCodec := codec.NewDefaultExtendedCommitCodec()
+
+	vote := func(val sdk.ConsAddress, prices map[uint64][]byte) cometabci.ExtendedVoteInfo {
+		info, err := testutils.CreateExtendedVoteInfo(val, prices, veCodec)
+		require.NoError(t, err)
+		return info
+	}
+
+	t.Run("replays the stake-weighted median", func(t *testing.T) {
+		_, bz, err := testutils.CreateExtendedCommitInfo([]cometabci.ExtendedVoteInfo{
+			vote(val1, map[uint64][]byte{0: encodePrice(t, 100), 1: encodePrice(t, 10)}),
+			vote(val2, map[uint64][]byte{0: encodePrice(t, 101)}),
+			vote(val3, map[uint64][]byte{0: encodePrice(t, 102), 1: encodePrice(t, 11)}),
+			vote(val4, nil),
+		}, extCommitCodec)
+		require.NoError(t, err)
+
+		result, err := replay.Replay(log.NewNopLogger(), bz, snapshot())
+		require.NoError(t, err)
+
+		require.Len(t, result.Votes, 4)
+		require.True(t, result.Votes[0].Included())
+		require.Equal(t, math.NewInt(40), *result.Votes[0].Power)
+		require.Equal(t, map[connecttypes.CurrencyPair]*big.Int{btcUSD: big.NewInt(100), ethUSD: big.NewInt(10)}, result.Votes[0].Prices)
+		require.False(t, result.Votes[3].Included())
+
+		// ETH/USD is only reported by 60% of the voting power
+		require.Equal(t, map[connecttypes.CurrencyPair]*big.Int{btcUSD: big.NewInt(101)}, result.Prices)
+
+		require.Len(t, result.CurrencyPairs, 2)
+		btc := result.CurrencyPairs[0]
+		require.Equal(t, btcUSD, btc.CurrencyPair)
+		require.Equal(t, math.NewInt(90), btc.SubmittedPower)
+		require.Equal(t, math.NewInt(100), btc.TotalPower)
+		require.Equal(t, math.LegacyMustNewDecFromStr("0.9"), btc.PercentSubmitted)
+		require.Equal(t, big.NewInt(101), btc.Price)
+		require.Len(t, btc.Reports, 3)
+		require.Equal(t, val1, btc.Reports[0].Validator)
+		require.Equal(t, math.NewInt(70), btc.Reports[1].CumulativePower)
+
+		eth := result.CurrencyPairs[1]
+		require.Equal(t, ethUSD, eth.CurrencyPair)
+		require.Equal(t, math.LegacyMustNewDecFromStr("0.6"), eth.PercentSubmitted)
+		require.Nil(t, eth.Price)
+	})
+
+	t.Run("lowers the threshold", func(t *testing.T) {
+		_, bz, err := testutils.CreateExtendedCommitInfo([]cometabci.ExtendedVoteInfo{
+			vote(val1, map[uint64][]byte{1: encodePrice(t, 10)}),
+			vote(val3, map[uint64][]byte{1: encodePrice(t, 11)}),
+		}, extCommitCodec)
+		require.NoError(t, err)
+
+		result, err := replay.Replay(log.NewNopLogger(), bz, snapshot(), replay.WithPowerThreshold(math.LegacyMustNewDecFromStr("0.5")))
+		require.NoError(t, err)
+		require.Equal(t, map[connecttypes.CurrencyPair]*big.Int{ethUSD: big.NewInt(10)}, result.Prices)
+	})
+
+	t.Run("applies per-market thresholds", func(t *testing.T) {
+		_, bz, err := testutils.CreateExtendedCommitInfo([]cometabci.ExtendedVoteInfo{
+			vote(val1, map[uint64][]byte{0: encodePrice(t, 100), 1: encodePrice(t, 10)}),
+			vote(val3, map[uint64][]byte{0: encodePrice(t, 102), 1: encodePrice(t, 11)}),
+		}, extCommitCodec)
+		require.NoError(t, err)
+
+		thresholds := mocks.NewPowerThresholdStore(t)
+		thresholds.On("GetPowerThreshold", mock.Anything, btcUSD).Return(math.LegacyDec{}, false, nil)
+		thresholds.On("GetPowerThreshold", mock.Anything, ethUSD).Return(math.LegacyMustNewDecFromStr("0.5"), true, nil)
+
+		result, err := replay.Replay(log.NewNopLogger(), bz, snapshot(), replay.WithAggregationOptions(voteweighted.WithPowerThresholdStore(thresholds)))
+		require.NoError(t, err)
+		require.Equal(t, map[connecttypes.CurrencyPair]*big.Int{ethUSD: big.NewInt(10)}, result.Prices)
+
+		require.Len(t, result.CurrencyPairs, 2)
+		require.Equal(t, voteweighted.DefaultPowerThreshold, result.CurrencyPairs[0].Threshold)
+		require.Equal(t, math.LegacyMustNewDecFromStr("0.5"), result.CurrencyPairs[1].Threshold)
+	})
+
+	t.Run("uses the configured aggregation function", func(t *testing.T) {
+		_, bz, err := testutils.CreateExtendedCommitInfo([]cometabci.ExtendedVoteInfo{
+			vote(val1, map[uint64][]byte{0: encodePrice(t, 100)}),
+			vote(val2, map[uint64][]byte{0: encodePrice(t, 101)}),
+			vote(val3, map[uint64][]byte{0: encodePrice(t, 102)}),
+		}, extCommitCodec)
+		require.NoError(t, err)
+
+		// the quorum requires every validator to report a price
+		result, err := replay.Replay(log.NewNopLogger(), bz, snapshot(), replay.WithAggregateFn(
+			func(
+				logger log.Logger,
+				validatorStore voteweighted.ValidatorStore,
+				threshold math.LegacyDec,
+				opts ...voteweighted.Option,
+			) aggregator.AggregateFnFromContext[string, map[connecttypes.CurrencyPair]*big.Int] {
+				return voteweighted.MedianWithQuorumFromContext(logger, validatorStore, threshold, 4, opts...)
+			},
+		))
+		require.NoError(t, err)
+		require.Empty(t, result.Prices)
+	})
+
+	t.Run("reports invalid vote extensions and excludes them", func(t *testing.T) {
+		// a vote extension with more prices than currency pairs fails validation
+		_, bz, err := testutils.CreateExtendedCommitInfo([]cometabci.ExtendedVoteInfo{
+			vote(val1, map[uint64][]byte{0: encodePrice(t, 100), 1: encodePrice(t, 10), 2: encodePrice(t, 1)}),
+			{Validator: cometabci.Validator{Address: val2}, VoteExtension: []byte("invalid")},
+			vote(val3, map[uint64][]byte{0: encodePrice(t, 102)}),
+		}, extCommitCodec)
+		require.NoError(t, err)
+
+		result, err := replay.Replay(log.NewNopLogger(), bz, snapshot())
+		require.NoError(t, err)
+
+		require.Error(t, result.Votes[0].ValidateErr)
+		require.Error(t, result.Votes[1].DecodeErr)
+		require.True(t, result.Votes[2].Included())
+		require.Empty(t, result.Prices)
+	})
+
+	t.Run("decodes prices with the configured strategy", func(t *testing.T) {
+		s := snapshot()
+		s.Prices = map[connecttypes.CurrencyPair]*big.Int{btcUSD: big.NewInt(100)}
+
+		_, bz, err := testutils.CreateExtendedCommitInfo([]cometabci.ExtendedVoteInfo{
+			vote(val1, map[uint64][]byte{0: encodePrice(t, 1)}),
+			vote(val2, map[uint64][]byte{0: encodePrice(t, 1)}),
+			vote(val3, map[uint64][]byte{0: encodePrice(t, -1)}),
+		}, extCommitCodec)
+		require.NoError(t, err)
+
+		result, err := replay.Replay(log.NewNopLogger(), bz, s, replay.WithCurrencyPairStrategy(
+			func(keeper currencypair.OracleKeeper) currencypair.CurrencyPairStrategy {
+				return currencypair.NewDeltaCurrencyPairStrategy(keeper)
+			},
+		))
+		require.NoError(t, err)
+		require.Equal(t, map[connecttypes.CurrencyPair]*big.Int{btcUSD: big.NewInt(101)}, result.Prices)
+	})
+
+	t.Run("fails on an invalid extended commit", func(t *testing.T) {
+		_, err := replay.Replay(log.NewNopLogger(), []byte("invalid"), snapshot())
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,160 @@
+package replay
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/skip-mev/connect/v2/abci/strategies/currencypair"
+	"github.com/skip-mev/connect/v2/pkg/math/voteweighted"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+var (
+	_ currencypair.OracleKeeper   = (*OracleKeeper)(nil)
+	_ voteweighted.ValidatorStore = (*ValidatorStore)(nil)
+	_ stakingtypes.ValidatorI     = validator{}
+)
+
+// Validator is a validator in the validator-set snapshot.
+type Validator struct {
+	// ConsAddress is the validator's consensus address.
+	ConsAddress sdk.ConsAddress
+
+	// Power is the validator's voting power, i.e. the weight of its prices in the stake-weighted median.
+	Power math.Int
+}
+
+// Snapshot is the application state required to replay the aggregation of an extended commit.
+type Snapshot struct {
+	// Height is the height of the block the extended commit is included in.
+	Height int64
+
+	// Validators is the validator set, including validators that did not vote.
+	Validators []Validator
+
+	// CurrencyPairs maps the currency pair ids used in vote extensions to currency pairs.
+	CurrencyPairs map[uint64]connecttypes.CurrencyPair
+
+	// NumRemovedCurrencyPairs is the number of currency pairs removed in the block the vote extensions were
+	// created in. Vote extensions may include prices for them.
+	NumRemovedCurrencyPairs uint64
+
+	// Prices are the on-chain prices at the time the vote extensions were created. They are only required by
+	// currency pair strategies that encode prices relative to the on-chain price.
+	Prices map[connecttypes.CurrencyPair]*big.Int
+}
+
+// OracleKeeper is an in-memory implementation of the x/oracle state required by the currency pair strategies,
+// backed by a Snapshot.
+type OracleKeeper struct {
+	snapshot Snapshot
+	ids      map[connecttypes.CurrencyPair]uint64
+}
+
+// NewOracleKeeper returns a new OracleKeeper backed by the given snapshot.
+func NewOracleKeeper(snapshot Snapshot) *OracleKeeper {
+	ids := make(map[connecttypes.CurrencyPair]uint64, len(snapshot.CurrencyPairs))
+	for id, cp := range snapshot.CurrencyPairs {
+		ids[cp] = id
+	}
+
+	return &OracleKeeper{
+		snapshot: snapshot,
+		ids:      ids,
+	}
+}
+
+func (k *OracleKeeper) GetCurrencyPairFromID(_ context.Context, id uint64) (connecttypes.CurrencyPair, bool) {
+	cp, ok := k.snapshot.CurrencyPairs[id]
+	return cp, ok
+}
+
+func (k *OracleKeeper) GetIDForCurrencyPair(_ context.Context, cp connecttypes.CurrencyPair) (uint64, bool) {
+	id, ok := k.ids[cp]
+	return id, ok
+}
+
+func (k *OracleKeeper) GetPriceForCurrencyPair(_ context.Context, cp connecttypes.CurrencyPair) (oracletypes.QuotePrice, error) {
+	price, ok := k.snapshot.Prices[cp]
+	if !ok {
+		return oracletypes.QuotePrice{}, oracletypes.NewQuotePriceNotExistError(cp)
+	}
+
+	return oracletypes.QuotePrice{
+		Price: math.NewIntFromBigInt(price),
+	}, nil
+}
+
+func (k *OracleKeeper) GetNumCurrencyPairs(_ context.Context) (uint64, error) {
+	return uint64(len(k.snapshot.CurrencyPairs)), nil
+}
+
+func (k *OracleKeeper) GetNumRemovedCurrencyPairs(_ context.Context) (uint64, error) {
+	return k.snapshot.NumRemovedCurrencyPairs, nil
+}
+
+func (k *OracleKeeper) GetAllCurrencyPairs(_ context.Context) []connecttypes.CurrencyPair {
+	cps := make([]connecttypes.CurrencyPair, 0, len(k.snapshot.CurrencyPairs))
+	for _, cp := range k.snapshot.CurrencyPairs {
+		cps = append(cps, cp)
+	}
+
+	return cps
+}
+
+// validator is used for compatibility between stakingtypes.ValidatorI and a Validator.
+type validator struct {
+	stakingtypes.ValidatorI
+	power math.Int
+}
+
+// GetBondedTokens returns the power of the validator.
+func (v validator) GetBondedTokens() math.Int {
+	return v.power
+}
+
+// ValidatorStore is an in-memory implementation of the voteweighted.ValidatorStore, backed by a Snapshot.
+type ValidatorStore struct {
+	powers map[string]math.Int
+	total  math.Int
+}
+
+// NewValidatorStore returns a new ValidatorStore backed by the given snapshot.
+func NewValidatorStore(snapshot Snapshot) *ValidatorStore {
+	store := &ValidatorStore{
+		powers: make(map[string]math.Int, len(snapshot.Validators)),
+		total:  math.ZeroInt(),
+	}
+
+	for _, val := range snapshot.Validators {
+		store.powers[val.ConsAddress.String()] = val.Power
+		store.total = store.total.Add(val.Power)
+	}
+
+	return store
+}
+
+// Power returns the voting power of the given validator, and false if it is not in the validator set.
+func (s *ValidatorStore) Power(addr sdk.ConsAddress) (math.Int, bool) {
+	power, ok := s.powers[addr.String()]
+	return power, ok
+}
+
+func (s *ValidatorStore) ValidatorByConsAddr(_ context.Context, addr sdk.ConsAddress) (stakingtypes.ValidatorI, error) {
+	power, ok := s.Power(addr)
+	if !ok {
+		return nil, fmt.Errorf("could not find validator %s", addr.String())
+	}
+
+	return validator{power: power}, nil
+}
+
+func (s *ValidatorStore) TotalBondedTokens(_ context.Context) (math.Int, error) {
+	return s.total, nil
+}
@@ -134,6 +134,53 @@ func (_c *VoteAggregator_GetPriceForValidator_Call) RunAndReturn(run func(types.
 	return _c
 }
 
+// GetSpreads provides a mock function with no fields
+func (_m *VoteAggregator) GetSpreads() map[pkgtypes.CurrencyPair]*big.Int {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSpreads")
+	}
+
+	var r0 map[pkgtypes.CurrencyPair]*big.Int
+	if rf, ok := ret.Get(0).(func() map[pkgtypes.CurrencyPair]*big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[pkgtypes.CurrencyPair]*big.Int)
+		}
+	}
+
+	return r0
+}
+
+// VoteAggregator_GetSpreads_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSpreads'
+type VoteAggregator_GetSpreads_Call struct {
+	*mock.Call
+}
+
+// GetSpreads is a helper method to define mock.On call
+func (_e *VoteAggregator_Expecter) GetSpreads() *VoteAggregator_GetSpreads_Call {
+	return &VoteAggregator_GetSpreads_Call{Call: _e.mock.On("GetSpreads")}
+}
+
+func (_c *VoteAggregator_GetSpreads_Call) Run(run func()) *VoteAggregator_GetSpreads_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *VoteAggregator_GetSpreads_Call) Return(_a0 map[pkgtypes.CurrencyPair]*big.Int) *VoteAggregator_GetSpreads_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *VoteAggregator_GetSpreads_Call) RunAndReturn(run func() map[pkgtypes.CurrencyPair]*big.Int) *VoteAggregator_GetSpreads_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewVoteAggregator creates a new instance of VoteAggregator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewVoteAggregator(t interface {
@@ -0,0 +1,54 @@
+package aggregator
+
+import (
+	"math/big"
+
+	connectabci "github.com/skip-mev/connect/v2/abci/types"
+	"github.com/skip-mev/connect/v2/aggregator"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+)
+
+// VoteAggregatorOption is a function that enables optional configuration of the DefaultVoteAggregator.
+type VoteAggregatorOption func(*DefaultVoteAggregator)
+
+// WithSpreadFnFromContext returns a VoteAggregatorOption that configures the DefaultVoteAggregator to
+// compute a per currency-pair spread (i.e. a measure of the dispersion of validator prices) alongside
+// the aggregated price. The spread is computed over the same set of validator prices as the price.
+func WithSpreadFnFromContext(
+	spreadFn aggregator.AggregateFnFromContext[string, map[connecttypes.CurrencyPair]*big.Int],
+) VoteAggregatorOption {
+	return func(dva *DefaultVoteAggregator) {
+		dva.spreadFn = spreadFn
+	}
+}
+
+// PriceApplierOption is a function that enables optional configuration of the oracle PriceApplier.
+type PriceApplierOption func(*oraclePriceApplier)
+
+// WithTxInjection returns a PriceApplierOption that configures how the PriceApplier locates the extended
+// commit info in the proposal. It must match the TxInjection of the ProposalHandler. By default, the extended
+// commit info is expected to be the first tx of the proposal.
+func WithTxInjection(injection connectabci.TxInjection) PriceApplierOption {
+	return func(opa *oraclePriceApplier) {
+		if err := injection.ValidateBasic(); err != nil {
+			panic(err)
+		}
+
+		opa.injection = injection
+	}
+}
+
+// WithOracleDataValidation returns a PriceApplierOption that configures the PriceApplier to validate the
+// oracle data of each proposal before applying prices. If validation fails, no prices are applied and a
+// NoPriceUpdateError is returned. This must be set to the ValidateOracleData method of the ProposalHandler,
+// on the PriceAppliers of both the VoteExtensionHandler and the PreBlockHandler, when the ProposalHandler
+// accepts proposals with invalid oracle data.
+func WithOracleDataValidation(validateOracleDataFn ValidateOracleDataFn) PriceApplierOption {
+	return func(opa *oraclePriceApplier) {
+		if validateOracleDataFn == nil {
+			panic("validate oracle data function cannot be nil")
+		}
+
+		opa.validateOracleDataFn = validateOracleDataFn
+	}
+}
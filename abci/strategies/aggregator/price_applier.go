@@ -1,6 +1,7 @@
 package aggregator
 
 import (
+	"errors"
 	"math/big"
 
 	"cosmossdk.io/log"
@@ -45,8 +46,17 @@ type oraclePriceApplier struct {
 	// codecs
 	voteExtensionCodec  codec.VoteExtensionCodec
 	extendedCommitCodec codec.ExtendedCommitCodec
+
+	// injection locates the extended commit info in the proposal.
+	injection connectabcitypes.TxInjection
+
+	// validateOracleDataFn, if set, validates the oracle data of the proposal before prices are applied.
+	validateOracleDataFn ValidateOracleDataFn
 }
 
+// ValidateOracleDataFn validates the oracle data injected into the given block.
+type ValidateOracleDataFn func(ctx sdk.Context, req *cometabci.RequestFinalizeBlock) error
+
 // NewOraclePriceApplier returns a new oraclePriceApplier.
 func NewOraclePriceApplier(
 	va VoteAggregator,
@@ -54,20 +64,60 @@ func NewOraclePriceApplier(
 	voteExtensionCodec codec.VoteExtensionCodec,
 	extendedCommitCodec codec.ExtendedCommitCodec,
 	logger log.Logger,
+	opts ...PriceApplierOption,
 ) PriceApplier {
-	return &oraclePriceApplier{
+	opa := &oraclePriceApplier{
 		va:                  va,
 		ok:                  ok,
 		logger:              logger,
 		voteExtensionCodec:  voteExtensionCodec,
 		extendedCommitCodec: extendedCommitCodec,
+		injection:           connectabcitypes.DefaultTxInjection(),
+	}
+
+	for _, opt := range opts {
+		opt(opa)
 	}
+
+	return opa
 }
 
 func (opa *oraclePriceApplier) ApplyPricesFromVoteExtensions(ctx sdk.Context, req *cometabci.RequestFinalizeBlock) (map[connecttypes.CurrencyPair]*big.Int, error) {
+	// Blocks that were accepted with invalid oracle data do not update prices. If the oracle data could not be
+	// validated, prices are neither applied nor skipped.
+	if opa.validateOracleDataFn != nil {
+		if err := opa.validateOracleDataFn(ctx, req); err != nil {
+			if errors.As(err, &connectabcitypes.OracleDataNotValidatedError{}) {
+				opa.logger.Error(
+					"oracle data was not validated; cannot apply prices",
+					"height", req.Height,
+					"err", err,
+				)
+
+				return nil, err
+			}
+
+			opa.logger.Info(
+				"oracle data failed validation; skipping price update",
+				"height", req.Height,
+				"err", err,
+			)
+
+			return nil, connectabcitypes.NoPriceUpdateError{
+				Err: err,
+			}
+		}
+	}
+
 	// If vote extensions have been enabled, the extended commit info - which
 	// contains the vote extensions - must be included in the request.
-	votes, err := GetOracleVotes(req.Txs, opa.voteExtensionCodec, opa.extendedCommitCodec)
+	// The vote extensions were created at the previous height.
+	votes, err := GetOracleVotesFromInjection(
+		req.Txs,
+		opa.injection,
+		codec.VoteExtensionCodecForHeight(opa.voteExtensionCodec, req.Height-1),
+		codec.ExtendedCommitCodecForHeight(opa.extendedCommitCodec, req.Height),
+	)
 	if err != nil {
 		opa.logger.Error(
 			"failed to get extended commit info from proposal",
@@ -100,6 +150,8 @@ func (opa *oraclePriceApplier) ApplyPricesFromVoteExtensions(ctx sdk.Context, re
 		return nil, err
 	}
 
+	spreads := opa.va.GetSpreads()
+
 	currencyPairs := opa.ok.GetAllCurrencyPairs(ctx)
 	for _, cp := range currencyPairs {
 		price, ok := prices[cp]
@@ -129,6 +181,12 @@ func (opa *oraclePriceApplier) ApplyPricesFromVoteExtensions(ctx sdk.Context, re
 			BlockHeight:    uint64(ctx.BlockHeight()), //nolint:gosec
 		}
 
+		// Include the spread of the validator prices if one was computed.
+		if spread, ok := spreads[cp]; ok && spread != nil && spread.Sign() >= 0 {
+			spreadInt := math.NewIntFromBigInt(spread)
+			quotePrice.Spread = &spreadInt
+		}
+
 		if err := opa.ok.SetPriceForCurrencyPair(ctx, cp, quotePrice); err != nil {
 			opa.logger.Error(
 				"failed to set price for currency pair",
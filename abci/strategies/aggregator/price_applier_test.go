@@ -1,6 +1,7 @@
 package aggregator_test
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
 	"testing"
@@ -13,6 +14,7 @@ import (
 	"github.com/skip-mev/connect/v2/abci/strategies/aggregator/mocks"
 	"github.com/skip-mev/connect/v2/abci/strategies/codec"
 	"github.com/skip-mev/connect/v2/abci/testutils"
+	connectabci "github.com/skip-mev/connect/v2/abci/types"
 	abcimocks "github.com/skip-mev/connect/v2/abci/types/mocks"
 
 	"cosmossdk.io/log"
@@ -53,6 +55,51 @@ func TestPriceApplier(t *testing.T) {
 		require.Nil(t, prices)
 	})
 
+	t.Run("if oracle data fails validation, skip the price update", func(t *testing.T) {
+		validateErr := fmt.Errorf("invalid oracle data")
+		pa := aggregator.NewOraclePriceApplier(
+			va,
+			ok,
+			veCodec,
+			extCommitcodec,
+			log.NewNopLogger(),
+			aggregator.WithOracleDataValidation(func(_ sdk.Context, req *abcitypes.RequestFinalizeBlock) error {
+				require.Equal(t, int64(4), req.Height)
+				require.Equal(t, [][]byte{[]byte("garbage")}, req.Txs)
+				return validateErr
+			}),
+		)
+
+		prices, err := pa.ApplyPricesFromVoteExtensions(sdk.Context{}, &abcitypes.RequestFinalizeBlock{
+			Height: 4,
+			Txs:    [][]byte{[]byte("garbage")},
+		})
+		require.ErrorIs(t, err, connectabci.NoPriceUpdateError{Err: validateErr})
+		require.Nil(t, prices)
+	})
+
+	t.Run("if oracle data was not validated, neither apply nor skip the price update", func(t *testing.T) {
+		notValidatedErr := connectabci.OracleDataNotValidatedError{Height: 4}
+		pa := aggregator.NewOraclePriceApplier(
+			va,
+			ok,
+			veCodec,
+			extCommitcodec,
+			log.NewNopLogger(),
+			aggregator.WithOracleDataValidation(func(_ sdk.Context, _ *abcitypes.RequestFinalizeBlock) error {
+				return notValidatedErr
+			}),
+		)
+
+		prices, err := pa.ApplyPricesFromVoteExtensions(sdk.Context{}, &abcitypes.RequestFinalizeBlock{
+			Height: 4,
+			Txs:    [][]byte{[]byte("garbage")},
+		})
+		require.ErrorIs(t, err, notValidatedErr)
+		require.False(t, errors.As(err, &connectabci.NoPriceUpdateError{}))
+		require.Nil(t, prices)
+	})
+
 	t.Run("if vote aggregation fails, fail", func(t *testing.T) {
 		prices := map[uint64][]byte{
 			1: []byte("price1"),
@@ -128,6 +175,7 @@ func TestPriceApplier(t *testing.T) {
 		}).Return(map[connecttypes.CurrencyPair]*big.Int{
 			cp: big.NewInt(-100),
 		}, nil)
+		va.On("GetSpreads").Return(map[connecttypes.CurrencyPair]*big.Int{}).Once()
 
 		ok.On("GetAllCurrencyPairs", ctx).Return(
 			[]connecttypes.CurrencyPair{cp},
@@ -197,6 +245,9 @@ func TestPriceApplier(t *testing.T) {
 		}).Return(map[connecttypes.CurrencyPair]*big.Int{
 			cp: big.NewInt(150),
 		}, nil)
+		va.On("GetSpreads").Return(map[connecttypes.CurrencyPair]*big.Int{
+			cp: big.NewInt(50),
+		}).Once()
 
 		// return multiple prices
 		ok.On("GetAllCurrencyPairs", ctx).Return(
@@ -209,6 +260,8 @@ func TestPriceApplier(t *testing.T) {
 			require.Equal(t, qp.Price.BigInt(), big.NewInt(150))
 			require.Equal(t, qp.BlockTimestamp, ctx.BlockHeader().Time)
 			require.Equal(t, qp.BlockHeight, uint64(ctx.BlockHeight())) //nolint:gosec
+			require.NotNil(t, qp.Spread)
+			require.Equal(t, qp.Spread.BigInt(), big.NewInt(50))
 		})
 
 		prices, err := pa.ApplyPricesFromVoteExtensions(ctx, &abcitypes.RequestFinalizeBlock{
@@ -31,11 +31,23 @@ func GetOracleVotes(
 	veCodec codec.VoteExtensionCodec,
 	extCommitCodec codec.ExtendedCommitCodec,
 ) ([]Vote, error) {
-	if len(proposal) < connectabci.NumInjectedTxs {
-		return nil, connectabci.MissingCommitInfoError{}
+	return GetOracleVotesFromInjection(proposal, connectabci.DefaultTxInjection(), veCodec, extCommitCodec)
+}
+
+// GetOracleVotesFromInjection returns all oracle vote extensions that were injected into the block, locating
+// the extended commit info in the proposal with the given TxInjection.
+func GetOracleVotesFromInjection(
+	proposal [][]byte,
+	injection connectabci.TxInjection,
+	veCodec codec.VoteExtensionCodec,
+	extCommitCodec codec.ExtendedCommitCodec,
+) ([]Vote, error) {
+	_, extCommitBz, err := injection.Locate(proposal)
+	if err != nil {
+		return nil, err
 	}
 
-	extendedCommitInfo, err := extCommitCodec.Decode(proposal[connectabci.OracleInfoIndex])
+	extendedCommitInfo, err := extCommitCodec.Decode(extCommitBz)
 	if err != nil {
 		return nil, connectabci.CodecError{
 			Err: fmt.Errorf("error decoding extended-commit-info: %w", err),
@@ -87,20 +99,33 @@ type VoteAggregator interface {
 	// GetPriceForValidator gets the prices reported by a given validator. This method depends
 	// on the prices from the latest set of aggregated votes.
 	GetPriceForValidator(validator sdk.ConsAddress) map[connecttypes.CurrencyPair]*big.Int
+
+	// GetSpreads gets the spread (dispersion of the validator prices) of each currency pair from
+	// the latest set of aggregated votes. A currency pair has no spread if the aggregator was not
+	// configured to compute spreads.
+	GetSpreads() map[connecttypes.CurrencyPair]*big.Int
 }
 
 func NewDefaultVoteAggregator(
 	logger log.Logger,
 	aggregateFn aggregator.AggregateFnFromContext[string, map[connecttypes.CurrencyPair]*big.Int],
 	strategy currencypair.CurrencyPairStrategy,
+	opts ...VoteAggregatorOption,
 ) VoteAggregator {
-	return &DefaultVoteAggregator{
+	dva := &DefaultVoteAggregator{
 		logger: logger,
 		priceAggregator: aggregator.NewDataAggregator(
 			aggregator.WithAggregateFnFromContext(aggregateFn),
 		),
 		currencyPairStrategy: strategy,
+		spreads:              make(map[connecttypes.CurrencyPair]*big.Int),
+	}
+
+	for _, opt := range opts {
+		opt(dva)
 	}
+
+	return dva
 }
 
 type DefaultVoteAggregator struct {
@@ -110,6 +135,11 @@ type DefaultVoteAggregator struct {
 	// decoding prices / currency-pair ids
 	currencyPairStrategy currencypair.CurrencyPairStrategy
 
+	// spreadFn is an optional function used to compute the spread of the validator prices
+	// per currency-pair. spreads holds the spreads from the latest set of aggregated votes.
+	spreadFn aggregator.AggregateFnFromContext[string, map[connecttypes.CurrencyPair]*big.Int]
+	spreads  map[connecttypes.CurrencyPair]*big.Int
+
 	logger log.Logger
 }
 
@@ -117,12 +147,19 @@ func (dva *DefaultVoteAggregator) AggregateOracleVotes(ctx sdk.Context, votes []
 	// Reset the price aggregator and set the aggregationFn to use the latest application-state.
 	dva.priceAggregator.ResetProviderData()
 
+	// If the strategy allows validators to omit prices, retrieve the prices that may have been omitted.
+	var omittable map[uint64]currencypair.CurrencyPairPrice
+	if omissionStrategy, ok := dva.currencyPairStrategy.(currencypair.OmissionStrategy); ok {
+		omittable = omissionStrategy.GetOmittablePrices(ctx)
+	}
+
 	// Iterate through all vote extensions and consolidate all price info before
 	// aggregating.
+	reported := make(map[connecttypes.CurrencyPair]struct{})
 	for _, vote := range votes {
 		consAddrStr := vote.ConsAddress.String()
 
-		if err := dva.addVoteToAggregator(ctx, consAddrStr, vote.OracleVoteExtension); err != nil {
+		if err := dva.addVoteToAggregator(ctx, consAddrStr, vote.OracleVoteExtension, omittable, reported); err != nil {
 			dva.logger.Error(
 				"failed to add vote to aggregator",
 				"validator_address", consAddrStr,
@@ -137,9 +174,27 @@ func (dva *DefaultVoteAggregator) AggregateOracleVotes(ctx sdk.Context, votes []
 	dva.priceAggregator.AggregateDataFromContext(ctx)
 	prices := dva.priceAggregator.GetAggregatedData()
 
+	// Compute the spread of the validator prices for each currency pair, if configured.
+	dva.spreads = make(map[connecttypes.CurrencyPair]*big.Int)
+	if dva.spreadFn != nil {
+		dva.spreads = dva.spreadFn(ctx)(dva.priceAggregator.GetProviderData())
+	}
+
+	// Currency pairs that every validator omitted are not updated, so that the on-chain price
+	// ages until the heartbeat forces validators to report it.
+	if omittable != nil {
+		for cp := range prices {
+			if _, ok := reported[cp]; !ok {
+				delete(prices, cp)
+				delete(dva.spreads, cp)
+			}
+		}
+	}
+
 	dva.logger.Debug(
 		"aggregated oracle data",
 		"num_prices", len(prices),
+		"num_spreads", len(dva.spreads),
 	)
 
 	return prices, nil
@@ -148,8 +203,16 @@ func (dva *DefaultVoteAggregator) AggregateOracleVotes(ctx sdk.Context, votes []
 // addVoteToAggregator consolidates the oracle data from a single validator
 // into the price aggregator. The oracle data is provided in the form of a vote
 // extension. The vote extension contains the prices for each currency pair that
-// the validator is providing for the current block.
-func (dva *DefaultVoteAggregator) addVoteToAggregator(ctx sdk.Context, address string, oracleData vetypes.OracleVoteExtension) error {
+// the validator is providing for the current block. If the validator participated (i.e. its vote
+// extension is non-empty), any omittable price that it omitted is treated as agreement with the
+// on-chain price. Currency pairs for which the validator reported a price are added to reported.
+func (dva *DefaultVoteAggregator) addVoteToAggregator(
+	ctx sdk.Context,
+	address string,
+	oracleData vetypes.OracleVoteExtension,
+	omittable map[uint64]currencypair.CurrencyPairPrice,
+	reported map[connecttypes.CurrencyPair]struct{},
+) error {
 	if len(oracleData.Prices) == 0 {
 		return nil
 	}
@@ -192,11 +255,24 @@ func (dva *DefaultVoteAggregator) addVoteToAggregator(ctx sdk.Context, address s
 		}
 
 		prices[cp] = price
+		reported[cp] = struct{}{}
+	}
+
+	// Treat omitted prices as agreement with the on-chain price.
+	numOmitted := 0
+	for cpID, omitted := range omittable {
+		if _, ok := oracleData.Prices[cpID]; ok {
+			continue
+		}
+
+		prices[omitted.CurrencyPair] = new(big.Int).Set(omitted.Price)
+		numOmitted++
 	}
 
 	dva.logger.Debug(
 		"adding oracle prices to aggregator",
 		"num_prices", len(prices),
+		"num_omitted_prices", numOmitted,
 		"validator_address", address,
 	)
 
@@ -209,3 +285,7 @@ func (dva *DefaultVoteAggregator) GetPriceForValidator(validator sdk.ConsAddress
 	consAddrStr := validator.String()
 	return dva.priceAggregator.GetDataByProvider(consAddrStr)
 }
+
+func (dva *DefaultVoteAggregator) GetSpreads() map[connecttypes.CurrencyPair]*big.Int {
+	return dva.spreads
+}
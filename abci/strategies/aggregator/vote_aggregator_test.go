@@ -14,11 +14,14 @@ import (
 
 	"github.com/skip-mev/connect/v2/abci/strategies/aggregator"
 	"github.com/skip-mev/connect/v2/abci/strategies/codec"
+	"github.com/skip-mev/connect/v2/abci/strategies/currencypair"
 	currencypairmocks "github.com/skip-mev/connect/v2/abci/strategies/currencypair/mocks"
 	"github.com/skip-mev/connect/v2/abci/testutils"
+	connectabci "github.com/skip-mev/connect/v2/abci/types"
 	"github.com/skip-mev/connect/v2/pkg/math/voteweighted"
 	"github.com/skip-mev/connect/v2/pkg/math/voteweighted/mocks"
 	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
 )
 
 var (
@@ -462,3 +465,197 @@ func (s *VoteAggregatorTestSuite) TestAggregateOracleVotes() {
 		s.Require().Len(prices, 0)
 	})
 }
+
+func (s *VoteAggregatorTestSuite) TestAggregateOracleVotesWithSpread() {
+	mockValidatorStore := mocks.NewValidatorStore(s.T())
+	aggregationFn := voteweighted.MedianFromContext(
+		log.NewTestLogger(s.T()),
+		mockValidatorStore,
+		voteweighted.DefaultPowerThreshold,
+	)
+	spreadFn := voteweighted.SpreadFromContext(
+		log.NewTestLogger(s.T()),
+		mockValidatorStore,
+		voteweighted.DefaultPowerThreshold,
+	)
+	mockValidatorStore.On("TotalBondedTokens", mock.Anything).Return(math.NewInt(100), nil)
+
+	cpID := currencypairmocks.NewCurrencyPairStrategy(s.T())
+
+	s.Run("no spreads are computed without a spread function", func() {
+		handler := aggregator.NewDefaultVoteAggregator(
+			log.NewTestLogger(s.T()),
+			aggregationFn,
+			cpID,
+		)
+
+		prices, err := handler.AggregateOracleVotes(s.ctx, nil)
+		s.Require().NoError(err)
+		s.Require().Len(prices, 0)
+		s.Require().Len(handler.GetSpreads(), 0)
+	})
+
+	s.Run("spread is computed from the same validator prices as the price", func() {
+		handler := aggregator.NewDefaultVoteAggregator(
+			log.NewTestLogger(s.T()),
+			aggregationFn,
+			cpID,
+			aggregator.WithSpreadFnFromContext(spreadFn),
+		)
+
+		myValVoteInfo, err := testutils.CreateExtendedVoteInfo(s.myVal, map[uint64][]byte{0: oneHundred.Bytes()}, s.veCodec)
+		s.Require().NoError(err)
+		otherValVoteInfo, err := testutils.CreateExtendedVoteInfo(val1, map[uint64][]byte{0: twoHundred.Bytes()}, s.veCodec)
+		s.Require().NoError(err)
+
+		_, commitBz, err := testutils.CreateExtendedCommitInfo([]cometabci.ExtendedVoteInfo{myValVoteInfo, otherValVoteInfo}, s.commitCodec)
+		s.Require().NoError(err)
+
+		votes, err := aggregator.GetOracleVotes([][]byte{commitBz}, s.veCodec, s.commitCodec)
+		s.Require().NoError(err)
+
+		// The validators are looked up once for the price and once for the spread.
+		mockValidatorStore.On("ValidatorByConsAddr", mock.Anything, s.myVal).Return(
+			stakingtypes.Validator{
+				Tokens: math.NewInt(50),
+				Status: stakingtypes.Bonded,
+			},
+			nil,
+		).Twice()
+		mockValidatorStore.On("ValidatorByConsAddr", mock.Anything, val1).Return(
+			stakingtypes.Validator{
+				Tokens: math.NewInt(50),
+				Status: stakingtypes.Bonded,
+			},
+			nil,
+		).Twice()
+
+		cpID.On("FromID", s.ctx, uint64(0)).Return(btcUSD, nil).Twice()
+		cpID.On("GetDecodedPrice", s.ctx, btcUSD, oneHundred.Bytes()).Return(oneHundred, nil).Once()
+		cpID.On("GetDecodedPrice", s.ctx, btcUSD, twoHundred.Bytes()).Return(twoHundred, nil).Once()
+
+		prices, err := handler.AggregateOracleVotes(s.ctx, votes)
+		s.Require().NoError(err)
+		s.Require().Len(prices, 1)
+		s.Require().Equal(oneHundred.String(), prices[btcUSD].String())
+
+		spreads := handler.GetSpreads()
+		s.Require().Len(spreads, 1)
+		s.Require().Equal(oneHundred.String(), spreads[btcUSD].String())
+	})
+}
+
+func (s *VoteAggregatorTestSuite) TestAggregateOracleVotesWithOmission() {
+	ctx := testutils.CreateBaseSDKContext(s.T()).WithBlockHeight(10)
+	solUSD := connecttypes.NewCurrencyPair("SOL", "USD")
+	val3 := sdk.ConsAddress("val3")
+
+	// Set up the on-chain state. Every price was last updated 2 blocks ago.
+	oracleKeeper := currencypairmocks.NewOracleKeeper(s.T())
+	onChainPrices := map[connecttypes.CurrencyPair]int64{btcUSD: 100, ethUSD: 200, solUSD: 300}
+	oracleKeeper.On("GetAllCurrencyPairs", mock.Anything).Return([]connecttypes.CurrencyPair{btcUSD, ethUSD, solUSD})
+	for id, cp := range []connecttypes.CurrencyPair{btcUSD, ethUSD, solUSD} {
+		oracleKeeper.On("GetIDForCurrencyPair", mock.Anything, cp).Return(uint64(id), true).Maybe()  //nolint:gosec
+		oracleKeeper.On("GetCurrencyPairFromID", mock.Anything, uint64(id)).Return(cp, true).Maybe() //nolint:gosec
+		oracleKeeper.On("GetPriceForCurrencyPair", mock.Anything, cp).Return(oracletypes.QuotePrice{
+			Price:       math.NewInt(onChainPrices[cp]),
+			BlockHeight: 8,
+		}, nil).Maybe()
+	}
+
+	strategy := currencypair.NewDeviationCurrencyPairStrategy(oracleKeeper, math.LegacyNewDecWithPrec(1, 2), 5)
+
+	mockValidatorStore := mocks.NewValidatorStore(s.T())
+	mockValidatorStore.On("TotalBondedTokens", mock.Anything).Return(math.NewInt(100), nil)
+	for val, stake := range map[string]int64{s.myVal.String(): 40, val1.String(): 30, val2.String(): 20} {
+		addr, err := sdk.ConsAddressFromBech32(val)
+		s.Require().NoError(err)
+
+		mockValidatorStore.On("ValidatorByConsAddr", mock.Anything, addr).Return(
+			stakingtypes.Validator{
+				Tokens: math.NewInt(stake),
+				Status: stakingtypes.Bonded,
+			},
+			nil,
+		).Maybe()
+	}
+
+	handler := aggregator.NewDefaultVoteAggregator(
+		log.NewTestLogger(s.T()),
+		voteweighted.MedianFromContext(
+			log.NewTestLogger(s.T()),
+			mockValidatorStore,
+			voteweighted.DefaultPowerThreshold,
+		),
+		strategy,
+	)
+
+	encodeDelta := func(delta int64) []byte {
+		bz, err := big.NewInt(delta).GobEncode()
+		s.Require().NoError(err)
+		return bz
+	}
+
+	// myVal reports a moved btc/usd price, val1 reports an unchanged btc/usd price (to signal participation),
+	// val2 reports a moved eth/usd price, and val3 did not participate. Every other price was omitted.
+	voteInfos := make([]cometabci.ExtendedVoteInfo, 0, 4)
+	for val, prices := range map[string]map[uint64][]byte{
+		s.myVal.String(): {0: encodeDelta(20)},
+		val1.String():    {0: encodeDelta(0)},
+		val2.String():    {1: encodeDelta(10)},
+		val3.String():    {},
+	} {
+		addr, err := sdk.ConsAddressFromBech32(val)
+		s.Require().NoError(err)
+
+		voteInfo, err := testutils.CreateExtendedVoteInfo(addr, prices, s.veCodec)
+		s.Require().NoError(err)
+		voteInfos = append(voteInfos, voteInfo)
+	}
+
+	_, commitBz, err := testutils.CreateExtendedCommitInfo(voteInfos, s.commitCodec)
+	s.Require().NoError(err)
+
+	votes, err := aggregator.GetOracleVotes([][]byte{commitBz}, s.veCodec, s.commitCodec)
+	s.Require().NoError(err)
+
+	prices, err := handler.AggregateOracleVotes(ctx, votes)
+	s.Require().NoError(err)
+
+	// sol/usd was omitted by every validator, so it is not updated.
+	s.Require().Len(prices, 2)
+	s.Require().Equal(big.NewInt(100).String(), prices[btcUSD].String())
+	s.Require().Equal(big.NewInt(200).String(), prices[ethUSD].String())
+
+	// Omitted prices are attributed to the validators that omitted them.
+	s.Require().Equal(map[connecttypes.CurrencyPair]*big.Int{
+		btcUSD: big.NewInt(120),
+		ethUSD: big.NewInt(200),
+		solUSD: big.NewInt(300),
+	}, handler.GetPriceForValidator(s.myVal))
+	s.Require().Len(handler.GetPriceForValidator(val3), 0)
+}
+
+func (s *VoteAggregatorTestSuite) TestGetOracleVotesFromInjection() {
+	voteInfo, err := testutils.CreateExtendedVoteInfo(val1, map[uint64][]byte{0: oneHundred.Bytes()}, s.veCodec)
+	s.Require().NoError(err)
+
+	_, commitBz, err := testutils.CreateExtendedCommitInfo([]cometabci.ExtendedVoteInfo{voteInfo}, s.commitCodec)
+	s.Require().NoError(err)
+
+	injection := connectabci.NewMarkerTxInjection([]byte("oracle:"), 0)
+
+	s.Run("locates the extended commit by marker", func() {
+		proposal := [][]byte{[]byte("other"), injection.Wrap(commitBz)}
+
+		votes, err := aggregator.GetOracleVotesFromInjection(proposal, injection, s.veCodec, s.commitCodec)
+		s.Require().NoError(err)
+		s.Require().Len(votes, 1)
+		s.Require().Equal(val1, votes[0].ConsAddress)
+	})
+
+	s.Run("returns an error if the extended commit is missing", func() {
+		_, err := aggregator.GetOracleVotesFromInjection([][]byte{commitBz}, injection, s.veCodec, s.commitCodec)
+		s.Require().ErrorIs(err, connectabci.MissingCommitInfoError{})
+	})
+}
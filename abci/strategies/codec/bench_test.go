@@ -0,0 +1,86 @@
+package codec_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	compression "github.com/skip-mev/connect/v2/abci/strategies/codec"
+	vetypes "github.com/skip-mev/connect/v2/abci/ve/types"
+)
+
+// BenchmarkVoteExtensionCodecs compares the size and latency of the vote extension codecs on the recorded
+// vote extension fixtures. The dictionary is trained on the first fixtures, and all codecs are evaluated on
+// the remaining ones. Run with:
+//
+//	go test ./abci/strategies/codec -run ^$ -bench BenchmarkVoteExtensionCodecs -benchmem
+func BenchmarkVoteExtensionCodecs(b *testing.B) {
+	fixtures := loadVoteExtensionFixtures(b)
+
+	dictCompressor, err := compression.NewZStdDictCompressor(trainDictionary(b, fixtures))
+	require.NoError(b, err)
+
+	voteExtensions := make([]vetypes.OracleVoteExtension, 0, len(fixtures)-numTrainingFixtures)
+	for _, fixture := range fixtures[numTrainingFixtures:] {
+		var ve vetypes.OracleVoteExtension
+		require.NoError(b, ve.Unmarshal(fixture))
+		voteExtensions = append(voteExtensions, ve)
+	}
+
+	codecs := []struct {
+		name  string
+		codec compression.VoteExtensionCodec
+	}{
+		{
+			name:  "default",
+			codec: compression.NewDefaultVoteExtensionCodec(),
+		},
+		{
+			name:  "zlib",
+			codec: compression.NewCompressionVoteExtensionCodec(compression.NewDefaultVoteExtensionCodec(), compression.NewZLibCompressor()),
+		},
+		{
+			name:  "zstd",
+			codec: compression.NewCompressionVoteExtensionCodec(compression.NewDefaultVoteExtensionCodec(), compression.NewZStdCompressor()),
+		},
+		{
+			name:  "zstd-dictionary",
+			codec: compression.NewCompressionVoteExtensionCodec(compression.NewDefaultVoteExtensionCodec(), dictCompressor),
+		},
+		{
+			name:  "packed",
+			codec: compression.NewPackedVoteExtensionCodec(),
+		},
+		{
+			name:  "packed-zstd",
+			codec: compression.NewCompressionVoteExtensionCodec(compression.NewPackedVoteExtensionCodec(), compression.NewZStdCompressor()),
+		},
+	}
+
+	for _, tc := range codecs {
+		// encode every vote extension once to measure the size
+		encoded := make([][]byte, len(voteExtensions))
+		size := 0
+		for i, ve := range voteExtensions {
+			encoded[i], err = tc.codec.Encode(ve)
+			require.NoError(b, err)
+			size += len(encoded[i])
+		}
+
+		b.Run(tc.name+"/encode", func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				_, _ = tc.codec.Encode(voteExtensions[n%len(voteExtensions)])
+			}
+
+			b.ReportMetric(float64(size)/float64(len(voteExtensions)), "bytes/ve")
+		})
+
+		b.Run(tc.name+"/decode", func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				_, _ = tc.codec.Decode(encoded[n%len(encoded)])
+			}
+
+			b.ReportMetric(float64(size)/float64(len(voteExtensions)), "bytes/ve")
+		})
+	}
+}
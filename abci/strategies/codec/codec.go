@@ -157,6 +157,17 @@ func (codec *CompressionVoteExtensionCodec) Decode(bz []byte) (vetypes.OracleVot
 	return codec.codec.Decode(bz)
 }
 
+// ForHeight returns the codec used for vote extensions created at the given height, compressing with the
+// underlying codec scheduled at that height if it is height-aware.
+func (codec *CompressionVoteExtensionCodec) ForHeight(height int64) VoteExtensionCodec {
+	heightAware, ok := codec.codec.(HeightAwareVoteExtensionCodec)
+	if !ok {
+		return codec
+	}
+
+	return NewCompressionVoteExtensionCodec(heightAware.ForHeight(height), codec.compressor)
+}
+
 // DefaultExtendedCommitCodec is the default implementation of ExtendedCommitCodec. It uses the
 // vanilla implementations of Unmarshal / Marshal under the hood.
 type DefaultExtendedCommitCodec struct{}
@@ -215,3 +226,14 @@ func (codec *CompressionExtendedCommitCodec) Decode(bz []byte) (cometabci.Extend
 
 	return codec.codec.Decode(bz)
 }
+
+// ForHeight returns the codec used for extended commits included in proposals at the given height, compressing
+// with the underlying codec scheduled at that height if it is height-aware.
+func (codec *CompressionExtendedCommitCodec) ForHeight(height int64) ExtendedCommitCodec {
+	heightAware, ok := codec.codec.(HeightAwareExtendedCommitCodec)
+	if !ok {
+		return codec
+	}
+
+	return NewCompressionExtendedCommitCodec(heightAware.ForHeight(height), codec.compressor)
+}
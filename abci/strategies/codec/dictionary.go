@@ -0,0 +1,125 @@
+package codec
+
+import (
+	"fmt"
+
+	"github.com/klauspost/compress/dict"
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	// DefaultZStdDictionarySize is the default maximum size (in bytes) of a trained zstd dictionary.
+	DefaultZStdDictionarySize = 16 * 1024
+
+	// zstdDictionaryHashBytes is the minimum match length indexed when training a dictionary. Vote
+	// extensions are small, so short matches are preferred.
+	zstdDictionaryHashBytes = 4
+)
+
+// ZStdDictCompressor is a Compressor that uses zstd with a pre-trained dictionary to compress / decompress
+// byte arrays. Vote extensions are small, highly repetitive protobufs that compress poorly on their own, so
+// a dictionary shared by all validators yields much smaller payloads. All validators must use the same
+// dictionary. This object is thread-safe.
+type ZStdDictCompressor struct {
+	encoder *zstd.Encoder
+	decoder *zstd.Decoder
+}
+
+// NewZStdDictCompressor returns a new ZStdDictCompressor that uses the given zstd dictionary. The dictionary
+// is expected to be in the zstd dictionary format, i.e. as returned by TrainZStdDictionary.
+func NewZStdDictCompressor(dictionary []byte) (*ZStdDictCompressor, error) {
+	if len(dictionary) == 0 {
+		return nil, fmt.Errorf("zstd dictionary cannot be empty")
+	}
+
+	encoder, err := zstd.NewWriter(nil, zstd.WithEncoderDict(dictionary))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create zstd encoder: %w", err)
+	}
+
+	decoder, err := zstd.NewReader(nil, zstd.WithDecoderDicts(dictionary))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create zstd decoder: %w", err)
+	}
+
+	return &ZStdDictCompressor{
+		encoder: encoder,
+		decoder: decoder,
+	}, nil
+}
+
+// Compress compresses the given byte array using zstd and the compressor's dictionary.
+func (c *ZStdDictCompressor) Compress(bz []byte) ([]byte, error) {
+	return c.encoder.EncodeAll(bz, nil), nil
+}
+
+// Decompress decompresses the given byte array using zstd and the compressor's dictionary. It returns
+// an error if the decompression fails.
+func (c *ZStdDictCompressor) Decompress(bz []byte) ([]byte, error) {
+	if len(bz) == 0 {
+		return nil, nil
+	}
+
+	return c.decoder.DecodeAll(bz, nil)
+}
+
+// TrainZStdDictionary trains a zstd dictionary of at most maxSize bytes from the given samples. The samples
+// should be uncompressed payloads representative of the data that will be compressed, i.e. vote extensions
+// as encoded by the DefaultVoteExtensionCodec. The id is embedded in the dictionary and every frame compressed
+// with it. If the id is 0, a random id is used.
+func TrainZStdDictionary(samples [][]byte, maxSize int, id uint32) ([]byte, error) {
+	if len(samples) == 0 {
+		return nil, fmt.Errorf("at least one sample is required to train a zstd dictionary")
+	}
+
+	if maxSize <= 0 {
+		return nil, fmt.Errorf("zstd dictionary size must be positive; got %d", maxSize)
+	}
+
+	return dict.BuildZstdDict(samples, dict.Options{
+		MaxDictSize: maxSize,
+		HashBytes:   zstdDictionaryHashBytes,
+		ZstdDictID:  id,
+	})
+}
+
+// VoteExtensionSamplesFromExtendedCommits returns the vote extensions included in the given extended commits
+// (i.e. as injected in block proposals), encoded with the DefaultVoteExtensionCodec so that they can be used
+// to train a dictionary. Empty vote extensions are skipped.
+func VoteExtensionSamplesFromExtendedCommits(
+	extendedCommits [][]byte,
+	extCommitCodec ExtendedCommitCodec,
+	veCodec VoteExtensionCodec,
+) ([][]byte, error) {
+	defaultCodec := NewDefaultVoteExtensionCodec()
+
+	var samples [][]byte
+	for _, bz := range extendedCommits {
+		extendedCommit, err := extCommitCodec.Decode(bz)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode extended commit: %w", err)
+		}
+
+		for _, vote := range extendedCommit.Votes {
+			if len(vote.VoteExtension) == 0 {
+				continue
+			}
+
+			ve, err := veCodec.Decode(vote.VoteExtension)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode vote extension: %w", err)
+			}
+
+			sample, err := defaultCodec.Encode(ve)
+			if err != nil {
+				return nil, err
+			}
+
+			if len(sample) > 0 {
+				samples = append(samples, sample)
+			}
+		}
+	}
+
+	return samples, nil
+}
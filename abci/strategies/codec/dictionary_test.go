@@ -0,0 +1,173 @@
+package codec_test
+
+import (
+	"encoding/binary"
+	"os"
+	"testing"
+
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+
+	compression "github.com/skip-mev/connect/v2/abci/strategies/codec"
+	vetypes "github.com/skip-mev/connect/v2/abci/ve/types"
+)
+
+const (
+	// voteExtensionFixtures contains the vote extensions of a simulated network of 30 validators over 5 heights
+	// with 80 markets, encoded as a sequence of uvarint length-prefixed OracleVoteExtension protobufs. Prices
+	// are encoded by the DeltaCurrencyPairStrategy.
+	voteExtensionFixtures = "testdata/vote_extensions.bin"
+
+	// numTrainingFixtures is the number of fixtures (the first 2 heights) used to train dictionaries. The
+	// remaining fixtures are used for evaluation.
+	numTrainingFixtures = 60
+)
+
+// loadVoteExtensionFixtures returns the recorded vote extension fixtures encoded with the default codec.
+func loadVoteExtensionFixtures(tb testing.TB) [][]byte {
+	tb.Helper()
+
+	bz, err := os.ReadFile(voteExtensionFixtures)
+	require.NoError(tb, err)
+
+	var fixtures [][]byte
+	for len(bz) > 0 {
+		size, n := binary.Uvarint(bz)
+		require.Positive(tb, n)
+
+		fixtures = append(fixtures, bz[n:n+int(size)]) //nolint:gosec
+		bz = bz[n+int(size):]                          //nolint:gosec
+	}
+
+	return fixtures
+}
+
+// trainDictionary trains a dictionary on the training fixtures.
+func trainDictionary(tb testing.TB, fixtures [][]byte) []byte {
+	tb.Helper()
+
+	dictionary, err := compression.TrainZStdDictionary(fixtures[:numTrainingFixtures], compression.DefaultZStdDictionarySize, 1)
+	require.NoError(tb, err)
+
+	return dictionary
+}
+
+func TestZStdDictCompressor(t *testing.T) {
+	fixtures := loadVoteExtensionFixtures(t)
+	dictionary := trainDictionary(t, fixtures)
+
+	t.Run("empty dictionary fails", func(t *testing.T) {
+		_, err := compression.NewZStdDictCompressor(nil)
+		require.Error(t, err)
+	})
+
+	t.Run("invalid dictionary fails", func(t *testing.T) {
+		_, err := compression.NewZStdDictCompressor([]byte("not a dictionary"))
+		require.Error(t, err)
+	})
+
+	t.Run("training without samples fails", func(t *testing.T) {
+		_, err := compression.TrainZStdDictionary(nil, compression.DefaultZStdDictionarySize, 1)
+		require.Error(t, err)
+	})
+
+	t.Run("test encoding / decoding", func(t *testing.T) {
+		compressor, err := compression.NewZStdDictCompressor(dictionary)
+		require.NoError(t, err)
+
+		codec := compression.NewCompressionVoteExtensionCodec(compression.NewDefaultVoteExtensionCodec(), compressor)
+		for _, fixture := range fixtures[numTrainingFixtures:] {
+			var ve vetypes.OracleVoteExtension
+			require.NoError(t, ve.Unmarshal(fixture))
+
+			bz, err := codec.Encode(ve)
+			require.NoError(t, err)
+
+			decoded, err := codec.Decode(bz)
+			require.NoError(t, err)
+			require.Equal(t, ve.Prices, decoded.Prices)
+		}
+	})
+
+	t.Run("test decoding empty byte array", func(t *testing.T) {
+		compressor, err := compression.NewZStdDictCompressor(dictionary)
+		require.NoError(t, err)
+
+		codec := compression.NewCompressionVoteExtensionCodec(compression.NewDefaultVoteExtensionCodec(), compressor)
+		_, err = codec.Decode([]byte{})
+		require.NoError(t, err)
+	})
+
+	t.Run("dictionary compresses better than zstd without a dictionary", func(t *testing.T) {
+		compressor, err := compression.NewZStdDictCompressor(dictionary)
+		require.NoError(t, err)
+
+		var dictSize, zstdSize int
+		for _, fixture := range fixtures[numTrainingFixtures:] {
+			dictBz, err := compressor.Compress(fixture)
+			require.NoError(t, err)
+			dictSize += len(dictBz)
+
+			zstdBz, err := compression.NewZStdCompressor().Compress(fixture)
+			require.NoError(t, err)
+			zstdSize += len(zstdBz)
+		}
+
+		require.Less(t, dictSize, zstdSize)
+	})
+
+	t.Run("frames compressed with a different dictionary fail to decompress", func(t *testing.T) {
+		compressor, err := compression.NewZStdDictCompressor(dictionary)
+		require.NoError(t, err)
+
+		other, err := compression.TrainZStdDictionary(fixtures[numTrainingFixtures:], compression.DefaultZStdDictionarySize, 2)
+		require.NoError(t, err)
+		otherCompressor, err := compression.NewZStdDictCompressor(other)
+		require.NoError(t, err)
+
+		bz, err := compressor.Compress(fixtures[0])
+		require.NoError(t, err)
+
+		_, err = otherCompressor.Decompress(bz)
+		require.Error(t, err)
+	})
+}
+
+func TestVoteExtensionSamplesFromExtendedCommits(t *testing.T) {
+	ve := vetypes.OracleVoteExtension{
+		Prices: map[uint64][]byte{
+			1: []byte("1"),
+			2: []byte("2"),
+		},
+	}
+
+	veCodec := compression.NewCompressionVoteExtensionCodec(
+		compression.NewDefaultVoteExtensionCodec(),
+		compression.NewZStdCompressor(),
+	)
+	extCommitCodec := compression.NewCompressionExtendedCommitCodec(
+		compression.NewDefaultExtendedCommitCodec(),
+		compression.NewZStdCompressor(),
+	)
+
+	veBz, err := veCodec.Encode(ve)
+	require.NoError(t, err)
+
+	extCommitBz, err := extCommitCodec.Encode(cmtabci.ExtendedCommitInfo{
+		Votes: []cmtabci.ExtendedVoteInfo{
+			{VoteExtension: veBz},
+			{VoteExtension: nil},
+		},
+	})
+	require.NoError(t, err)
+
+	samples, err := compression.VoteExtensionSamplesFromExtendedCommits([][]byte{extCommitBz}, extCommitCodec, veCodec)
+	require.NoError(t, err)
+
+	// the empty vote extension is skipped, and the sample is uncompressed
+	require.Len(t, samples, 1)
+
+	decoded, err := compression.NewDefaultVoteExtensionCodec().Decode(samples[0])
+	require.NoError(t, err)
+	require.Equal(t, ve.Prices, decoded.Prices)
+}
@@ -0,0 +1,289 @@
+package codec
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sort"
+
+	vetypes "github.com/skip-mev/connect/v2/abci/ve/types"
+)
+
+const (
+	// PackedVoteExtensionVersion1 is the first version of the packed vote extension encoding. The encoding is
+	// laid out as follows:
+	//
+	//	version (1 byte) | number of prices (uvarint) | ids (uvarint deltas) | prices
+	//
+	// The ids are sorted in ascending order, and each id is encoded as the difference from the previous id. Each
+	// price is prefixed with a uvarint header whose lowest bit selects its kind. If the bit is unset, the price is
+	// a gob encoded big.Int whose value is stored as a zigzag encoded integer in the remaining bits of the header.
+	// Otherwise, the remaining bits store the length of the raw price bytes, which follow the header.
+	PackedVoteExtensionVersion1 byte = 1
+
+	// gobIntVersion is the version byte written by big.Int.GobEncode, the sign is stored in the lowest bit.
+	gobIntVersion byte = 1 << 1
+
+	// maxPackedIntBits is the maximum bit length of an integer price that can be packed into a header. One bit
+	// is used by the zigzag encoding and one by the price kind.
+	maxPackedIntBits = 62
+)
+
+// PackedVoteExtensionCodec is a VoteExtensionCodec that encodes vote extensions in a compact, versioned,
+// columnar layout. Currency pair ids are sorted and delta encoded, and prices that are gob encoded integers
+// (as produced by the currency pair strategies) are packed as zigzag varints. Any other price is stored as
+// is, so the encoding is lossless for arbitrary vote extensions. It can be composed with the
+// CompressionVoteExtensionCodec.
+type PackedVoteExtensionCodec struct{}
+
+// NewPackedVoteExtensionCodec returns a new PackedVoteExtensionCodec.
+func NewPackedVoteExtensionCodec() *PackedVoteExtensionCodec {
+	return &PackedVoteExtensionCodec{}
+}
+
+// Encode encodes the vote extension using the latest version of the packed encoding. An empty vote extension
+// is encoded as an empty byte array.
+func (codec *PackedVoteExtensionCodec) Encode(ve vetypes.OracleVoteExtension) ([]byte, error) {
+	if len(ve.Prices) == 0 {
+		return []byte{}, nil
+	}
+
+	ids := make([]uint64, 0, len(ve.Prices))
+	for id := range ve.Prices {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	bz := make([]byte, 0, 1+binary.MaxVarintLen64*(2*len(ids)+1))
+	bz = append(bz, PackedVoteExtensionVersion1)
+	bz = binary.AppendUvarint(bz, uint64(len(ids)))
+
+	// write the id column
+	var prev uint64
+	for _, id := range ids {
+		bz = binary.AppendUvarint(bz, id-prev)
+		prev = id
+	}
+
+	// write the price column
+	for _, id := range ids {
+		price := ve.Prices[id]
+		if value, ok := packGobInt(price); ok {
+			bz = binary.AppendUvarint(bz, zigzag(value)<<1)
+			continue
+		}
+
+		bz = binary.AppendUvarint(bz, uint64(len(price))<<1|1)
+		bz = append(bz, price...)
+	}
+
+	return bz, nil
+}
+
+// Decode decodes a vote extension encoded with any supported version of the packed encoding. An empty byte
+// array is decoded as an empty vote extension.
+func (codec *PackedVoteExtensionCodec) Decode(bz []byte) (vetypes.OracleVoteExtension, error) {
+	if len(bz) == 0 {
+		return vetypes.OracleVoteExtension{}, nil
+	}
+
+	switch bz[0] {
+	case PackedVoteExtensionVersion1:
+		return decodePackedV1(bz[1:])
+	default:
+		return vetypes.OracleVoteExtension{}, fmt.Errorf("unsupported packed vote extension version %d", bz[0])
+	}
+}
+
+// IsPackedVoteExtension returns true if the given byte array is encoded with a known version of the packed
+// encoding. Protobuf encoded vote extensions never start with a version byte, as field number 0 is invalid.
+func IsPackedVoteExtension(bz []byte) bool {
+	return len(bz) > 0 && bz[0] == PackedVoteExtensionVersion1
+}
+
+// decodePackedV1 decodes the body of a vote extension encoded with PackedVoteExtensionVersion1.
+func decodePackedV1(bz []byte) (vetypes.OracleVoteExtension, error) {
+	r := packedReader{bz: bz}
+
+	n, err := r.uvarint()
+	if err != nil {
+		return vetypes.OracleVoteExtension{}, err
+	}
+
+	// every entry takes at least two bytes, bound the count before allocating
+	if n == 0 || n > uint64(len(r.bz)) {
+		return vetypes.OracleVoteExtension{}, fmt.Errorf("invalid number of prices %d", n)
+	}
+
+	ids := make([]uint64, n)
+	for i := range ids {
+		delta, err := r.uvarint()
+		if err != nil {
+			return vetypes.OracleVoteExtension{}, err
+		}
+
+		// ids are strictly increasing, so only the first id may have a zero delta
+		if i > 0 && delta == 0 {
+			return vetypes.OracleVoteExtension{}, fmt.Errorf("duplicate currency pair id %d", ids[i-1])
+		}
+
+		if i > 0 {
+			if delta > math.MaxUint64-ids[i-1] {
+				return vetypes.OracleVoteExtension{}, fmt.Errorf("currency pair id overflows")
+			}
+			delta += ids[i-1]
+		}
+		ids[i] = delta
+	}
+
+	prices := make(map[uint64][]byte, n)
+	for _, id := range ids {
+		header, err := r.uvarint()
+		if err != nil {
+			return vetypes.OracleVoteExtension{}, err
+		}
+
+		if header&1 == 0 {
+			prices[id] = unpackGobInt(unzigzag(header >> 1))
+			continue
+		}
+
+		price, err := r.bytes(header >> 1)
+		if err != nil {
+			return vetypes.OracleVoteExtension{}, err
+		}
+		prices[id] = price
+	}
+
+	if len(r.bz) != 0 {
+		return vetypes.OracleVoteExtension{}, fmt.Errorf("unexpected %d trailing bytes", len(r.bz))
+	}
+
+	return vetypes.OracleVoteExtension{Prices: prices}, nil
+}
+
+// packGobInt returns the value of the given price if it is the canonical gob encoding of a big.Int that fits
+// in maxPackedIntBits bits, i.e. if it can be reconstructed exactly by unpackGobInt.
+func packGobInt(bz []byte) (int64, bool) {
+	if len(bz) == 0 || bz[0]&^1 != gobIntVersion {
+		return 0, false
+	}
+
+	neg := bz[0]&1 == 1
+	magnitude := bz[1:]
+
+	// the magnitude of a canonical encoding has no leading zeros, and zero is never negative
+	if (len(magnitude) > 0 && magnitude[0] == 0) || (neg && len(magnitude) == 0) || len(magnitude) > 8 {
+		return 0, false
+	}
+
+	var value uint64
+	for _, b := range magnitude {
+		value = value<<8 | uint64(b)
+	}
+
+	if value >= 1<<maxPackedIntBits {
+		return 0, false
+	}
+
+	if neg {
+		return -int64(value), true
+	}
+	return int64(value), true
+}
+
+// unpackGobInt returns the gob encoding of the given value, as returned by big.Int.GobEncode.
+func unpackGobInt(value int64) []byte {
+	header := gobIntVersion
+	magnitude := uint64(value)
+	if value < 0 {
+		header |= 1
+		magnitude = uint64(-value)
+	}
+
+	bz := binary.BigEndian.AppendUint64(nil, magnitude)
+	i := 0
+	for i < len(bz) && bz[i] == 0 {
+		i++
+	}
+
+	return append([]byte{header}, bz[i:]...)
+}
+
+func zigzag(value int64) uint64 {
+	return uint64(value<<1) ^ uint64(value>>63)
+}
+
+func unzigzag(value uint64) int64 {
+	return int64(value>>1) ^ -int64(value&1)
+}
+
+// packedReader reads the fields of a packed vote extension.
+type packedReader struct {
+	bz []byte
+}
+
+func (r *packedReader) uvarint() (uint64, error) {
+	value, n := binary.Uvarint(r.bz)
+	if n <= 0 {
+		return 0, fmt.Errorf("invalid uvarint in packed vote extension")
+	}
+
+	r.bz = r.bz[n:]
+	return value, nil
+}
+
+func (r *packedReader) bytes(n uint64) ([]byte, error) {
+	if n > uint64(len(r.bz)) {
+		return nil, fmt.Errorf("price length %d exceeds remaining %d bytes", n, len(r.bz))
+	}
+
+	bz := make([]byte, n)
+	copy(bz, r.bz[:n])
+	r.bz = r.bz[n:]
+	return bz, nil
+}
+
+// MigrationVoteExtensionCodec is a VoteExtensionCodec that encodes vote extensions with the packed encoding, and
+// decodes both packed vote extensions and vote extensions encoded with a legacy codec. It allows a chain to migrate
+// to the packed encoding through a coordinated upgrade: the vote extensions of the last height before the upgrade
+// are included in the first proposal after it, and are still encoded with the legacy codec. Once the chain is past
+// the upgrade height, the legacy codec can be replaced by the PackedVoteExtensionCodec in a later release.
+type MigrationVoteExtensionCodec struct {
+	legacy VoteExtensionCodec
+	packed *PackedVoteExtensionCodec
+}
+
+// NewMigrationVoteExtensionCodec returns a new MigrationVoteExtensionCodec given the codec used prior to the upgrade.
+// The legacy codec must not be compressed, compression should be applied on top of the MigrationVoteExtensionCodec.
+func NewMigrationVoteExtensionCodec(legacy VoteExtensionCodec) *MigrationVoteExtensionCodec {
+	return &MigrationVoteExtensionCodec{
+		legacy: legacy,
+		packed: NewPackedVoteExtensionCodec(),
+	}
+}
+
+// Encode encodes the vote extension using the packed encoding.
+func (codec *MigrationVoteExtensionCodec) Encode(ve vetypes.OracleVoteExtension) ([]byte, error) {
+	return codec.packed.Encode(ve)
+}
+
+// Decode decodes the vote extension using the packed encoding if it is versioned, and the legacy codec otherwise.
+func (codec *MigrationVoteExtensionCodec) Decode(bz []byte) (vetypes.OracleVoteExtension, error) {
+	if IsPackedVoteExtension(bz) {
+		return codec.packed.Decode(bz)
+	}
+
+	return codec.legacy.Decode(bz)
+}
+
+// ForHeight returns the codec used for vote extensions created at the given height, decoding legacy vote
+// extensions with the legacy codec scheduled at that height if it is height-aware.
+func (codec *MigrationVoteExtensionCodec) ForHeight(height int64) VoteExtensionCodec {
+	heightAware, ok := codec.legacy.(HeightAwareVoteExtensionCodec)
+	if !ok {
+		return codec
+	}
+
+	return NewMigrationVoteExtensionCodec(heightAware.ForHeight(height))
+}
@@ -0,0 +1,207 @@
+package codec_test
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	compression "github.com/skip-mev/connect/v2/abci/strategies/codec"
+	vetypes "github.com/skip-mev/connect/v2/abci/ve/types"
+)
+
+// randomPrice returns a random price, mixing gob encoded integers of various sizes with arbitrary bytes.
+func randomPrice(r *rand.Rand) []byte {
+	switch r.Intn(6) {
+	case 0:
+		bz := make([]byte, r.Intn(40))
+		r.Read(bz)
+		return bz
+	case 1:
+		// non-canonical gob encodings must be preserved as is
+		return []byte{0x03}
+	case 2:
+		// integers larger than 64 bits
+		value := new(big.Int).Lsh(big.NewInt(r.Int63()), uint(r.Intn(100))) //nolint:gosec
+		bz, _ := value.GobEncode()
+		return bz
+	case 3:
+		bz, _ := big.NewInt(0).GobEncode()
+		return bz
+	default:
+		value := big.NewInt(r.Int63() >> r.Intn(63))
+		if r.Intn(2) == 0 {
+			value.Neg(value)
+		}
+		bz, _ := value.GobEncode()
+		return bz
+	}
+}
+
+// randomID returns a random currency pair id, biased towards small, dense ids and the boundaries.
+func randomID(r *rand.Rand) uint64 {
+	switch r.Intn(4) {
+	case 0:
+		return r.Uint64()
+	case 1:
+		return math.MaxUint64 - uint64(r.Intn(3))
+	default:
+		return uint64(r.Intn(500))
+	}
+}
+
+func TestPackedVoteExtensionCodec(t *testing.T) {
+	codec := compression.NewPackedVoteExtensionCodec()
+	defaultCodec := compression.NewDefaultVoteExtensionCodec()
+
+	t.Run("round-trips losslessly against the default codec", func(t *testing.T) {
+		r := rand.New(rand.NewSource(1))
+
+		for i := 0; i < 1000; i++ {
+			ve := vetypes.OracleVoteExtension{
+				Prices: make(map[uint64][]byte),
+			}
+			for j := r.Intn(100); j > 0; j-- {
+				ve.Prices[randomID(r)] = randomPrice(r)
+			}
+
+			// the expected vote extension is the one obtained through the default codec
+			defaultBz, err := defaultCodec.Encode(ve)
+			require.NoError(t, err)
+			expected, err := defaultCodec.Decode(defaultBz)
+			require.NoError(t, err)
+
+			bz, err := codec.Encode(ve)
+			require.NoError(t, err)
+			decoded, err := codec.Decode(bz)
+			require.NoError(t, err)
+			require.Len(t, decoded.Prices, len(expected.Prices))
+			for id, price := range expected.Prices {
+				require.Equal(t, price, decoded.Prices[id])
+			}
+
+			// re-encoding the default codec's output yields the same bytes
+			reencoded, err := codec.Encode(expected)
+			require.NoError(t, err)
+			require.Equal(t, bz, reencoded)
+		}
+	})
+
+	t.Run("test decoding empty byte array", func(t *testing.T) {
+		ve, err := codec.Decode([]byte{})
+		require.NoError(t, err)
+		require.Empty(t, ve.Prices)
+
+		bz, err := codec.Encode(vetypes.OracleVoteExtension{})
+		require.NoError(t, err)
+		require.Empty(t, bz)
+	})
+
+	t.Run("is smaller than the default codec on recorded vote extensions", func(t *testing.T) {
+		fixtures := loadVoteExtensionFixtures(t)
+
+		var packedSize, defaultSize int
+		for _, fixture := range fixtures {
+			ve, err := defaultCodec.Decode(fixture)
+			require.NoError(t, err)
+
+			bz, err := codec.Encode(ve)
+			require.NoError(t, err)
+			require.True(t, compression.IsPackedVoteExtension(bz))
+
+			packedSize += len(bz)
+			defaultSize += len(fixture)
+		}
+
+		require.Less(t, packedSize, defaultSize)
+	})
+
+	t.Run("rejects invalid encodings", func(t *testing.T) {
+		cases := []struct {
+			name string
+			bz   []byte
+		}{
+			{
+				name: "unknown version",
+				bz:   []byte{0x02, 0x01, 0x01, 0x00},
+			},
+			{
+				name: "no prices",
+				bz:   []byte{compression.PackedVoteExtensionVersion1, 0x00},
+			},
+			{
+				name: "truncated ids",
+				bz:   []byte{compression.PackedVoteExtensionVersion1, 0x02, 0x01},
+			},
+			{
+				name: "duplicate ids",
+				bz:   []byte{compression.PackedVoteExtensionVersion1, 0x02, 0x01, 0x00, 0x00, 0x00},
+			},
+			{
+				name: "truncated raw price",
+				bz:   []byte{compression.PackedVoteExtensionVersion1, 0x01, 0x01, 0x05, 0xff},
+			},
+			{
+				name: "trailing bytes",
+				bz:   []byte{compression.PackedVoteExtensionVersion1, 0x01, 0x01, 0x00, 0x00},
+			},
+		}
+
+		for _, tc := range cases {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := codec.Decode(tc.bz)
+				require.Error(t, err)
+			})
+		}
+	})
+}
+
+func TestMigrationVoteExtensionCodec(t *testing.T) {
+	ve := vetypes.OracleVoteExtension{
+		Prices: map[uint64][]byte{
+			1: []byte("1"),
+			2: []byte("2"),
+		},
+	}
+
+	legacyCodec := compression.NewCompressionVoteExtensionCodec(
+		compression.NewDefaultVoteExtensionCodec(),
+		compression.NewZStdCompressor(),
+	)
+	codec := compression.NewCompressionVoteExtensionCodec(
+		compression.NewMigrationVoteExtensionCodec(compression.NewDefaultVoteExtensionCodec()),
+		compression.NewZStdCompressor(),
+	)
+
+	t.Run("decodes vote extensions encoded before the upgrade", func(t *testing.T) {
+		bz, err := legacyCodec.Encode(ve)
+		require.NoError(t, err)
+
+		decoded, err := codec.Decode(bz)
+		require.NoError(t, err)
+		require.Equal(t, ve.Prices, decoded.Prices)
+	})
+
+	t.Run("encodes vote extensions with the packed encoding", func(t *testing.T) {
+		bz, err := codec.Encode(ve)
+		require.NoError(t, err)
+
+		decoded, err := codec.Decode(bz)
+		require.NoError(t, err)
+		require.Equal(t, ve.Prices, decoded.Prices)
+
+		packedCodec := compression.NewCompressionVoteExtensionCodec(
+			compression.NewPackedVoteExtensionCodec(),
+			compression.NewZStdCompressor(),
+		)
+		decoded, err = packedCodec.Decode(bz)
+		require.NoError(t, err)
+		require.Equal(t, ve.Prices, decoded.Prices)
+
+		// nodes that have not upgraded cannot decode the packed encoding
+		_, err = legacyCodec.Decode(bz)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,283 @@
+package codec
+
+import (
+	"errors"
+	"fmt"
+
+	cometabci "github.com/cometbft/cometbft/abci/types"
+
+	vetypes "github.com/skip-mev/connect/v2/abci/ve/types"
+)
+
+// HeightAwareVoteExtensionCodec is a VoteExtensionCodec whose encoding depends on the height at which the
+// vote extension is created.
+type HeightAwareVoteExtensionCodec interface {
+	VoteExtensionCodec
+
+	// ForHeight returns the codec used for vote extensions created at the given height.
+	ForHeight(height int64) VoteExtensionCodec
+}
+
+// HeightAwareExtendedCommitCodec is an ExtendedCommitCodec whose encoding depends on the height of the
+// proposal the extended commit is included in.
+type HeightAwareExtendedCommitCodec interface {
+	ExtendedCommitCodec
+
+	// ForHeight returns the codec used for extended commits included in proposals at the given height.
+	ForHeight(height int64) ExtendedCommitCodec
+}
+
+// VoteExtensionCodecForHeight returns the codec to use for vote extensions created at the given height. If
+// the codec is not height-aware, it is returned as is. Codecs wrapping a height-aware codec, such as the
+// CompressionVoteExtensionCodec, are themselves height-aware.
+func VoteExtensionCodecForHeight(codec VoteExtensionCodec, height int64) VoteExtensionCodec {
+	if heightAware, ok := codec.(HeightAwareVoteExtensionCodec); ok {
+		return heightAware.ForHeight(height)
+	}
+
+	return codec
+}
+
+// ExtendedCommitCodecForHeight returns the codec to use for extended commits included in proposals at the
+// given height. If the codec is not height-aware, it is returned as is. Codecs wrapping a height-aware codec,
+// such as the CompressionExtendedCommitCodec, are themselves height-aware.
+func ExtendedCommitCodecForHeight(codec ExtendedCommitCodec, height int64) ExtendedCommitCodec {
+	if heightAware, ok := codec.(HeightAwareExtendedCommitCodec); ok {
+		return heightAware.ForHeight(height)
+	}
+
+	return codec
+}
+
+// CodecUpgrade schedules a codec to be used from the given height onwards.
+type CodecUpgrade[C any] struct {
+	// Height is the first height at which the codec is used.
+	Height int64
+
+	// Codec is the codec used from Height onwards.
+	Codec C
+}
+
+// codecSchedule selects a codec by height from a set of scheduled upgrades.
+type codecSchedule[C any] struct {
+	// codecs are ordered by height, the first codec is used from genesis.
+	codecs []CodecUpgrade[C]
+
+	// transitionWindow is the number of heights, starting at an upgrade height, during which the codec
+	// used prior to the upgrade is still accepted when decoding.
+	transitionWindow int64
+}
+
+// newCodecSchedule returns a new codecSchedule, validating the upgrades.
+func newCodecSchedule[C any](initial C, transitionWindow int64, upgrades []CodecUpgrade[C]) (codecSchedule[C], error) {
+	if transitionWindow < 0 {
+		return codecSchedule[C]{}, fmt.Errorf("transition window cannot be negative; got %d", transitionWindow)
+	}
+
+	codecs := make([]CodecUpgrade[C], 0, len(upgrades)+1)
+	codecs = append(codecs, CodecUpgrade[C]{Codec: initial})
+	for _, upgrade := range upgrades {
+		if prev := codecs[len(codecs)-1].Height; upgrade.Height <= prev {
+			return codecSchedule[C]{}, fmt.Errorf(
+				"codec upgrade heights must be positive and strictly increasing; got %d after %d", upgrade.Height, prev,
+			)
+		}
+
+		codecs = append(codecs, upgrade)
+	}
+
+	return codecSchedule[C]{
+		codecs:           codecs,
+		transitionWindow: transitionWindow,
+	}, nil
+}
+
+// forHeight returns the upgrade in effect at the given height, and the upgrade prior to it if the height is
+// within its transition window.
+func (s codecSchedule[C]) forHeight(height int64) (current CodecUpgrade[C], previous *CodecUpgrade[C]) {
+	i := len(s.codecs) - 1
+	for i > 0 && s.codecs[i].Height > height {
+		i--
+	}
+
+	if i > 0 && height < s.codecs[i].Height+s.transitionWindow {
+		previous = &s.codecs[i-1]
+	}
+
+	return s.codecs[i], previous
+}
+
+// initial returns the codec used from genesis.
+func (s codecSchedule[C]) initial() C {
+	return s.codecs[0].Codec
+}
+
+// CodecMismatchError is returned when none of the codecs scheduled at a height can decode a payload, i.e. the
+// payload was encoded with a codec that is not scheduled at that height.
+type CodecMismatchError struct {
+	Height int64
+	Errs   []error
+}
+
+func (e CodecMismatchError) Error() string {
+	return fmt.Sprintf("no codec scheduled at height %d can decode the payload: %s", e.Height, errors.Join(e.Errs...))
+}
+
+// HeightVoteExtensionCodec is a VoteExtensionCodec that selects the codec by height from a schedule of codec
+// upgrades, e.g. as configured by a chain parameter. This allows a chain to switch vote extension encodings
+// at a coordinated upgrade height without an upgrade handler. During the transition window following an
+// upgrade, vote extensions encoded with the previous codec are still accepted.
+type HeightVoteExtensionCodec struct {
+	schedule codecSchedule[VoteExtensionCodec]
+}
+
+// NewHeightVoteExtensionCodec returns a new HeightVoteExtensionCodec that uses the initial codec until the
+// first upgrade height, and each upgrade's codec from its height onwards.
+func NewHeightVoteExtensionCodec(
+	initial VoteExtensionCodec,
+	transitionWindow int64,
+	upgrades ...CodecUpgrade[VoteExtensionCodec],
+) (*HeightVoteExtensionCodec, error) {
+	schedule, err := newCodecSchedule(initial, transitionWindow, upgrades)
+	if err != nil {
+		return nil, err
+	}
+
+	return &HeightVoteExtensionCodec{
+		schedule: schedule,
+	}, nil
+}
+
+// ForHeight returns the codec used for vote extensions created at the given height.
+func (codec *HeightVoteExtensionCodec) ForHeight(height int64) VoteExtensionCodec {
+	current, previous := codec.schedule.forHeight(height)
+
+	candidates := []CodecUpgrade[VoteExtensionCodec]{current}
+	if previous != nil {
+		candidates = append(candidates, *previous)
+	}
+
+	return &scheduledVoteExtensionCodec{
+		height:     height,
+		candidates: candidates,
+	}
+}
+
+// Encode encodes the vote extension using the initial codec, as the height of the vote extension is unknown.
+// Callers must use ForHeight to encode vote extensions created after an upgrade.
+func (codec *HeightVoteExtensionCodec) Encode(ve vetypes.OracleVoteExtension) ([]byte, error) {
+	return codec.schedule.initial().Encode(ve)
+}
+
+// Decode decodes the vote extension using the initial codec, as the height of the vote extension is unknown.
+// Callers must use ForHeight to decode vote extensions created after an upgrade.
+func (codec *HeightVoteExtensionCodec) Decode(bz []byte) (vetypes.OracleVoteExtension, error) {
+	return codec.schedule.initial().Decode(bz)
+}
+
+// scheduledVoteExtensionCodec encodes with the first candidate, and decodes with the first candidate that
+// succeeds.
+type scheduledVoteExtensionCodec struct {
+	height     int64
+	candidates []CodecUpgrade[VoteExtensionCodec]
+}
+
+func (codec *scheduledVoteExtensionCodec) Encode(ve vetypes.OracleVoteExtension) ([]byte, error) {
+	return codec.candidates[0].Codec.Encode(ve)
+}
+
+func (codec *scheduledVoteExtensionCodec) Decode(bz []byte) (vetypes.OracleVoteExtension, error) {
+	errs := make([]error, 0, len(codec.candidates))
+	for _, candidate := range codec.candidates {
+		ve, err := candidate.Codec.Decode(bz)
+		if err == nil {
+			return ve, nil
+		}
+
+		errs = append(errs, fmt.Errorf("codec scheduled at height %d: %w", candidate.Height, err))
+	}
+
+	return vetypes.OracleVoteExtension{}, CodecMismatchError{
+		Height: codec.height,
+		Errs:   errs,
+	}
+}
+
+// HeightExtendedCommitCodec is an ExtendedCommitCodec that selects the codec by height from a schedule of
+// codec upgrades, e.g. as configured by a chain parameter. During the transition window following an upgrade,
+// extended commits encoded with the previous codec are still accepted.
+type HeightExtendedCommitCodec struct {
+	schedule codecSchedule[ExtendedCommitCodec]
+}
+
+// NewHeightExtendedCommitCodec returns a new HeightExtendedCommitCodec that uses the initial codec until the
+// first upgrade height, and each upgrade's codec from its height onwards.
+func NewHeightExtendedCommitCodec(
+	initial ExtendedCommitCodec,
+	transitionWindow int64,
+	upgrades ...CodecUpgrade[ExtendedCommitCodec],
+) (*HeightExtendedCommitCodec, error) {
+	schedule, err := newCodecSchedule(initial, transitionWindow, upgrades)
+	if err != nil {
+		return nil, err
+	}
+
+	return &HeightExtendedCommitCodec{
+		schedule: schedule,
+	}, nil
+}
+
+// ForHeight returns the codec used for extended commits included in proposals at the given height.
+func (codec *HeightExtendedCommitCodec) ForHeight(height int64) ExtendedCommitCodec {
+	current, previous := codec.schedule.forHeight(height)
+
+	candidates := []CodecUpgrade[ExtendedCommitCodec]{current}
+	if previous != nil {
+		candidates = append(candidates, *previous)
+	}
+
+	return &scheduledExtendedCommitCodec{
+		height:     height,
+		candidates: candidates,
+	}
+}
+
+// Encode encodes the extended commit using the initial codec, as the height of the proposal is unknown.
+// Callers must use ForHeight to encode extended commits included in proposals after an upgrade.
+func (codec *HeightExtendedCommitCodec) Encode(extendedCommitInfo cometabci.ExtendedCommitInfo) ([]byte, error) {
+	return codec.schedule.initial().Encode(extendedCommitInfo)
+}
+
+// Decode decodes the extended commit using the initial codec, as the height of the proposal is unknown.
+// Callers must use ForHeight to decode extended commits included in proposals after an upgrade.
+func (codec *HeightExtendedCommitCodec) Decode(bz []byte) (cometabci.ExtendedCommitInfo, error) {
+	return codec.schedule.initial().Decode(bz)
+}
+
+// scheduledExtendedCommitCodec encodes with the first candidate, and decodes with the first candidate that
+// succeeds.
+type scheduledExtendedCommitCodec struct {
+	height     int64
+	candidates []CodecUpgrade[ExtendedCommitCodec]
+}
+
+func (codec *scheduledExtendedCommitCodec) Encode(extendedCommitInfo cometabci.ExtendedCommitInfo) ([]byte, error) {
+	return codec.candidates[0].Codec.Encode(extendedCommitInfo)
+}
+
+func (codec *scheduledExtendedCommitCodec) Decode(bz []byte) (cometabci.ExtendedCommitInfo, error) {
+	errs := make([]error, 0, len(codec.candidates))
+	for _, candidate := range codec.candidates {
+		extendedCommitInfo, err := candidate.Codec.Decode(bz)
+		if err == nil {
+			return extendedCommitInfo, nil
+		}
+
+		errs = append(errs, fmt.Errorf("codec scheduled at height %d: %w", candidate.Height, err))
+	}
+
+	return cometabci.ExtendedCommitInfo{}, CodecMismatchError{
+		Height: codec.height,
+		Errs:   errs,
+	}
+}
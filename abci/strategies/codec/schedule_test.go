@@ -0,0 +1,171 @@
+package codec_test
+
+import (
+	"testing"
+
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+
+	compression "github.com/skip-mev/connect/v2/abci/strategies/codec"
+	vetypes "github.com/skip-mev/connect/v2/abci/ve/types"
+)
+
+func TestHeightVoteExtensionCodec(t *testing.T) {
+	ve := vetypes.OracleVoteExtension{
+		Prices: map[uint64][]byte{
+			1: []byte("1"),
+			2: []byte("2"),
+		},
+	}
+
+	legacy := compression.NewCompressionVoteExtensionCodec(
+		compression.NewDefaultVoteExtensionCodec(),
+		compression.NewZStdCompressor(),
+	)
+	packed := compression.NewCompressionVoteExtensionCodec(
+		compression.NewPackedVoteExtensionCodec(),
+		compression.NewZStdCompressor(),
+	)
+
+	// switch to the packed codec at height 10, accepting legacy vote extensions until height 12
+	codec, err := compression.NewHeightVoteExtensionCodec(legacy, 2, compression.CodecUpgrade[compression.VoteExtensionCodec]{
+		Height: 10,
+		Codec:  packed,
+	})
+	require.NoError(t, err)
+
+	legacyBz, err := legacy.Encode(ve)
+	require.NoError(t, err)
+	packedBz, err := packed.Encode(ve)
+	require.NoError(t, err)
+
+	t.Run("encodes with the codec scheduled at the height", func(t *testing.T) {
+		bz, err := compression.VoteExtensionCodecForHeight(codec, 9).Encode(ve)
+		require.NoError(t, err)
+		require.Equal(t, legacyBz, bz)
+
+		bz, err = compression.VoteExtensionCodecForHeight(codec, 10).Encode(ve)
+		require.NoError(t, err)
+		require.Equal(t, packedBz, bz)
+	})
+
+	t.Run("rejects the new codec before the upgrade", func(t *testing.T) {
+		_, err := compression.VoteExtensionCodecForHeight(codec, 9).Decode(packedBz)
+		require.ErrorAs(t, err, &compression.CodecMismatchError{})
+	})
+
+	t.Run("decodes with both codecs during the transition window", func(t *testing.T) {
+		for _, height := range []int64{10, 11} {
+			for _, bz := range [][]byte{legacyBz, packedBz} {
+				decoded, err := compression.VoteExtensionCodecForHeight(codec, height).Decode(bz)
+				require.NoError(t, err)
+				require.Equal(t, ve.Prices, decoded.Prices)
+			}
+		}
+	})
+
+	t.Run("rejects the previous codec after the transition window", func(t *testing.T) {
+		decoded, err := compression.VoteExtensionCodecForHeight(codec, 12).Decode(packedBz)
+		require.NoError(t, err)
+		require.Equal(t, ve.Prices, decoded.Prices)
+
+		_, err = compression.VoteExtensionCodecForHeight(codec, 12).Decode(legacyBz)
+		require.ErrorAs(t, err, &compression.CodecMismatchError{})
+	})
+
+	t.Run("uses the initial codec when the height is unknown", func(t *testing.T) {
+		decoded, err := codec.Decode(legacyBz)
+		require.NoError(t, err)
+		require.Equal(t, ve.Prices, decoded.Prices)
+
+		_, err = codec.Decode(packedBz)
+		require.Error(t, err)
+
+		bz, err := codec.Encode(ve)
+		require.NoError(t, err)
+		require.Equal(t, legacyBz, bz)
+	})
+
+	t.Run("codecs wrapping a height-aware codec are height-aware", func(t *testing.T) {
+		inner, err := compression.NewHeightVoteExtensionCodec(
+			compression.NewDefaultVoteExtensionCodec(),
+			0,
+			compression.CodecUpgrade[compression.VoteExtensionCodec]{
+				Height: 10,
+				Codec:  compression.NewPackedVoteExtensionCodec(),
+			},
+		)
+		require.NoError(t, err)
+
+		wrapped := compression.NewCompressionVoteExtensionCodec(inner, compression.NewZStdCompressor())
+
+		bz, err := compression.VoteExtensionCodecForHeight(wrapped, 9).Encode(ve)
+		require.NoError(t, err)
+		require.Equal(t, legacyBz, bz)
+
+		bz, err = compression.VoteExtensionCodecForHeight(wrapped, 10).Encode(ve)
+		require.NoError(t, err)
+		require.Equal(t, packedBz, bz)
+
+		_, err = compression.VoteExtensionCodecForHeight(wrapped, 10).Decode(legacyBz)
+		require.ErrorAs(t, err, &compression.CodecMismatchError{})
+	})
+
+	t.Run("codecs that are not height-aware are used at every height", func(t *testing.T) {
+		require.Equal(t, legacy, compression.VoteExtensionCodecForHeight(legacy, 10))
+	})
+
+	t.Run("invalid schedules fail", func(t *testing.T) {
+		_, err := compression.NewHeightVoteExtensionCodec(legacy, -1)
+		require.Error(t, err)
+
+		_, err = compression.NewHeightVoteExtensionCodec(legacy, 0, compression.CodecUpgrade[compression.VoteExtensionCodec]{
+			Height: 0,
+			Codec:  packed,
+		})
+		require.Error(t, err)
+
+		_, err = compression.NewHeightVoteExtensionCodec(
+			legacy,
+			0,
+			compression.CodecUpgrade[compression.VoteExtensionCodec]{Height: 10, Codec: packed},
+			compression.CodecUpgrade[compression.VoteExtensionCodec]{Height: 10, Codec: legacy},
+		)
+		require.Error(t, err)
+	})
+}
+
+func TestHeightExtendedCommitCodec(t *testing.T) {
+	extendedCommit := cmtabci.ExtendedCommitInfo{
+		Round: 1,
+		Votes: []cmtabci.ExtendedVoteInfo{
+			{VoteExtension: []byte("ve")},
+		},
+	}
+
+	legacy := compression.NewDefaultExtendedCommitCodec()
+	zstd := compression.NewCompressionExtendedCommitCodec(
+		compression.NewDefaultExtendedCommitCodec(),
+		compression.NewZStdCompressor(),
+	)
+
+	codec, err := compression.NewHeightExtendedCommitCodec(legacy, 1, compression.CodecUpgrade[compression.ExtendedCommitCodec]{
+		Height: 5,
+		Codec:  zstd,
+	})
+	require.NoError(t, err)
+
+	zstdBz, err := zstd.Encode(extendedCommit)
+	require.NoError(t, err)
+
+	bz, err := compression.ExtendedCommitCodecForHeight(codec, 5).Encode(extendedCommit)
+	require.NoError(t, err)
+	require.Equal(t, zstdBz, bz)
+
+	decoded, err := compression.ExtendedCommitCodecForHeight(codec, 5).Decode(zstdBz)
+	require.NoError(t, err)
+	require.Equal(t, extendedCommit.Votes, decoded.Votes)
+
+	_, err = compression.ExtendedCommitCodecForHeight(codec, 4).Decode(zstdBz)
+	require.ErrorAs(t, err, &compression.CodecMismatchError{})
+}
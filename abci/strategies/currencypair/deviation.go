@@ -0,0 +1,112 @@
+package currencypair
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+)
+
+// DeviationCurrencyPairStrategy is a strategy that inherits from the DeltaCurrencyPairStrategy but allows
+// validators to omit a currency pair from their vote extension when the price they observe is within a
+// configurable deviation of the on-chain price, and the on-chain price is younger than a heartbeat age. A
+// validator that omits such a currency pair is considered to agree with the on-chain price.
+type DeviationCurrencyPairStrategy struct {
+	*DeltaCurrencyPairStrategy
+
+	// deviation is the maximum relative difference between a validator's price and the on-chain price
+	// for which the price may be omitted.
+	deviation math.LegacyDec
+
+	// heartbeat is the number of blocks after which an on-chain price must be refreshed by validators
+	// submitting their prices, regardless of the deviation.
+	heartbeat uint64
+}
+
+// NewDeviationCurrencyPairStrategy returns a new DeviationCurrencyPairStrategy instance. The deviation must
+// be non-negative and the heartbeat must be positive.
+func NewDeviationCurrencyPairStrategy(
+	oracleKeeper OracleKeeper,
+	deviation math.LegacyDec,
+	heartbeat uint64,
+) *DeviationCurrencyPairStrategy {
+	if deviation.IsNil() || deviation.IsNegative() {
+		panic("deviation must be non-negative")
+	}
+
+	if heartbeat == 0 {
+		panic("heartbeat must be positive")
+	}
+
+	return &DeviationCurrencyPairStrategy{
+		DeltaCurrencyPairStrategy: NewDeltaCurrencyPairStrategy(oracleKeeper),
+		deviation:                 deviation,
+		heartbeat:                 heartbeat,
+	}
+}
+
+// ShouldOmit returns true if the given price is within the deviation of the on-chain price and the on-chain
+// price will still be younger than the heartbeat when the vote extension is aggregated (i.e. at the next
+// height).
+func (s *DeviationCurrencyPairStrategy) ShouldOmit(
+	ctx sdk.Context,
+	cp connecttypes.CurrencyPair,
+	price *big.Int,
+) bool {
+	onChainPrice, ok := s.getFreshOnChainPrice(ctx, cp, ctx.BlockHeight()+1)
+	if !ok {
+		return false
+	}
+
+	// |price - onChainPrice| <= deviation * onChainPrice
+	diff := new(big.Int).Sub(price, onChainPrice)
+	maxDiff := s.deviation.MulInt(math.NewIntFromBigInt(onChainPrice))
+
+	return math.LegacyNewDecFromBigInt(diff.Abs(diff)).LTE(maxDiff)
+}
+
+// GetOmittablePrices returns the on-chain prices, indexed by currency pair ID, that are younger than the
+// heartbeat at the current height. A validator that omits one of these currency pairs from its vote
+// extension is considered to agree with the on-chain price.
+func (s *DeviationCurrencyPairStrategy) GetOmittablePrices(ctx sdk.Context) map[uint64]CurrencyPairPrice {
+	prices := make(map[uint64]CurrencyPairPrice)
+	for _, cp := range s.oracleKeeper.GetAllCurrencyPairs(ctx) {
+		id, found := s.oracleKeeper.GetIDForCurrencyPair(ctx, cp)
+		if !found {
+			continue
+		}
+
+		price, ok := s.getFreshOnChainPrice(ctx, cp, ctx.BlockHeight())
+		if !ok {
+			continue
+		}
+
+		prices[id] = CurrencyPairPrice{
+			CurrencyPair: cp,
+			Price:        price,
+		}
+	}
+
+	return prices
+}
+
+// getFreshOnChainPrice returns the on-chain price for the given currency pair if it exists and is younger
+// than the heartbeat at the given height.
+func (s *DeviationCurrencyPairStrategy) getFreshOnChainPrice(
+	ctx sdk.Context,
+	cp connecttypes.CurrencyPair,
+	height int64,
+) (*big.Int, bool) {
+	quote, err := s.oracleKeeper.GetPriceForCurrencyPair(ctx, cp)
+	if err != nil || quote.Price.IsNil() || !quote.Price.IsPositive() {
+		return nil, false
+	}
+
+	if height < 0 || quote.BlockHeight > uint64(height) || uint64(height)-quote.BlockHeight >= s.heartbeat {
+		return nil, false
+	}
+
+	return quote.Price.BigInt(), true
+}
@@ -0,0 +1,150 @@
+package currencypair_test
+
+import (
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/abci/strategies/currencypair"
+	mocks "github.com/skip-mev/connect/v2/abci/strategies/currencypair/mocks"
+	"github.com/skip-mev/connect/v2/abci/testutils"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+func TestDeviationCurrencyPairStrategyShouldOmit(t *testing.T) {
+	cp := connecttypes.NewCurrencyPair("BTC", "USD")
+	deviation := math.LegacyNewDecWithPrec(1, 2) // 1%
+	heartbeat := uint64(10)
+
+	cases := []struct {
+		name       string
+		height     int64
+		quote      oracletypes.QuotePrice
+		quoteErr   error
+		price      *big.Int
+		shouldOmit bool
+	}{
+		{
+			name:       "price does not exist in state",
+			height:     10,
+			quoteErr:   oracletypes.QuotePriceNotExistError{},
+			price:      big.NewInt(100),
+			shouldOmit: false,
+		},
+		{
+			name:   "price within deviation of a fresh on-chain price",
+			height: 10,
+			quote: oracletypes.QuotePrice{
+				Price:       math.NewInt(1000),
+				BlockHeight: 5,
+			},
+			price:      big.NewInt(1010),
+			shouldOmit: true,
+		},
+		{
+			name:   "price below the on-chain price within deviation",
+			height: 10,
+			quote: oracletypes.QuotePrice{
+				Price:       math.NewInt(1000),
+				BlockHeight: 5,
+			},
+			price:      big.NewInt(990),
+			shouldOmit: true,
+		},
+		{
+			name:   "price outside deviation of a fresh on-chain price",
+			height: 10,
+			quote: oracletypes.QuotePrice{
+				Price:       math.NewInt(1000),
+				BlockHeight: 5,
+			},
+			price:      big.NewInt(1011),
+			shouldOmit: false,
+		},
+		{
+			name:   "price within deviation of an on-chain price that reaches the heartbeat at the next height",
+			height: 10,
+			quote: oracletypes.QuotePrice{
+				Price:       math.NewInt(1000),
+				BlockHeight: 1,
+			},
+			price:      big.NewInt(1000),
+			shouldOmit: false,
+		},
+		{
+			name:   "price within deviation of an on-chain price just younger than the heartbeat",
+			height: 10,
+			quote: oracletypes.QuotePrice{
+				Price:       math.NewInt(1000),
+				BlockHeight: 2,
+			},
+			price:      big.NewInt(1000),
+			shouldOmit: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ok := mocks.NewOracleKeeper(t)
+			ctx := testutils.CreateBaseSDKContext(t).WithBlockHeight(tc.height)
+			strategy := currencypair.NewDeviationCurrencyPairStrategy(ok, deviation, heartbeat)
+
+			ok.On(
+				"GetPriceForCurrencyPair",
+				mock.Anything,
+				cp,
+			).Return(tc.quote, tc.quoteErr)
+
+			require.Equal(t, tc.shouldOmit, strategy.ShouldOmit(ctx, cp, tc.price))
+		})
+	}
+}
+
+func TestDeviationCurrencyPairStrategyGetOmittablePrices(t *testing.T) {
+	btcUSD := connecttypes.NewCurrencyPair("BTC", "USD")
+	ethUSD := connecttypes.NewCurrencyPair("ETH", "USD")
+	solUSD := connecttypes.NewCurrencyPair("SOL", "USD")
+
+	ok := mocks.NewOracleKeeper(t)
+	ctx := testutils.CreateBaseSDKContext(t).WithBlockHeight(10)
+	strategy := currencypair.NewDeviationCurrencyPairStrategy(ok, math.LegacyNewDecWithPrec(1, 2), 5)
+
+	ok.On("GetAllCurrencyPairs", mock.Anything).Return([]connecttypes.CurrencyPair{btcUSD, ethUSD, solUSD})
+	ok.On("GetIDForCurrencyPair", mock.Anything, btcUSD).Return(uint64(0), true)
+	ok.On("GetIDForCurrencyPair", mock.Anything, ethUSD).Return(uint64(1), true)
+	ok.On("GetIDForCurrencyPair", mock.Anything, solUSD).Return(uint64(2), true)
+
+	// btc/usd is fresh, eth/usd is at the heartbeat, and sol/usd has no price
+	ok.On("GetPriceForCurrencyPair", mock.Anything, btcUSD).Return(oracletypes.QuotePrice{
+		Price:       math.NewInt(100),
+		BlockHeight: 6,
+	}, nil)
+	ok.On("GetPriceForCurrencyPair", mock.Anything, ethUSD).Return(oracletypes.QuotePrice{
+		Price:       math.NewInt(200),
+		BlockHeight: 5,
+	}, nil)
+	ok.On("GetPriceForCurrencyPair", mock.Anything, solUSD).Return(
+		oracletypes.QuotePrice{},
+		oracletypes.QuotePriceNotExistError{},
+	)
+
+	prices := strategy.GetOmittablePrices(ctx)
+	require.Len(t, prices, 1)
+	require.Equal(t, btcUSD, prices[0].CurrencyPair)
+	require.Equal(t, big.NewInt(100), prices[0].Price)
+}
+
+func TestNewDeviationCurrencyPairStrategy(t *testing.T) {
+	ok := mocks.NewOracleKeeper(t)
+
+	require.Panics(t, func() {
+		currencypair.NewDeviationCurrencyPairStrategy(ok, math.LegacyNewDec(-1), 1)
+	})
+	require.Panics(t, func() {
+		currencypair.NewDeviationCurrencyPairStrategy(ok, math.LegacyZeroDec(), 0)
+	})
+}
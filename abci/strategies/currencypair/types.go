@@ -56,3 +56,28 @@ type CurrencyPairStrategy interface { //nolint
 		ctx sdk.Context,
 	) (uint64, error)
 }
+
+// OmissionStrategy is a CurrencyPairStrategy that allows validators to omit currency pairs from their vote
+// extensions. A validator that omits an omittable currency pair is considered to agree with its on-chain
+// price, rather than to have not reported a price for it.
+type OmissionStrategy interface {
+	CurrencyPairStrategy
+
+	// ShouldOmit returns true if the given price may be omitted from the vote extension being created at
+	// the current height.
+	ShouldOmit(
+		ctx sdk.Context,
+		cp connecttypes.CurrencyPair,
+		price *big.Int,
+	) bool
+
+	// GetOmittablePrices returns the on-chain prices, indexed by currency pair ID, that validators may
+	// have omitted from the vote extensions being aggregated at the current height.
+	GetOmittablePrices(ctx sdk.Context) map[uint64]CurrencyPairPrice
+}
+
+// CurrencyPairPrice is a price for a given currency pair.
+type CurrencyPairPrice struct {
+	CurrencyPair connecttypes.CurrencyPair
+	Price        *big.Int
+}
@@ -58,3 +58,32 @@ func (e MissingCommitInfoError) Error() string {
 func (e MissingCommitInfoError) Label() string {
 	return "MissingCommitInfoError"
 }
+
+// NoPriceUpdateError is an error that is returned when the oracle data injected into a proposal fails validation,
+// and the block is accepted without a price update rather than rejected.
+type NoPriceUpdateError struct {
+	Err error
+}
+
+func (e NoPriceUpdateError) Error() string {
+	return fmt.Sprintf("no price update: %s", e.Err.Error())
+}
+
+func (e NoPriceUpdateError) Label() string {
+	return "NoPriceUpdateError"
+}
+
+// OracleDataNotValidatedError is an error that is returned when the oracle data of a block must be validated
+// before prices are applied, but was neither validated in ProcessProposal nor can be validated in the current
+// context, e.g. in ExtendVote.
+type OracleDataNotValidatedError struct {
+	Height int64
+}
+
+func (e OracleDataNotValidatedError) Error() string {
+	return fmt.Sprintf("oracle data of the block at height %d was not validated", e.Height)
+}
+
+func (e OracleDataNotValidatedError) Label() string {
+	return "OracleDataNotValidatedError"
+}
@@ -0,0 +1,10 @@
+package types
+
+const (
+	// EventTypeNoPriceUpdate is emitted when a block is finalized without a price update because the
+	// oracle data injected into it failed validation.
+	EventTypeNoPriceUpdate = "oracle_no_price_update"
+
+	AttributeKeyHeight = "height"
+	AttributeKeyReason = "reason"
+)
@@ -0,0 +1,173 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// TxInjection defines where a tx injected by a vote-extension consumer, such as the oracle's extended commit
+// info, is placed within a proposal and how it is located by the handlers that consume it.
+//
+// By default, the tx is injected and located at a fixed index. If a Marker is set, the marker is prefixed to
+// the injected tx and the tx is located as the first tx of the proposal that starts with the marker, regardless
+// of its index. This allows several consumers that inject txs to compose, as each consumer may move the txs
+// injected by the others. The marker must never be a prefix of a valid application tx.
+type TxInjection struct {
+	// Index is the index at which the tx is injected into the proposal. If Marker is empty, the tx is also
+	// located by this index.
+	Index int
+
+	// Marker, if non-empty, is prefixed to the injected tx and used to locate it.
+	Marker []byte
+}
+
+// DefaultTxInjection returns the TxInjection of the oracle data, located as the first tx of the proposal.
+func DefaultTxInjection() TxInjection {
+	return TxInjection{
+		Index: OracleInfoIndex,
+	}
+}
+
+// NewMarkerTxInjection returns a TxInjection that injects the tx at the given index, prefixed by the given
+// marker, and locates it by the marker.
+func NewMarkerTxInjection(marker []byte, index int) TxInjection {
+	return TxInjection{
+		Index:  index,
+		Marker: marker,
+	}
+}
+
+// ValidateBasic returns an error if the TxInjection is invalid.
+func (t TxInjection) ValidateBasic() error {
+	if t.Index < 0 {
+		return fmt.Errorf("injection index cannot be negative: %d", t.Index)
+	}
+
+	return nil
+}
+
+// Wrap returns the tx that is injected into the proposal for the given payload.
+func (t TxInjection) Wrap(payload []byte) []byte {
+	if len(t.Marker) == 0 {
+		return payload
+	}
+
+	tx := make([]byte, 0, len(t.Marker)+len(payload))
+	tx = append(tx, t.Marker...)
+	return append(tx, payload...)
+}
+
+// Locate returns the index of the injected tx in the given proposal, and its payload. A MissingCommitInfoError
+// is returned if the proposal does not contain the injected tx.
+func (t TxInjection) Locate(txs [][]byte) (int, []byte, error) {
+	if len(t.Marker) == 0 {
+		if t.Index >= len(txs) {
+			return 0, nil, MissingCommitInfoError{}
+		}
+
+		return t.Index, txs[t.Index], nil
+	}
+
+	for i, tx := range txs {
+		if bytes.HasPrefix(tx, t.Marker) {
+			return i, tx[len(t.Marker):], nil
+		}
+	}
+
+	return 0, nil, MissingCommitInfoError{}
+}
+
+// Insert returns the given txs with tx inserted at the injection index, or appended if there are fewer txs than
+// the index. The given txs are not modified.
+func (t TxInjection) Insert(txs [][]byte, tx []byte) [][]byte {
+	index := min(t.Index, len(txs))
+
+	injected := make([][]byte, 0, len(txs)+1)
+	injected = append(injected, txs[:index]...)
+	injected = append(injected, tx)
+	return append(injected, txs[index:]...)
+}
+
+// Remove returns the given txs without the tx at the given index. The given txs are not modified.
+func (t TxInjection) Remove(txs [][]byte, index int) [][]byte {
+	removed := make([][]byte, 0, len(txs))
+	removed = append(removed, txs[:index]...)
+	return append(removed, txs[index+1:]...)
+}
+
+// InjectionRegistry holds the TxInjection of every vote-extension consumer that injects a tx into the
+// proposals of a chain, so that several consumers can coexist in one proposal. The registry ensures that the
+// injected txs of different consumers cannot be mistaken for one another, and allows the application to
+// separate the injected txs from the application txs of a proposal.
+type InjectionRegistry struct {
+	names      []string
+	injections map[string]TxInjection
+}
+
+// NewInjectionRegistry returns a new, empty InjectionRegistry.
+func NewInjectionRegistry() *InjectionRegistry {
+	return &InjectionRegistry{
+		injections: make(map[string]TxInjection),
+	}
+}
+
+// Register registers the TxInjection of the consumer with the given name. An error is returned if the name is
+// already registered, or if the injected tx could be mistaken for the injected tx of another consumer, i.e. if
+// more than one consumer is located by index, or if a marker is a prefix of another.
+func (r *InjectionRegistry) Register(name string, injection TxInjection) error {
+	if len(name) == 0 {
+		return fmt.Errorf("injection name cannot be empty")
+	}
+
+	if err := injection.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid injection %s: %w", name, err)
+	}
+
+	if _, ok := r.injections[name]; ok {
+		return fmt.Errorf("injection %s is already registered", name)
+	}
+
+	for _, other := range r.names {
+		registered := r.injections[other]
+
+		switch {
+		case len(injection.Marker) == 0 && len(registered.Marker) == 0:
+			return fmt.Errorf("injections %s and %s are both located by index; at most one injection may omit a marker", name, other)
+		case len(injection.Marker) == 0 || len(registered.Marker) == 0:
+			continue
+		case bytes.HasPrefix(injection.Marker, registered.Marker) || bytes.HasPrefix(registered.Marker, injection.Marker):
+			return fmt.Errorf("markers of injections %s and %s conflict", name, other)
+		}
+	}
+
+	r.names = append(r.names, name)
+	r.injections[name] = injection
+
+	return nil
+}
+
+// Injection returns the TxInjection registered under the given name, and false if there is none.
+func (r *InjectionRegistry) Injection(name string) (TxInjection, bool) {
+	injection, ok := r.injections[name]
+	return injection, ok
+}
+
+// RemoveInjected returns the given txs without the txs injected by any registered consumer. The given txs are
+// not modified.
+func (r *InjectionRegistry) RemoveInjected(txs [][]byte) [][]byte {
+	injected := make(map[int]struct{}, len(r.names))
+	for _, name := range r.names {
+		if i, _, err := r.injections[name].Locate(txs); err == nil {
+			injected[i] = struct{}{}
+		}
+	}
+
+	appTxs := make([][]byte, 0, len(txs))
+	for i, tx := range txs {
+		if _, ok := injected[i]; !ok {
+			appTxs = append(appTxs, tx)
+		}
+	}
+
+	return appTxs
+}
@@ -0,0 +1,78 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/abci/types"
+)
+
+func TestTxInjection(t *testing.T) {
+	t.Run("default injection locates the first tx", func(t *testing.T) {
+		injection := types.DefaultTxInjection()
+		require.Equal(t, []byte("payload"), injection.Wrap([]byte("payload")))
+
+		i, payload, err := injection.Locate([][]byte{[]byte("payload"), []byte("tx")})
+		require.NoError(t, err)
+		require.Equal(t, 0, i)
+		require.Equal(t, []byte("payload"), payload)
+
+		_, _, err = injection.Locate(nil)
+		require.ErrorIs(t, err, types.MissingCommitInfoError{})
+	})
+
+	t.Run("marker injection locates the tx at any index", func(t *testing.T) {
+		injection := types.NewMarkerTxInjection([]byte("m:"), 5)
+		tx := injection.Wrap([]byte("payload"))
+		require.Equal(t, []byte("m:payload"), tx)
+
+		// the index is clamped to the number of txs
+		txs := injection.Insert([][]byte{[]byte("a"), []byte("b")}, tx)
+		require.Equal(t, [][]byte{[]byte("a"), []byte("b"), tx}, txs)
+
+		i, payload, err := injection.Locate(txs)
+		require.NoError(t, err)
+		require.Equal(t, 2, i)
+		require.Equal(t, []byte("payload"), payload)
+		require.Equal(t, [][]byte{[]byte("a"), []byte("b")}, injection.Remove(txs, i))
+
+		_, _, err = injection.Locate([][]byte{[]byte("payload")})
+		require.ErrorIs(t, err, types.MissingCommitInfoError{})
+	})
+
+	t.Run("negative index is invalid", func(t *testing.T) {
+		require.Error(t, types.NewMarkerTxInjection([]byte("m:"), -1).ValidateBasic())
+	})
+}
+
+func TestInjectionRegistry(t *testing.T) {
+	t.Run("registers non-conflicting injections", func(t *testing.T) {
+		registry := types.NewInjectionRegistry()
+		require.NoError(t, registry.Register("oracle", types.DefaultTxInjection()))
+		require.NoError(t, registry.Register("a", types.NewMarkerTxInjection([]byte("a:"), 1)))
+		require.NoError(t, registry.Register("b", types.NewMarkerTxInjection([]byte("b:"), 2)))
+
+		injection, ok := registry.Injection("a")
+		require.True(t, ok)
+		require.Equal(t, []byte("a:"), injection.Marker)
+
+		_, ok = registry.Injection("c")
+		require.False(t, ok)
+
+		txs := [][]byte{[]byte("oracle"), []byte("tx1"), []byte("b:data"), []byte("a:data"), []byte("tx2")}
+		require.Equal(t, [][]byte{[]byte("tx1"), []byte("tx2")}, registry.RemoveInjected(txs))
+	})
+
+	t.Run("rejects conflicting injections", func(t *testing.T) {
+		registry := types.NewInjectionRegistry()
+		require.NoError(t, registry.Register("oracle", types.DefaultTxInjection()))
+		require.NoError(t, registry.Register("a", types.NewMarkerTxInjection([]byte("a:"), 1)))
+
+		require.Error(t, registry.Register("", types.NewMarkerTxInjection([]byte("c:"), 0)))
+		require.Error(t, registry.Register("a", types.NewMarkerTxInjection([]byte("c:"), 0)))
+		require.Error(t, registry.Register("index", types.TxInjection{Index: 1}))
+		require.Error(t, registry.Register("prefix", types.NewMarkerTxInjection([]byte("a:b"), 0)))
+		require.Error(t, registry.Register("invalid", types.NewMarkerTxInjection([]byte("c:"), -1)))
+	})
+}
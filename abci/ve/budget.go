@@ -0,0 +1,238 @@
+package ve
+
+import (
+	"context"
+	"math/big"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	compression "github.com/skip-mev/connect/v2/abci/strategies/codec"
+	"github.com/skip-mev/connect/v2/abci/strategies/currencypair"
+	"github.com/skip-mev/connect/v2/abci/ve/types"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	servicemetrics "github.com/skip-mev/connect/v2/service/metrics"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+// PriceKeeper defines the interface contract required to retrieve the latest on-chain price for a
+// currency pair. It is used to prioritize prices when a vote extension exceeds its budget.
+type PriceKeeper interface {
+	GetPriceForCurrencyPair(ctx context.Context, cp connecttypes.CurrencyPair) (oracletypes.QuotePrice, error)
+}
+
+// pricePriority tracks the information used to prioritize a single price in a vote extension.
+type pricePriority struct {
+	id uint64
+
+	// onChain is false if there is no price for the currency pair in state.
+	onChain bool
+
+	// lastUpdated is the height at which the on-chain price was last updated.
+	lastUpdated uint64
+
+	// move is the absolute relative difference between the oracle price and the on-chain price.
+	move *big.Rat
+}
+
+// encodeWithinBudget encodes the given vote extension. If a budget is configured and the encoded vote
+// extension exceeds it, the prices are prioritized and only the largest set of highest priority prices
+// that fits within the budget is included. The remaining prices are deferred to a later height. Prices
+// that validators may omit are never deferred, as an omitted price is counted as agreement with the
+// on-chain price.
+func (h *VoteExtensionHandler) encodeWithinBudget(
+	ctx sdk.Context,
+	voteExt types.OracleVoteExtension,
+	rawPrices map[string]string,
+) ([]byte, error) {
+	codec := compression.VoteExtensionCodecForHeight(h.voteExtensionCodec, ctx.BlockHeight())
+
+	bz, err := codec.Encode(voteExt)
+	if err != nil || h.maxVoteExtensionBytes == 0 || len(bz) <= h.maxVoteExtensionBytes {
+		return bz, err
+	}
+
+	h.metrics.ObserveMessageSize(servicemetrics.UnbudgetedVoteExtension, len(bz))
+
+	// Split the prices into those that must be included and those that may be deferred.
+	required := h.getUndeferrablePrices(ctx, voteExt.Prices)
+	deferrable := make(map[uint64][]byte, len(voteExt.Prices)-len(required))
+	for id, price := range voteExt.Prices {
+		if _, ok := required[id]; !ok {
+			deferrable[id] = price
+		}
+	}
+
+	ids := h.prioritizePrices(ctx, deferrable, rawPrices)
+
+	// encode returns the vote extension containing the required prices and the n highest priority
+	// deferrable prices.
+	encode := func(n int) ([]byte, error) {
+		prices := make(map[uint64][]byte, len(required)+n)
+		for id := range required {
+			prices[id] = voteExt.Prices[id]
+		}
+		for _, id := range ids[:n] {
+			prices[id] = voteExt.Prices[id]
+		}
+
+		return codec.Encode(types.OracleVoteExtension{Prices: prices})
+	}
+
+	// Find the largest number of deferrable prices that fits within the budget. Every price is known to
+	// exceed it.
+	var searchErr error
+	included := max(sort.Search(len(ids), func(n int) bool {
+		encoded, err := encode(n)
+		if err != nil {
+			searchErr = err
+			return true
+		}
+
+		return len(encoded) > h.maxVoteExtensionBytes
+	})-1, 0)
+	if searchErr != nil {
+		return nil, searchErr
+	}
+
+	budgeted, err := encode(included)
+	if err != nil {
+		return nil, err
+	}
+
+	h.logger.Info(
+		"vote extension exceeded budget; deferring lowest priority prices",
+		"height", ctx.BlockHeight(),
+		"budget (bytes)", h.maxVoteExtensionBytes,
+		"unbudgeted size (bytes)", len(bz),
+		"size (bytes)", len(budgeted),
+		"required_prices", len(required),
+		"included_prices", included,
+		"deferred_prices", len(ids)-included,
+	)
+
+	if len(budgeted) > h.maxVoteExtensionBytes {
+		h.logger.Error(
+			"prices that cannot be deferred exceed the vote extension budget",
+			"height", ctx.BlockHeight(),
+			"budget (bytes)", h.maxVoteExtensionBytes,
+			"size (bytes)", len(budgeted),
+			"required_prices", len(required),
+		)
+	}
+
+	h.metrics.ObserveMessageSize(servicemetrics.DeferredVoteExtension, len(bz)-len(budgeted))
+
+	return budgeted, nil
+}
+
+// getUndeferrablePrices returns the IDs of the given prices that cannot be deferred. If the currency pair
+// strategy allows validators to omit prices, a validator that leaves out a price that is omittable when
+// its vote extension is aggregated is considered to agree with the on-chain price. A deferred price would
+// be indistinguishable from an unchanged one, so these prices are always included.
+func (h *VoteExtensionHandler) getUndeferrablePrices(
+	ctx sdk.Context,
+	prices map[uint64][]byte,
+) map[uint64]struct{} {
+	omissionStrategy, ok := h.currencyPairStrategy.(currencypair.OmissionStrategy)
+	if !ok {
+		return nil
+	}
+
+	// Vote extensions created at this height are aggregated at the next height, against the state that
+	// includes the prices of the current proposal (which have already been applied).
+	omittable := omissionStrategy.GetOmittablePrices(ctx.WithBlockHeight(ctx.BlockHeight() + 1))
+
+	required := make(map[uint64]struct{})
+	for id := range prices {
+		if _, ok := omittable[id]; ok {
+			required[id] = struct{}{}
+		}
+	}
+
+	return required
+}
+
+// prioritizePrices returns the currency pair IDs of the given prices in order of priority. Currency pairs
+// without an on-chain price come first, followed by those whose oracle price moved the most relative to the
+// on-chain price. Ties are broken by the height at which the on-chain price was last updated (stalest first),
+// and finally by ID to ensure the ordering is deterministic.
+func (h *VoteExtensionHandler) prioritizePrices(
+	ctx sdk.Context,
+	prices map[uint64][]byte,
+	rawPrices map[string]string,
+) []uint64 {
+	// Index the oracle prices by currency pair.
+	oraclePrices := make(map[connecttypes.CurrencyPair]*big.Int, len(rawPrices))
+	for cpString, priceString := range rawPrices {
+		cp, err := connecttypes.CurrencyPairFromString(cpString)
+		if err != nil {
+			continue
+		}
+
+		if price, ok := new(big.Int).SetString(priceString, 10); ok {
+			oraclePrices[cp] = price
+		}
+	}
+
+	priorities := make([]pricePriority, 0, len(prices))
+	for id := range prices {
+		priorities = append(priorities, h.getPricePriority(ctx, id, oraclePrices))
+	}
+
+	sort.Slice(priorities, func(i, j int) bool {
+		a, b := priorities[i], priorities[j]
+		if a.onChain != b.onChain {
+			return !a.onChain
+		}
+
+		if a.onChain {
+			if cmp := a.move.Cmp(b.move); cmp != 0 {
+				return cmp > 0
+			}
+
+			if a.lastUpdated != b.lastUpdated {
+				return a.lastUpdated < b.lastUpdated
+			}
+		}
+
+		return a.id < b.id
+	})
+
+	ids := make([]uint64, len(priorities))
+	for i, priority := range priorities {
+		ids[i] = priority.id
+	}
+
+	return ids
+}
+
+// getPricePriority returns the priority information for the price with the given currency pair ID.
+func (h *VoteExtensionHandler) getPricePriority(
+	ctx sdk.Context,
+	id uint64,
+	oraclePrices map[connecttypes.CurrencyPair]*big.Int,
+) pricePriority {
+	cp, err := h.currencyPairStrategy.FromID(ctx, id)
+	if err != nil {
+		return pricePriority{id: id}
+	}
+
+	qp, err := h.priceKeeper.GetPriceForCurrencyPair(ctx, cp)
+	if err != nil || qp.Price.IsNil() || !qp.Price.IsPositive() {
+		return pricePriority{id: id}
+	}
+
+	move := new(big.Rat)
+	if price, ok := oraclePrices[cp]; ok {
+		diff := new(big.Int).Sub(price, qp.Price.BigInt())
+		move.SetFrac(diff.Abs(diff), qp.Price.BigInt())
+	}
+
+	return pricePriority{
+		id:          id,
+		onChain:     true,
+		lastUpdated: qp.BlockHeight,
+		move:        move,
+	}
+}
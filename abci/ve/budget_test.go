@@ -0,0 +1,275 @@
+package ve_test
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	cometabci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/mock"
+
+	aggregatormocks "github.com/skip-mev/connect/v2/abci/strategies/aggregator/mocks"
+	"github.com/skip-mev/connect/v2/abci/strategies/codec"
+	"github.com/skip-mev/connect/v2/abci/strategies/currencypair"
+	mockstrategies "github.com/skip-mev/connect/v2/abci/strategies/currencypair/mocks"
+	"github.com/skip-mev/connect/v2/abci/ve"
+	abcitypes "github.com/skip-mev/connect/v2/abci/ve/types"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/service/clients/oracle/mocks"
+	servicemetrics "github.com/skip-mev/connect/v2/service/metrics"
+	metricsmocks "github.com/skip-mev/connect/v2/service/metrics/mocks"
+	servicetypes "github.com/skip-mev/connect/v2/service/servers/oracle/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+var solUSD = connecttypes.NewCurrencyPair("SOL", "USD")
+
+func (s *VoteExtensionTestSuite) TestExtendVoteWithBudget() {
+	type onChainPrice struct {
+		price  int64
+		height uint64
+	}
+
+	// the ids assigned to each currency pair
+	ids := map[connecttypes.CurrencyPair]uint64{
+		btcUSD: 0,
+		ethUSD: 1,
+		solUSD: 2,
+	}
+
+	cases := []struct {
+		name           string
+		oraclePrices   map[connecttypes.CurrencyPair]int64
+		onChainPrices  map[connecttypes.CurrencyPair]onChainPrice
+		budgetPrices   int
+		expectedPrices []connecttypes.CurrencyPair
+	}{
+		{
+			name: "vote extension within budget includes all prices",
+			oraclePrices: map[connecttypes.CurrencyPair]int64{
+				btcUSD: 1000,
+				ethUSD: 2000,
+				solUSD: 3000,
+			},
+			onChainPrices:  map[connecttypes.CurrencyPair]onChainPrice{},
+			budgetPrices:   3,
+			expectedPrices: []connecttypes.CurrencyPair{btcUSD, ethUSD, solUSD},
+		},
+		{
+			name: "prices that moved the most are included first",
+			oraclePrices: map[connecttypes.CurrencyPair]int64{
+				btcUSD: 1100, // 10% move
+				ethUSD: 2020, // 1% move
+				solUSD: 3600, // 20% move
+			},
+			onChainPrices: map[connecttypes.CurrencyPair]onChainPrice{
+				btcUSD: {price: 1000, height: 10},
+				ethUSD: {price: 2000, height: 10},
+				solUSD: {price: 3000, height: 10},
+			},
+			budgetPrices:   2,
+			expectedPrices: []connecttypes.CurrencyPair{btcUSD, solUSD},
+		},
+		{
+			name: "prices without an on-chain price are included first",
+			oraclePrices: map[connecttypes.CurrencyPair]int64{
+				btcUSD: 1100,
+				ethUSD: 4000,
+				solUSD: 3600,
+			},
+			onChainPrices: map[connecttypes.CurrencyPair]onChainPrice{
+				btcUSD: {price: 1000, height: 10},
+				ethUSD: {price: 2000, height: 10},
+			},
+			budgetPrices:   1,
+			expectedPrices: []connecttypes.CurrencyPair{solUSD},
+		},
+		{
+			name: "stalest prices are included first if the moves are equal",
+			oraclePrices: map[connecttypes.CurrencyPair]int64{
+				btcUSD: 1000,
+				ethUSD: 2000,
+				solUSD: 3000,
+			},
+			onChainPrices: map[connecttypes.CurrencyPair]onChainPrice{
+				btcUSD: {price: 1000, height: 10},
+				ethUSD: {price: 2000, height: 5},
+				solUSD: {price: 3000, height: 8},
+			},
+			budgetPrices:   2,
+			expectedPrices: []connecttypes.CurrencyPair{ethUSD, solUSD},
+		},
+		{
+			name: "large moves on recently updated prices outrank small moves on stale prices",
+			oraclePrices: map[connecttypes.CurrencyPair]int64{
+				btcUSD: 1100, // 10% move, stale for 1 block
+				ethUSD: 2100, // 5% move, stale for 20 blocks
+				solUSD: 3030, // 1% move, stale for 19 blocks
+			},
+			onChainPrices: map[connecttypes.CurrencyPair]onChainPrice{
+				btcUSD: {price: 1000, height: 20},
+				ethUSD: {price: 2000, height: 1},
+				solUSD: {price: 3000, height: 2},
+			},
+			budgetPrices:   1,
+			expectedPrices: []connecttypes.CurrencyPair{btcUSD},
+		},
+		{
+			name: "budget smaller than a single price returns an empty vote extension",
+			oraclePrices: map[connecttypes.CurrencyPair]int64{
+				btcUSD: 1000,
+				ethUSD: 2000,
+				solUSD: 3000,
+			},
+			onChainPrices:  map[connecttypes.CurrencyPair]onChainPrice{},
+			budgetPrices:   0,
+			expectedPrices: []connecttypes.CurrencyPair{},
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			ctx := s.ctx.WithBlockHeight(20)
+			cdc := codec.NewDefaultVoteExtensionCodec()
+
+			// Every encoded price is of the same size (2 bytes), so the budget is the size of a vote
+			// extension with the given number of prices.
+			budgetExt := abcitypes.OracleVoteExtension{Prices: make(map[uint64][]byte)}
+			for i := 0; i < tc.budgetPrices; i++ {
+				budgetExt.Prices[uint64(i)] = big.NewInt(1000).Bytes() //nolint:gosec
+			}
+			budgetBz, err := cdc.Encode(budgetExt)
+			s.Require().NoError(err)
+			budget := max(len(budgetBz), 1)
+
+			rawPrices := make(map[string]string)
+			cps := mockstrategies.NewCurrencyPairStrategy(s.T())
+			priceKeeper := mockstrategies.NewOracleKeeper(s.T())
+			for cp, price := range tc.oraclePrices {
+				rawPrices[cp.String()] = fmt.Sprint(price)
+
+				cps.On("ID", mock.Anything, cp).Return(ids[cp], nil)
+				cps.On("GetEncodedPrice", mock.Anything, cp, big.NewInt(price)).Return(big.NewInt(price).Bytes(), nil)
+				cps.On("FromID", mock.Anything, ids[cp]).Return(cp, nil).Maybe()
+
+				if onChain, ok := tc.onChainPrices[cp]; ok {
+					priceKeeper.On("GetPriceForCurrencyPair", mock.Anything, cp).Return(oracletypes.QuotePrice{
+						Price:       math.NewInt(onChain.price),
+						BlockHeight: onChain.height,
+					}, nil).Maybe()
+				} else {
+					priceKeeper.On("GetPriceForCurrencyPair", mock.Anything, cp).Return(
+						oracletypes.QuotePrice{},
+						fmt.Errorf("no price"),
+					).Maybe()
+				}
+			}
+
+			client := mocks.NewOracleClient(s.T())
+			client.On("Prices", mock.Anything, mock.Anything).Return(
+				&servicetypes.QueryPricesResponse{
+					Prices: rawPrices,
+				},
+				nil,
+			)
+
+			metrics := metricsmocks.NewMetrics(s.T())
+			metrics.On("ObserveABCIMethodLatency", servicemetrics.ExtendVote, mock.Anything)
+			metrics.On("AddABCIRequest", servicemetrics.ExtendVote, servicemetrics.Success{})
+			if len(tc.expectedPrices) != len(tc.oraclePrices) {
+				metrics.On("ObserveMessageSize", servicemetrics.UnbudgetedVoteExtension, mock.Anything).Once()
+				metrics.On("ObserveMessageSize", servicemetrics.DeferredVoteExtension, mock.Anything).Once()
+			}
+
+			priceApplier := aggregatormocks.NewPriceApplier(s.T())
+			priceApplier.On("ApplyPricesFromVoteExtensions", ctx, mock.Anything).Return(nil, nil)
+
+			h := ve.NewVoteExtensionHandler(
+				log.NewTestLogger(s.T()),
+				client,
+				time.Second*1,
+				cps,
+				cdc,
+				priceApplier,
+				metrics,
+				ve.WithVoteExtensionBudget(budget, priceKeeper),
+			)
+
+			resp, err := h.ExtendVoteHandler()(ctx, &cometabci.RequestExtendVote{})
+			s.Require().NoError(err)
+			s.Require().LessOrEqual(len(resp.VoteExtension), budget)
+
+			ext, err := cdc.Decode(resp.VoteExtension)
+			s.Require().NoError(err)
+			s.Require().Len(ext.Prices, len(tc.expectedPrices))
+			for _, cp := range tc.expectedPrices {
+				s.Require().Equal(big.NewInt(tc.oraclePrices[cp]).Bytes(), ext.Prices[ids[cp]])
+			}
+		})
+	}
+}
+
+func (s *VoteExtensionTestSuite) TestExtendVoteWithBudgetAndOmission() {
+	ctx := s.ctx.WithBlockHeight(10)
+
+	// BTC and ETH have fresh on-chain prices, so validators may omit them. SOL's on-chain price is older
+	// than the heartbeat and must be reported.
+	cps := []connecttypes.CurrencyPair{btcUSD, ethUSD, solUSD}
+	onChainPrices := []oracletypes.QuotePrice{
+		{Price: math.NewInt(1000), BlockHeight: 8},
+		{Price: math.NewInt(2000), BlockHeight: 8},
+		{Price: math.NewInt(3000), BlockHeight: 2},
+	}
+
+	oracleKeeper := mockstrategies.NewOracleKeeper(s.T())
+	oracleKeeper.On("GetAllCurrencyPairs", mock.Anything).Return(cps).Maybe()
+	for id, cp := range cps {
+		oracleKeeper.On("GetIDForCurrencyPair", mock.Anything, cp).Return(uint64(id), true).Maybe()  //nolint:gosec
+		oracleKeeper.On("GetCurrencyPairFromID", mock.Anything, uint64(id)).Return(cp, true).Maybe() //nolint:gosec
+		oracleKeeper.On("GetPriceForCurrencyPair", mock.Anything, cp).Return(onChainPrices[id], nil).Maybe()
+	}
+
+	client := mocks.NewOracleClient(s.T())
+	client.On("Prices", mock.Anything, mock.Anything).Return(
+		&servicetypes.QueryPricesResponse{
+			Prices: map[string]string{
+				btcUSD.String(): "1005", // within the deviation, omitted
+				ethUSD.String(): "2060", // 3% move on a price that may be omitted
+				solUSD.String(): "3600", // 20% move on a stale price
+			},
+		},
+		nil,
+	)
+
+	priceApplier := aggregatormocks.NewPriceApplier(s.T())
+	priceApplier.On("ApplyPricesFromVoteExtensions", ctx, mock.Anything).Return(nil, nil)
+
+	// The budget only fits the ETH price. Although SOL has a higher priority, deferring ETH would be
+	// indistinguishable from omitting it, i.e. agreeing with the on-chain price.
+	cdc := codec.NewDefaultVoteExtensionCodec()
+	ethDelta, err := big.NewInt(60).GobEncode()
+	s.Require().NoError(err)
+	budgetBz, err := cdc.Encode(abcitypes.OracleVoteExtension{Prices: map[uint64][]byte{1: ethDelta}})
+	s.Require().NoError(err)
+
+	h := ve.NewVoteExtensionHandler(
+		log.NewTestLogger(s.T()),
+		client,
+		time.Second*1,
+		currencypair.NewDeviationCurrencyPairStrategy(oracleKeeper, math.LegacyNewDecWithPrec(1, 2), 5),
+		cdc,
+		priceApplier,
+		servicemetrics.NewNopMetrics(),
+		ve.WithVoteExtensionBudget(len(budgetBz), oracleKeeper),
+	)
+
+	resp, err := h.ExtendVoteHandler()(ctx, &cometabci.RequestExtendVote{})
+	s.Require().NoError(err)
+	s.Require().LessOrEqual(len(resp.VoteExtension), len(budgetBz))
+
+	ext, err := cdc.Decode(resp.VoteExtension)
+	s.Require().NoError(err)
+	s.Require().Equal(map[uint64][]byte{1: ethDelta}, ext.Prices)
+}
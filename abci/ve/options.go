@@ -0,0 +1,23 @@
+package ve
+
+// Option is a function that enables optional configuration of the VoteExtensionHandler.
+type Option func(*VoteExtensionHandler)
+
+// WithVoteExtensionBudget returns an Option that configures the maximum size (in bytes) of the
+// vote extensions created by the VoteExtensionHandler. When the encoded vote extension exceeds
+// the budget, prices are prioritized using the latest on-chain prices retrieved from the given
+// PriceKeeper, and the lowest priority prices are deferred to a later height.
+func WithVoteExtensionBudget(maxBytes int, priceKeeper PriceKeeper) Option {
+	return func(h *VoteExtensionHandler) {
+		if maxBytes <= 0 {
+			panic("vote extension budget must be positive")
+		}
+
+		if priceKeeper == nil {
+			panic("price keeper cannot be nil")
+		}
+
+		h.maxVoteExtensionBytes = maxBytes
+		h.priceKeeper = priceKeeper
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"math/big"
+	"slices"
 	"time"
 
 	"cosmossdk.io/log"
@@ -47,6 +49,14 @@ type VoteExtensionHandler struct {
 
 	// metrics is the service metrics interface that the vote-extension handler will use to report metrics.
 	metrics servicemetrics.Metrics
+
+	// maxVoteExtensionBytes is the maximum size of an encoded vote extension. If zero, the vote
+	// extension is not budgeted.
+	maxVoteExtensionBytes int
+
+	// priceKeeper is used to retrieve the latest on-chain prices when prioritizing prices to fit
+	// within the vote extension budget.
+	priceKeeper PriceKeeper
 }
 
 // NewVoteExtensionHandler returns a new VoteExtensionHandler.
@@ -58,8 +68,9 @@ func NewVoteExtensionHandler(
 	codec compression.VoteExtensionCodec,
 	priceApplier aggregator.PriceApplier,
 	metrics servicemetrics.Metrics,
+	opts ...Option,
 ) *VoteExtensionHandler {
-	return &VoteExtensionHandler{
+	h := &VoteExtensionHandler{
 		logger:               logger,
 		oracleClient:         oracleClient,
 		timeout:              timeout,
@@ -68,6 +79,12 @@ func NewVoteExtensionHandler(
 		metrics:              metrics,
 		priceApplier:         priceApplier,
 	}
+
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h
 }
 
 // ExtendVoteHandler returns a handler that extends a vote with the oracle's
@@ -119,8 +136,12 @@ func (h *VoteExtensionHandler) ExtendVoteHandler() sdk.ExtendVoteHandler {
 		reqFinalizeBlock := &cometabci.RequestFinalizeBlock{
 			Txs:    req.Txs,
 			Height: req.Height,
+			Hash:   req.Hash,
 		}
-		if _, err = h.priceApplier.ApplyPricesFromVoteExtensions(ctx, reqFinalizeBlock); err != nil {
+		// If the proposal was accepted with invalid oracle data, the vote is extended against the current
+		// on-chain prices, as the block does not update them.
+		var noPriceUpdate connectabci.NoPriceUpdateError
+		if _, err = h.priceApplier.ApplyPricesFromVoteExtensions(ctx, reqFinalizeBlock); err != nil && !errors.As(err, &noPriceUpdate) {
 			h.logger.Error(
 				"failed to aggregate oracle votes",
 				"height", req.Height,
@@ -182,7 +203,7 @@ func (h *VoteExtensionHandler) ExtendVoteHandler() sdk.ExtendVoteHandler {
 			return &cometabci.ResponseExtendVote{VoteExtension: []byte{}}, err
 		}
 
-		bz, err := h.voteExtensionCodec.Encode(voteExt)
+		bz, err := h.encodeWithinBudget(ctx, voteExt, oracleResp.Prices)
 		if err != nil {
 			h.logger.Error(
 				"failed to marshal vote extension; returning empty vote extension",
@@ -244,7 +265,7 @@ func (h *VoteExtensionHandler) VerifyVoteExtensionHandler() sdk.VerifyVoteExtens
 		}
 
 		// decode the vote-extension bytes
-		voteExtension, err := h.voteExtensionCodec.Decode(req.VoteExtension)
+		voteExtension, err := compression.VoteExtensionCodecForHeight(h.voteExtensionCodec, req.Height).Decode(req.VoteExtension)
 		if err != nil {
 			h.logger.Error(
 				"failed to decode vote extension",
@@ -290,6 +311,10 @@ func (h *VoteExtensionHandler) VerifyVoteExtensionHandler() sdk.VerifyVoteExtens
 func (h *VoteExtensionHandler) transformOracleServicePrices(ctx sdk.Context, prices map[string]string) (types.OracleVoteExtension, error) {
 	strategyPrices := make(map[uint64][]byte)
 
+	// If the strategy allows prices to be omitted, track the omitted prices.
+	omissionStrategy, canOmit := h.currencyPairStrategy.(currencypair.OmissionStrategy)
+	omitted := make(map[uint64]currencypair.CurrencyPairPrice)
+
 	// Iterate over the prices and transform them into the correct format.
 	for currencyPairID, priceString := range prices {
 		cp, err := connecttypes.CurrencyPairFromString(currencyPairID)
@@ -314,6 +339,22 @@ func (h *VoteExtensionHandler) transformOracleServicePrices(ctx sdk.Context, pri
 			continue
 		}
 
+		// Omit the price if the strategy considers the on-chain price to be current.
+		if canOmit && omissionStrategy.ShouldOmit(ctx, cp, rawPrice) {
+			h.logger.Debug(
+				"omitting oracle price",
+				"currency_pair", cp,
+				"height", ctx.BlockHeight(),
+			)
+
+			omitted[cpID] = currencypair.CurrencyPairPrice{
+				CurrencyPair: cp,
+				Price:        rawPrice,
+			}
+
+			continue
+		}
+
 		// Determine the encoded price for the currency pair based on the strategy.
 		encodedPrice, err := h.currencyPairStrategy.GetEncodedPrice(ctx, cp, rawPrice)
 		if err != nil {
@@ -335,7 +376,23 @@ func (h *VoteExtensionHandler) transformOracleServicePrices(ctx sdk.Context, pri
 		strategyPrices[cpID] = encodedPrice
 	}
 
-	h.logger.Debug("transformed oracle prices", "prices", len(strategyPrices))
+	// An empty vote extension is indistinguishable from a validator that failed to fetch prices, so if
+	// every price was omitted, the price with the lowest ID is included to signal participation.
+	if len(strategyPrices) == 0 && len(omitted) > 0 {
+		ids := slices.Sorted(maps.Keys(omitted))
+
+		price := omitted[ids[0]]
+		encodedPrice, err := h.currencyPairStrategy.GetEncodedPrice(ctx, price.CurrencyPair, price.Price)
+		if err == nil {
+			strategyPrices[ids[0]] = encodedPrice
+		}
+	}
+
+	h.logger.Debug(
+		"transformed oracle prices",
+		"prices", len(strategyPrices),
+		"omitted_prices", len(omitted),
+	)
 
 	return types.OracleVoteExtension{
 		Prices: strategyPrices,
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	cometabci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/mock"
@@ -15,6 +16,7 @@ import (
 	aggregatormocks "github.com/skip-mev/connect/v2/abci/strategies/aggregator/mocks"
 	"github.com/skip-mev/connect/v2/abci/strategies/codec"
 	codecmocks "github.com/skip-mev/connect/v2/abci/strategies/codec/mocks"
+	"github.com/skip-mev/connect/v2/abci/strategies/currencypair"
 	mockstrategies "github.com/skip-mev/connect/v2/abci/strategies/currencypair/mocks"
 	"github.com/skip-mev/connect/v2/abci/testutils"
 	connectabci "github.com/skip-mev/connect/v2/abci/types"
@@ -26,6 +28,7 @@ import (
 	servicemetrics "github.com/skip-mev/connect/v2/service/metrics"
 	metricsmocks "github.com/skip-mev/connect/v2/service/metrics/mocks"
 	servicetypes "github.com/skip-mev/connect/v2/service/servers/oracle/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
 )
 
 var (
@@ -920,3 +923,81 @@ func (s *VoteExtensionTestSuite) TestVoteExtensionSize() {
 	})
 	s.Require().NoError(err)
 }
+
+func (s *VoteExtensionTestSuite) TestExtendVoteWithOmission() {
+	cases := []struct {
+		name           string
+		prices         map[string]string
+		expectedDeltas map[uint64]int64
+	}{
+		{
+			name: "prices within the deviation of the on-chain price are omitted",
+			prices: map[string]string{
+				btcUSD.String(): "1005",
+				ethUSD.String(): "2500",
+			},
+			expectedDeltas: map[uint64]int64{
+				1: 500,
+			},
+		},
+		{
+			name: "if every price is omitted, the price with the lowest id is included",
+			prices: map[string]string{
+				btcUSD.String(): "1005",
+				ethUSD.String(): "2010",
+			},
+			expectedDeltas: map[uint64]int64{
+				0: 5,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			ctx := s.ctx.WithBlockHeight(10)
+
+			oracleKeeper := mockstrategies.NewOracleKeeper(s.T())
+			for id, cp := range []connecttypes.CurrencyPair{btcUSD, ethUSD} {
+				oracleKeeper.On("GetIDForCurrencyPair", mock.Anything, cp).Return(uint64(id), true) //nolint:gosec
+				oracleKeeper.On("GetPriceForCurrencyPair", mock.Anything, cp).Return(oracletypes.QuotePrice{
+					Price:       math.NewInt(int64(id+1) * 1000),
+					BlockHeight: 8,
+				}, nil)
+			}
+
+			client := mocks.NewOracleClient(s.T())
+			client.On("Prices", mock.Anything, mock.Anything).Return(
+				&servicetypes.QueryPricesResponse{
+					Prices: tc.prices,
+				},
+				nil,
+			)
+
+			priceApplier := aggregatormocks.NewPriceApplier(s.T())
+			priceApplier.On("ApplyPricesFromVoteExtensions", ctx, mock.Anything).Return(nil, nil)
+
+			cdc := codec.NewDefaultVoteExtensionCodec()
+			h := ve.NewVoteExtensionHandler(
+				log.NewTestLogger(s.T()),
+				client,
+				time.Second*1,
+				currencypair.NewDeviationCurrencyPairStrategy(oracleKeeper, math.LegacyNewDecWithPrec(1, 2), 5),
+				cdc,
+				priceApplier,
+				servicemetrics.NewNopMetrics(),
+			)
+
+			resp, err := h.ExtendVoteHandler()(ctx, &cometabci.RequestExtendVote{})
+			s.Require().NoError(err)
+
+			ext, err := cdc.Decode(resp.VoteExtension)
+			s.Require().NoError(err)
+			s.Require().Len(ext.Prices, len(tc.expectedDeltas))
+			for id, delta := range tc.expectedDeltas {
+				var decoded big.Int
+				s.Require().NoError(decoded.GobDecode(ext.Prices[id]))
+				s.Require().Equal(big.NewInt(delta).String(), decoded.String())
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@
 package marketmapv2
 
 import (
+	v1beta1 "cosmossdk.io/api/cosmos/base/query/v1beta1"
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -16,23 +17,31 @@ import (
 )
 
 var (
-	md_MarketMapRequest protoreflect.MessageDescriptor
+	md_MarketFilter          protoreflect.MessageDescriptor
+	fd_MarketFilter_enabled  protoreflect.FieldDescriptor
+	fd_MarketFilter_provider protoreflect.FieldDescriptor
+	fd_MarketFilter_base     protoreflect.FieldDescriptor
+	fd_MarketFilter_quote    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_connect_marketmap_v2_query_proto_init()
-	md_MarketMapRequest = File_connect_marketmap_v2_query_proto.Messages().ByName("MarketMapRequest")
+	md_MarketFilter = File_connect_marketmap_v2_query_proto.Messages().ByName("MarketFilter")
+	fd_MarketFilter_enabled = md_MarketFilter.Fields().ByName("enabled")
+	fd_MarketFilter_provider = md_MarketFilter.Fields().ByName("provider")
+	fd_MarketFilter_base = md_MarketFilter.Fields().ByName("base")
+	fd_MarketFilter_quote = md_MarketFilter.Fields().ByName("quote")
 }
 
-var _ protoreflect.Message = (*fastReflection_MarketMapRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_MarketFilter)(nil)
 
-type fastReflection_MarketMapRequest MarketMapRequest
+type fastReflection_MarketFilter MarketFilter
 
-func (x *MarketMapRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MarketMapRequest)(x)
+func (x *MarketFilter) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MarketFilter)(x)
 }
 
-func (x *MarketMapRequest) slowProtoReflect() protoreflect.Message {
+func (x *MarketFilter) slowProtoReflect() protoreflect.Message {
 	mi := &file_connect_marketmap_v2_query_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -44,43 +53,43 @@ func (x *MarketMapRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MarketMapRequest_messageType fastReflection_MarketMapRequest_messageType
-var _ protoreflect.MessageType = fastReflection_MarketMapRequest_messageType{}
+var _fastReflection_MarketFilter_messageType fastReflection_MarketFilter_messageType
+var _ protoreflect.MessageType = fastReflection_MarketFilter_messageType{}
 
-type fastReflection_MarketMapRequest_messageType struct{}
+type fastReflection_MarketFilter_messageType struct{}
 
-func (x fastReflection_MarketMapRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MarketMapRequest)(nil)
+func (x fastReflection_MarketFilter_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MarketFilter)(nil)
 }
-func (x fastReflection_MarketMapRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_MarketMapRequest)
+func (x fastReflection_MarketFilter_messageType) New() protoreflect.Message {
+	return new(fastReflection_MarketFilter)
 }
-func (x fastReflection_MarketMapRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MarketMapRequest
+func (x fastReflection_MarketFilter_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MarketFilter
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MarketMapRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_MarketMapRequest
+func (x *fastReflection_MarketFilter) Descriptor() protoreflect.MessageDescriptor {
+	return md_MarketFilter
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MarketMapRequest) Type() protoreflect.MessageType {
-	return _fastReflection_MarketMapRequest_messageType
+func (x *fastReflection_MarketFilter) Type() protoreflect.MessageType {
+	return _fastReflection_MarketFilter_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MarketMapRequest) New() protoreflect.Message {
-	return new(fastReflection_MarketMapRequest)
+func (x *fastReflection_MarketFilter) New() protoreflect.Message {
+	return new(fastReflection_MarketFilter)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MarketMapRequest) Interface() protoreflect.ProtoMessage {
-	return (*MarketMapRequest)(x)
+func (x *fastReflection_MarketFilter) Interface() protoreflect.ProtoMessage {
+	return (*MarketFilter)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -88,7 +97,31 @@ func (x *fastReflection_MarketMapRequest) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MarketMapRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MarketFilter) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Enabled != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Enabled))
+		if !f(fd_MarketFilter_enabled, value) {
+			return
+		}
+	}
+	if x.Provider != "" {
+		value := protoreflect.ValueOfString(x.Provider)
+		if !f(fd_MarketFilter_provider, value) {
+			return
+		}
+	}
+	if x.Base != "" {
+		value := protoreflect.ValueOfString(x.Base)
+		if !f(fd_MarketFilter_base, value) {
+			return
+		}
+	}
+	if x.Quote != "" {
+		value := protoreflect.ValueOfString(x.Quote)
+		if !f(fd_MarketFilter_quote, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -102,13 +135,21 @@ func (x *fastReflection_MarketMapRequest) Range(f func(protoreflect.FieldDescrip
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MarketMapRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MarketFilter) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketFilter.enabled":
+		return x.Enabled != 0
+	case "connect.marketmap.v2.MarketFilter.provider":
+		return x.Provider != ""
+	case "connect.marketmap.v2.MarketFilter.base":
+		return x.Base != ""
+	case "connect.marketmap.v2.MarketFilter.quote":
+		return x.Quote != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketMapRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketFilter"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.MarketMapRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketFilter does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -118,13 +159,21 @@ func (x *fastReflection_MarketMapRequest) Has(fd protoreflect.FieldDescriptor) b
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MarketMapRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MarketFilter) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketFilter.enabled":
+		x.Enabled = 0
+	case "connect.marketmap.v2.MarketFilter.provider":
+		x.Provider = ""
+	case "connect.marketmap.v2.MarketFilter.base":
+		x.Base = ""
+	case "connect.marketmap.v2.MarketFilter.quote":
+		x.Quote = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketMapRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketFilter"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.MarketMapRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketFilter does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -134,13 +183,25 @@ func (x *fastReflection_MarketMapRequest) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MarketMapRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MarketFilter) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "connect.marketmap.v2.MarketFilter.enabled":
+		value := x.Enabled
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "connect.marketmap.v2.MarketFilter.provider":
+		value := x.Provider
+		return protoreflect.ValueOfString(value)
+	case "connect.marketmap.v2.MarketFilter.base":
+		value := x.Base
+		return protoreflect.ValueOfString(value)
+	case "connect.marketmap.v2.MarketFilter.quote":
+		value := x.Quote
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketMapRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketFilter"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.MarketMapRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketFilter does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -154,13 +215,21 @@ func (x *fastReflection_MarketMapRequest) Get(descriptor protoreflect.FieldDescr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MarketMapRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MarketFilter) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketFilter.enabled":
+		x.Enabled = (MarketEnabledFilter)(value.Enum())
+	case "connect.marketmap.v2.MarketFilter.provider":
+		x.Provider = value.Interface().(string)
+	case "connect.marketmap.v2.MarketFilter.base":
+		x.Base = value.Interface().(string)
+	case "connect.marketmap.v2.MarketFilter.quote":
+		x.Quote = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketMapRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketFilter"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.MarketMapRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketFilter does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -174,36 +243,52 @@ func (x *fastReflection_MarketMapRequest) Set(fd protoreflect.FieldDescriptor, v
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MarketMapRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MarketFilter) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketFilter.enabled":
+		panic(fmt.Errorf("field enabled of message connect.marketmap.v2.MarketFilter is not mutable"))
+	case "connect.marketmap.v2.MarketFilter.provider":
+		panic(fmt.Errorf("field provider of message connect.marketmap.v2.MarketFilter is not mutable"))
+	case "connect.marketmap.v2.MarketFilter.base":
+		panic(fmt.Errorf("field base of message connect.marketmap.v2.MarketFilter is not mutable"))
+	case "connect.marketmap.v2.MarketFilter.quote":
+		panic(fmt.Errorf("field quote of message connect.marketmap.v2.MarketFilter is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketMapRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketFilter"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.MarketMapRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketFilter does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MarketMapRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MarketFilter) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketFilter.enabled":
+		return protoreflect.ValueOfEnum(0)
+	case "connect.marketmap.v2.MarketFilter.provider":
+		return protoreflect.ValueOfString("")
+	case "connect.marketmap.v2.MarketFilter.base":
+		return protoreflect.ValueOfString("")
+	case "connect.marketmap.v2.MarketFilter.quote":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketMapRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketFilter"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.MarketMapRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketFilter does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MarketMapRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MarketFilter) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in connect.marketmap.v2.MarketMapRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in connect.marketmap.v2.MarketFilter", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -211,7 +296,7 @@ func (x *fastReflection_MarketMapRequest) WhichOneof(d protoreflect.OneofDescrip
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MarketMapRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MarketFilter) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -222,7 +307,7 @@ func (x *fastReflection_MarketMapRequest) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MarketMapRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MarketFilter) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -234,7 +319,7 @@ func (x *fastReflection_MarketMapRequest) SetUnknown(fields protoreflect.RawFiel
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MarketMapRequest) IsValid() bool {
+func (x *fastReflection_MarketFilter) IsValid() bool {
 	return x != nil
 }
 
@@ -244,9 +329,9 @@ func (x *fastReflection_MarketMapRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MarketMapRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MarketFilter) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MarketMapRequest)
+		x := input.Message.Interface().(*MarketFilter)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -258,6 +343,21 @@ func (x *fastReflection_MarketMapRequest) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
+		if x.Enabled != 0 {
+			n += 1 + runtime.Sov(uint64(x.Enabled))
+		}
+		l = len(x.Provider)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Base)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Quote)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -268,7 +368,7 @@ func (x *fastReflection_MarketMapRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MarketMapRequest)
+		x := input.Message.Interface().(*MarketFilter)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -287,6 +387,32 @@ func (x *fastReflection_MarketMapRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Quote) > 0 {
+			i -= len(x.Quote)
+			copy(dAtA[i:], x.Quote)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Quote)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Base) > 0 {
+			i -= len(x.Base)
+			copy(dAtA[i:], x.Base)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Base)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Provider) > 0 {
+			i -= len(x.Provider)
+			copy(dAtA[i:], x.Provider)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Provider)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Enabled != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Enabled))
+			i--
+			dAtA[i] = 0x8
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -298,7 +424,7 @@ func (x *fastReflection_MarketMapRequest) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MarketMapRequest)
+		x := input.Message.Interface().(*MarketFilter)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -330,12 +456,127 @@ func (x *fastReflection_MarketMapRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketMapRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketFilter: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketMapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				}
+				x.Enabled = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Enabled |= MarketEnabledFilter(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Provider = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Base = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Quote = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -372,29 +613,27 @@ func (x *fastReflection_MarketMapRequest) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MarketMapResponse              protoreflect.MessageDescriptor
-	fd_MarketMapResponse_market_map   protoreflect.FieldDescriptor
-	fd_MarketMapResponse_last_updated protoreflect.FieldDescriptor
-	fd_MarketMapResponse_chain_id     protoreflect.FieldDescriptor
+	md_MarketMapRequest            protoreflect.MessageDescriptor
+	fd_MarketMapRequest_pagination protoreflect.FieldDescriptor
+	fd_MarketMapRequest_filter     protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_connect_marketmap_v2_query_proto_init()
-	md_MarketMapResponse = File_connect_marketmap_v2_query_proto.Messages().ByName("MarketMapResponse")
-	fd_MarketMapResponse_market_map = md_MarketMapResponse.Fields().ByName("market_map")
-	fd_MarketMapResponse_last_updated = md_MarketMapResponse.Fields().ByName("last_updated")
-	fd_MarketMapResponse_chain_id = md_MarketMapResponse.Fields().ByName("chain_id")
+	md_MarketMapRequest = File_connect_marketmap_v2_query_proto.Messages().ByName("MarketMapRequest")
+	fd_MarketMapRequest_pagination = md_MarketMapRequest.Fields().ByName("pagination")
+	fd_MarketMapRequest_filter = md_MarketMapRequest.Fields().ByName("filter")
 }
 
-var _ protoreflect.Message = (*fastReflection_MarketMapResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MarketMapRequest)(nil)
 
-type fastReflection_MarketMapResponse MarketMapResponse
+type fastReflection_MarketMapRequest MarketMapRequest
 
-func (x *MarketMapResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MarketMapResponse)(x)
+func (x *MarketMapRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MarketMapRequest)(x)
 }
 
-func (x *MarketMapResponse) slowProtoReflect() protoreflect.Message {
+func (x *MarketMapRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_connect_marketmap_v2_query_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -406,43 +645,43 @@ func (x *MarketMapResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MarketMapResponse_messageType fastReflection_MarketMapResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MarketMapResponse_messageType{}
+var _fastReflection_MarketMapRequest_messageType fastReflection_MarketMapRequest_messageType
+var _ protoreflect.MessageType = fastReflection_MarketMapRequest_messageType{}
 
-type fastReflection_MarketMapResponse_messageType struct{}
+type fastReflection_MarketMapRequest_messageType struct{}
 
-func (x fastReflection_MarketMapResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MarketMapResponse)(nil)
+func (x fastReflection_MarketMapRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MarketMapRequest)(nil)
 }
-func (x fastReflection_MarketMapResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MarketMapResponse)
+func (x fastReflection_MarketMapRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_MarketMapRequest)
 }
-func (x fastReflection_MarketMapResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MarketMapResponse
+func (x fastReflection_MarketMapRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MarketMapRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MarketMapResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MarketMapResponse
+func (x *fastReflection_MarketMapRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_MarketMapRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MarketMapResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MarketMapResponse_messageType
+func (x *fastReflection_MarketMapRequest) Type() protoreflect.MessageType {
+	return _fastReflection_MarketMapRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MarketMapResponse) New() protoreflect.Message {
-	return new(fastReflection_MarketMapResponse)
+func (x *fastReflection_MarketMapRequest) New() protoreflect.Message {
+	return new(fastReflection_MarketMapRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MarketMapResponse) Interface() protoreflect.ProtoMessage {
-	return (*MarketMapResponse)(x)
+func (x *fastReflection_MarketMapRequest) Interface() protoreflect.ProtoMessage {
+	return (*MarketMapRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -450,22 +689,16 @@ func (x *fastReflection_MarketMapResponse) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MarketMapResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.MarketMap != nil {
-		value := protoreflect.ValueOfMessage(x.MarketMap.ProtoReflect())
-		if !f(fd_MarketMapResponse_market_map, value) {
+func (x *fastReflection_MarketMapRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_MarketMapRequest_pagination, value) {
 			return
 		}
 	}
-	if x.LastUpdated != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.LastUpdated)
-		if !f(fd_MarketMapResponse_last_updated, value) {
-			return
-		}
-	}
-	if x.ChainId != "" {
-		value := protoreflect.ValueOfString(x.ChainId)
-		if !f(fd_MarketMapResponse_chain_id, value) {
+	if x.Filter != nil {
+		value := protoreflect.ValueOfMessage(x.Filter.ProtoReflect())
+		if !f(fd_MarketMapRequest_filter, value) {
 			return
 		}
 	}
@@ -482,19 +715,17 @@ func (x *fastReflection_MarketMapResponse) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MarketMapResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MarketMapRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "connect.marketmap.v2.MarketMapResponse.market_map":
-		return x.MarketMap != nil
-	case "connect.marketmap.v2.MarketMapResponse.last_updated":
-		return x.LastUpdated != uint64(0)
-	case "connect.marketmap.v2.MarketMapResponse.chain_id":
-		return x.ChainId != ""
+	case "connect.marketmap.v2.MarketMapRequest.pagination":
+		return x.Pagination != nil
+	case "connect.marketmap.v2.MarketMapRequest.filter":
+		return x.Filter != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketMapResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketMapRequest"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.MarketMapResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketMapRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -504,19 +735,17 @@ func (x *fastReflection_MarketMapResponse) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MarketMapResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MarketMapRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "connect.marketmap.v2.MarketMapResponse.market_map":
-		x.MarketMap = nil
-	case "connect.marketmap.v2.MarketMapResponse.last_updated":
-		x.LastUpdated = uint64(0)
-	case "connect.marketmap.v2.MarketMapResponse.chain_id":
-		x.ChainId = ""
+	case "connect.marketmap.v2.MarketMapRequest.pagination":
+		x.Pagination = nil
+	case "connect.marketmap.v2.MarketMapRequest.filter":
+		x.Filter = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketMapResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketMapRequest"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.MarketMapResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketMapRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -526,22 +755,19 @@ func (x *fastReflection_MarketMapResponse) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MarketMapResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MarketMapRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "connect.marketmap.v2.MarketMapResponse.market_map":
-		value := x.MarketMap
+	case "connect.marketmap.v2.MarketMapRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "connect.marketmap.v2.MarketMapRequest.filter":
+		value := x.Filter
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "connect.marketmap.v2.MarketMapResponse.last_updated":
-		value := x.LastUpdated
-		return protoreflect.ValueOfUint64(value)
-	case "connect.marketmap.v2.MarketMapResponse.chain_id":
-		value := x.ChainId
-		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketMapResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketMapRequest"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.MarketMapResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketMapRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -555,19 +781,17 @@ func (x *fastReflection_MarketMapResponse) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MarketMapResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MarketMapRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "connect.marketmap.v2.MarketMapResponse.market_map":
-		x.MarketMap = value.Message().Interface().(*MarketMap)
-	case "connect.marketmap.v2.MarketMapResponse.last_updated":
-		x.LastUpdated = value.Uint()
-	case "connect.marketmap.v2.MarketMapResponse.chain_id":
-		x.ChainId = value.Interface().(string)
+	case "connect.marketmap.v2.MarketMapRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	case "connect.marketmap.v2.MarketMapRequest.filter":
+		x.Filter = value.Message().Interface().(*MarketFilter)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketMapResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketMapRequest"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.MarketMapResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketMapRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -581,52 +805,52 @@ func (x *fastReflection_MarketMapResponse) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MarketMapResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MarketMapRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "connect.marketmap.v2.MarketMapResponse.market_map":
-		if x.MarketMap == nil {
-			x.MarketMap = new(MarketMap)
+	case "connect.marketmap.v2.MarketMapRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
 		}
-		return protoreflect.ValueOfMessage(x.MarketMap.ProtoReflect())
-	case "connect.marketmap.v2.MarketMapResponse.last_updated":
-		panic(fmt.Errorf("field last_updated of message connect.marketmap.v2.MarketMapResponse is not mutable"))
-	case "connect.marketmap.v2.MarketMapResponse.chain_id":
-		panic(fmt.Errorf("field chain_id of message connect.marketmap.v2.MarketMapResponse is not mutable"))
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "connect.marketmap.v2.MarketMapRequest.filter":
+		if x.Filter == nil {
+			x.Filter = new(MarketFilter)
+		}
+		return protoreflect.ValueOfMessage(x.Filter.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketMapResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketMapRequest"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.MarketMapResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketMapRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MarketMapResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MarketMapRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "connect.marketmap.v2.MarketMapResponse.market_map":
-		m := new(MarketMap)
+	case "connect.marketmap.v2.MarketMapRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "connect.marketmap.v2.MarketMapRequest.filter":
+		m := new(MarketFilter)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "connect.marketmap.v2.MarketMapResponse.last_updated":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "connect.marketmap.v2.MarketMapResponse.chain_id":
-		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketMapResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketMapRequest"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.MarketMapResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketMapRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MarketMapResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MarketMapRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in connect.marketmap.v2.MarketMapResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in connect.marketmap.v2.MarketMapRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -634,7 +858,7 @@ func (x *fastReflection_MarketMapResponse) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MarketMapResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MarketMapRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -645,7 +869,7 @@ func (x *fastReflection_MarketMapResponse) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MarketMapResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MarketMapRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -657,7 +881,7 @@ func (x *fastReflection_MarketMapResponse) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MarketMapResponse) IsValid() bool {
+func (x *fastReflection_MarketMapRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -667,9 +891,9 @@ func (x *fastReflection_MarketMapResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MarketMapResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MarketMapRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MarketMapResponse)
+		x := input.Message.Interface().(*MarketMapRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -681,15 +905,12 @@ func (x *fastReflection_MarketMapResponse) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.MarketMap != nil {
-			l = options.Size(x.MarketMap)
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.LastUpdated != 0 {
-			n += 1 + runtime.Sov(uint64(x.LastUpdated))
-		}
-		l = len(x.ChainId)
-		if l > 0 {
+		if x.Filter != nil {
+			l = options.Size(x.Filter)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -702,7 +923,7 @@ func (x *fastReflection_MarketMapResponse) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MarketMapResponse)
+		x := input.Message.Interface().(*MarketMapRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -721,20 +942,22 @@ func (x *fastReflection_MarketMapResponse) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ChainId) > 0 {
-			i -= len(x.ChainId)
-			copy(dAtA[i:], x.ChainId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChainId)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if x.LastUpdated != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastUpdated))
+		if x.Filter != nil {
+			encoded, err := options.Marshal(x.Filter)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x12
 		}
-		if x.MarketMap != nil {
-			encoded, err := options.Marshal(x.MarketMap)
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -758,7 +981,7 @@ func (x *fastReflection_MarketMapResponse) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MarketMapResponse)
+		x := input.Message.Interface().(*MarketMapRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -790,15 +1013,15 @@ func (x *fastReflection_MarketMapResponse) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketMapResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketMapRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketMapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketMapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MarketMap", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -825,37 +1048,18 @@ func (x *fastReflection_MarketMapResponse) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.MarketMap == nil {
-					x.MarketMap = &MarketMap{}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MarketMap); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastUpdated", wireType)
-				}
-				x.LastUpdated = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.LastUpdated |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -865,23 +1069,27 @@ func (x *fastReflection_MarketMapResponse) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ChainId = string(dAtA[iNdEx:postIndex])
+				if x.Filter == nil {
+					x.Filter = &MarketFilter{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Filter); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -919,23 +1127,31 @@ func (x *fastReflection_MarketMapResponse) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MarketsRequest protoreflect.MessageDescriptor
+	md_MarketMapResponse              protoreflect.MessageDescriptor
+	fd_MarketMapResponse_market_map   protoreflect.FieldDescriptor
+	fd_MarketMapResponse_last_updated protoreflect.FieldDescriptor
+	fd_MarketMapResponse_chain_id     protoreflect.FieldDescriptor
+	fd_MarketMapResponse_pagination   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_connect_marketmap_v2_query_proto_init()
-	md_MarketsRequest = File_connect_marketmap_v2_query_proto.Messages().ByName("MarketsRequest")
+	md_MarketMapResponse = File_connect_marketmap_v2_query_proto.Messages().ByName("MarketMapResponse")
+	fd_MarketMapResponse_market_map = md_MarketMapResponse.Fields().ByName("market_map")
+	fd_MarketMapResponse_last_updated = md_MarketMapResponse.Fields().ByName("last_updated")
+	fd_MarketMapResponse_chain_id = md_MarketMapResponse.Fields().ByName("chain_id")
+	fd_MarketMapResponse_pagination = md_MarketMapResponse.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_MarketsRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_MarketMapResponse)(nil)
 
-type fastReflection_MarketsRequest MarketsRequest
+type fastReflection_MarketMapResponse MarketMapResponse
 
-func (x *MarketsRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MarketsRequest)(x)
+func (x *MarketMapResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MarketMapResponse)(x)
 }
 
-func (x *MarketsRequest) slowProtoReflect() protoreflect.Message {
+func (x *MarketMapResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_connect_marketmap_v2_query_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -947,43 +1163,43 @@ func (x *MarketsRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MarketsRequest_messageType fastReflection_MarketsRequest_messageType
-var _ protoreflect.MessageType = fastReflection_MarketsRequest_messageType{}
+var _fastReflection_MarketMapResponse_messageType fastReflection_MarketMapResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MarketMapResponse_messageType{}
 
-type fastReflection_MarketsRequest_messageType struct{}
+type fastReflection_MarketMapResponse_messageType struct{}
 
-func (x fastReflection_MarketsRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MarketsRequest)(nil)
+func (x fastReflection_MarketMapResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MarketMapResponse)(nil)
 }
-func (x fastReflection_MarketsRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_MarketsRequest)
+func (x fastReflection_MarketMapResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MarketMapResponse)
 }
-func (x fastReflection_MarketsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MarketsRequest
+func (x fastReflection_MarketMapResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MarketMapResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MarketsRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_MarketsRequest
+func (x *fastReflection_MarketMapResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MarketMapResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MarketsRequest) Type() protoreflect.MessageType {
-	return _fastReflection_MarketsRequest_messageType
+func (x *fastReflection_MarketMapResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MarketMapResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MarketsRequest) New() protoreflect.Message {
-	return new(fastReflection_MarketsRequest)
+func (x *fastReflection_MarketMapResponse) New() protoreflect.Message {
+	return new(fastReflection_MarketMapResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MarketsRequest) Interface() protoreflect.ProtoMessage {
-	return (*MarketsRequest)(x)
+func (x *fastReflection_MarketMapResponse) Interface() protoreflect.ProtoMessage {
+	return (*MarketMapResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -991,7 +1207,31 @@ func (x *fastReflection_MarketsRequest) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MarketsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MarketMapResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MarketMap != nil {
+		value := protoreflect.ValueOfMessage(x.MarketMap.ProtoReflect())
+		if !f(fd_MarketMapResponse_market_map, value) {
+			return
+		}
+	}
+	if x.LastUpdated != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LastUpdated)
+		if !f(fd_MarketMapResponse_last_updated, value) {
+			return
+		}
+	}
+	if x.ChainId != "" {
+		value := protoreflect.ValueOfString(x.ChainId)
+		if !f(fd_MarketMapResponse_chain_id, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_MarketMapResponse_pagination, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1005,13 +1245,21 @@ func (x *fastReflection_MarketsRequest) Range(f func(protoreflect.FieldDescripto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MarketsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MarketMapResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketsRequest"))
-		}
-		panic(fmt.Errorf("message connect.marketmap.v2.MarketsRequest does not contain field %s", fd.FullName()))
+	case "connect.marketmap.v2.MarketMapResponse.market_map":
+		return x.MarketMap != nil
+	case "connect.marketmap.v2.MarketMapResponse.last_updated":
+		return x.LastUpdated != uint64(0)
+	case "connect.marketmap.v2.MarketMapResponse.chain_id":
+		return x.ChainId != ""
+	case "connect.marketmap.v2.MarketMapResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketMapResponse"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketMapResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1021,13 +1269,21 @@ func (x *fastReflection_MarketsRequest) Has(fd protoreflect.FieldDescriptor) boo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MarketsRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MarketMapResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketMapResponse.market_map":
+		x.MarketMap = nil
+	case "connect.marketmap.v2.MarketMapResponse.last_updated":
+		x.LastUpdated = uint64(0)
+	case "connect.marketmap.v2.MarketMapResponse.chain_id":
+		x.ChainId = ""
+	case "connect.marketmap.v2.MarketMapResponse.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketMapResponse"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.MarketsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketMapResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1037,13 +1293,25 @@ func (x *fastReflection_MarketsRequest) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MarketsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MarketMapResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "connect.marketmap.v2.MarketMapResponse.market_map":
+		value := x.MarketMap
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "connect.marketmap.v2.MarketMapResponse.last_updated":
+		value := x.LastUpdated
+		return protoreflect.ValueOfUint64(value)
+	case "connect.marketmap.v2.MarketMapResponse.chain_id":
+		value := x.ChainId
+		return protoreflect.ValueOfString(value)
+	case "connect.marketmap.v2.MarketMapResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketMapResponse"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.MarketsRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketMapResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1057,13 +1325,21 @@ func (x *fastReflection_MarketsRequest) Get(descriptor protoreflect.FieldDescrip
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MarketsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MarketMapResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketMapResponse.market_map":
+		x.MarketMap = value.Message().Interface().(*MarketMap)
+	case "connect.marketmap.v2.MarketMapResponse.last_updated":
+		x.LastUpdated = value.Uint()
+	case "connect.marketmap.v2.MarketMapResponse.chain_id":
+		x.ChainId = value.Interface().(string)
+	case "connect.marketmap.v2.MarketMapResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketMapResponse"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.MarketsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketMapResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1077,36 +1353,60 @@ func (x *fastReflection_MarketsRequest) Set(fd protoreflect.FieldDescriptor, val
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MarketsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MarketMapResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketMapResponse.market_map":
+		if x.MarketMap == nil {
+			x.MarketMap = new(MarketMap)
+		}
+		return protoreflect.ValueOfMessage(x.MarketMap.ProtoReflect())
+	case "connect.marketmap.v2.MarketMapResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "connect.marketmap.v2.MarketMapResponse.last_updated":
+		panic(fmt.Errorf("field last_updated of message connect.marketmap.v2.MarketMapResponse is not mutable"))
+	case "connect.marketmap.v2.MarketMapResponse.chain_id":
+		panic(fmt.Errorf("field chain_id of message connect.marketmap.v2.MarketMapResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketMapResponse"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.MarketsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketMapResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MarketsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MarketMapResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketMapResponse.market_map":
+		m := new(MarketMap)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "connect.marketmap.v2.MarketMapResponse.last_updated":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "connect.marketmap.v2.MarketMapResponse.chain_id":
+		return protoreflect.ValueOfString("")
+	case "connect.marketmap.v2.MarketMapResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketMapResponse"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.MarketsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketMapResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MarketsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MarketMapResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in connect.marketmap.v2.MarketsRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in connect.marketmap.v2.MarketMapResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1114,7 +1414,7 @@ func (x *fastReflection_MarketsRequest) WhichOneof(d protoreflect.OneofDescripto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MarketsRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MarketMapResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1125,7 +1425,7 @@ func (x *fastReflection_MarketsRequest) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MarketsRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MarketMapResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1137,7 +1437,7 @@ func (x *fastReflection_MarketsRequest) SetUnknown(fields protoreflect.RawFields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MarketsRequest) IsValid() bool {
+func (x *fastReflection_MarketMapResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -1147,9 +1447,9 @@ func (x *fastReflection_MarketsRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MarketsRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MarketMapResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MarketsRequest)
+		x := input.Message.Interface().(*MarketMapResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1161,6 +1461,21 @@ func (x *fastReflection_MarketsRequest) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
+		if x.MarketMap != nil {
+			l = options.Size(x.MarketMap)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.LastUpdated != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastUpdated))
+		}
+		l = len(x.ChainId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1171,7 +1486,7 @@ func (x *fastReflection_MarketsRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MarketsRequest)
+		x := input.Message.Interface().(*MarketMapResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1190,6 +1505,46 @@ func (x *fastReflection_MarketsRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.ChainId) > 0 {
+			i -= len(x.ChainId)
+			copy(dAtA[i:], x.ChainId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChainId)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.LastUpdated != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastUpdated))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.MarketMap != nil {
+			encoded, err := options.Marshal(x.MarketMap)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -1201,7 +1556,7 @@ func (x *fastReflection_MarketsRequest) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MarketsRequest)
+		x := input.Message.Interface().(*MarketMapResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1233,40 +1588,163 @@ func (x *fastReflection_MarketsRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketsRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketMapResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketMapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MarketMap", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MarketMap == nil {
+					x.MarketMap = &MarketMap{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MarketMap); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastUpdated", wireType)
+				}
+				x.LastUpdated = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastUpdated |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.ChainId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
 		Marshal:           marshal,
 		Unmarshal:         unmarshal,
 		Merge:             nil,
@@ -1274,77 +1752,28 @@ func (x *fastReflection_MarketsRequest) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_MarketsResponse_1_list)(nil)
-
-type _MarketsResponse_1_list struct {
-	list *[]*Market
-}
-
-func (x *_MarketsResponse_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_MarketsResponse_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_MarketsResponse_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Market)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_MarketsResponse_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Market)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_MarketsResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(Market)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_MarketsResponse_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_MarketsResponse_1_list) NewElement() protoreflect.Value {
-	v := new(Market)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_MarketsResponse_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_MarketsResponse         protoreflect.MessageDescriptor
-	fd_MarketsResponse_markets protoreflect.FieldDescriptor
+	md_MarketsRequest            protoreflect.MessageDescriptor
+	fd_MarketsRequest_pagination protoreflect.FieldDescriptor
+	fd_MarketsRequest_filter     protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_connect_marketmap_v2_query_proto_init()
-	md_MarketsResponse = File_connect_marketmap_v2_query_proto.Messages().ByName("MarketsResponse")
-	fd_MarketsResponse_markets = md_MarketsResponse.Fields().ByName("markets")
+	md_MarketsRequest = File_connect_marketmap_v2_query_proto.Messages().ByName("MarketsRequest")
+	fd_MarketsRequest_pagination = md_MarketsRequest.Fields().ByName("pagination")
+	fd_MarketsRequest_filter = md_MarketsRequest.Fields().ByName("filter")
 }
 
-var _ protoreflect.Message = (*fastReflection_MarketsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MarketsRequest)(nil)
 
-type fastReflection_MarketsResponse MarketsResponse
+type fastReflection_MarketsRequest MarketsRequest
 
-func (x *MarketsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MarketsResponse)(x)
+func (x *MarketsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MarketsRequest)(x)
 }
 
-func (x *MarketsResponse) slowProtoReflect() protoreflect.Message {
+func (x *MarketsRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_connect_marketmap_v2_query_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1356,43 +1785,43 @@ func (x *MarketsResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MarketsResponse_messageType fastReflection_MarketsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MarketsResponse_messageType{}
+var _fastReflection_MarketsRequest_messageType fastReflection_MarketsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_MarketsRequest_messageType{}
 
-type fastReflection_MarketsResponse_messageType struct{}
+type fastReflection_MarketsRequest_messageType struct{}
 
-func (x fastReflection_MarketsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MarketsResponse)(nil)
+func (x fastReflection_MarketsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MarketsRequest)(nil)
 }
-func (x fastReflection_MarketsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MarketsResponse)
+func (x fastReflection_MarketsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_MarketsRequest)
 }
-func (x fastReflection_MarketsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MarketsResponse
+func (x fastReflection_MarketsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MarketsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MarketsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MarketsResponse
+func (x *fastReflection_MarketsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_MarketsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MarketsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MarketsResponse_messageType
+func (x *fastReflection_MarketsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_MarketsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MarketsResponse) New() protoreflect.Message {
-	return new(fastReflection_MarketsResponse)
+func (x *fastReflection_MarketsRequest) New() protoreflect.Message {
+	return new(fastReflection_MarketsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MarketsResponse) Interface() protoreflect.ProtoMessage {
-	return (*MarketsResponse)(x)
+func (x *fastReflection_MarketsRequest) Interface() protoreflect.ProtoMessage {
+	return (*MarketsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1400,10 +1829,16 @@ func (x *fastReflection_MarketsResponse) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MarketsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Markets) != 0 {
-		value := protoreflect.ValueOfList(&_MarketsResponse_1_list{list: &x.Markets})
-		if !f(fd_MarketsResponse_markets, value) {
+func (x *fastReflection_MarketsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_MarketsRequest_pagination, value) {
+			return
+		}
+	}
+	if x.Filter != nil {
+		value := protoreflect.ValueOfMessage(x.Filter.ProtoReflect())
+		if !f(fd_MarketsRequest_filter, value) {
 			return
 		}
 	}
@@ -1420,15 +1855,17 @@ func (x *fastReflection_MarketsResponse) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MarketsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MarketsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "connect.marketmap.v2.MarketsResponse.markets":
-		return len(x.Markets) != 0
+	case "connect.marketmap.v2.MarketsRequest.pagination":
+		return x.Pagination != nil
+	case "connect.marketmap.v2.MarketsRequest.filter":
+		return x.Filter != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketsRequest"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.MarketsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1438,15 +1875,17 @@ func (x *fastReflection_MarketsResponse) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MarketsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MarketsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "connect.marketmap.v2.MarketsResponse.markets":
-		x.Markets = nil
+	case "connect.marketmap.v2.MarketsRequest.pagination":
+		x.Pagination = nil
+	case "connect.marketmap.v2.MarketsRequest.filter":
+		x.Filter = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketsRequest"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.MarketsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1456,19 +1895,19 @@ func (x *fastReflection_MarketsResponse) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MarketsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MarketsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "connect.marketmap.v2.MarketsResponse.markets":
-		if len(x.Markets) == 0 {
-			return protoreflect.ValueOfList(&_MarketsResponse_1_list{})
-		}
-		listValue := &_MarketsResponse_1_list{list: &x.Markets}
-		return protoreflect.ValueOfList(listValue)
+	case "connect.marketmap.v2.MarketsRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "connect.marketmap.v2.MarketsRequest.filter":
+		value := x.Filter
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketsRequest"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.MarketsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1482,17 +1921,17 @@ func (x *fastReflection_MarketsResponse) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MarketsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MarketsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "connect.marketmap.v2.MarketsResponse.markets":
-		lv := value.List()
-		clv := lv.(*_MarketsResponse_1_list)
-		x.Markets = *clv.list
+	case "connect.marketmap.v2.MarketsRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	case "connect.marketmap.v2.MarketsRequest.filter":
+		x.Filter = value.Message().Interface().(*MarketFilter)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketsRequest"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.MarketsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1506,45 +1945,52 @@ func (x *fastReflection_MarketsResponse) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MarketsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MarketsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "connect.marketmap.v2.MarketsResponse.markets":
-		if x.Markets == nil {
-			x.Markets = []*Market{}
+	case "connect.marketmap.v2.MarketsRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
 		}
-		value := &_MarketsResponse_1_list{list: &x.Markets}
-		return protoreflect.ValueOfList(value)
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "connect.marketmap.v2.MarketsRequest.filter":
+		if x.Filter == nil {
+			x.Filter = new(MarketFilter)
+		}
+		return protoreflect.ValueOfMessage(x.Filter.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketsRequest"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.MarketsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MarketsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MarketsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "connect.marketmap.v2.MarketsResponse.markets":
-		list := []*Market{}
-		return protoreflect.ValueOfList(&_MarketsResponse_1_list{list: &list})
+	case "connect.marketmap.v2.MarketsRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "connect.marketmap.v2.MarketsRequest.filter":
+		m := new(MarketFilter)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketsRequest"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.MarketsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MarketsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MarketsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in connect.marketmap.v2.MarketsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in connect.marketmap.v2.MarketsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1552,7 +1998,7 @@ func (x *fastReflection_MarketsResponse) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MarketsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MarketsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1563,7 +2009,7 @@ func (x *fastReflection_MarketsResponse) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MarketsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MarketsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1575,7 +2021,7 @@ func (x *fastReflection_MarketsResponse) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MarketsResponse) IsValid() bool {
+func (x *fastReflection_MarketsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -1585,9 +2031,9 @@ func (x *fastReflection_MarketsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MarketsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MarketsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MarketsResponse)
+		x := input.Message.Interface().(*MarketsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1599,11 +2045,13 @@ func (x *fastReflection_MarketsResponse) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if len(x.Markets) > 0 {
-			for _, e := range x.Markets {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Filter != nil {
+			l = options.Size(x.Filter)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1615,7 +2063,7 @@ func (x *fastReflection_MarketsResponse) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MarketsResponse)
+		x := input.Message.Interface().(*MarketsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1634,21 +2082,33 @@ func (x *fastReflection_MarketsResponse) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Markets) > 0 {
-			for iNdEx := len(x.Markets) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Markets[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
+		if x.Filter != nil {
+			encoded, err := options.Marshal(x.Filter)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
 			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -1661,7 +2121,7 @@ func (x *fastReflection_MarketsResponse) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MarketsResponse)
+		x := input.Message.Interface().(*MarketsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1693,15 +2153,15 @@ func (x *fastReflection_MarketsResponse) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1728,8 +2188,46 @@ func (x *fastReflection_MarketsResponse) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Markets = append(x.Markets, &Market{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Markets[len(x.Markets)-1]); err != nil {
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Filter == nil {
+					x.Filter = &MarketFilter{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Filter); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -1768,26 +2266,79 @@ func (x *fastReflection_MarketsResponse) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_MarketsResponse_1_list)(nil)
+
+type _MarketsResponse_1_list struct {
+	list *[]*Market
+}
+
+func (x *_MarketsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MarketsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MarketsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Market)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MarketsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Market)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MarketsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(Market)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MarketsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MarketsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(Market)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MarketsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_MarketRequest               protoreflect.MessageDescriptor
-	fd_MarketRequest_currency_pair protoreflect.FieldDescriptor
+	md_MarketsResponse            protoreflect.MessageDescriptor
+	fd_MarketsResponse_markets    protoreflect.FieldDescriptor
+	fd_MarketsResponse_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_connect_marketmap_v2_query_proto_init()
-	md_MarketRequest = File_connect_marketmap_v2_query_proto.Messages().ByName("MarketRequest")
-	fd_MarketRequest_currency_pair = md_MarketRequest.Fields().ByName("currency_pair")
+	md_MarketsResponse = File_connect_marketmap_v2_query_proto.Messages().ByName("MarketsResponse")
+	fd_MarketsResponse_markets = md_MarketsResponse.Fields().ByName("markets")
+	fd_MarketsResponse_pagination = md_MarketsResponse.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_MarketRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_MarketsResponse)(nil)
 
-type fastReflection_MarketRequest MarketRequest
+type fastReflection_MarketsResponse MarketsResponse
 
-func (x *MarketRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MarketRequest)(x)
+func (x *MarketsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MarketsResponse)(x)
 }
 
-func (x *MarketRequest) slowProtoReflect() protoreflect.Message {
+func (x *MarketsResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_connect_marketmap_v2_query_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1799,43 +2350,43 @@ func (x *MarketRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MarketRequest_messageType fastReflection_MarketRequest_messageType
-var _ protoreflect.MessageType = fastReflection_MarketRequest_messageType{}
+var _fastReflection_MarketsResponse_messageType fastReflection_MarketsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MarketsResponse_messageType{}
 
-type fastReflection_MarketRequest_messageType struct{}
+type fastReflection_MarketsResponse_messageType struct{}
 
-func (x fastReflection_MarketRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MarketRequest)(nil)
+func (x fastReflection_MarketsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MarketsResponse)(nil)
 }
-func (x fastReflection_MarketRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_MarketRequest)
+func (x fastReflection_MarketsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MarketsResponse)
 }
-func (x fastReflection_MarketRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MarketRequest
+func (x fastReflection_MarketsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MarketsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MarketRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_MarketRequest
+func (x *fastReflection_MarketsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MarketsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MarketRequest) Type() protoreflect.MessageType {
-	return _fastReflection_MarketRequest_messageType
+func (x *fastReflection_MarketsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MarketsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MarketRequest) New() protoreflect.Message {
-	return new(fastReflection_MarketRequest)
+func (x *fastReflection_MarketsResponse) New() protoreflect.Message {
+	return new(fastReflection_MarketsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MarketRequest) Interface() protoreflect.ProtoMessage {
-	return (*MarketRequest)(x)
+func (x *fastReflection_MarketsResponse) Interface() protoreflect.ProtoMessage {
+	return (*MarketsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1843,10 +2394,16 @@ func (x *fastReflection_MarketRequest) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MarketRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.CurrencyPair != nil {
-		value := protoreflect.ValueOfMessage(x.CurrencyPair.ProtoReflect())
-		if !f(fd_MarketRequest_currency_pair, value) {
+func (x *fastReflection_MarketsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Markets) != 0 {
+		value := protoreflect.ValueOfList(&_MarketsResponse_1_list{list: &x.Markets})
+		if !f(fd_MarketsResponse_markets, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_MarketsResponse_pagination, value) {
 			return
 		}
 	}
@@ -1863,15 +2420,17 @@ func (x *fastReflection_MarketRequest) Range(f func(protoreflect.FieldDescriptor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MarketRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MarketsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "connect.marketmap.v2.MarketRequest.currency_pair":
-		return x.CurrencyPair != nil
+	case "connect.marketmap.v2.MarketsResponse.markets":
+		return len(x.Markets) != 0
+	case "connect.marketmap.v2.MarketsResponse.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketsResponse"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.MarketRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1881,15 +2440,17 @@ func (x *fastReflection_MarketRequest) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MarketRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MarketsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "connect.marketmap.v2.MarketRequest.currency_pair":
-		x.CurrencyPair = nil
+	case "connect.marketmap.v2.MarketsResponse.markets":
+		x.Markets = nil
+	case "connect.marketmap.v2.MarketsResponse.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketsResponse"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.MarketRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1899,16 +2460,22 @@ func (x *fastReflection_MarketRequest) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MarketRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MarketsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "connect.marketmap.v2.MarketRequest.currency_pair":
-		value := x.CurrencyPair
+	case "connect.marketmap.v2.MarketsResponse.markets":
+		if len(x.Markets) == 0 {
+			return protoreflect.ValueOfList(&_MarketsResponse_1_list{})
+		}
+		listValue := &_MarketsResponse_1_list{list: &x.Markets}
+		return protoreflect.ValueOfList(listValue)
+	case "connect.marketmap.v2.MarketsResponse.pagination":
+		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketsResponse"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.MarketRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1922,15 +2489,19 @@ func (x *fastReflection_MarketRequest) Get(descriptor protoreflect.FieldDescript
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MarketRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MarketsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "connect.marketmap.v2.MarketRequest.currency_pair":
-		x.CurrencyPair = value.Message().Interface().(*v2.CurrencyPair)
+	case "connect.marketmap.v2.MarketsResponse.markets":
+		lv := value.List()
+		clv := lv.(*_MarketsResponse_1_list)
+		x.Markets = *clv.list
+	case "connect.marketmap.v2.MarketsResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketsResponse"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.MarketRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1944,44 +2515,53 @@ func (x *fastReflection_MarketRequest) Set(fd protoreflect.FieldDescriptor, valu
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MarketRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MarketsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "connect.marketmap.v2.MarketRequest.currency_pair":
-		if x.CurrencyPair == nil {
-			x.CurrencyPair = new(v2.CurrencyPair)
+	case "connect.marketmap.v2.MarketsResponse.markets":
+		if x.Markets == nil {
+			x.Markets = []*Market{}
 		}
-		return protoreflect.ValueOfMessage(x.CurrencyPair.ProtoReflect())
+		value := &_MarketsResponse_1_list{list: &x.Markets}
+		return protoreflect.ValueOfList(value)
+	case "connect.marketmap.v2.MarketsResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketsResponse"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.MarketRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MarketRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MarketsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "connect.marketmap.v2.MarketRequest.currency_pair":
-		m := new(v2.CurrencyPair)
+	case "connect.marketmap.v2.MarketsResponse.markets":
+		list := []*Market{}
+		return protoreflect.ValueOfList(&_MarketsResponse_1_list{list: &list})
+	case "connect.marketmap.v2.MarketsResponse.pagination":
+		m := new(v1beta1.PageResponse)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketsResponse"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.MarketRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MarketRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MarketsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in connect.marketmap.v2.MarketRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in connect.marketmap.v2.MarketsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1989,7 +2569,7 @@ func (x *fastReflection_MarketRequest) WhichOneof(d protoreflect.OneofDescriptor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MarketRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MarketsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2000,7 +2580,7 @@ func (x *fastReflection_MarketRequest) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MarketRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MarketsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2012,7 +2592,7 @@ func (x *fastReflection_MarketRequest) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MarketRequest) IsValid() bool {
+func (x *fastReflection_MarketsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -2022,9 +2602,9 @@ func (x *fastReflection_MarketRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MarketRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MarketsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MarketRequest)
+		x := input.Message.Interface().(*MarketsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2036,8 +2616,14 @@ func (x *fastReflection_MarketRequest) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.CurrencyPair != nil {
-			l = options.Size(x.CurrencyPair)
+		if len(x.Markets) > 0 {
+			for _, e := range x.Markets {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -2050,7 +2636,7 @@ func (x *fastReflection_MarketRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MarketRequest)
+		x := input.Message.Interface().(*MarketsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2069,8 +2655,8 @@ func (x *fastReflection_MarketRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.CurrencyPair != nil {
-			encoded, err := options.Marshal(x.CurrencyPair)
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2081,7 +2667,23 @@ func (x *fastReflection_MarketRequest) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
+		}
+		if len(x.Markets) > 0 {
+			for iNdEx := len(x.Markets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Markets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -2094,7 +2696,7 @@ func (x *fastReflection_MarketRequest) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MarketRequest)
+		x := input.Message.Interface().(*MarketsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2126,15 +2728,15 @@ func (x *fastReflection_MarketRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -2161,10 +2763,44 @@ func (x *fastReflection_MarketRequest) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.CurrencyPair == nil {
-					x.CurrencyPair = &v2.CurrencyPair{}
+				x.Markets = append(x.Markets, &Market{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Markets[len(x.Markets)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CurrencyPair); err != nil {
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -2204,25 +2840,25 @@ func (x *fastReflection_MarketRequest) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MarketResponse        protoreflect.MessageDescriptor
-	fd_MarketResponse_market protoreflect.FieldDescriptor
+	md_MarketRequest               protoreflect.MessageDescriptor
+	fd_MarketRequest_currency_pair protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_connect_marketmap_v2_query_proto_init()
-	md_MarketResponse = File_connect_marketmap_v2_query_proto.Messages().ByName("MarketResponse")
-	fd_MarketResponse_market = md_MarketResponse.Fields().ByName("market")
+	md_MarketRequest = File_connect_marketmap_v2_query_proto.Messages().ByName("MarketRequest")
+	fd_MarketRequest_currency_pair = md_MarketRequest.Fields().ByName("currency_pair")
 }
 
-var _ protoreflect.Message = (*fastReflection_MarketResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MarketRequest)(nil)
 
-type fastReflection_MarketResponse MarketResponse
+type fastReflection_MarketRequest MarketRequest
 
-func (x *MarketResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MarketResponse)(x)
+func (x *MarketRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MarketRequest)(x)
 }
 
-func (x *MarketResponse) slowProtoReflect() protoreflect.Message {
+func (x *MarketRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_connect_marketmap_v2_query_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2234,43 +2870,43 @@ func (x *MarketResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MarketResponse_messageType fastReflection_MarketResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MarketResponse_messageType{}
+var _fastReflection_MarketRequest_messageType fastReflection_MarketRequest_messageType
+var _ protoreflect.MessageType = fastReflection_MarketRequest_messageType{}
 
-type fastReflection_MarketResponse_messageType struct{}
+type fastReflection_MarketRequest_messageType struct{}
 
-func (x fastReflection_MarketResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MarketResponse)(nil)
+func (x fastReflection_MarketRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MarketRequest)(nil)
 }
-func (x fastReflection_MarketResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MarketResponse)
+func (x fastReflection_MarketRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_MarketRequest)
 }
-func (x fastReflection_MarketResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MarketResponse
+func (x fastReflection_MarketRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MarketRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MarketResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MarketResponse
+func (x *fastReflection_MarketRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_MarketRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MarketResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MarketResponse_messageType
+func (x *fastReflection_MarketRequest) Type() protoreflect.MessageType {
+	return _fastReflection_MarketRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MarketResponse) New() protoreflect.Message {
-	return new(fastReflection_MarketResponse)
+func (x *fastReflection_MarketRequest) New() protoreflect.Message {
+	return new(fastReflection_MarketRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MarketResponse) Interface() protoreflect.ProtoMessage {
-	return (*MarketResponse)(x)
+func (x *fastReflection_MarketRequest) Interface() protoreflect.ProtoMessage {
+	return (*MarketRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2278,10 +2914,10 @@ func (x *fastReflection_MarketResponse) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MarketResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Market != nil {
-		value := protoreflect.ValueOfMessage(x.Market.ProtoReflect())
-		if !f(fd_MarketResponse_market, value) {
+func (x *fastReflection_MarketRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.CurrencyPair != nil {
+		value := protoreflect.ValueOfMessage(x.CurrencyPair.ProtoReflect())
+		if !f(fd_MarketRequest_currency_pair, value) {
 			return
 		}
 	}
@@ -2298,15 +2934,15 @@ func (x *fastReflection_MarketResponse) Range(f func(protoreflect.FieldDescripto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MarketResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MarketRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "connect.marketmap.v2.MarketResponse.market":
-		return x.Market != nil
+	case "connect.marketmap.v2.MarketRequest.currency_pair":
+		return x.CurrencyPair != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketRequest"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.MarketResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2316,15 +2952,15 @@ func (x *fastReflection_MarketResponse) Has(fd protoreflect.FieldDescriptor) boo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MarketResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MarketRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "connect.marketmap.v2.MarketResponse.market":
-		x.Market = nil
+	case "connect.marketmap.v2.MarketRequest.currency_pair":
+		x.CurrencyPair = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketRequest"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.MarketResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2334,16 +2970,16 @@ func (x *fastReflection_MarketResponse) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MarketResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MarketRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "connect.marketmap.v2.MarketResponse.market":
-		value := x.Market
+	case "connect.marketmap.v2.MarketRequest.currency_pair":
+		value := x.CurrencyPair
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketRequest"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.MarketResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2357,15 +2993,15 @@ func (x *fastReflection_MarketResponse) Get(descriptor protoreflect.FieldDescrip
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MarketResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MarketRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "connect.marketmap.v2.MarketResponse.market":
-		x.Market = value.Message().Interface().(*Market)
+	case "connect.marketmap.v2.MarketRequest.currency_pair":
+		x.CurrencyPair = value.Message().Interface().(*v2.CurrencyPair)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketRequest"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.MarketResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2379,44 +3015,44 @@ func (x *fastReflection_MarketResponse) Set(fd protoreflect.FieldDescriptor, val
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MarketResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MarketRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "connect.marketmap.v2.MarketResponse.market":
-		if x.Market == nil {
-			x.Market = new(Market)
+	case "connect.marketmap.v2.MarketRequest.currency_pair":
+		if x.CurrencyPair == nil {
+			x.CurrencyPair = new(v2.CurrencyPair)
 		}
-		return protoreflect.ValueOfMessage(x.Market.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.CurrencyPair.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketRequest"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.MarketResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MarketResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MarketRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "connect.marketmap.v2.MarketResponse.market":
-		m := new(Market)
+	case "connect.marketmap.v2.MarketRequest.currency_pair":
+		m := new(v2.CurrencyPair)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketRequest"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.MarketResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MarketResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MarketRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in connect.marketmap.v2.MarketResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in connect.marketmap.v2.MarketRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2424,7 +3060,7 @@ func (x *fastReflection_MarketResponse) WhichOneof(d protoreflect.OneofDescripto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MarketResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MarketRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2435,7 +3071,7 @@ func (x *fastReflection_MarketResponse) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MarketResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MarketRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2447,7 +3083,7 @@ func (x *fastReflection_MarketResponse) SetUnknown(fields protoreflect.RawFields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MarketResponse) IsValid() bool {
+func (x *fastReflection_MarketRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -2457,9 +3093,9 @@ func (x *fastReflection_MarketResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MarketResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MarketRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MarketResponse)
+		x := input.Message.Interface().(*MarketRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2471,8 +3107,8 @@ func (x *fastReflection_MarketResponse) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Market != nil {
-			l = options.Size(x.Market)
+		if x.CurrencyPair != nil {
+			l = options.Size(x.CurrencyPair)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -2485,7 +3121,7 @@ func (x *fastReflection_MarketResponse) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MarketResponse)
+		x := input.Message.Interface().(*MarketRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2504,8 +3140,8 @@ func (x *fastReflection_MarketResponse) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Market != nil {
-			encoded, err := options.Marshal(x.Market)
+		if x.CurrencyPair != nil {
+			encoded, err := options.Marshal(x.CurrencyPair)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2529,7 +3165,7 @@ func (x *fastReflection_MarketResponse) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MarketResponse)
+		x := input.Message.Interface().(*MarketRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2561,15 +3197,15 @@ func (x *fastReflection_MarketResponse) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -2596,10 +3232,10 @@ func (x *fastReflection_MarketResponse) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Market == nil {
-					x.Market = &Market{}
+				if x.CurrencyPair == nil {
+					x.CurrencyPair = &v2.CurrencyPair{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Market); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CurrencyPair); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -2639,23 +3275,25 @@ func (x *fastReflection_MarketResponse) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_ParamsRequest protoreflect.MessageDescriptor
+	md_MarketResponse        protoreflect.MessageDescriptor
+	fd_MarketResponse_market protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_connect_marketmap_v2_query_proto_init()
-	md_ParamsRequest = File_connect_marketmap_v2_query_proto.Messages().ByName("ParamsRequest")
+	md_MarketResponse = File_connect_marketmap_v2_query_proto.Messages().ByName("MarketResponse")
+	fd_MarketResponse_market = md_MarketResponse.Fields().ByName("market")
 }
 
-var _ protoreflect.Message = (*fastReflection_ParamsRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_MarketResponse)(nil)
 
-type fastReflection_ParamsRequest ParamsRequest
+type fastReflection_MarketResponse MarketResponse
 
-func (x *ParamsRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ParamsRequest)(x)
+func (x *MarketResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MarketResponse)(x)
 }
 
-func (x *ParamsRequest) slowProtoReflect() protoreflect.Message {
+func (x *MarketResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_connect_marketmap_v2_query_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2667,43 +3305,43 @@ func (x *ParamsRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ParamsRequest_messageType fastReflection_ParamsRequest_messageType
-var _ protoreflect.MessageType = fastReflection_ParamsRequest_messageType{}
+var _fastReflection_MarketResponse_messageType fastReflection_MarketResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MarketResponse_messageType{}
 
-type fastReflection_ParamsRequest_messageType struct{}
+type fastReflection_MarketResponse_messageType struct{}
 
-func (x fastReflection_ParamsRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ParamsRequest)(nil)
+func (x fastReflection_MarketResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MarketResponse)(nil)
 }
-func (x fastReflection_ParamsRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_ParamsRequest)
+func (x fastReflection_MarketResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MarketResponse)
 }
-func (x fastReflection_ParamsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ParamsRequest
+func (x fastReflection_MarketResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MarketResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ParamsRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_ParamsRequest
+func (x *fastReflection_MarketResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MarketResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ParamsRequest) Type() protoreflect.MessageType {
-	return _fastReflection_ParamsRequest_messageType
+func (x *fastReflection_MarketResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MarketResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ParamsRequest) New() protoreflect.Message {
-	return new(fastReflection_ParamsRequest)
+func (x *fastReflection_MarketResponse) New() protoreflect.Message {
+	return new(fastReflection_MarketResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ParamsRequest) Interface() protoreflect.ProtoMessage {
-	return (*ParamsRequest)(x)
+func (x *fastReflection_MarketResponse) Interface() protoreflect.ProtoMessage {
+	return (*MarketResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2711,7 +3349,13 @@ func (x *fastReflection_ParamsRequest) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ParamsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MarketResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Market != nil {
+		value := protoreflect.ValueOfMessage(x.Market.ProtoReflect())
+		if !f(fd_MarketResponse_market, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -2725,13 +3369,15 @@ func (x *fastReflection_ParamsRequest) Range(f func(protoreflect.FieldDescriptor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ParamsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MarketResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketResponse.market":
+		return x.Market != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketResponse"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.ParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2741,13 +3387,15 @@ func (x *fastReflection_ParamsRequest) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ParamsRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MarketResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketResponse.market":
+		x.Market = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketResponse"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.ParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2757,13 +3405,16 @@ func (x *fastReflection_ParamsRequest) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ParamsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MarketResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "connect.marketmap.v2.MarketResponse.market":
+		value := x.Market
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketResponse"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.ParamsRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2777,13 +3428,15 @@ func (x *fastReflection_ParamsRequest) Get(descriptor protoreflect.FieldDescript
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ParamsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MarketResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketResponse.market":
+		x.Market = value.Message().Interface().(*Market)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketResponse"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.ParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2797,36 +3450,44 @@ func (x *fastReflection_ParamsRequest) Set(fd protoreflect.FieldDescriptor, valu
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ParamsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MarketResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketResponse.market":
+		if x.Market == nil {
+			x.Market = new(Market)
+		}
+		return protoreflect.ValueOfMessage(x.Market.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketResponse"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.ParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ParamsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MarketResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketResponse.market":
+		m := new(Market)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketResponse"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.ParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ParamsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MarketResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in connect.marketmap.v2.ParamsRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in connect.marketmap.v2.MarketResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2834,7 +3495,7 @@ func (x *fastReflection_ParamsRequest) WhichOneof(d protoreflect.OneofDescriptor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ParamsRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MarketResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2845,7 +3506,7 @@ func (x *fastReflection_ParamsRequest) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ParamsRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MarketResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2857,7 +3518,7 @@ func (x *fastReflection_ParamsRequest) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ParamsRequest) IsValid() bool {
+func (x *fastReflection_MarketResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -2867,9 +3528,9 @@ func (x *fastReflection_ParamsRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ParamsRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MarketResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ParamsRequest)
+		x := input.Message.Interface().(*MarketResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2881,6 +3542,10 @@ func (x *fastReflection_ParamsRequest) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
+		if x.Market != nil {
+			l = options.Size(x.Market)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2891,7 +3556,7 @@ func (x *fastReflection_ParamsRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ParamsRequest)
+		x := input.Message.Interface().(*MarketResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2910,6 +3575,20 @@ func (x *fastReflection_ParamsRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Market != nil {
+			encoded, err := options.Marshal(x.Market)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -2921,7 +3600,7 @@ func (x *fastReflection_ParamsRequest) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ParamsRequest)
+		x := input.Message.Interface().(*MarketResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2953,12 +3632,48 @@ func (x *fastReflection_ParamsRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Market == nil {
+					x.Market = &Market{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Market); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2995,25 +3710,23 @@ func (x *fastReflection_ParamsRequest) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_ParamsResponse        protoreflect.MessageDescriptor
-	fd_ParamsResponse_params protoreflect.FieldDescriptor
+	md_ParamsRequest protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_connect_marketmap_v2_query_proto_init()
-	md_ParamsResponse = File_connect_marketmap_v2_query_proto.Messages().ByName("ParamsResponse")
-	fd_ParamsResponse_params = md_ParamsResponse.Fields().ByName("params")
+	md_ParamsRequest = File_connect_marketmap_v2_query_proto.Messages().ByName("ParamsRequest")
 }
 
-var _ protoreflect.Message = (*fastReflection_ParamsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_ParamsRequest)(nil)
 
-type fastReflection_ParamsResponse ParamsResponse
+type fastReflection_ParamsRequest ParamsRequest
 
-func (x *ParamsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ParamsResponse)(x)
+func (x *ParamsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ParamsRequest)(x)
 }
 
-func (x *ParamsResponse) slowProtoReflect() protoreflect.Message {
+func (x *ParamsRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_connect_marketmap_v2_query_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3025,43 +3738,43 @@ func (x *ParamsResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ParamsResponse_messageType fastReflection_ParamsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_ParamsResponse_messageType{}
+var _fastReflection_ParamsRequest_messageType fastReflection_ParamsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_ParamsRequest_messageType{}
 
-type fastReflection_ParamsResponse_messageType struct{}
+type fastReflection_ParamsRequest_messageType struct{}
 
-func (x fastReflection_ParamsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ParamsResponse)(nil)
+func (x fastReflection_ParamsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ParamsRequest)(nil)
 }
-func (x fastReflection_ParamsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_ParamsResponse)
+func (x fastReflection_ParamsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_ParamsRequest)
 }
-func (x fastReflection_ParamsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ParamsResponse
+func (x fastReflection_ParamsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParamsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ParamsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_ParamsResponse
+func (x *fastReflection_ParamsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParamsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ParamsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_ParamsResponse_messageType
+func (x *fastReflection_ParamsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_ParamsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ParamsResponse) New() protoreflect.Message {
-	return new(fastReflection_ParamsResponse)
+func (x *fastReflection_ParamsRequest) New() protoreflect.Message {
+	return new(fastReflection_ParamsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ParamsResponse) Interface() protoreflect.ProtoMessage {
-	return (*ParamsResponse)(x)
+func (x *fastReflection_ParamsRequest) Interface() protoreflect.ProtoMessage {
+	return (*ParamsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3069,13 +3782,7 @@ func (x *fastReflection_ParamsResponse) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ParamsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Params != nil {
-		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-		if !f(fd_ParamsResponse_params, value) {
-			return
-		}
-	}
+func (x *fastReflection_ParamsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -3089,15 +3796,13 @@ func (x *fastReflection_ParamsResponse) Range(f func(protoreflect.FieldDescripto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ParamsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ParamsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "connect.marketmap.v2.ParamsResponse.params":
-		return x.Params != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ParamsRequest"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.ParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.ParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3107,15 +3812,13 @@ func (x *fastReflection_ParamsResponse) Has(fd protoreflect.FieldDescriptor) boo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ParamsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ParamsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "connect.marketmap.v2.ParamsResponse.params":
-		x.Params = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ParamsRequest"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.ParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.ParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3125,16 +3828,13 @@ func (x *fastReflection_ParamsResponse) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ParamsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ParamsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "connect.marketmap.v2.ParamsResponse.params":
-		value := x.Params
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ParamsRequest"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.ParamsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.ParamsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3148,15 +3848,13 @@ func (x *fastReflection_ParamsResponse) Get(descriptor protoreflect.FieldDescrip
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ParamsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ParamsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "connect.marketmap.v2.ParamsResponse.params":
-		x.Params = value.Message().Interface().(*Params)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ParamsRequest"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.ParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.ParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3170,44 +3868,36 @@ func (x *fastReflection_ParamsResponse) Set(fd protoreflect.FieldDescriptor, val
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ParamsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ParamsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "connect.marketmap.v2.ParamsResponse.params":
-		if x.Params == nil {
-			x.Params = new(Params)
-		}
-		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ParamsRequest"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.ParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.ParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ParamsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ParamsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "connect.marketmap.v2.ParamsResponse.params":
-		m := new(Params)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ParamsRequest"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.ParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.ParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ParamsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ParamsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in connect.marketmap.v2.ParamsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in connect.marketmap.v2.ParamsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3215,7 +3905,7 @@ func (x *fastReflection_ParamsResponse) WhichOneof(d protoreflect.OneofDescripto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ParamsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ParamsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3226,7 +3916,7 @@ func (x *fastReflection_ParamsResponse) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ParamsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ParamsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3238,7 +3928,7 @@ func (x *fastReflection_ParamsResponse) SetUnknown(fields protoreflect.RawFields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ParamsResponse) IsValid() bool {
+func (x *fastReflection_ParamsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -3248,9 +3938,9 @@ func (x *fastReflection_ParamsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ParamsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ParamsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ParamsResponse)
+		x := input.Message.Interface().(*ParamsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3262,10 +3952,6 @@ func (x *fastReflection_ParamsResponse) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Params != nil {
-			l = options.Size(x.Params)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3276,7 +3962,7 @@ func (x *fastReflection_ParamsResponse) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ParamsResponse)
+		x := input.Message.Interface().(*ParamsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3295,20 +3981,6 @@ func (x *fastReflection_ParamsResponse) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Params != nil {
-			encoded, err := options.Marshal(x.Params)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0xa
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -3320,7 +3992,7 @@ func (x *fastReflection_ParamsResponse) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ParamsResponse)
+		x := input.Message.Interface().(*ParamsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3352,48 +4024,12 @@ func (x *fastReflection_ParamsResponse) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Params == nil {
-					x.Params = &Params{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3430,23 +4066,25 @@ func (x *fastReflection_ParamsResponse) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_LastUpdatedRequest protoreflect.MessageDescriptor
+	md_ParamsResponse        protoreflect.MessageDescriptor
+	fd_ParamsResponse_params protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_connect_marketmap_v2_query_proto_init()
-	md_LastUpdatedRequest = File_connect_marketmap_v2_query_proto.Messages().ByName("LastUpdatedRequest")
+	md_ParamsResponse = File_connect_marketmap_v2_query_proto.Messages().ByName("ParamsResponse")
+	fd_ParamsResponse_params = md_ParamsResponse.Fields().ByName("params")
 }
 
-var _ protoreflect.Message = (*fastReflection_LastUpdatedRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_ParamsResponse)(nil)
 
-type fastReflection_LastUpdatedRequest LastUpdatedRequest
+type fastReflection_ParamsResponse ParamsResponse
 
-func (x *LastUpdatedRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_LastUpdatedRequest)(x)
+func (x *ParamsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ParamsResponse)(x)
 }
 
-func (x *LastUpdatedRequest) slowProtoReflect() protoreflect.Message {
+func (x *ParamsResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_connect_marketmap_v2_query_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3458,43 +4096,43 @@ func (x *LastUpdatedRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_LastUpdatedRequest_messageType fastReflection_LastUpdatedRequest_messageType
-var _ protoreflect.MessageType = fastReflection_LastUpdatedRequest_messageType{}
+var _fastReflection_ParamsResponse_messageType fastReflection_ParamsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_ParamsResponse_messageType{}
 
-type fastReflection_LastUpdatedRequest_messageType struct{}
+type fastReflection_ParamsResponse_messageType struct{}
 
-func (x fastReflection_LastUpdatedRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_LastUpdatedRequest)(nil)
+func (x fastReflection_ParamsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ParamsResponse)(nil)
 }
-func (x fastReflection_LastUpdatedRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_LastUpdatedRequest)
+func (x fastReflection_ParamsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_ParamsResponse)
 }
-func (x fastReflection_LastUpdatedRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_LastUpdatedRequest
+func (x fastReflection_ParamsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParamsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_LastUpdatedRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_LastUpdatedRequest
+func (x *fastReflection_ParamsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParamsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_LastUpdatedRequest) Type() protoreflect.MessageType {
-	return _fastReflection_LastUpdatedRequest_messageType
+func (x *fastReflection_ParamsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_ParamsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_LastUpdatedRequest) New() protoreflect.Message {
-	return new(fastReflection_LastUpdatedRequest)
+func (x *fastReflection_ParamsResponse) New() protoreflect.Message {
+	return new(fastReflection_ParamsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_LastUpdatedRequest) Interface() protoreflect.ProtoMessage {
-	return (*LastUpdatedRequest)(x)
+func (x *fastReflection_ParamsResponse) Interface() protoreflect.ProtoMessage {
+	return (*ParamsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3502,7 +4140,13 @@ func (x *fastReflection_LastUpdatedRequest) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_LastUpdatedRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_ParamsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_ParamsResponse_params, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -3516,13 +4160,15 @@ func (x *fastReflection_LastUpdatedRequest) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_LastUpdatedRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ParamsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "connect.marketmap.v2.ParamsResponse.params":
+		return x.Params != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.LastUpdatedRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ParamsResponse"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.LastUpdatedRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.ParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3532,13 +4178,15 @@ func (x *fastReflection_LastUpdatedRequest) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_LastUpdatedRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ParamsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "connect.marketmap.v2.ParamsResponse.params":
+		x.Params = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.LastUpdatedRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ParamsResponse"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.LastUpdatedRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.ParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3548,13 +4196,16 @@ func (x *fastReflection_LastUpdatedRequest) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_LastUpdatedRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ParamsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "connect.marketmap.v2.ParamsResponse.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.LastUpdatedRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ParamsResponse"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.LastUpdatedRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.ParamsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3568,13 +4219,15 @@ func (x *fastReflection_LastUpdatedRequest) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_LastUpdatedRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ParamsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "connect.marketmap.v2.ParamsResponse.params":
+		x.Params = value.Message().Interface().(*Params)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.LastUpdatedRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ParamsResponse"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.LastUpdatedRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.ParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3588,36 +4241,44 @@ func (x *fastReflection_LastUpdatedRequest) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_LastUpdatedRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ParamsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "connect.marketmap.v2.ParamsResponse.params":
+		if x.Params == nil {
+			x.Params = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.LastUpdatedRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ParamsResponse"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.LastUpdatedRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.ParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_LastUpdatedRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ParamsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "connect.marketmap.v2.ParamsResponse.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.LastUpdatedRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ParamsResponse"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.LastUpdatedRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.ParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_LastUpdatedRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ParamsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in connect.marketmap.v2.LastUpdatedRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in connect.marketmap.v2.ParamsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3625,7 +4286,7 @@ func (x *fastReflection_LastUpdatedRequest) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_LastUpdatedRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ParamsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3636,7 +4297,7 @@ func (x *fastReflection_LastUpdatedRequest) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_LastUpdatedRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ParamsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3648,7 +4309,7 @@ func (x *fastReflection_LastUpdatedRequest) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_LastUpdatedRequest) IsValid() bool {
+func (x *fastReflection_ParamsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -3658,9 +4319,9 @@ func (x *fastReflection_LastUpdatedRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_LastUpdatedRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ParamsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*LastUpdatedRequest)
+		x := input.Message.Interface().(*ParamsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3672,6 +4333,10 @@ func (x *fastReflection_LastUpdatedRequest) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
+		if x.Params != nil {
+			l = options.Size(x.Params)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3682,7 +4347,7 @@ func (x *fastReflection_LastUpdatedRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*LastUpdatedRequest)
+		x := input.Message.Interface().(*ParamsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3701,6 +4366,20 @@ func (x *fastReflection_LastUpdatedRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -3712,7 +4391,7 @@ func (x *fastReflection_LastUpdatedRequest) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*LastUpdatedRequest)
+		x := input.Message.Interface().(*ParamsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3744,12 +4423,48 @@ func (x *fastReflection_LastUpdatedRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LastUpdatedRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LastUpdatedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3786,25 +4501,23 @@ func (x *fastReflection_LastUpdatedRequest) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_LastUpdatedResponse              protoreflect.MessageDescriptor
-	fd_LastUpdatedResponse_last_updated protoreflect.FieldDescriptor
+	md_LastUpdatedRequest protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_connect_marketmap_v2_query_proto_init()
-	md_LastUpdatedResponse = File_connect_marketmap_v2_query_proto.Messages().ByName("LastUpdatedResponse")
-	fd_LastUpdatedResponse_last_updated = md_LastUpdatedResponse.Fields().ByName("last_updated")
+	md_LastUpdatedRequest = File_connect_marketmap_v2_query_proto.Messages().ByName("LastUpdatedRequest")
 }
 
-var _ protoreflect.Message = (*fastReflection_LastUpdatedResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_LastUpdatedRequest)(nil)
 
-type fastReflection_LastUpdatedResponse LastUpdatedResponse
+type fastReflection_LastUpdatedRequest LastUpdatedRequest
 
-func (x *LastUpdatedResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_LastUpdatedResponse)(x)
+func (x *LastUpdatedRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_LastUpdatedRequest)(x)
 }
 
-func (x *LastUpdatedResponse) slowProtoReflect() protoreflect.Message {
+func (x *LastUpdatedRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_connect_marketmap_v2_query_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3816,43 +4529,43 @@ func (x *LastUpdatedResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_LastUpdatedResponse_messageType fastReflection_LastUpdatedResponse_messageType
-var _ protoreflect.MessageType = fastReflection_LastUpdatedResponse_messageType{}
+var _fastReflection_LastUpdatedRequest_messageType fastReflection_LastUpdatedRequest_messageType
+var _ protoreflect.MessageType = fastReflection_LastUpdatedRequest_messageType{}
 
-type fastReflection_LastUpdatedResponse_messageType struct{}
+type fastReflection_LastUpdatedRequest_messageType struct{}
 
-func (x fastReflection_LastUpdatedResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_LastUpdatedResponse)(nil)
+func (x fastReflection_LastUpdatedRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_LastUpdatedRequest)(nil)
 }
-func (x fastReflection_LastUpdatedResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_LastUpdatedResponse)
+func (x fastReflection_LastUpdatedRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_LastUpdatedRequest)
 }
-func (x fastReflection_LastUpdatedResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_LastUpdatedResponse
+func (x fastReflection_LastUpdatedRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_LastUpdatedRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_LastUpdatedResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_LastUpdatedResponse
+func (x *fastReflection_LastUpdatedRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_LastUpdatedRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_LastUpdatedResponse) Type() protoreflect.MessageType {
-	return _fastReflection_LastUpdatedResponse_messageType
+func (x *fastReflection_LastUpdatedRequest) Type() protoreflect.MessageType {
+	return _fastReflection_LastUpdatedRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_LastUpdatedResponse) New() protoreflect.Message {
-	return new(fastReflection_LastUpdatedResponse)
+func (x *fastReflection_LastUpdatedRequest) New() protoreflect.Message {
+	return new(fastReflection_LastUpdatedRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_LastUpdatedResponse) Interface() protoreflect.ProtoMessage {
-	return (*LastUpdatedResponse)(x)
+func (x *fastReflection_LastUpdatedRequest) Interface() protoreflect.ProtoMessage {
+	return (*LastUpdatedRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3860,13 +4573,7 @@ func (x *fastReflection_LastUpdatedResponse) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_LastUpdatedResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.LastUpdated != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.LastUpdated)
-		if !f(fd_LastUpdatedResponse_last_updated, value) {
-			return
-		}
-	}
+func (x *fastReflection_LastUpdatedRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -3880,15 +4587,13 @@ func (x *fastReflection_LastUpdatedResponse) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_LastUpdatedResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_LastUpdatedRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "connect.marketmap.v2.LastUpdatedResponse.last_updated":
-		return x.LastUpdated != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.LastUpdatedResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.LastUpdatedRequest"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.LastUpdatedResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.LastUpdatedRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3898,15 +4603,13 @@ func (x *fastReflection_LastUpdatedResponse) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_LastUpdatedResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_LastUpdatedRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "connect.marketmap.v2.LastUpdatedResponse.last_updated":
-		x.LastUpdated = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.LastUpdatedResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.LastUpdatedRequest"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.LastUpdatedResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.LastUpdatedRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3916,16 +4619,13 @@ func (x *fastReflection_LastUpdatedResponse) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_LastUpdatedResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_LastUpdatedRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "connect.marketmap.v2.LastUpdatedResponse.last_updated":
-		value := x.LastUpdated
-		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.LastUpdatedResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.LastUpdatedRequest"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.LastUpdatedResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.LastUpdatedRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3939,15 +4639,13 @@ func (x *fastReflection_LastUpdatedResponse) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_LastUpdatedResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_LastUpdatedRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "connect.marketmap.v2.LastUpdatedResponse.last_updated":
-		x.LastUpdated = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.LastUpdatedResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.LastUpdatedRequest"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.LastUpdatedResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.LastUpdatedRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3961,40 +4659,36 @@ func (x *fastReflection_LastUpdatedResponse) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_LastUpdatedResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_LastUpdatedRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "connect.marketmap.v2.LastUpdatedResponse.last_updated":
-		panic(fmt.Errorf("field last_updated of message connect.marketmap.v2.LastUpdatedResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.LastUpdatedResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.LastUpdatedRequest"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.LastUpdatedResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.LastUpdatedRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_LastUpdatedResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_LastUpdatedRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "connect.marketmap.v2.LastUpdatedResponse.last_updated":
-		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.LastUpdatedResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.LastUpdatedRequest"))
 		}
-		panic(fmt.Errorf("message connect.marketmap.v2.LastUpdatedResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message connect.marketmap.v2.LastUpdatedRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_LastUpdatedResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_LastUpdatedRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in connect.marketmap.v2.LastUpdatedResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in connect.marketmap.v2.LastUpdatedRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4002,7 +4696,7 @@ func (x *fastReflection_LastUpdatedResponse) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_LastUpdatedResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_LastUpdatedRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4013,7 +4707,7 @@ func (x *fastReflection_LastUpdatedResponse) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_LastUpdatedResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_LastUpdatedRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4025,7 +4719,7 @@ func (x *fastReflection_LastUpdatedResponse) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_LastUpdatedResponse) IsValid() bool {
+func (x *fastReflection_LastUpdatedRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -4035,9 +4729,9 @@ func (x *fastReflection_LastUpdatedResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_LastUpdatedResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_LastUpdatedRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*LastUpdatedResponse)
+		x := input.Message.Interface().(*LastUpdatedRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4049,9 +4743,6 @@ func (x *fastReflection_LastUpdatedResponse) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if x.LastUpdated != 0 {
-			n += 1 + runtime.Sov(uint64(x.LastUpdated))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -4062,7 +4753,7 @@ func (x *fastReflection_LastUpdatedResponse) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*LastUpdatedResponse)
+		x := input.Message.Interface().(*LastUpdatedRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4081,11 +4772,6 @@ func (x *fastReflection_LastUpdatedResponse) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.LastUpdated != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastUpdated))
-			i--
-			dAtA[i] = 0x8
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -4097,7 +4783,7 @@ func (x *fastReflection_LastUpdatedResponse) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*LastUpdatedResponse)
+		x := input.Message.Interface().(*LastUpdatedRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4129,31 +4815,12 @@ func (x *fastReflection_LastUpdatedResponse) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LastUpdatedResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LastUpdatedRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LastUpdatedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LastUpdatedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastUpdated", wireType)
-				}
-				x.LastUpdated = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.LastUpdated |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4189,371 +4856,6971 @@ func (x *fastReflection_LastUpdatedResponse) ProtoMethods() *protoiface.Methods
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: connect/marketmap/v2/query.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_LastUpdatedResponse              protoreflect.MessageDescriptor
+	fd_LastUpdatedResponse_last_updated protoreflect.FieldDescriptor
 )
 
-// MarketMapRequest is the query request for the MarketMap query.
-// It takes no arguments.
-type MarketMapRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_connect_marketmap_v2_query_proto_init()
+	md_LastUpdatedResponse = File_connect_marketmap_v2_query_proto.Messages().ByName("LastUpdatedResponse")
+	fd_LastUpdatedResponse_last_updated = md_LastUpdatedResponse.Fields().ByName("last_updated")
 }
 
-func (x *MarketMapRequest) Reset() {
-	*x = MarketMapRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_connect_marketmap_v2_query_proto_msgTypes[0]
+var _ protoreflect.Message = (*fastReflection_LastUpdatedResponse)(nil)
+
+type fastReflection_LastUpdatedResponse LastUpdatedResponse
+
+func (x *LastUpdatedResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_LastUpdatedResponse)(x)
+}
+
+func (x *LastUpdatedResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_connect_marketmap_v2_query_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *MarketMapRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_LastUpdatedResponse_messageType fastReflection_LastUpdatedResponse_messageType
+var _ protoreflect.MessageType = fastReflection_LastUpdatedResponse_messageType{}
 
-func (*MarketMapRequest) ProtoMessage() {}
+type fastReflection_LastUpdatedResponse_messageType struct{}
 
-// Deprecated: Use MarketMapRequest.ProtoReflect.Descriptor instead.
-func (*MarketMapRequest) Descriptor() ([]byte, []int) {
-	return file_connect_marketmap_v2_query_proto_rawDescGZIP(), []int{0}
+func (x fastReflection_LastUpdatedResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_LastUpdatedResponse)(nil)
 }
-
-// MarketMapResponse is the query response for the MarketMap query.
-type MarketMapResponse struct {
+func (x fastReflection_LastUpdatedResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_LastUpdatedResponse)
+}
+func (x fastReflection_LastUpdatedResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_LastUpdatedResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_LastUpdatedResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_LastUpdatedResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_LastUpdatedResponse) Type() protoreflect.MessageType {
+	return _fastReflection_LastUpdatedResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_LastUpdatedResponse) New() protoreflect.Message {
+	return new(fastReflection_LastUpdatedResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_LastUpdatedResponse) Interface() protoreflect.ProtoMessage {
+	return (*LastUpdatedResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_LastUpdatedResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.LastUpdated != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LastUpdated)
+		if !f(fd_LastUpdatedResponse_last_updated, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_LastUpdatedResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.LastUpdatedResponse.last_updated":
+		return x.LastUpdated != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.LastUpdatedResponse"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.LastUpdatedResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LastUpdatedResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.LastUpdatedResponse.last_updated":
+		x.LastUpdated = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.LastUpdatedResponse"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.LastUpdatedResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_LastUpdatedResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "connect.marketmap.v2.LastUpdatedResponse.last_updated":
+		value := x.LastUpdated
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.LastUpdatedResponse"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.LastUpdatedResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LastUpdatedResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.LastUpdatedResponse.last_updated":
+		x.LastUpdated = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.LastUpdatedResponse"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.LastUpdatedResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LastUpdatedResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.LastUpdatedResponse.last_updated":
+		panic(fmt.Errorf("field last_updated of message connect.marketmap.v2.LastUpdatedResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.LastUpdatedResponse"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.LastUpdatedResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_LastUpdatedResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.LastUpdatedResponse.last_updated":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.LastUpdatedResponse"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.LastUpdatedResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_LastUpdatedResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in connect.marketmap.v2.LastUpdatedResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_LastUpdatedResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LastUpdatedResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_LastUpdatedResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_LastUpdatedResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*LastUpdatedResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.LastUpdated != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastUpdated))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*LastUpdatedResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.LastUpdated != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastUpdated))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*LastUpdatedResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LastUpdatedResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LastUpdatedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastUpdated", wireType)
+				}
+				x.LastUpdated = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastUpdated |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ScheduledMarketChangesRequest protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_connect_marketmap_v2_query_proto_init()
+	md_ScheduledMarketChangesRequest = File_connect_marketmap_v2_query_proto.Messages().ByName("ScheduledMarketChangesRequest")
+}
+
+var _ protoreflect.Message = (*fastReflection_ScheduledMarketChangesRequest)(nil)
+
+type fastReflection_ScheduledMarketChangesRequest ScheduledMarketChangesRequest
+
+func (x *ScheduledMarketChangesRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ScheduledMarketChangesRequest)(x)
+}
+
+func (x *ScheduledMarketChangesRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_connect_marketmap_v2_query_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ScheduledMarketChangesRequest_messageType fastReflection_ScheduledMarketChangesRequest_messageType
+var _ protoreflect.MessageType = fastReflection_ScheduledMarketChangesRequest_messageType{}
+
+type fastReflection_ScheduledMarketChangesRequest_messageType struct{}
+
+func (x fastReflection_ScheduledMarketChangesRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ScheduledMarketChangesRequest)(nil)
+}
+func (x fastReflection_ScheduledMarketChangesRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_ScheduledMarketChangesRequest)
+}
+func (x fastReflection_ScheduledMarketChangesRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ScheduledMarketChangesRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ScheduledMarketChangesRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_ScheduledMarketChangesRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ScheduledMarketChangesRequest) Type() protoreflect.MessageType {
+	return _fastReflection_ScheduledMarketChangesRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ScheduledMarketChangesRequest) New() protoreflect.Message {
+	return new(fastReflection_ScheduledMarketChangesRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ScheduledMarketChangesRequest) Interface() protoreflect.ProtoMessage {
+	return (*ScheduledMarketChangesRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ScheduledMarketChangesRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ScheduledMarketChangesRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ScheduledMarketChangesRequest"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.ScheduledMarketChangesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledMarketChangesRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ScheduledMarketChangesRequest"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.ScheduledMarketChangesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ScheduledMarketChangesRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ScheduledMarketChangesRequest"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.ScheduledMarketChangesRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledMarketChangesRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ScheduledMarketChangesRequest"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.ScheduledMarketChangesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledMarketChangesRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ScheduledMarketChangesRequest"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.ScheduledMarketChangesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ScheduledMarketChangesRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ScheduledMarketChangesRequest"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.ScheduledMarketChangesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ScheduledMarketChangesRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in connect.marketmap.v2.ScheduledMarketChangesRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ScheduledMarketChangesRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledMarketChangesRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ScheduledMarketChangesRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ScheduledMarketChangesRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ScheduledMarketChangesRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ScheduledMarketChangesRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ScheduledMarketChangesRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScheduledMarketChangesRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScheduledMarketChangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_ScheduledMarketChangesResponse_1_list)(nil)
+
+type _ScheduledMarketChangesResponse_1_list struct {
+	list *[]*ScheduledMarketChange
+}
+
+func (x *_ScheduledMarketChangesResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ScheduledMarketChangesResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ScheduledMarketChangesResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ScheduledMarketChange)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ScheduledMarketChangesResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ScheduledMarketChange)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ScheduledMarketChangesResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(ScheduledMarketChange)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ScheduledMarketChangesResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ScheduledMarketChangesResponse_1_list) NewElement() protoreflect.Value {
+	v := new(ScheduledMarketChange)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ScheduledMarketChangesResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ScheduledMarketChangesResponse         protoreflect.MessageDescriptor
+	fd_ScheduledMarketChangesResponse_changes protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_connect_marketmap_v2_query_proto_init()
+	md_ScheduledMarketChangesResponse = File_connect_marketmap_v2_query_proto.Messages().ByName("ScheduledMarketChangesResponse")
+	fd_ScheduledMarketChangesResponse_changes = md_ScheduledMarketChangesResponse.Fields().ByName("changes")
+}
+
+var _ protoreflect.Message = (*fastReflection_ScheduledMarketChangesResponse)(nil)
+
+type fastReflection_ScheduledMarketChangesResponse ScheduledMarketChangesResponse
+
+func (x *ScheduledMarketChangesResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ScheduledMarketChangesResponse)(x)
+}
+
+func (x *ScheduledMarketChangesResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_connect_marketmap_v2_query_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ScheduledMarketChangesResponse_messageType fastReflection_ScheduledMarketChangesResponse_messageType
+var _ protoreflect.MessageType = fastReflection_ScheduledMarketChangesResponse_messageType{}
+
+type fastReflection_ScheduledMarketChangesResponse_messageType struct{}
+
+func (x fastReflection_ScheduledMarketChangesResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ScheduledMarketChangesResponse)(nil)
+}
+func (x fastReflection_ScheduledMarketChangesResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_ScheduledMarketChangesResponse)
+}
+func (x fastReflection_ScheduledMarketChangesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ScheduledMarketChangesResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ScheduledMarketChangesResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_ScheduledMarketChangesResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ScheduledMarketChangesResponse) Type() protoreflect.MessageType {
+	return _fastReflection_ScheduledMarketChangesResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ScheduledMarketChangesResponse) New() protoreflect.Message {
+	return new(fastReflection_ScheduledMarketChangesResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ScheduledMarketChangesResponse) Interface() protoreflect.ProtoMessage {
+	return (*ScheduledMarketChangesResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ScheduledMarketChangesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Changes) != 0 {
+		value := protoreflect.ValueOfList(&_ScheduledMarketChangesResponse_1_list{list: &x.Changes})
+		if !f(fd_ScheduledMarketChangesResponse_changes, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ScheduledMarketChangesResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.ScheduledMarketChangesResponse.changes":
+		return len(x.Changes) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ScheduledMarketChangesResponse"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.ScheduledMarketChangesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledMarketChangesResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.ScheduledMarketChangesResponse.changes":
+		x.Changes = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ScheduledMarketChangesResponse"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.ScheduledMarketChangesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ScheduledMarketChangesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "connect.marketmap.v2.ScheduledMarketChangesResponse.changes":
+		if len(x.Changes) == 0 {
+			return protoreflect.ValueOfList(&_ScheduledMarketChangesResponse_1_list{})
+		}
+		listValue := &_ScheduledMarketChangesResponse_1_list{list: &x.Changes}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ScheduledMarketChangesResponse"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.ScheduledMarketChangesResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledMarketChangesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.ScheduledMarketChangesResponse.changes":
+		lv := value.List()
+		clv := lv.(*_ScheduledMarketChangesResponse_1_list)
+		x.Changes = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ScheduledMarketChangesResponse"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.ScheduledMarketChangesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledMarketChangesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.ScheduledMarketChangesResponse.changes":
+		if x.Changes == nil {
+			x.Changes = []*ScheduledMarketChange{}
+		}
+		value := &_ScheduledMarketChangesResponse_1_list{list: &x.Changes}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ScheduledMarketChangesResponse"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.ScheduledMarketChangesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ScheduledMarketChangesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.ScheduledMarketChangesResponse.changes":
+		list := []*ScheduledMarketChange{}
+		return protoreflect.ValueOfList(&_ScheduledMarketChangesResponse_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ScheduledMarketChangesResponse"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.ScheduledMarketChangesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ScheduledMarketChangesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in connect.marketmap.v2.ScheduledMarketChangesResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ScheduledMarketChangesResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledMarketChangesResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ScheduledMarketChangesResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ScheduledMarketChangesResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ScheduledMarketChangesResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Changes) > 0 {
+			for _, e := range x.Changes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ScheduledMarketChangesResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Changes) > 0 {
+			for iNdEx := len(x.Changes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Changes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ScheduledMarketChangesResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScheduledMarketChangesResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScheduledMarketChangesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Changes = append(x.Changes, &ScheduledMarketChange{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Changes[len(x.Changes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MarketAuthorityScopesRequest protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_connect_marketmap_v2_query_proto_init()
+	md_MarketAuthorityScopesRequest = File_connect_marketmap_v2_query_proto.Messages().ByName("MarketAuthorityScopesRequest")
+}
+
+var _ protoreflect.Message = (*fastReflection_MarketAuthorityScopesRequest)(nil)
+
+type fastReflection_MarketAuthorityScopesRequest MarketAuthorityScopesRequest
+
+func (x *MarketAuthorityScopesRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MarketAuthorityScopesRequest)(x)
+}
+
+func (x *MarketAuthorityScopesRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_connect_marketmap_v2_query_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MarketAuthorityScopesRequest_messageType fastReflection_MarketAuthorityScopesRequest_messageType
+var _ protoreflect.MessageType = fastReflection_MarketAuthorityScopesRequest_messageType{}
+
+type fastReflection_MarketAuthorityScopesRequest_messageType struct{}
+
+func (x fastReflection_MarketAuthorityScopesRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MarketAuthorityScopesRequest)(nil)
+}
+func (x fastReflection_MarketAuthorityScopesRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_MarketAuthorityScopesRequest)
+}
+func (x fastReflection_MarketAuthorityScopesRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MarketAuthorityScopesRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MarketAuthorityScopesRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_MarketAuthorityScopesRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MarketAuthorityScopesRequest) Type() protoreflect.MessageType {
+	return _fastReflection_MarketAuthorityScopesRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MarketAuthorityScopesRequest) New() protoreflect.Message {
+	return new(fastReflection_MarketAuthorityScopesRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MarketAuthorityScopesRequest) Interface() protoreflect.ProtoMessage {
+	return (*MarketAuthorityScopesRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MarketAuthorityScopesRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MarketAuthorityScopesRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketAuthorityScopesRequest"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketAuthorityScopesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketAuthorityScopesRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketAuthorityScopesRequest"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketAuthorityScopesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MarketAuthorityScopesRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketAuthorityScopesRequest"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketAuthorityScopesRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketAuthorityScopesRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketAuthorityScopesRequest"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketAuthorityScopesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketAuthorityScopesRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketAuthorityScopesRequest"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketAuthorityScopesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MarketAuthorityScopesRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketAuthorityScopesRequest"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketAuthorityScopesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MarketAuthorityScopesRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in connect.marketmap.v2.MarketAuthorityScopesRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MarketAuthorityScopesRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketAuthorityScopesRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MarketAuthorityScopesRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MarketAuthorityScopesRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MarketAuthorityScopesRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MarketAuthorityScopesRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MarketAuthorityScopesRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketAuthorityScopesRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketAuthorityScopesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MarketAuthorityScopesResponse_1_list)(nil)
+
+type _MarketAuthorityScopesResponse_1_list struct {
+	list *[]*MarketAuthorityScope
+}
+
+func (x *_MarketAuthorityScopesResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MarketAuthorityScopesResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MarketAuthorityScopesResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MarketAuthorityScope)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MarketAuthorityScopesResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MarketAuthorityScope)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MarketAuthorityScopesResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(MarketAuthorityScope)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MarketAuthorityScopesResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MarketAuthorityScopesResponse_1_list) NewElement() protoreflect.Value {
+	v := new(MarketAuthorityScope)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MarketAuthorityScopesResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MarketAuthorityScopesResponse        protoreflect.MessageDescriptor
+	fd_MarketAuthorityScopesResponse_scopes protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_connect_marketmap_v2_query_proto_init()
+	md_MarketAuthorityScopesResponse = File_connect_marketmap_v2_query_proto.Messages().ByName("MarketAuthorityScopesResponse")
+	fd_MarketAuthorityScopesResponse_scopes = md_MarketAuthorityScopesResponse.Fields().ByName("scopes")
+}
+
+var _ protoreflect.Message = (*fastReflection_MarketAuthorityScopesResponse)(nil)
+
+type fastReflection_MarketAuthorityScopesResponse MarketAuthorityScopesResponse
+
+func (x *MarketAuthorityScopesResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MarketAuthorityScopesResponse)(x)
+}
+
+func (x *MarketAuthorityScopesResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_connect_marketmap_v2_query_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MarketAuthorityScopesResponse_messageType fastReflection_MarketAuthorityScopesResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MarketAuthorityScopesResponse_messageType{}
+
+type fastReflection_MarketAuthorityScopesResponse_messageType struct{}
+
+func (x fastReflection_MarketAuthorityScopesResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MarketAuthorityScopesResponse)(nil)
+}
+func (x fastReflection_MarketAuthorityScopesResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MarketAuthorityScopesResponse)
+}
+func (x fastReflection_MarketAuthorityScopesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MarketAuthorityScopesResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MarketAuthorityScopesResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MarketAuthorityScopesResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MarketAuthorityScopesResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MarketAuthorityScopesResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MarketAuthorityScopesResponse) New() protoreflect.Message {
+	return new(fastReflection_MarketAuthorityScopesResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MarketAuthorityScopesResponse) Interface() protoreflect.ProtoMessage {
+	return (*MarketAuthorityScopesResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MarketAuthorityScopesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Scopes) != 0 {
+		value := protoreflect.ValueOfList(&_MarketAuthorityScopesResponse_1_list{list: &x.Scopes})
+		if !f(fd_MarketAuthorityScopesResponse_scopes, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MarketAuthorityScopesResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketAuthorityScopesResponse.scopes":
+		return len(x.Scopes) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketAuthorityScopesResponse"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketAuthorityScopesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketAuthorityScopesResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketAuthorityScopesResponse.scopes":
+		x.Scopes = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketAuthorityScopesResponse"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketAuthorityScopesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MarketAuthorityScopesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "connect.marketmap.v2.MarketAuthorityScopesResponse.scopes":
+		if len(x.Scopes) == 0 {
+			return protoreflect.ValueOfList(&_MarketAuthorityScopesResponse_1_list{})
+		}
+		listValue := &_MarketAuthorityScopesResponse_1_list{list: &x.Scopes}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketAuthorityScopesResponse"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketAuthorityScopesResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketAuthorityScopesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketAuthorityScopesResponse.scopes":
+		lv := value.List()
+		clv := lv.(*_MarketAuthorityScopesResponse_1_list)
+		x.Scopes = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketAuthorityScopesResponse"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketAuthorityScopesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketAuthorityScopesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketAuthorityScopesResponse.scopes":
+		if x.Scopes == nil {
+			x.Scopes = []*MarketAuthorityScope{}
+		}
+		value := &_MarketAuthorityScopesResponse_1_list{list: &x.Scopes}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketAuthorityScopesResponse"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketAuthorityScopesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MarketAuthorityScopesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketAuthorityScopesResponse.scopes":
+		list := []*MarketAuthorityScope{}
+		return protoreflect.ValueOfList(&_MarketAuthorityScopesResponse_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketAuthorityScopesResponse"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketAuthorityScopesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MarketAuthorityScopesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in connect.marketmap.v2.MarketAuthorityScopesResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MarketAuthorityScopesResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketAuthorityScopesResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MarketAuthorityScopesResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MarketAuthorityScopesResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MarketAuthorityScopesResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Scopes) > 0 {
+			for _, e := range x.Scopes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MarketAuthorityScopesResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Scopes) > 0 {
+			for iNdEx := len(x.Scopes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Scopes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MarketAuthorityScopesResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketAuthorityScopesResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketAuthorityScopesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Scopes = append(x.Scopes, &MarketAuthorityScope{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Scopes[len(x.Scopes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MarketHistoryRequest               protoreflect.MessageDescriptor
+	fd_MarketHistoryRequest_currency_pair protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_connect_marketmap_v2_query_proto_init()
+	md_MarketHistoryRequest = File_connect_marketmap_v2_query_proto.Messages().ByName("MarketHistoryRequest")
+	fd_MarketHistoryRequest_currency_pair = md_MarketHistoryRequest.Fields().ByName("currency_pair")
+}
+
+var _ protoreflect.Message = (*fastReflection_MarketHistoryRequest)(nil)
+
+type fastReflection_MarketHistoryRequest MarketHistoryRequest
+
+func (x *MarketHistoryRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MarketHistoryRequest)(x)
+}
+
+func (x *MarketHistoryRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_connect_marketmap_v2_query_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MarketHistoryRequest_messageType fastReflection_MarketHistoryRequest_messageType
+var _ protoreflect.MessageType = fastReflection_MarketHistoryRequest_messageType{}
+
+type fastReflection_MarketHistoryRequest_messageType struct{}
+
+func (x fastReflection_MarketHistoryRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MarketHistoryRequest)(nil)
+}
+func (x fastReflection_MarketHistoryRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_MarketHistoryRequest)
+}
+func (x fastReflection_MarketHistoryRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MarketHistoryRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MarketHistoryRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_MarketHistoryRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MarketHistoryRequest) Type() protoreflect.MessageType {
+	return _fastReflection_MarketHistoryRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MarketHistoryRequest) New() protoreflect.Message {
+	return new(fastReflection_MarketHistoryRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MarketHistoryRequest) Interface() protoreflect.ProtoMessage {
+	return (*MarketHistoryRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MarketHistoryRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.CurrencyPair != nil {
+		value := protoreflect.ValueOfMessage(x.CurrencyPair.ProtoReflect())
+		if !f(fd_MarketHistoryRequest_currency_pair, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MarketHistoryRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketHistoryRequest.currency_pair":
+		return x.CurrencyPair != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketHistoryRequest"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketHistoryRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketHistoryRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketHistoryRequest.currency_pair":
+		x.CurrencyPair = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketHistoryRequest"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketHistoryRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MarketHistoryRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "connect.marketmap.v2.MarketHistoryRequest.currency_pair":
+		value := x.CurrencyPair
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketHistoryRequest"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketHistoryRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketHistoryRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketHistoryRequest.currency_pair":
+		x.CurrencyPair = value.Message().Interface().(*v2.CurrencyPair)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketHistoryRequest"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketHistoryRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketHistoryRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketHistoryRequest.currency_pair":
+		if x.CurrencyPair == nil {
+			x.CurrencyPair = new(v2.CurrencyPair)
+		}
+		return protoreflect.ValueOfMessage(x.CurrencyPair.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketHistoryRequest"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketHistoryRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MarketHistoryRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketHistoryRequest.currency_pair":
+		m := new(v2.CurrencyPair)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketHistoryRequest"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketHistoryRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MarketHistoryRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in connect.marketmap.v2.MarketHistoryRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MarketHistoryRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketHistoryRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MarketHistoryRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MarketHistoryRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MarketHistoryRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.CurrencyPair != nil {
+			l = options.Size(x.CurrencyPair)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MarketHistoryRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.CurrencyPair != nil {
+			encoded, err := options.Marshal(x.CurrencyPair)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MarketHistoryRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketHistoryRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.CurrencyPair == nil {
+					x.CurrencyPair = &v2.CurrencyPair{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CurrencyPair); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MarketHistoryResponse_1_list)(nil)
+
+type _MarketHistoryResponse_1_list struct {
+	list *[]*MarketChange
+}
+
+func (x *_MarketHistoryResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MarketHistoryResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MarketHistoryResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MarketChange)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MarketHistoryResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MarketChange)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MarketHistoryResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(MarketChange)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MarketHistoryResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MarketHistoryResponse_1_list) NewElement() protoreflect.Value {
+	v := new(MarketChange)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MarketHistoryResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MarketHistoryResponse                 protoreflect.MessageDescriptor
+	fd_MarketHistoryResponse_changes         protoreflect.FieldDescriptor
+	fd_MarketHistoryResponse_earliest_height protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_connect_marketmap_v2_query_proto_init()
+	md_MarketHistoryResponse = File_connect_marketmap_v2_query_proto.Messages().ByName("MarketHistoryResponse")
+	fd_MarketHistoryResponse_changes = md_MarketHistoryResponse.Fields().ByName("changes")
+	fd_MarketHistoryResponse_earliest_height = md_MarketHistoryResponse.Fields().ByName("earliest_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_MarketHistoryResponse)(nil)
+
+type fastReflection_MarketHistoryResponse MarketHistoryResponse
+
+func (x *MarketHistoryResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MarketHistoryResponse)(x)
+}
+
+func (x *MarketHistoryResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_connect_marketmap_v2_query_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MarketHistoryResponse_messageType fastReflection_MarketHistoryResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MarketHistoryResponse_messageType{}
+
+type fastReflection_MarketHistoryResponse_messageType struct{}
+
+func (x fastReflection_MarketHistoryResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MarketHistoryResponse)(nil)
+}
+func (x fastReflection_MarketHistoryResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MarketHistoryResponse)
+}
+func (x fastReflection_MarketHistoryResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MarketHistoryResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MarketHistoryResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MarketHistoryResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MarketHistoryResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MarketHistoryResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MarketHistoryResponse) New() protoreflect.Message {
+	return new(fastReflection_MarketHistoryResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MarketHistoryResponse) Interface() protoreflect.ProtoMessage {
+	return (*MarketHistoryResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MarketHistoryResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Changes) != 0 {
+		value := protoreflect.ValueOfList(&_MarketHistoryResponse_1_list{list: &x.Changes})
+		if !f(fd_MarketHistoryResponse_changes, value) {
+			return
+		}
+	}
+	if x.EarliestHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EarliestHeight)
+		if !f(fd_MarketHistoryResponse_earliest_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MarketHistoryResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketHistoryResponse.changes":
+		return len(x.Changes) != 0
+	case "connect.marketmap.v2.MarketHistoryResponse.earliest_height":
+		return x.EarliestHeight != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketHistoryResponse"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketHistoryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketHistoryResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketHistoryResponse.changes":
+		x.Changes = nil
+	case "connect.marketmap.v2.MarketHistoryResponse.earliest_height":
+		x.EarliestHeight = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketHistoryResponse"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketHistoryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MarketHistoryResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "connect.marketmap.v2.MarketHistoryResponse.changes":
+		if len(x.Changes) == 0 {
+			return protoreflect.ValueOfList(&_MarketHistoryResponse_1_list{})
+		}
+		listValue := &_MarketHistoryResponse_1_list{list: &x.Changes}
+		return protoreflect.ValueOfList(listValue)
+	case "connect.marketmap.v2.MarketHistoryResponse.earliest_height":
+		value := x.EarliestHeight
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketHistoryResponse"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketHistoryResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketHistoryResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketHistoryResponse.changes":
+		lv := value.List()
+		clv := lv.(*_MarketHistoryResponse_1_list)
+		x.Changes = *clv.list
+	case "connect.marketmap.v2.MarketHistoryResponse.earliest_height":
+		x.EarliestHeight = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketHistoryResponse"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketHistoryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketHistoryResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketHistoryResponse.changes":
+		if x.Changes == nil {
+			x.Changes = []*MarketChange{}
+		}
+		value := &_MarketHistoryResponse_1_list{list: &x.Changes}
+		return protoreflect.ValueOfList(value)
+	case "connect.marketmap.v2.MarketHistoryResponse.earliest_height":
+		panic(fmt.Errorf("field earliest_height of message connect.marketmap.v2.MarketHistoryResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketHistoryResponse"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketHistoryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MarketHistoryResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketHistoryResponse.changes":
+		list := []*MarketChange{}
+		return protoreflect.ValueOfList(&_MarketHistoryResponse_1_list{list: &list})
+	case "connect.marketmap.v2.MarketHistoryResponse.earliest_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketHistoryResponse"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketHistoryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MarketHistoryResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in connect.marketmap.v2.MarketHistoryResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MarketHistoryResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketHistoryResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MarketHistoryResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MarketHistoryResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MarketHistoryResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Changes) > 0 {
+			for _, e := range x.Changes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.EarliestHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.EarliestHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MarketHistoryResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.EarliestHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EarliestHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Changes) > 0 {
+			for iNdEx := len(x.Changes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Changes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MarketHistoryResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketHistoryResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Changes = append(x.Changes, &MarketChange{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Changes[len(x.Changes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EarliestHeight", wireType)
+				}
+				x.EarliestHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EarliestHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MarketMapAtHeightRequest        protoreflect.MessageDescriptor
+	fd_MarketMapAtHeightRequest_height protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_connect_marketmap_v2_query_proto_init()
+	md_MarketMapAtHeightRequest = File_connect_marketmap_v2_query_proto.Messages().ByName("MarketMapAtHeightRequest")
+	fd_MarketMapAtHeightRequest_height = md_MarketMapAtHeightRequest.Fields().ByName("height")
+}
+
+var _ protoreflect.Message = (*fastReflection_MarketMapAtHeightRequest)(nil)
+
+type fastReflection_MarketMapAtHeightRequest MarketMapAtHeightRequest
+
+func (x *MarketMapAtHeightRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MarketMapAtHeightRequest)(x)
+}
+
+func (x *MarketMapAtHeightRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_connect_marketmap_v2_query_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MarketMapAtHeightRequest_messageType fastReflection_MarketMapAtHeightRequest_messageType
+var _ protoreflect.MessageType = fastReflection_MarketMapAtHeightRequest_messageType{}
+
+type fastReflection_MarketMapAtHeightRequest_messageType struct{}
+
+func (x fastReflection_MarketMapAtHeightRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MarketMapAtHeightRequest)(nil)
+}
+func (x fastReflection_MarketMapAtHeightRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_MarketMapAtHeightRequest)
+}
+func (x fastReflection_MarketMapAtHeightRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MarketMapAtHeightRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MarketMapAtHeightRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_MarketMapAtHeightRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MarketMapAtHeightRequest) Type() protoreflect.MessageType {
+	return _fastReflection_MarketMapAtHeightRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MarketMapAtHeightRequest) New() protoreflect.Message {
+	return new(fastReflection_MarketMapAtHeightRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MarketMapAtHeightRequest) Interface() protoreflect.ProtoMessage {
+	return (*MarketMapAtHeightRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MarketMapAtHeightRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Height != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Height)
+		if !f(fd_MarketMapAtHeightRequest_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MarketMapAtHeightRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketMapAtHeightRequest.height":
+		return x.Height != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketMapAtHeightRequest"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketMapAtHeightRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketMapAtHeightRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketMapAtHeightRequest.height":
+		x.Height = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketMapAtHeightRequest"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketMapAtHeightRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MarketMapAtHeightRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "connect.marketmap.v2.MarketMapAtHeightRequest.height":
+		value := x.Height
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketMapAtHeightRequest"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketMapAtHeightRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketMapAtHeightRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketMapAtHeightRequest.height":
+		x.Height = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketMapAtHeightRequest"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketMapAtHeightRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketMapAtHeightRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketMapAtHeightRequest.height":
+		panic(fmt.Errorf("field height of message connect.marketmap.v2.MarketMapAtHeightRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketMapAtHeightRequest"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketMapAtHeightRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MarketMapAtHeightRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketMapAtHeightRequest.height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketMapAtHeightRequest"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketMapAtHeightRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MarketMapAtHeightRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in connect.marketmap.v2.MarketMapAtHeightRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MarketMapAtHeightRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketMapAtHeightRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MarketMapAtHeightRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MarketMapAtHeightRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MarketMapAtHeightRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MarketMapAtHeightRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MarketMapAtHeightRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketMapAtHeightRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketMapAtHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MarketMapAtHeightResponse            protoreflect.MessageDescriptor
+	fd_MarketMapAtHeightResponse_market_map protoreflect.FieldDescriptor
+	fd_MarketMapAtHeightResponse_height     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_connect_marketmap_v2_query_proto_init()
+	md_MarketMapAtHeightResponse = File_connect_marketmap_v2_query_proto.Messages().ByName("MarketMapAtHeightResponse")
+	fd_MarketMapAtHeightResponse_market_map = md_MarketMapAtHeightResponse.Fields().ByName("market_map")
+	fd_MarketMapAtHeightResponse_height = md_MarketMapAtHeightResponse.Fields().ByName("height")
+}
+
+var _ protoreflect.Message = (*fastReflection_MarketMapAtHeightResponse)(nil)
+
+type fastReflection_MarketMapAtHeightResponse MarketMapAtHeightResponse
+
+func (x *MarketMapAtHeightResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MarketMapAtHeightResponse)(x)
+}
+
+func (x *MarketMapAtHeightResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_connect_marketmap_v2_query_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MarketMapAtHeightResponse_messageType fastReflection_MarketMapAtHeightResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MarketMapAtHeightResponse_messageType{}
+
+type fastReflection_MarketMapAtHeightResponse_messageType struct{}
+
+func (x fastReflection_MarketMapAtHeightResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MarketMapAtHeightResponse)(nil)
+}
+func (x fastReflection_MarketMapAtHeightResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MarketMapAtHeightResponse)
+}
+func (x fastReflection_MarketMapAtHeightResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MarketMapAtHeightResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MarketMapAtHeightResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MarketMapAtHeightResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MarketMapAtHeightResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MarketMapAtHeightResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MarketMapAtHeightResponse) New() protoreflect.Message {
+	return new(fastReflection_MarketMapAtHeightResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MarketMapAtHeightResponse) Interface() protoreflect.ProtoMessage {
+	return (*MarketMapAtHeightResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MarketMapAtHeightResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MarketMap != nil {
+		value := protoreflect.ValueOfMessage(x.MarketMap.ProtoReflect())
+		if !f(fd_MarketMapAtHeightResponse_market_map, value) {
+			return
+		}
+	}
+	if x.Height != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Height)
+		if !f(fd_MarketMapAtHeightResponse_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MarketMapAtHeightResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketMapAtHeightResponse.market_map":
+		return x.MarketMap != nil
+	case "connect.marketmap.v2.MarketMapAtHeightResponse.height":
+		return x.Height != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketMapAtHeightResponse"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketMapAtHeightResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketMapAtHeightResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketMapAtHeightResponse.market_map":
+		x.MarketMap = nil
+	case "connect.marketmap.v2.MarketMapAtHeightResponse.height":
+		x.Height = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketMapAtHeightResponse"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketMapAtHeightResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MarketMapAtHeightResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "connect.marketmap.v2.MarketMapAtHeightResponse.market_map":
+		value := x.MarketMap
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "connect.marketmap.v2.MarketMapAtHeightResponse.height":
+		value := x.Height
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketMapAtHeightResponse"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketMapAtHeightResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketMapAtHeightResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketMapAtHeightResponse.market_map":
+		x.MarketMap = value.Message().Interface().(*MarketMap)
+	case "connect.marketmap.v2.MarketMapAtHeightResponse.height":
+		x.Height = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketMapAtHeightResponse"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketMapAtHeightResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketMapAtHeightResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketMapAtHeightResponse.market_map":
+		if x.MarketMap == nil {
+			x.MarketMap = new(MarketMap)
+		}
+		return protoreflect.ValueOfMessage(x.MarketMap.ProtoReflect())
+	case "connect.marketmap.v2.MarketMapAtHeightResponse.height":
+		panic(fmt.Errorf("field height of message connect.marketmap.v2.MarketMapAtHeightResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketMapAtHeightResponse"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketMapAtHeightResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MarketMapAtHeightResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketMapAtHeightResponse.market_map":
+		m := new(MarketMap)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "connect.marketmap.v2.MarketMapAtHeightResponse.height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketMapAtHeightResponse"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketMapAtHeightResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MarketMapAtHeightResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in connect.marketmap.v2.MarketMapAtHeightResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MarketMapAtHeightResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketMapAtHeightResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MarketMapAtHeightResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MarketMapAtHeightResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MarketMapAtHeightResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.MarketMap != nil {
+			l = options.Size(x.MarketMap)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MarketMapAtHeightResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.MarketMap != nil {
+			encoded, err := options.Marshal(x.MarketMap)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MarketMapAtHeightResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketMapAtHeightResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketMapAtHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MarketMap", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MarketMap == nil {
+					x.MarketMap = &MarketMap{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MarketMap); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MarketsSinceRequest        protoreflect.MessageDescriptor
+	fd_MarketsSinceRequest_height protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_connect_marketmap_v2_query_proto_init()
+	md_MarketsSinceRequest = File_connect_marketmap_v2_query_proto.Messages().ByName("MarketsSinceRequest")
+	fd_MarketsSinceRequest_height = md_MarketsSinceRequest.Fields().ByName("height")
+}
+
+var _ protoreflect.Message = (*fastReflection_MarketsSinceRequest)(nil)
+
+type fastReflection_MarketsSinceRequest MarketsSinceRequest
+
+func (x *MarketsSinceRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MarketsSinceRequest)(x)
+}
+
+func (x *MarketsSinceRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_connect_marketmap_v2_query_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MarketsSinceRequest_messageType fastReflection_MarketsSinceRequest_messageType
+var _ protoreflect.MessageType = fastReflection_MarketsSinceRequest_messageType{}
+
+type fastReflection_MarketsSinceRequest_messageType struct{}
+
+func (x fastReflection_MarketsSinceRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MarketsSinceRequest)(nil)
+}
+func (x fastReflection_MarketsSinceRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_MarketsSinceRequest)
+}
+func (x fastReflection_MarketsSinceRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MarketsSinceRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MarketsSinceRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_MarketsSinceRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MarketsSinceRequest) Type() protoreflect.MessageType {
+	return _fastReflection_MarketsSinceRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MarketsSinceRequest) New() protoreflect.Message {
+	return new(fastReflection_MarketsSinceRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MarketsSinceRequest) Interface() protoreflect.ProtoMessage {
+	return (*MarketsSinceRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MarketsSinceRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Height != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Height)
+		if !f(fd_MarketsSinceRequest_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MarketsSinceRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketsSinceRequest.height":
+		return x.Height != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketsSinceRequest"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketsSinceRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketsSinceRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketsSinceRequest.height":
+		x.Height = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketsSinceRequest"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketsSinceRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MarketsSinceRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "connect.marketmap.v2.MarketsSinceRequest.height":
+		value := x.Height
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketsSinceRequest"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketsSinceRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketsSinceRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketsSinceRequest.height":
+		x.Height = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketsSinceRequest"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketsSinceRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketsSinceRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketsSinceRequest.height":
+		panic(fmt.Errorf("field height of message connect.marketmap.v2.MarketsSinceRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketsSinceRequest"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketsSinceRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MarketsSinceRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketsSinceRequest.height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketsSinceRequest"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketsSinceRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MarketsSinceRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in connect.marketmap.v2.MarketsSinceRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MarketsSinceRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketsSinceRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MarketsSinceRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MarketsSinceRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MarketsSinceRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MarketsSinceRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MarketsSinceRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketsSinceRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketsSinceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MarketsSinceResponse_1_list)(nil)
+
+type _MarketsSinceResponse_1_list struct {
+	list *[]*Market
+}
+
+func (x *_MarketsSinceResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MarketsSinceResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MarketsSinceResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Market)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MarketsSinceResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Market)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MarketsSinceResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(Market)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MarketsSinceResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MarketsSinceResponse_1_list) NewElement() protoreflect.Value {
+	v := new(Market)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MarketsSinceResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MarketsSinceResponse_2_list)(nil)
+
+type _MarketsSinceResponse_2_list struct {
+	list *[]string
+}
+
+func (x *_MarketsSinceResponse_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MarketsSinceResponse_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MarketsSinceResponse_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MarketsSinceResponse_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MarketsSinceResponse_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MarketsSinceResponse at list field RemovedMarkets as it is not of Message kind"))
+}
+
+func (x *_MarketsSinceResponse_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MarketsSinceResponse_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MarketsSinceResponse_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MarketsSinceResponse                 protoreflect.MessageDescriptor
+	fd_MarketsSinceResponse_markets         protoreflect.FieldDescriptor
+	fd_MarketsSinceResponse_removed_markets protoreflect.FieldDescriptor
+	fd_MarketsSinceResponse_last_updated    protoreflect.FieldDescriptor
+	fd_MarketsSinceResponse_chain_id        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_connect_marketmap_v2_query_proto_init()
+	md_MarketsSinceResponse = File_connect_marketmap_v2_query_proto.Messages().ByName("MarketsSinceResponse")
+	fd_MarketsSinceResponse_markets = md_MarketsSinceResponse.Fields().ByName("markets")
+	fd_MarketsSinceResponse_removed_markets = md_MarketsSinceResponse.Fields().ByName("removed_markets")
+	fd_MarketsSinceResponse_last_updated = md_MarketsSinceResponse.Fields().ByName("last_updated")
+	fd_MarketsSinceResponse_chain_id = md_MarketsSinceResponse.Fields().ByName("chain_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_MarketsSinceResponse)(nil)
+
+type fastReflection_MarketsSinceResponse MarketsSinceResponse
+
+func (x *MarketsSinceResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MarketsSinceResponse)(x)
+}
+
+func (x *MarketsSinceResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_connect_marketmap_v2_query_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MarketsSinceResponse_messageType fastReflection_MarketsSinceResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MarketsSinceResponse_messageType{}
+
+type fastReflection_MarketsSinceResponse_messageType struct{}
+
+func (x fastReflection_MarketsSinceResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MarketsSinceResponse)(nil)
+}
+func (x fastReflection_MarketsSinceResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MarketsSinceResponse)
+}
+func (x fastReflection_MarketsSinceResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MarketsSinceResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MarketsSinceResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MarketsSinceResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MarketsSinceResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MarketsSinceResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MarketsSinceResponse) New() protoreflect.Message {
+	return new(fastReflection_MarketsSinceResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MarketsSinceResponse) Interface() protoreflect.ProtoMessage {
+	return (*MarketsSinceResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MarketsSinceResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Markets) != 0 {
+		value := protoreflect.ValueOfList(&_MarketsSinceResponse_1_list{list: &x.Markets})
+		if !f(fd_MarketsSinceResponse_markets, value) {
+			return
+		}
+	}
+	if len(x.RemovedMarkets) != 0 {
+		value := protoreflect.ValueOfList(&_MarketsSinceResponse_2_list{list: &x.RemovedMarkets})
+		if !f(fd_MarketsSinceResponse_removed_markets, value) {
+			return
+		}
+	}
+	if x.LastUpdated != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LastUpdated)
+		if !f(fd_MarketsSinceResponse_last_updated, value) {
+			return
+		}
+	}
+	if x.ChainId != "" {
+		value := protoreflect.ValueOfString(x.ChainId)
+		if !f(fd_MarketsSinceResponse_chain_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MarketsSinceResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketsSinceResponse.markets":
+		return len(x.Markets) != 0
+	case "connect.marketmap.v2.MarketsSinceResponse.removed_markets":
+		return len(x.RemovedMarkets) != 0
+	case "connect.marketmap.v2.MarketsSinceResponse.last_updated":
+		return x.LastUpdated != uint64(0)
+	case "connect.marketmap.v2.MarketsSinceResponse.chain_id":
+		return x.ChainId != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketsSinceResponse"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketsSinceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketsSinceResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketsSinceResponse.markets":
+		x.Markets = nil
+	case "connect.marketmap.v2.MarketsSinceResponse.removed_markets":
+		x.RemovedMarkets = nil
+	case "connect.marketmap.v2.MarketsSinceResponse.last_updated":
+		x.LastUpdated = uint64(0)
+	case "connect.marketmap.v2.MarketsSinceResponse.chain_id":
+		x.ChainId = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketsSinceResponse"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketsSinceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MarketsSinceResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "connect.marketmap.v2.MarketsSinceResponse.markets":
+		if len(x.Markets) == 0 {
+			return protoreflect.ValueOfList(&_MarketsSinceResponse_1_list{})
+		}
+		listValue := &_MarketsSinceResponse_1_list{list: &x.Markets}
+		return protoreflect.ValueOfList(listValue)
+	case "connect.marketmap.v2.MarketsSinceResponse.removed_markets":
+		if len(x.RemovedMarkets) == 0 {
+			return protoreflect.ValueOfList(&_MarketsSinceResponse_2_list{})
+		}
+		listValue := &_MarketsSinceResponse_2_list{list: &x.RemovedMarkets}
+		return protoreflect.ValueOfList(listValue)
+	case "connect.marketmap.v2.MarketsSinceResponse.last_updated":
+		value := x.LastUpdated
+		return protoreflect.ValueOfUint64(value)
+	case "connect.marketmap.v2.MarketsSinceResponse.chain_id":
+		value := x.ChainId
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketsSinceResponse"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketsSinceResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketsSinceResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketsSinceResponse.markets":
+		lv := value.List()
+		clv := lv.(*_MarketsSinceResponse_1_list)
+		x.Markets = *clv.list
+	case "connect.marketmap.v2.MarketsSinceResponse.removed_markets":
+		lv := value.List()
+		clv := lv.(*_MarketsSinceResponse_2_list)
+		x.RemovedMarkets = *clv.list
+	case "connect.marketmap.v2.MarketsSinceResponse.last_updated":
+		x.LastUpdated = value.Uint()
+	case "connect.marketmap.v2.MarketsSinceResponse.chain_id":
+		x.ChainId = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketsSinceResponse"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketsSinceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketsSinceResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketsSinceResponse.markets":
+		if x.Markets == nil {
+			x.Markets = []*Market{}
+		}
+		value := &_MarketsSinceResponse_1_list{list: &x.Markets}
+		return protoreflect.ValueOfList(value)
+	case "connect.marketmap.v2.MarketsSinceResponse.removed_markets":
+		if x.RemovedMarkets == nil {
+			x.RemovedMarkets = []string{}
+		}
+		value := &_MarketsSinceResponse_2_list{list: &x.RemovedMarkets}
+		return protoreflect.ValueOfList(value)
+	case "connect.marketmap.v2.MarketsSinceResponse.last_updated":
+		panic(fmt.Errorf("field last_updated of message connect.marketmap.v2.MarketsSinceResponse is not mutable"))
+	case "connect.marketmap.v2.MarketsSinceResponse.chain_id":
+		panic(fmt.Errorf("field chain_id of message connect.marketmap.v2.MarketsSinceResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketsSinceResponse"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketsSinceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MarketsSinceResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketsSinceResponse.markets":
+		list := []*Market{}
+		return protoreflect.ValueOfList(&_MarketsSinceResponse_1_list{list: &list})
+	case "connect.marketmap.v2.MarketsSinceResponse.removed_markets":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MarketsSinceResponse_2_list{list: &list})
+	case "connect.marketmap.v2.MarketsSinceResponse.last_updated":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "connect.marketmap.v2.MarketsSinceResponse.chain_id":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketsSinceResponse"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketsSinceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MarketsSinceResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in connect.marketmap.v2.MarketsSinceResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MarketsSinceResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketsSinceResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MarketsSinceResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MarketsSinceResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MarketsSinceResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Markets) > 0 {
+			for _, e := range x.Markets {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.RemovedMarkets) > 0 {
+			for _, s := range x.RemovedMarkets {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.LastUpdated != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastUpdated))
+		}
+		l = len(x.ChainId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MarketsSinceResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ChainId) > 0 {
+			i -= len(x.ChainId)
+			copy(dAtA[i:], x.ChainId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChainId)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.LastUpdated != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastUpdated))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.RemovedMarkets) > 0 {
+			for iNdEx := len(x.RemovedMarkets) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.RemovedMarkets[iNdEx])
+				copy(dAtA[i:], x.RemovedMarkets[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RemovedMarkets[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Markets) > 0 {
+			for iNdEx := len(x.Markets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Markets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MarketsSinceResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketsSinceResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketsSinceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Markets = append(x.Markets, &Market{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Markets[len(x.Markets)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemovedMarkets", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RemovedMarkets = append(x.RemovedMarkets, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastUpdated", wireType)
+				}
+				x.LastUpdated = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastUpdated |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChainId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ProvidersRequest protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_connect_marketmap_v2_query_proto_init()
+	md_ProvidersRequest = File_connect_marketmap_v2_query_proto.Messages().ByName("ProvidersRequest")
+}
+
+var _ protoreflect.Message = (*fastReflection_ProvidersRequest)(nil)
+
+type fastReflection_ProvidersRequest ProvidersRequest
+
+func (x *ProvidersRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ProvidersRequest)(x)
+}
+
+func (x *ProvidersRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_connect_marketmap_v2_query_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ProvidersRequest_messageType fastReflection_ProvidersRequest_messageType
+var _ protoreflect.MessageType = fastReflection_ProvidersRequest_messageType{}
+
+type fastReflection_ProvidersRequest_messageType struct{}
+
+func (x fastReflection_ProvidersRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ProvidersRequest)(nil)
+}
+func (x fastReflection_ProvidersRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_ProvidersRequest)
+}
+func (x fastReflection_ProvidersRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProvidersRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ProvidersRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProvidersRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ProvidersRequest) Type() protoreflect.MessageType {
+	return _fastReflection_ProvidersRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ProvidersRequest) New() protoreflect.Message {
+	return new(fastReflection_ProvidersRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ProvidersRequest) Interface() protoreflect.ProtoMessage {
+	return (*ProvidersRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ProvidersRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ProvidersRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ProvidersRequest"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.ProvidersRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProvidersRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ProvidersRequest"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.ProvidersRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ProvidersRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ProvidersRequest"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.ProvidersRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProvidersRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ProvidersRequest"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.ProvidersRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProvidersRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ProvidersRequest"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.ProvidersRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ProvidersRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ProvidersRequest"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.ProvidersRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ProvidersRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in connect.marketmap.v2.ProvidersRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ProvidersRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProvidersRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ProvidersRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ProvidersRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ProvidersRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ProvidersRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ProvidersRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProvidersRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProvidersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_ProvidersResponse_1_list)(nil)
+
+type _ProvidersResponse_1_list struct {
+	list *[]*ProviderInfo
+}
+
+func (x *_ProvidersResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ProvidersResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ProvidersResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ProviderInfo)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ProvidersResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ProviderInfo)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ProvidersResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(ProviderInfo)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ProvidersResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ProvidersResponse_1_list) NewElement() protoreflect.Value {
+	v := new(ProviderInfo)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ProvidersResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ProvidersResponse           protoreflect.MessageDescriptor
+	fd_ProvidersResponse_providers protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_connect_marketmap_v2_query_proto_init()
+	md_ProvidersResponse = File_connect_marketmap_v2_query_proto.Messages().ByName("ProvidersResponse")
+	fd_ProvidersResponse_providers = md_ProvidersResponse.Fields().ByName("providers")
+}
+
+var _ protoreflect.Message = (*fastReflection_ProvidersResponse)(nil)
+
+type fastReflection_ProvidersResponse ProvidersResponse
+
+func (x *ProvidersResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ProvidersResponse)(x)
+}
+
+func (x *ProvidersResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_connect_marketmap_v2_query_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ProvidersResponse_messageType fastReflection_ProvidersResponse_messageType
+var _ protoreflect.MessageType = fastReflection_ProvidersResponse_messageType{}
+
+type fastReflection_ProvidersResponse_messageType struct{}
+
+func (x fastReflection_ProvidersResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ProvidersResponse)(nil)
+}
+func (x fastReflection_ProvidersResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_ProvidersResponse)
+}
+func (x fastReflection_ProvidersResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProvidersResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ProvidersResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProvidersResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ProvidersResponse) Type() protoreflect.MessageType {
+	return _fastReflection_ProvidersResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ProvidersResponse) New() protoreflect.Message {
+	return new(fastReflection_ProvidersResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ProvidersResponse) Interface() protoreflect.ProtoMessage {
+	return (*ProvidersResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ProvidersResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Providers) != 0 {
+		value := protoreflect.ValueOfList(&_ProvidersResponse_1_list{list: &x.Providers})
+		if !f(fd_ProvidersResponse_providers, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ProvidersResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.ProvidersResponse.providers":
+		return len(x.Providers) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ProvidersResponse"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.ProvidersResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProvidersResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.ProvidersResponse.providers":
+		x.Providers = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ProvidersResponse"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.ProvidersResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ProvidersResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "connect.marketmap.v2.ProvidersResponse.providers":
+		if len(x.Providers) == 0 {
+			return protoreflect.ValueOfList(&_ProvidersResponse_1_list{})
+		}
+		listValue := &_ProvidersResponse_1_list{list: &x.Providers}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ProvidersResponse"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.ProvidersResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProvidersResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.ProvidersResponse.providers":
+		lv := value.List()
+		clv := lv.(*_ProvidersResponse_1_list)
+		x.Providers = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ProvidersResponse"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.ProvidersResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProvidersResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.ProvidersResponse.providers":
+		if x.Providers == nil {
+			x.Providers = []*ProviderInfo{}
+		}
+		value := &_ProvidersResponse_1_list{list: &x.Providers}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ProvidersResponse"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.ProvidersResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ProvidersResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.ProvidersResponse.providers":
+		list := []*ProviderInfo{}
+		return protoreflect.ValueOfList(&_ProvidersResponse_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.ProvidersResponse"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.ProvidersResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ProvidersResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in connect.marketmap.v2.ProvidersResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ProvidersResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProvidersResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ProvidersResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ProvidersResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ProvidersResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Providers) > 0 {
+			for _, e := range x.Providers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ProvidersResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Providers) > 0 {
+			for iNdEx := len(x.Providers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Providers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ProvidersResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProvidersResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Providers = append(x.Providers, &ProviderInfo{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Providers[len(x.Providers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: connect/marketmap/v2/query.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MarketEnabledFilter filters markets by the enabled state of their ticker.
+type MarketEnabledFilter int32
+
+const (
+	// MARKET_ENABLED_FILTER_UNSPECIFIED matches all markets.
+	MarketEnabledFilter_MARKET_ENABLED_FILTER_UNSPECIFIED MarketEnabledFilter = 0
+	// MARKET_ENABLED_FILTER_ENABLED matches enabled markets.
+	MarketEnabledFilter_MARKET_ENABLED_FILTER_ENABLED MarketEnabledFilter = 1
+	// MARKET_ENABLED_FILTER_DISABLED matches disabled markets.
+	MarketEnabledFilter_MARKET_ENABLED_FILTER_DISABLED MarketEnabledFilter = 2
+)
+
+// Enum value maps for MarketEnabledFilter.
+var (
+	MarketEnabledFilter_name = map[int32]string{
+		0: "MARKET_ENABLED_FILTER_UNSPECIFIED",
+		1: "MARKET_ENABLED_FILTER_ENABLED",
+		2: "MARKET_ENABLED_FILTER_DISABLED",
+	}
+	MarketEnabledFilter_value = map[string]int32{
+		"MARKET_ENABLED_FILTER_UNSPECIFIED": 0,
+		"MARKET_ENABLED_FILTER_ENABLED":     1,
+		"MARKET_ENABLED_FILTER_DISABLED":    2,
+	}
+)
+
+func (x MarketEnabledFilter) Enum() *MarketEnabledFilter {
+	p := new(MarketEnabledFilter)
+	*p = x
+	return p
+}
+
+func (x MarketEnabledFilter) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MarketEnabledFilter) Descriptor() protoreflect.EnumDescriptor {
+	return file_connect_marketmap_v2_query_proto_enumTypes[0].Descriptor()
+}
+
+func (MarketEnabledFilter) Type() protoreflect.EnumType {
+	return &file_connect_marketmap_v2_query_proto_enumTypes[0]
+}
+
+func (x MarketEnabledFilter) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MarketEnabledFilter.Descriptor instead.
+func (MarketEnabledFilter) EnumDescriptor() ([]byte, []int) {
+	return file_connect_marketmap_v2_query_proto_rawDescGZIP(), []int{0}
+}
+
+// MarketFilter filters the markets returned by the MarketMap and Markets
+// queries. A market matches the filter if it matches every set field.
+type MarketFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Enabled filters markets by the enabled state of their ticker.
+	Enabled MarketEnabledFilter `protobuf:"varint,1,opt,name=enabled,proto3,enum=connect.marketmap.v2.MarketEnabledFilter" json:"enabled,omitempty"`
+	// Provider matches markets with a provider config of the given provider
+	// name.
+	Provider string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	// Base matches markets with the given base asset.
+	Base string `protobuf:"bytes,3,opt,name=base,proto3" json:"base,omitempty"`
+	// Quote matches markets with the given quote asset.
+	Quote string `protobuf:"bytes,4,opt,name=quote,proto3" json:"quote,omitempty"`
+}
+
+func (x *MarketFilter) Reset() {
+	*x = MarketFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_marketmap_v2_query_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketFilter) ProtoMessage() {}
+
+// Deprecated: Use MarketFilter.ProtoReflect.Descriptor instead.
+func (*MarketFilter) Descriptor() ([]byte, []int) {
+	return file_connect_marketmap_v2_query_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MarketFilter) GetEnabled() MarketEnabledFilter {
+	if x != nil {
+		return x.Enabled
+	}
+	return MarketEnabledFilter_MARKET_ENABLED_FILTER_UNSPECIFIED
+}
+
+func (x *MarketFilter) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *MarketFilter) GetBase() string {
+	if x != nil {
+		return x.Base
+	}
+	return ""
+}
+
+func (x *MarketFilter) GetQuote() string {
+	if x != nil {
+		return x.Quote
+	}
+	return ""
+}
+
+// MarketMapRequest is the query request for the MarketMap query.
+type MarketMapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Pagination paginates the markets, ordered by ticker string. All markets
+	// are returned if it is unset.
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// Filter filters the returned markets.
+	Filter *MarketFilter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *MarketMapRequest) Reset() {
+	*x = MarketMapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_marketmap_v2_query_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketMapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketMapRequest) ProtoMessage() {}
+
+// Deprecated: Use MarketMapRequest.ProtoReflect.Descriptor instead.
+func (*MarketMapRequest) Descriptor() ([]byte, []int) {
+	return file_connect_marketmap_v2_query_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MarketMapRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *MarketMapRequest) GetFilter() *MarketFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+// MarketMapResponse is the query response for the MarketMap query.
+type MarketMapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// MarketMap defines the global set of market configurations for all providers
+	// and markets.
+	MarketMap *MarketMap `protobuf:"bytes,1,opt,name=market_map,json=marketMap,proto3" json:"market_map,omitempty"`
+	// LastUpdated is the last block height that the market map was updated.
+	// This field can be used as an optimization for clients checking if there
+	// is a new update to the map.
+	LastUpdated uint64 `protobuf:"varint,2,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	// ChainId is the chain identifier for the market map.
+	ChainId string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Pagination is the pagination of the markets. It is only set for paginated
+	// requests.
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *MarketMapResponse) Reset() {
+	*x = MarketMapResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_marketmap_v2_query_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketMapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketMapResponse) ProtoMessage() {}
+
+// Deprecated: Use MarketMapResponse.ProtoReflect.Descriptor instead.
+func (*MarketMapResponse) Descriptor() ([]byte, []int) {
+	return file_connect_marketmap_v2_query_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MarketMapResponse) GetMarketMap() *MarketMap {
+	if x != nil {
+		return x.MarketMap
+	}
+	return nil
+}
+
+func (x *MarketMapResponse) GetLastUpdated() uint64 {
+	if x != nil {
+		return x.LastUpdated
+	}
+	return 0
+}
+
+func (x *MarketMapResponse) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *MarketMapResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// MarketsRequest is the query request for the Market query.
+type MarketsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Pagination paginates the markets, ordered by ticker string. All markets
+	// are returned if it is unset.
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// Filter filters the returned markets.
+	Filter *MarketFilter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *MarketsRequest) Reset() {
+	*x = MarketsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_marketmap_v2_query_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketsRequest) ProtoMessage() {}
+
+// Deprecated: Use MarketsRequest.ProtoReflect.Descriptor instead.
+func (*MarketsRequest) Descriptor() ([]byte, []int) {
+	return file_connect_marketmap_v2_query_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MarketsRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *MarketsRequest) GetFilter() *MarketFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+// MarketsResponse is the query response for the Markets query.
+type MarketsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Markets is a sorted list of all markets in the module.
+	Markets []*Market `protobuf:"bytes,1,rep,name=markets,proto3" json:"markets,omitempty"`
+	// Pagination is the pagination of the markets. It is only set for paginated
+	// requests.
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *MarketsResponse) Reset() {
+	*x = MarketsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_marketmap_v2_query_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketsResponse) ProtoMessage() {}
+
+// Deprecated: Use MarketsResponse.ProtoReflect.Descriptor instead.
+func (*MarketsResponse) Descriptor() ([]byte, []int) {
+	return file_connect_marketmap_v2_query_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MarketsResponse) GetMarkets() []*Market {
+	if x != nil {
+		return x.Markets
+	}
+	return nil
+}
+
+func (x *MarketsResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// MarketRequest is the query request for the Market query.
+// It takes the currency pair of the market as an argument.
+type MarketRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// CurrencyPair is the currency pair associated with the market being
+	// requested.
+	CurrencyPair *v2.CurrencyPair `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"`
+}
+
+func (x *MarketRequest) Reset() {
+	*x = MarketRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_marketmap_v2_query_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketRequest) ProtoMessage() {}
+
+// Deprecated: Use MarketRequest.ProtoReflect.Descriptor instead.
+func (*MarketRequest) Descriptor() ([]byte, []int) {
+	return file_connect_marketmap_v2_query_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MarketRequest) GetCurrencyPair() *v2.CurrencyPair {
+	if x != nil {
+		return x.CurrencyPair
+	}
+	return nil
+}
+
+// MarketResponse is the query response for the Market query.
+type MarketResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Market is the configuration of a single market to be price-fetched for.
+	Market *Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+}
+
+func (x *MarketResponse) Reset() {
+	*x = MarketResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_marketmap_v2_query_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketResponse) ProtoMessage() {}
+
+// Deprecated: Use MarketResponse.ProtoReflect.Descriptor instead.
+func (*MarketResponse) Descriptor() ([]byte, []int) {
+	return file_connect_marketmap_v2_query_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MarketResponse) GetMarket() *Market {
+	if x != nil {
+		return x.Market
+	}
+	return nil
+}
+
+// ParamsRequest is the request type for the Query/Params RPC method.
+type ParamsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+}
 
-	// MarketMap defines the global set of market configurations for all providers
-	// and markets.
-	MarketMap *MarketMap `protobuf:"bytes,1,opt,name=market_map,json=marketMap,proto3" json:"market_map,omitempty"`
-	// LastUpdated is the last block height that the market map was updated.
-	// This field can be used as an optimization for clients checking if there
-	// is a new update to the map.
-	LastUpdated uint64 `protobuf:"varint,2,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
-	// ChainId is the chain identifier for the market map.
-	ChainId string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+func (x *ParamsRequest) Reset() {
+	*x = ParamsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_marketmap_v2_query_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *MarketMapResponse) Reset() {
-	*x = MarketMapResponse{}
+func (x *ParamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParamsRequest) ProtoMessage() {}
+
+// Deprecated: Use ParamsRequest.ProtoReflect.Descriptor instead.
+func (*ParamsRequest) Descriptor() ([]byte, []int) {
+	return file_connect_marketmap_v2_query_proto_rawDescGZIP(), []int{7}
+}
+
+// ParamsResponse is the response type for the Query/Params RPC method.
+type ParamsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *ParamsResponse) Reset() {
+	*x = ParamsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_connect_marketmap_v2_query_proto_msgTypes[1]
+		mi := &file_connect_marketmap_v2_query_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MarketMapResponse) String() string {
+func (x *ParamsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarketMapResponse) ProtoMessage() {}
+func (*ParamsResponse) ProtoMessage() {}
 
-// Deprecated: Use MarketMapResponse.ProtoReflect.Descriptor instead.
-func (*MarketMapResponse) Descriptor() ([]byte, []int) {
-	return file_connect_marketmap_v2_query_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use ParamsResponse.ProtoReflect.Descriptor instead.
+func (*ParamsResponse) Descriptor() ([]byte, []int) {
+	return file_connect_marketmap_v2_query_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *MarketMapResponse) GetMarketMap() *MarketMap {
+func (x *ParamsResponse) GetParams() *Params {
 	if x != nil {
-		return x.MarketMap
+		return x.Params
 	}
 	return nil
 }
 
-func (x *MarketMapResponse) GetLastUpdated() uint64 {
+// LastUpdatedRequest is the request type for the Query/LastUpdated RPC
+// method.
+type LastUpdatedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LastUpdatedRequest) Reset() {
+	*x = LastUpdatedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_marketmap_v2_query_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LastUpdatedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LastUpdatedRequest) ProtoMessage() {}
+
+// Deprecated: Use LastUpdatedRequest.ProtoReflect.Descriptor instead.
+func (*LastUpdatedRequest) Descriptor() ([]byte, []int) {
+	return file_connect_marketmap_v2_query_proto_rawDescGZIP(), []int{9}
+}
+
+// LastUpdatedResponse is the response type for the Query/LastUpdated RPC
+// method.
+type LastUpdatedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LastUpdated uint64 `protobuf:"varint,1,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+}
+
+func (x *LastUpdatedResponse) Reset() {
+	*x = LastUpdatedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_marketmap_v2_query_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LastUpdatedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LastUpdatedResponse) ProtoMessage() {}
+
+// Deprecated: Use LastUpdatedResponse.ProtoReflect.Descriptor instead.
+func (*LastUpdatedResponse) Descriptor() ([]byte, []int) {
+	return file_connect_marketmap_v2_query_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *LastUpdatedResponse) GetLastUpdated() uint64 {
 	if x != nil {
 		return x.LastUpdated
 	}
 	return 0
 }
 
-func (x *MarketMapResponse) GetChainId() string {
+// ScheduledMarketChangesRequest is the request type for the
+// Query/ScheduledMarketChanges RPC method.
+type ScheduledMarketChangesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ScheduledMarketChangesRequest) Reset() {
+	*x = ScheduledMarketChangesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_marketmap_v2_query_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduledMarketChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledMarketChangesRequest) ProtoMessage() {}
+
+// Deprecated: Use ScheduledMarketChangesRequest.ProtoReflect.Descriptor instead.
+func (*ScheduledMarketChangesRequest) Descriptor() ([]byte, []int) {
+	return file_connect_marketmap_v2_query_proto_rawDescGZIP(), []int{11}
+}
+
+// ScheduledMarketChangesResponse is the response type for the
+// Query/ScheduledMarketChanges RPC method.
+type ScheduledMarketChangesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Changes []*ScheduledMarketChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *ScheduledMarketChangesResponse) Reset() {
+	*x = ScheduledMarketChangesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_marketmap_v2_query_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduledMarketChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledMarketChangesResponse) ProtoMessage() {}
+
+// Deprecated: Use ScheduledMarketChangesResponse.ProtoReflect.Descriptor instead.
+func (*ScheduledMarketChangesResponse) Descriptor() ([]byte, []int) {
+	return file_connect_marketmap_v2_query_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ScheduledMarketChangesResponse) GetChanges() []*ScheduledMarketChange {
 	if x != nil {
-		return x.ChainId
+		return x.Changes
 	}
-	return ""
+	return nil
 }
 
-// MarketsRequest is the query request for the Market query.
-type MarketsRequest struct {
+// MarketAuthorityScopesRequest is the request type for the
+// Query/MarketAuthorityScopes RPC method.
+type MarketAuthorityScopesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MarketsRequest) Reset() {
-	*x = MarketsRequest{}
+func (x *MarketAuthorityScopesRequest) Reset() {
+	*x = MarketAuthorityScopesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_connect_marketmap_v2_query_proto_msgTypes[2]
+		mi := &file_connect_marketmap_v2_query_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MarketsRequest) String() string {
+func (x *MarketAuthorityScopesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarketsRequest) ProtoMessage() {}
+func (*MarketAuthorityScopesRequest) ProtoMessage() {}
 
-// Deprecated: Use MarketsRequest.ProtoReflect.Descriptor instead.
-func (*MarketsRequest) Descriptor() ([]byte, []int) {
-	return file_connect_marketmap_v2_query_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use MarketAuthorityScopesRequest.ProtoReflect.Descriptor instead.
+func (*MarketAuthorityScopesRequest) Descriptor() ([]byte, []int) {
+	return file_connect_marketmap_v2_query_proto_rawDescGZIP(), []int{13}
 }
 
-// MarketsResponse is the query response for the Markets query.
-type MarketsResponse struct {
+// MarketAuthorityScopesResponse is the response type for the
+// Query/MarketAuthorityScopes RPC method.
+type MarketAuthorityScopesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Markets is a sorted list of all markets in the module.
-	Markets []*Market `protobuf:"bytes,1,rep,name=markets,proto3" json:"markets,omitempty"`
+	Scopes []*MarketAuthorityScope `protobuf:"bytes,1,rep,name=scopes,proto3" json:"scopes,omitempty"`
 }
 
-func (x *MarketsResponse) Reset() {
-	*x = MarketsResponse{}
+func (x *MarketAuthorityScopesResponse) Reset() {
+	*x = MarketAuthorityScopesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_connect_marketmap_v2_query_proto_msgTypes[3]
+		mi := &file_connect_marketmap_v2_query_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MarketsResponse) String() string {
+func (x *MarketAuthorityScopesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarketsResponse) ProtoMessage() {}
+func (*MarketAuthorityScopesResponse) ProtoMessage() {}
 
-// Deprecated: Use MarketsResponse.ProtoReflect.Descriptor instead.
-func (*MarketsResponse) Descriptor() ([]byte, []int) {
-	return file_connect_marketmap_v2_query_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use MarketAuthorityScopesResponse.ProtoReflect.Descriptor instead.
+func (*MarketAuthorityScopesResponse) Descriptor() ([]byte, []int) {
+	return file_connect_marketmap_v2_query_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *MarketsResponse) GetMarkets() []*Market {
+func (x *MarketAuthorityScopesResponse) GetScopes() []*MarketAuthorityScope {
 	if x != nil {
-		return x.Markets
+		return x.Scopes
 	}
 	return nil
 }
 
-// MarketRequest is the query request for the Market query.
-// It takes the currency pair of the market as an argument.
-type MarketRequest struct {
+// MarketHistoryRequest is the request type for the Query/MarketHistory RPC
+// method.
+type MarketHistoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// CurrencyPair is the currency pair associated with the market being
+	// CurrencyPair is the currency pair of the market whose history is
 	// requested.
 	CurrencyPair *v2.CurrencyPair `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"`
 }
 
-func (x *MarketRequest) Reset() {
-	*x = MarketRequest{}
+func (x *MarketHistoryRequest) Reset() {
+	*x = MarketHistoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_connect_marketmap_v2_query_proto_msgTypes[4]
+		mi := &file_connect_marketmap_v2_query_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MarketRequest) String() string {
+func (x *MarketHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarketRequest) ProtoMessage() {}
+func (*MarketHistoryRequest) ProtoMessage() {}
 
-// Deprecated: Use MarketRequest.ProtoReflect.Descriptor instead.
-func (*MarketRequest) Descriptor() ([]byte, []int) {
-	return file_connect_marketmap_v2_query_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use MarketHistoryRequest.ProtoReflect.Descriptor instead.
+func (*MarketHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_connect_marketmap_v2_query_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *MarketRequest) GetCurrencyPair() *v2.CurrencyPair {
+func (x *MarketHistoryRequest) GetCurrencyPair() *v2.CurrencyPair {
 	if x != nil {
 		return x.CurrencyPair
 	}
 	return nil
 }
 
-// MarketResponse is the query response for the Market query.
-type MarketResponse struct {
+// MarketHistoryResponse is the response type for the Query/MarketHistory RPC
+// method.
+type MarketHistoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Market is the configuration of a single market to be price-fetched for.
-	Market *Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+	Changes []*MarketChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	// EarliestHeight is the earliest height from which the market history is
+	// complete.
+	EarliestHeight uint64 `protobuf:"varint,2,opt,name=earliest_height,json=earliestHeight,proto3" json:"earliest_height,omitempty"`
 }
 
-func (x *MarketResponse) Reset() {
-	*x = MarketResponse{}
+func (x *MarketHistoryResponse) Reset() {
+	*x = MarketHistoryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_connect_marketmap_v2_query_proto_msgTypes[5]
+		mi := &file_connect_marketmap_v2_query_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MarketResponse) String() string {
+func (x *MarketHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketHistoryResponse) ProtoMessage() {}
+
+// Deprecated: Use MarketHistoryResponse.ProtoReflect.Descriptor instead.
+func (*MarketHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_connect_marketmap_v2_query_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MarketHistoryResponse) GetChanges() []*MarketChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *MarketHistoryResponse) GetEarliestHeight() uint64 {
+	if x != nil {
+		return x.EarliestHeight
+	}
+	return 0
+}
+
+// MarketMapAtHeightRequest is the request type for the Query/MarketMapAtHeight
+// RPC method.
+type MarketMapAtHeightRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Height is the block height at the end of which the market map is
+	// requested.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *MarketMapAtHeightRequest) Reset() {
+	*x = MarketMapAtHeightRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_marketmap_v2_query_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketMapAtHeightRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketMapAtHeightRequest) ProtoMessage() {}
+
+// Deprecated: Use MarketMapAtHeightRequest.ProtoReflect.Descriptor instead.
+func (*MarketMapAtHeightRequest) Descriptor() ([]byte, []int) {
+	return file_connect_marketmap_v2_query_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *MarketMapAtHeightRequest) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+// MarketMapAtHeightResponse is the response type for the
+// Query/MarketMapAtHeight RPC method.
+type MarketMapAtHeightResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// MarketMap is the market map at the end of the requested height.
+	MarketMap *MarketMap `protobuf:"bytes,1,opt,name=market_map,json=marketMap,proto3" json:"market_map,omitempty"`
+	// Height is the requested block height.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *MarketMapAtHeightResponse) Reset() {
+	*x = MarketMapAtHeightResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_marketmap_v2_query_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketMapAtHeightResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarketResponse) ProtoMessage() {}
+func (*MarketMapAtHeightResponse) ProtoMessage() {}
 
-// Deprecated: Use MarketResponse.ProtoReflect.Descriptor instead.
-func (*MarketResponse) Descriptor() ([]byte, []int) {
-	return file_connect_marketmap_v2_query_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use MarketMapAtHeightResponse.ProtoReflect.Descriptor instead.
+func (*MarketMapAtHeightResponse) Descriptor() ([]byte, []int) {
+	return file_connect_marketmap_v2_query_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *MarketResponse) GetMarket() *Market {
+func (x *MarketMapAtHeightResponse) GetMarketMap() *MarketMap {
 	if x != nil {
-		return x.Market
+		return x.MarketMap
 	}
 	return nil
 }
 
-// ParamsRequest is the request type for the Query/Params RPC method.
-type ParamsRequest struct {
+func (x *MarketMapAtHeightResponse) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+// MarketsSinceRequest is the request type for the Query/MarketsSince RPC
+// method.
+type MarketsSinceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Height is the block height after which the changed markets are returned.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (x *ParamsRequest) Reset() {
-	*x = ParamsRequest{}
+func (x *MarketsSinceRequest) Reset() {
+	*x = MarketsSinceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_connect_marketmap_v2_query_proto_msgTypes[6]
+		mi := &file_connect_marketmap_v2_query_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ParamsRequest) String() string {
+func (x *MarketsSinceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ParamsRequest) ProtoMessage() {}
+func (*MarketsSinceRequest) ProtoMessage() {}
 
-// Deprecated: Use ParamsRequest.ProtoReflect.Descriptor instead.
-func (*ParamsRequest) Descriptor() ([]byte, []int) {
-	return file_connect_marketmap_v2_query_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use MarketsSinceRequest.ProtoReflect.Descriptor instead.
+func (*MarketsSinceRequest) Descriptor() ([]byte, []int) {
+	return file_connect_marketmap_v2_query_proto_rawDescGZIP(), []int{19}
 }
 
-// ParamsResponse is the response type for the Query/Params RPC method.
-type ParamsResponse struct {
+func (x *MarketsSinceRequest) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+// MarketsSinceResponse is the response type for the Query/MarketsSince RPC
+// method.
+type MarketsSinceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	// Markets are the markets that were created or updated after the requested
+	// height, as they currently are, sorted by ticker string.
+	Markets []*Market `protobuf:"bytes,1,rep,name=markets,proto3" json:"markets,omitempty"`
+	// RemovedMarkets are the ticker strings of the markets that were removed
+	// after the requested height, sorted.
+	RemovedMarkets []string `protobuf:"bytes,2,rep,name=removed_markets,json=removedMarkets,proto3" json:"removed_markets,omitempty"`
+	// LastUpdated is the last block height that the market map was updated.
+	LastUpdated uint64 `protobuf:"varint,3,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	// ChainId is the chain identifier for the market map.
+	ChainId string `protobuf:"bytes,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (x *ParamsResponse) Reset() {
-	*x = ParamsResponse{}
+func (x *MarketsSinceResponse) Reset() {
+	*x = MarketsSinceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_connect_marketmap_v2_query_proto_msgTypes[7]
+		mi := &file_connect_marketmap_v2_query_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ParamsResponse) String() string {
+func (x *MarketsSinceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ParamsResponse) ProtoMessage() {}
+func (*MarketsSinceResponse) ProtoMessage() {}
 
-// Deprecated: Use ParamsResponse.ProtoReflect.Descriptor instead.
-func (*ParamsResponse) Descriptor() ([]byte, []int) {
-	return file_connect_marketmap_v2_query_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use MarketsSinceResponse.ProtoReflect.Descriptor instead.
+func (*MarketsSinceResponse) Descriptor() ([]byte, []int) {
+	return file_connect_marketmap_v2_query_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ParamsResponse) GetParams() *Params {
+func (x *MarketsSinceResponse) GetMarkets() []*Market {
 	if x != nil {
-		return x.Params
+		return x.Markets
 	}
 	return nil
 }
 
-// LastUpdatedRequest is the request type for the Query/LastUpdated RPC
-// method.
-type LastUpdatedRequest struct {
+func (x *MarketsSinceResponse) GetRemovedMarkets() []string {
+	if x != nil {
+		return x.RemovedMarkets
+	}
+	return nil
+}
+
+func (x *MarketsSinceResponse) GetLastUpdated() uint64 {
+	if x != nil {
+		return x.LastUpdated
+	}
+	return 0
+}
+
+func (x *MarketsSinceResponse) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+// ProvidersRequest is the request type for the Query/Providers RPC method.
+type ProvidersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *LastUpdatedRequest) Reset() {
-	*x = LastUpdatedRequest{}
+func (x *ProvidersRequest) Reset() {
+	*x = ProvidersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_connect_marketmap_v2_query_proto_msgTypes[8]
+		mi := &file_connect_marketmap_v2_query_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LastUpdatedRequest) String() string {
+func (x *ProvidersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LastUpdatedRequest) ProtoMessage() {}
+func (*ProvidersRequest) ProtoMessage() {}
 
-// Deprecated: Use LastUpdatedRequest.ProtoReflect.Descriptor instead.
-func (*LastUpdatedRequest) Descriptor() ([]byte, []int) {
-	return file_connect_marketmap_v2_query_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ProvidersRequest.ProtoReflect.Descriptor instead.
+func (*ProvidersRequest) Descriptor() ([]byte, []int) {
+	return file_connect_marketmap_v2_query_proto_rawDescGZIP(), []int{21}
 }
 
-// LastUpdatedResponse is the response type for the Query/LastUpdated RPC
-// method.
-type LastUpdatedResponse struct {
+// ProvidersResponse is the response type for the Query/Providers RPC method.
+type ProvidersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LastUpdated uint64 `protobuf:"varint,1,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	Providers []*ProviderInfo `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
 }
 
-func (x *LastUpdatedResponse) Reset() {
-	*x = LastUpdatedResponse{}
+func (x *ProvidersResponse) Reset() {
+	*x = ProvidersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_connect_marketmap_v2_query_proto_msgTypes[9]
+		mi := &file_connect_marketmap_v2_query_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LastUpdatedResponse) String() string {
+func (x *ProvidersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LastUpdatedResponse) ProtoMessage() {}
+func (*ProvidersResponse) ProtoMessage() {}
 
-// Deprecated: Use LastUpdatedResponse.ProtoReflect.Descriptor instead.
-func (*LastUpdatedResponse) Descriptor() ([]byte, []int) {
-	return file_connect_marketmap_v2_query_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ProvidersResponse.ProtoReflect.Descriptor instead.
+func (*ProvidersResponse) Descriptor() ([]byte, []int) {
+	return file_connect_marketmap_v2_query_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *LastUpdatedResponse) GetLastUpdated() uint64 {
+func (x *ProvidersResponse) GetProviders() []*ProviderInfo {
 	if x != nil {
-		return x.LastUpdated
+		return x.Providers
 	}
-	return 0
+	return nil
 }
 
 var File_connect_marketmap_v2_query_proto protoreflect.FileDescriptor
@@ -4565,107 +11832,291 @@ var file_connect_marketmap_v2_query_proto_rawDesc = []byte{
 	0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x24, 0x63, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76, 0x32, 0x2f, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x21, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f, 0x6d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x6d, 0x61, 0x70, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f, 0x6d,
-	0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2f, 0x76, 0x32, 0x2f, 0x70, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x12, 0x0a, 0x10, 0x4d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x97, 0x01, 0x0a,
-	0x11, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x44, 0x0a, 0x0a, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x6d, 0x61, 0x70,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2a, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x24, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76, 0x32, 0x2f, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61,
+	0x70, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x21, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x6d, 0x61, 0x70, 0x2f, 0x76, 0x32, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x23, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2f, 0x76, 0x32, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2f, 0x76, 0x32, 0x2f,
+	0x73, 0x63, 0x6f, 0x70, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x22, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2f, 0x76,
+	0x32, 0x2f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x23, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d,
+	0x61, 0x70, 0x2f, 0x76, 0x32, 0x2f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x99, 0x01, 0x0a, 0x0c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x43, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
 	0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x6d,
-	0x61, 0x72, 0x6b, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74,
-	0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
-	0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x22, 0x10, 0x0a, 0x0e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4f, 0x0a, 0x0f, 0x4d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x07, 0x6d,
-	0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63,
+	0x72, 0x6b, 0x65, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75,
+	0x6f, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x65,
+	0x22, 0x9c, 0x01, 0x0a, 0x10, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a,
+	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61,
+	0x70, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22,
+	0xe0, 0x01, 0x0a, 0x11, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0a, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f,
+	0x6d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32,
+	0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x19,
+	0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x9a, 0x01, 0x0a, 0x0e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a,
+	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61,
+	0x70, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22,
+	0x98, 0x01, 0x0a, 0x0f, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x07, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5a, 0x0a, 0x0d, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x0d, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61,
+	0x69, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x22, 0x4c, 0x0a, 0x0e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x2e,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x22, 0x0f, 0x0a, 0x0d, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4c, 0x0a, 0x0e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x2e, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x22, 0x14, 0x0a, 0x12, 0x4c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x38, 0x0a, 0x13, 0x4c, 0x61, 0x73,
+	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x22, 0x1f, 0x0a, 0x1d, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x6d, 0x0a, 0x1e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x2e, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x22, 0x1e, 0x0a, 0x1c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x69, 0x0a, 0x1d, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x53, 0x63, 0x6f, 0x70, 0x65,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x22, 0x61,
+	0x0a, 0x14, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32,
+	0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69,
+	0x72, 0x22, 0x84, 0x01, 0x0a, 0x15, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x07, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63,
 	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70,
-	0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
-	0x52, 0x07, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x22, 0x5a, 0x0a, 0x0d, 0x4d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x0d, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69,
-	0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
-	0x79, 0x50, 0x61, 0x69, 0x72, 0x22, 0x4c, 0x0a, 0x0e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x2e, 0x4d,
-	0x61, 0x72, 0x6b, 0x65, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x6d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x22, 0x0f, 0x0a, 0x0d, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x4c, 0x0a, 0x0e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x22, 0x14, 0x0a, 0x12, 0x4c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x38, 0x0a, 0x13, 0x4c, 0x61, 0x73, 0x74,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x32, 0x95, 0x05, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x85, 0x01, 0x0a,
-	0x09, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x12, 0x26, 0x2e, 0x63, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76,
-	0x32, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
-	0x4d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f, 0x6d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x6d, 0x61, 0x70, 0x12, 0x7d, 0x0a, 0x07, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x12,
-	0x24, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
-	0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e,
-	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f, 0x6d,
-	0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x73, 0x12, 0x79, 0x0a, 0x06, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x23, 0x2e,
+	0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12,
+	0x27, 0x0a, 0x0f, 0x65, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x65, 0x61, 0x72, 0x6c, 0x69, 0x65,
+	0x73, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x32, 0x0a, 0x18, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x4d, 0x61, 0x70, 0x41, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x79, 0x0a, 0x19,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x41, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0a, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
 	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61,
-	0x70, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e,
-	0x12, 0x1c, 0x2f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x6d, 0x61, 0x70, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x8e,
-	0x01, 0x0a, 0x0b, 0x4c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x28,
+	0x70, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x12,
+	0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x2d, 0x0a, 0x13, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xbb, 0x01, 0x0a, 0x14, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3c, 0x0a, 0x07, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x27, 0x0a,
+	0x0f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6c, 0x61,
+	0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x49, 0x64, 0x22, 0x12, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5b, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a,
+	0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x73, 0x2a, 0x89, 0x01, 0x0a, 0x13, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x25, 0x0a,
+	0x21, 0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54, 0x5f, 0x45, 0x4e, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x5f,
+	0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54, 0x5f, 0x45,
+	0x4e, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x45, 0x4e,
+	0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e, 0x4d, 0x41, 0x52, 0x4b, 0x45,
+	0x54, 0x5f, 0x45, 0x4e, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52,
+	0x5f, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x1a, 0x04, 0x88, 0xa3, 0x1e,
+	0x00, 0x32, 0xee, 0x0c, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x85, 0x01, 0x0a, 0x09,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x12, 0x26, 0x2e, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32,
+	0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x27, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4d,
+	0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x21, 0x12, 0x1f, 0x2f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x6d, 0x61, 0x70, 0x12, 0x7d, 0x0a, 0x07, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x24,
 	0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d,
-	0x61, 0x70, 0x2e, 0x76, 0x32, 0x2e, 0x4c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x61, 0x70, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x73, 0x12, 0x79, 0x0a, 0x06, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x23, 0x2e, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70,
+	0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x24, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12,
+	0x1c, 0x2f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x6d, 0x61, 0x70, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x8e, 0x01,
+	0x0a, 0x0b, 0x4c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x28, 0x2e,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61,
+	0x70, 0x2e, 0x76, 0x32, 0x2e, 0x4c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x2e, 0x4c,
+	0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2f, 0x76,
+	0x32, 0x2f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x79,
+	0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x23, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
 	0x63, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x2e,
-	0x4c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x63, 0x6f,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61,
+	0x70, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x63, 0x6f,
 	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2f,
-	0x76, 0x32, 0x2f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12,
-	0x79, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x23, 0x2e, 0x63, 0x6f, 0x6e, 0x6e,
+	0x76, 0x32, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0xbb, 0x01, 0x0a, 0x16, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x12, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x63, 0x6f, 0x6e, 0x6e,
 	0x65, 0x63, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32,
-	0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
-	0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d,
-	0x61, 0x70, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x63,
-	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70,
-	0x2f, 0x76, 0x32, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xcb, 0x01, 0x0a, 0x18, 0x63,
-	0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x31, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
-	0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f,
-	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2f, 0x76, 0x32, 0x3b, 0x6d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x76, 0x32, 0xa2, 0x02, 0x03, 0x43, 0x4d, 0x58, 0xaa, 0x02,
-	0x14, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d,
-	0x61, 0x70, 0x2e, 0x56, 0x32, 0xca, 0x02, 0x14, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5c,
-	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x5c, 0x56, 0x32, 0xe2, 0x02, 0x20, 0x43,
-	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70,
-	0x5c, 0x56, 0x32, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x16, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x3a, 0x3a, 0x4d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x6d, 0x61, 0x70, 0x3a, 0x3a, 0x56, 0x32, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x12, 0x2e, 0x2f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2f, 0x76, 0x32, 0x2f, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0xb7, 0x01, 0x0a, 0x15, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x53, 0x63, 0x6f, 0x70, 0x65,
+	0x73, 0x12, 0x32, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x53, 0x63, 0x6f, 0x70,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x2f, 0x12, 0x2d, 0x2f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x73, 0x63, 0x6f, 0x70, 0x65,
+	0x73, 0x12, 0x96, 0x01, 0x0a, 0x0d, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x12, 0x2a, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2b, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0xa8, 0x01, 0x0a, 0x11, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x41, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x2e, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4d, 0x61,
+	0x70, 0x41, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2f, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4d, 0x61,
+	0x70, 0x41, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x12, 0x2a, 0x2f, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2f, 0x76, 0x32,
+	0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x61, 0x74, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x92, 0x01, 0x0a, 0x0c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x73, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x29, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2a, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73,
+	0x53, 0x69, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x73, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x85, 0x01, 0x0a, 0x09, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x26, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x2e,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x27, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x21, 0x12, 0x1f, 0x2f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x6d, 0x61, 0x70, 0x2f, 0x76, 0x32, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x73, 0x42, 0xcb, 0x01, 0x0a, 0x18, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x42,
+	0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x31, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61,
+	0x70, 0x2f, 0x76, 0x32, 0x3b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x76, 0x32,
+	0xa2, 0x02, 0x03, 0x43, 0x4d, 0x58, 0xaa, 0x02, 0x14, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x56, 0x32, 0xca, 0x02, 0x14,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61,
+	0x70, 0x5c, 0x56, 0x32, 0xe2, 0x02, 0x20, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5c, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x5c, 0x56, 0x32, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x3a, 0x3a, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x3a, 0x3a, 0x56, 0x32,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4680,44 +12131,91 @@ func file_connect_marketmap_v2_query_proto_rawDescGZIP() []byte {
 	return file_connect_marketmap_v2_query_proto_rawDescData
 }
 
-var file_connect_marketmap_v2_query_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_connect_marketmap_v2_query_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_connect_marketmap_v2_query_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_connect_marketmap_v2_query_proto_goTypes = []interface{}{
-	(*MarketMapRequest)(nil),    // 0: connect.marketmap.v2.MarketMapRequest
-	(*MarketMapResponse)(nil),   // 1: connect.marketmap.v2.MarketMapResponse
-	(*MarketsRequest)(nil),      // 2: connect.marketmap.v2.MarketsRequest
-	(*MarketsResponse)(nil),     // 3: connect.marketmap.v2.MarketsResponse
-	(*MarketRequest)(nil),       // 4: connect.marketmap.v2.MarketRequest
-	(*MarketResponse)(nil),      // 5: connect.marketmap.v2.MarketResponse
-	(*ParamsRequest)(nil),       // 6: connect.marketmap.v2.ParamsRequest
-	(*ParamsResponse)(nil),      // 7: connect.marketmap.v2.ParamsResponse
-	(*LastUpdatedRequest)(nil),  // 8: connect.marketmap.v2.LastUpdatedRequest
-	(*LastUpdatedResponse)(nil), // 9: connect.marketmap.v2.LastUpdatedResponse
-	(*MarketMap)(nil),           // 10: connect.marketmap.v2.MarketMap
-	(*Market)(nil),              // 11: connect.marketmap.v2.Market
-	(*v2.CurrencyPair)(nil),     // 12: connect.types.v2.CurrencyPair
-	(*Params)(nil),              // 13: connect.marketmap.v2.Params
+	(MarketEnabledFilter)(0),               // 0: connect.marketmap.v2.MarketEnabledFilter
+	(*MarketFilter)(nil),                   // 1: connect.marketmap.v2.MarketFilter
+	(*MarketMapRequest)(nil),               // 2: connect.marketmap.v2.MarketMapRequest
+	(*MarketMapResponse)(nil),              // 3: connect.marketmap.v2.MarketMapResponse
+	(*MarketsRequest)(nil),                 // 4: connect.marketmap.v2.MarketsRequest
+	(*MarketsResponse)(nil),                // 5: connect.marketmap.v2.MarketsResponse
+	(*MarketRequest)(nil),                  // 6: connect.marketmap.v2.MarketRequest
+	(*MarketResponse)(nil),                 // 7: connect.marketmap.v2.MarketResponse
+	(*ParamsRequest)(nil),                  // 8: connect.marketmap.v2.ParamsRequest
+	(*ParamsResponse)(nil),                 // 9: connect.marketmap.v2.ParamsResponse
+	(*LastUpdatedRequest)(nil),             // 10: connect.marketmap.v2.LastUpdatedRequest
+	(*LastUpdatedResponse)(nil),            // 11: connect.marketmap.v2.LastUpdatedResponse
+	(*ScheduledMarketChangesRequest)(nil),  // 12: connect.marketmap.v2.ScheduledMarketChangesRequest
+	(*ScheduledMarketChangesResponse)(nil), // 13: connect.marketmap.v2.ScheduledMarketChangesResponse
+	(*MarketAuthorityScopesRequest)(nil),   // 14: connect.marketmap.v2.MarketAuthorityScopesRequest
+	(*MarketAuthorityScopesResponse)(nil),  // 15: connect.marketmap.v2.MarketAuthorityScopesResponse
+	(*MarketHistoryRequest)(nil),           // 16: connect.marketmap.v2.MarketHistoryRequest
+	(*MarketHistoryResponse)(nil),          // 17: connect.marketmap.v2.MarketHistoryResponse
+	(*MarketMapAtHeightRequest)(nil),       // 18: connect.marketmap.v2.MarketMapAtHeightRequest
+	(*MarketMapAtHeightResponse)(nil),      // 19: connect.marketmap.v2.MarketMapAtHeightResponse
+	(*MarketsSinceRequest)(nil),            // 20: connect.marketmap.v2.MarketsSinceRequest
+	(*MarketsSinceResponse)(nil),           // 21: connect.marketmap.v2.MarketsSinceResponse
+	(*ProvidersRequest)(nil),               // 22: connect.marketmap.v2.ProvidersRequest
+	(*ProvidersResponse)(nil),              // 23: connect.marketmap.v2.ProvidersResponse
+	(*v1beta1.PageRequest)(nil),            // 24: cosmos.base.query.v1beta1.PageRequest
+	(*MarketMap)(nil),                      // 25: connect.marketmap.v2.MarketMap
+	(*v1beta1.PageResponse)(nil),           // 26: cosmos.base.query.v1beta1.PageResponse
+	(*Market)(nil),                         // 27: connect.marketmap.v2.Market
+	(*v2.CurrencyPair)(nil),                // 28: connect.types.v2.CurrencyPair
+	(*Params)(nil),                         // 29: connect.marketmap.v2.Params
+	(*ScheduledMarketChange)(nil),          // 30: connect.marketmap.v2.ScheduledMarketChange
+	(*MarketAuthorityScope)(nil),           // 31: connect.marketmap.v2.MarketAuthorityScope
+	(*MarketChange)(nil),                   // 32: connect.marketmap.v2.MarketChange
+	(*ProviderInfo)(nil),                   // 33: connect.marketmap.v2.ProviderInfo
 }
 var file_connect_marketmap_v2_query_proto_depIdxs = []int32{
-	10, // 0: connect.marketmap.v2.MarketMapResponse.market_map:type_name -> connect.marketmap.v2.MarketMap
-	11, // 1: connect.marketmap.v2.MarketsResponse.markets:type_name -> connect.marketmap.v2.Market
-	12, // 2: connect.marketmap.v2.MarketRequest.currency_pair:type_name -> connect.types.v2.CurrencyPair
-	11, // 3: connect.marketmap.v2.MarketResponse.market:type_name -> connect.marketmap.v2.Market
-	13, // 4: connect.marketmap.v2.ParamsResponse.params:type_name -> connect.marketmap.v2.Params
-	0,  // 5: connect.marketmap.v2.Query.MarketMap:input_type -> connect.marketmap.v2.MarketMapRequest
-	2,  // 6: connect.marketmap.v2.Query.Markets:input_type -> connect.marketmap.v2.MarketsRequest
-	4,  // 7: connect.marketmap.v2.Query.Market:input_type -> connect.marketmap.v2.MarketRequest
-	8,  // 8: connect.marketmap.v2.Query.LastUpdated:input_type -> connect.marketmap.v2.LastUpdatedRequest
-	6,  // 9: connect.marketmap.v2.Query.Params:input_type -> connect.marketmap.v2.ParamsRequest
-	1,  // 10: connect.marketmap.v2.Query.MarketMap:output_type -> connect.marketmap.v2.MarketMapResponse
-	3,  // 11: connect.marketmap.v2.Query.Markets:output_type -> connect.marketmap.v2.MarketsResponse
-	5,  // 12: connect.marketmap.v2.Query.Market:output_type -> connect.marketmap.v2.MarketResponse
-	9,  // 13: connect.marketmap.v2.Query.LastUpdated:output_type -> connect.marketmap.v2.LastUpdatedResponse
-	7,  // 14: connect.marketmap.v2.Query.Params:output_type -> connect.marketmap.v2.ParamsResponse
-	10, // [10:15] is the sub-list for method output_type
-	5,  // [5:10] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	0,  // 0: connect.marketmap.v2.MarketFilter.enabled:type_name -> connect.marketmap.v2.MarketEnabledFilter
+	24, // 1: connect.marketmap.v2.MarketMapRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	1,  // 2: connect.marketmap.v2.MarketMapRequest.filter:type_name -> connect.marketmap.v2.MarketFilter
+	25, // 3: connect.marketmap.v2.MarketMapResponse.market_map:type_name -> connect.marketmap.v2.MarketMap
+	26, // 4: connect.marketmap.v2.MarketMapResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	24, // 5: connect.marketmap.v2.MarketsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	1,  // 6: connect.marketmap.v2.MarketsRequest.filter:type_name -> connect.marketmap.v2.MarketFilter
+	27, // 7: connect.marketmap.v2.MarketsResponse.markets:type_name -> connect.marketmap.v2.Market
+	26, // 8: connect.marketmap.v2.MarketsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	28, // 9: connect.marketmap.v2.MarketRequest.currency_pair:type_name -> connect.types.v2.CurrencyPair
+	27, // 10: connect.marketmap.v2.MarketResponse.market:type_name -> connect.marketmap.v2.Market
+	29, // 11: connect.marketmap.v2.ParamsResponse.params:type_name -> connect.marketmap.v2.Params
+	30, // 12: connect.marketmap.v2.ScheduledMarketChangesResponse.changes:type_name -> connect.marketmap.v2.ScheduledMarketChange
+	31, // 13: connect.marketmap.v2.MarketAuthorityScopesResponse.scopes:type_name -> connect.marketmap.v2.MarketAuthorityScope
+	28, // 14: connect.marketmap.v2.MarketHistoryRequest.currency_pair:type_name -> connect.types.v2.CurrencyPair
+	32, // 15: connect.marketmap.v2.MarketHistoryResponse.changes:type_name -> connect.marketmap.v2.MarketChange
+	25, // 16: connect.marketmap.v2.MarketMapAtHeightResponse.market_map:type_name -> connect.marketmap.v2.MarketMap
+	27, // 17: connect.marketmap.v2.MarketsSinceResponse.markets:type_name -> connect.marketmap.v2.Market
+	33, // 18: connect.marketmap.v2.ProvidersResponse.providers:type_name -> connect.marketmap.v2.ProviderInfo
+	2,  // 19: connect.marketmap.v2.Query.MarketMap:input_type -> connect.marketmap.v2.MarketMapRequest
+	4,  // 20: connect.marketmap.v2.Query.Markets:input_type -> connect.marketmap.v2.MarketsRequest
+	6,  // 21: connect.marketmap.v2.Query.Market:input_type -> connect.marketmap.v2.MarketRequest
+	10, // 22: connect.marketmap.v2.Query.LastUpdated:input_type -> connect.marketmap.v2.LastUpdatedRequest
+	8,  // 23: connect.marketmap.v2.Query.Params:input_type -> connect.marketmap.v2.ParamsRequest
+	12, // 24: connect.marketmap.v2.Query.ScheduledMarketChanges:input_type -> connect.marketmap.v2.ScheduledMarketChangesRequest
+	14, // 25: connect.marketmap.v2.Query.MarketAuthorityScopes:input_type -> connect.marketmap.v2.MarketAuthorityScopesRequest
+	16, // 26: connect.marketmap.v2.Query.MarketHistory:input_type -> connect.marketmap.v2.MarketHistoryRequest
+	18, // 27: connect.marketmap.v2.Query.MarketMapAtHeight:input_type -> connect.marketmap.v2.MarketMapAtHeightRequest
+	20, // 28: connect.marketmap.v2.Query.MarketsSince:input_type -> connect.marketmap.v2.MarketsSinceRequest
+	22, // 29: connect.marketmap.v2.Query.Providers:input_type -> connect.marketmap.v2.ProvidersRequest
+	3,  // 30: connect.marketmap.v2.Query.MarketMap:output_type -> connect.marketmap.v2.MarketMapResponse
+	5,  // 31: connect.marketmap.v2.Query.Markets:output_type -> connect.marketmap.v2.MarketsResponse
+	7,  // 32: connect.marketmap.v2.Query.Market:output_type -> connect.marketmap.v2.MarketResponse
+	11, // 33: connect.marketmap.v2.Query.LastUpdated:output_type -> connect.marketmap.v2.LastUpdatedResponse
+	9,  // 34: connect.marketmap.v2.Query.Params:output_type -> connect.marketmap.v2.ParamsResponse
+	13, // 35: connect.marketmap.v2.Query.ScheduledMarketChanges:output_type -> connect.marketmap.v2.ScheduledMarketChangesResponse
+	15, // 36: connect.marketmap.v2.Query.MarketAuthorityScopes:output_type -> connect.marketmap.v2.MarketAuthorityScopesResponse
+	17, // 37: connect.marketmap.v2.Query.MarketHistory:output_type -> connect.marketmap.v2.MarketHistoryResponse
+	19, // 38: connect.marketmap.v2.Query.MarketMapAtHeight:output_type -> connect.marketmap.v2.MarketMapAtHeightResponse
+	21, // 39: connect.marketmap.v2.Query.MarketsSince:output_type -> connect.marketmap.v2.MarketsSinceResponse
+	23, // 40: connect.marketmap.v2.Query.Providers:output_type -> connect.marketmap.v2.ProvidersResponse
+	30, // [30:41] is the sub-list for method output_type
+	19, // [19:30] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_connect_marketmap_v2_query_proto_init() }
@@ -4727,9 +12225,13 @@ func file_connect_marketmap_v2_query_proto_init() {
 	}
 	file_connect_marketmap_v2_market_proto_init()
 	file_connect_marketmap_v2_params_proto_init()
+	file_connect_marketmap_v2_schedule_proto_init()
+	file_connect_marketmap_v2_scope_proto_init()
+	file_connect_marketmap_v2_history_proto_init()
+	file_connect_marketmap_v2_registry_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_connect_marketmap_v2_query_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MarketMapRequest); i {
+			switch v := v.(*MarketFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4741,7 +12243,7 @@ func file_connect_marketmap_v2_query_proto_init() {
 			}
 		}
 		file_connect_marketmap_v2_query_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MarketMapResponse); i {
+			switch v := v.(*MarketMapRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4753,7 +12255,7 @@ func file_connect_marketmap_v2_query_proto_init() {
 			}
 		}
 		file_connect_marketmap_v2_query_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MarketsRequest); i {
+			switch v := v.(*MarketMapResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4765,7 +12267,7 @@ func file_connect_marketmap_v2_query_proto_init() {
 			}
 		}
 		file_connect_marketmap_v2_query_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MarketsResponse); i {
+			switch v := v.(*MarketsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4777,7 +12279,7 @@ func file_connect_marketmap_v2_query_proto_init() {
 			}
 		}
 		file_connect_marketmap_v2_query_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MarketRequest); i {
+			switch v := v.(*MarketsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4789,7 +12291,7 @@ func file_connect_marketmap_v2_query_proto_init() {
 			}
 		}
 		file_connect_marketmap_v2_query_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MarketResponse); i {
+			switch v := v.(*MarketRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4801,7 +12303,7 @@ func file_connect_marketmap_v2_query_proto_init() {
 			}
 		}
 		file_connect_marketmap_v2_query_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ParamsRequest); i {
+			switch v := v.(*MarketResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4813,7 +12315,7 @@ func file_connect_marketmap_v2_query_proto_init() {
 			}
 		}
 		file_connect_marketmap_v2_query_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ParamsResponse); i {
+			switch v := v.(*ParamsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4825,7 +12327,7 @@ func file_connect_marketmap_v2_query_proto_init() {
 			}
 		}
 		file_connect_marketmap_v2_query_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LastUpdatedRequest); i {
+			switch v := v.(*ParamsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4837,6 +12339,18 @@ func file_connect_marketmap_v2_query_proto_init() {
 			}
 		}
 		file_connect_marketmap_v2_query_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LastUpdatedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_marketmap_v2_query_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LastUpdatedResponse); i {
 			case 0:
 				return &v.state
@@ -4848,19 +12362,164 @@ func file_connect_marketmap_v2_query_proto_init() {
 				return nil
 			}
 		}
+		file_connect_marketmap_v2_query_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduledMarketChangesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_marketmap_v2_query_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduledMarketChangesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_marketmap_v2_query_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketAuthorityScopesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_marketmap_v2_query_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketAuthorityScopesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_marketmap_v2_query_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_marketmap_v2_query_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_marketmap_v2_query_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketMapAtHeightRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_marketmap_v2_query_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketMapAtHeightResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_marketmap_v2_query_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketsSinceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_marketmap_v2_query_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketsSinceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_marketmap_v2_query_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProvidersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_marketmap_v2_query_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProvidersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_connect_marketmap_v2_query_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   10,
+			NumEnums:      1,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_connect_marketmap_v2_query_proto_goTypes,
 		DependencyIndexes: file_connect_marketmap_v2_query_proto_depIdxs,
+		EnumInfos:         file_connect_marketmap_v2_query_proto_enumTypes,
 		MessageInfos:      file_connect_marketmap_v2_query_proto_msgTypes,
 	}.Build()
 	File_connect_marketmap_v2_query_proto = out.File
@@ -19,11 +19,17 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_MarketMap_FullMethodName   = "/connect.marketmap.v2.Query/MarketMap"
-	Query_Markets_FullMethodName     = "/connect.marketmap.v2.Query/Markets"
-	Query_Market_FullMethodName      = "/connect.marketmap.v2.Query/Market"
-	Query_LastUpdated_FullMethodName = "/connect.marketmap.v2.Query/LastUpdated"
-	Query_Params_FullMethodName      = "/connect.marketmap.v2.Query/Params"
+	Query_MarketMap_FullMethodName              = "/connect.marketmap.v2.Query/MarketMap"
+	Query_Markets_FullMethodName                = "/connect.marketmap.v2.Query/Markets"
+	Query_Market_FullMethodName                 = "/connect.marketmap.v2.Query/Market"
+	Query_LastUpdated_FullMethodName            = "/connect.marketmap.v2.Query/LastUpdated"
+	Query_Params_FullMethodName                 = "/connect.marketmap.v2.Query/Params"
+	Query_ScheduledMarketChanges_FullMethodName = "/connect.marketmap.v2.Query/ScheduledMarketChanges"
+	Query_MarketAuthorityScopes_FullMethodName  = "/connect.marketmap.v2.Query/MarketAuthorityScopes"
+	Query_MarketHistory_FullMethodName          = "/connect.marketmap.v2.Query/MarketHistory"
+	Query_MarketMapAtHeight_FullMethodName      = "/connect.marketmap.v2.Query/MarketMapAtHeight"
+	Query_MarketsSince_FullMethodName           = "/connect.marketmap.v2.Query/MarketsSince"
+	Query_Providers_FullMethodName              = "/connect.marketmap.v2.Query/Providers"
 )
 
 // QueryClient is the client API for Query service.
@@ -46,6 +52,27 @@ type QueryClient interface {
 	LastUpdated(ctx context.Context, in *LastUpdatedRequest, opts ...grpc.CallOption) (*LastUpdatedResponse, error)
 	// Params returns the current x/marketmap module parameters.
 	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+	// ScheduledMarketChanges returns the pending scheduled market changes,
+	// ordered by ID.
+	ScheduledMarketChanges(ctx context.Context, in *ScheduledMarketChangesRequest, opts ...grpc.CallOption) (*ScheduledMarketChangesResponse, error)
+	// MarketAuthorityScopes returns the scopes of the scoped market authorities,
+	// ordered by authority.
+	MarketAuthorityScopes(ctx context.Context, in *MarketAuthorityScopesRequest, opts ...grpc.CallOption) (*MarketAuthorityScopesResponse, error)
+	// MarketHistory returns the recorded changes made to the market of the given
+	// currency pair, ordered by the height at which they were made.
+	MarketHistory(ctx context.Context, in *MarketHistoryRequest, opts ...grpc.CallOption) (*MarketHistoryResponse, error)
+	// MarketMapAtHeight returns the market map as it was at the end of the given
+	// block height. Heights before the earliest height covered by the market
+	// history cannot be queried.
+	MarketMapAtHeight(ctx context.Context, in *MarketMapAtHeightRequest, opts ...grpc.CallOption) (*MarketMapAtHeightResponse, error)
+	// MarketsSince returns the markets that were created or updated, and the
+	// tickers of the markets that were removed, after the given block height.
+	// Heights before the earliest height covered by the market history cannot be
+	// queried.
+	MarketsSince(ctx context.Context, in *MarketsSinceRequest, opts ...grpc.CallOption) (*MarketsSinceResponse, error)
+	// Providers returns the providers registered in the provider registry,
+	// ordered by name.
+	Providers(ctx context.Context, in *ProvidersRequest, opts ...grpc.CallOption) (*ProvidersResponse, error)
 }
 
 type queryClient struct {
@@ -106,6 +133,66 @@ func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grp
 	return out, nil
 }
 
+func (c *queryClient) ScheduledMarketChanges(ctx context.Context, in *ScheduledMarketChangesRequest, opts ...grpc.CallOption) (*ScheduledMarketChangesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduledMarketChangesResponse)
+	err := c.cc.Invoke(ctx, Query_ScheduledMarketChanges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MarketAuthorityScopes(ctx context.Context, in *MarketAuthorityScopesRequest, opts ...grpc.CallOption) (*MarketAuthorityScopesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarketAuthorityScopesResponse)
+	err := c.cc.Invoke(ctx, Query_MarketAuthorityScopes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MarketHistory(ctx context.Context, in *MarketHistoryRequest, opts ...grpc.CallOption) (*MarketHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarketHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_MarketHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MarketMapAtHeight(ctx context.Context, in *MarketMapAtHeightRequest, opts ...grpc.CallOption) (*MarketMapAtHeightResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarketMapAtHeightResponse)
+	err := c.cc.Invoke(ctx, Query_MarketMapAtHeight_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MarketsSince(ctx context.Context, in *MarketsSinceRequest, opts ...grpc.CallOption) (*MarketsSinceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarketsSinceResponse)
+	err := c.cc.Invoke(ctx, Query_MarketsSince_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Providers(ctx context.Context, in *ProvidersRequest, opts ...grpc.CallOption) (*ProvidersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProvidersResponse)
+	err := c.cc.Invoke(ctx, Query_Providers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -126,6 +213,27 @@ type QueryServer interface {
 	LastUpdated(context.Context, *LastUpdatedRequest) (*LastUpdatedResponse, error)
 	// Params returns the current x/marketmap module parameters.
 	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	// ScheduledMarketChanges returns the pending scheduled market changes,
+	// ordered by ID.
+	ScheduledMarketChanges(context.Context, *ScheduledMarketChangesRequest) (*ScheduledMarketChangesResponse, error)
+	// MarketAuthorityScopes returns the scopes of the scoped market authorities,
+	// ordered by authority.
+	MarketAuthorityScopes(context.Context, *MarketAuthorityScopesRequest) (*MarketAuthorityScopesResponse, error)
+	// MarketHistory returns the recorded changes made to the market of the given
+	// currency pair, ordered by the height at which they were made.
+	MarketHistory(context.Context, *MarketHistoryRequest) (*MarketHistoryResponse, error)
+	// MarketMapAtHeight returns the market map as it was at the end of the given
+	// block height. Heights before the earliest height covered by the market
+	// history cannot be queried.
+	MarketMapAtHeight(context.Context, *MarketMapAtHeightRequest) (*MarketMapAtHeightResponse, error)
+	// MarketsSince returns the markets that were created or updated, and the
+	// tickers of the markets that were removed, after the given block height.
+	// Heights before the earliest height covered by the market history cannot be
+	// queried.
+	MarketsSince(context.Context, *MarketsSinceRequest) (*MarketsSinceResponse, error)
+	// Providers returns the providers registered in the provider registry,
+	// ordered by name.
+	Providers(context.Context, *ProvidersRequest) (*ProvidersResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -151,6 +259,24 @@ func (UnimplementedQueryServer) LastUpdated(context.Context, *LastUpdatedRequest
 func (UnimplementedQueryServer) Params(context.Context, *ParamsRequest) (*ParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) ScheduledMarketChanges(context.Context, *ScheduledMarketChangesRequest) (*ScheduledMarketChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledMarketChanges not implemented")
+}
+func (UnimplementedQueryServer) MarketAuthorityScopes(context.Context, *MarketAuthorityScopesRequest) (*MarketAuthorityScopesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketAuthorityScopes not implemented")
+}
+func (UnimplementedQueryServer) MarketHistory(context.Context, *MarketHistoryRequest) (*MarketHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketHistory not implemented")
+}
+func (UnimplementedQueryServer) MarketMapAtHeight(context.Context, *MarketMapAtHeightRequest) (*MarketMapAtHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketMapAtHeight not implemented")
+}
+func (UnimplementedQueryServer) MarketsSince(context.Context, *MarketsSinceRequest) (*MarketsSinceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketsSince not implemented")
+}
+func (UnimplementedQueryServer) Providers(context.Context, *ProvidersRequest) (*ProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Providers not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -262,6 +388,114 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ScheduledMarketChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduledMarketChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledMarketChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ScheduledMarketChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledMarketChanges(ctx, req.(*ScheduledMarketChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MarketAuthorityScopes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketAuthorityScopesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketAuthorityScopes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MarketAuthorityScopes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketAuthorityScopes(ctx, req.(*MarketAuthorityScopesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MarketHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MarketHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketHistory(ctx, req.(*MarketHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MarketMapAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketMapAtHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketMapAtHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MarketMapAtHeight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketMapAtHeight(ctx, req.(*MarketMapAtHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MarketsSince_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketsSinceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketsSince(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MarketsSince_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketsSince(ctx, req.(*MarketsSinceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Providers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Providers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Providers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Providers(ctx, req.(*ProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -289,6 +523,30 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "ScheduledMarketChanges",
+			Handler:    _Query_ScheduledMarketChanges_Handler,
+		},
+		{
+			MethodName: "MarketAuthorityScopes",
+			Handler:    _Query_MarketAuthorityScopes_Handler,
+		},
+		{
+			MethodName: "MarketHistory",
+			Handler:    _Query_MarketHistory_Handler,
+		},
+		{
+			MethodName: "MarketMapAtHeight",
+			Handler:    _Query_MarketMapAtHeight_Handler,
+		},
+		{
+			MethodName: "MarketsSince",
+			Handler:    _Query_MarketsSince_Handler,
+		},
+		{
+			MethodName: "Providers",
+			Handler:    _Query_Providers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "connect/marketmap/v2/query.proto",
@@ -19,12 +19,18 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_CreateMarkets_FullMethodName           = "/connect.marketmap.v2.Msg/CreateMarkets"
-	Msg_UpdateMarkets_FullMethodName           = "/connect.marketmap.v2.Msg/UpdateMarkets"
-	Msg_UpdateParams_FullMethodName            = "/connect.marketmap.v2.Msg/UpdateParams"
-	Msg_RemoveMarketAuthorities_FullMethodName = "/connect.marketmap.v2.Msg/RemoveMarketAuthorities"
-	Msg_UpsertMarkets_FullMethodName           = "/connect.marketmap.v2.Msg/UpsertMarkets"
-	Msg_RemoveMarkets_FullMethodName           = "/connect.marketmap.v2.Msg/RemoveMarkets"
+	Msg_CreateMarkets_FullMethodName               = "/connect.marketmap.v2.Msg/CreateMarkets"
+	Msg_UpdateMarkets_FullMethodName               = "/connect.marketmap.v2.Msg/UpdateMarkets"
+	Msg_UpdateParams_FullMethodName                = "/connect.marketmap.v2.Msg/UpdateParams"
+	Msg_RemoveMarketAuthorities_FullMethodName     = "/connect.marketmap.v2.Msg/RemoveMarketAuthorities"
+	Msg_UpsertMarkets_FullMethodName               = "/connect.marketmap.v2.Msg/UpsertMarkets"
+	Msg_RemoveMarkets_FullMethodName               = "/connect.marketmap.v2.Msg/RemoveMarkets"
+	Msg_ScheduleMarketChange_FullMethodName        = "/connect.marketmap.v2.Msg/ScheduleMarketChange"
+	Msg_CancelScheduledMarketChange_FullMethodName = "/connect.marketmap.v2.Msg/CancelScheduledMarketChange"
+	Msg_GrantMarketAuthorityScope_FullMethodName   = "/connect.marketmap.v2.Msg/GrantMarketAuthorityScope"
+	Msg_RevokeMarketAuthorityScope_FullMethodName  = "/connect.marketmap.v2.Msg/RevokeMarketAuthorityScope"
+	Msg_UpsertProviders_FullMethodName             = "/connect.marketmap.v2.Msg/UpsertProviders"
+	Msg_RemoveProviders_FullMethodName             = "/connect.marketmap.v2.Msg/RemoveProviders"
 )
 
 // MsgClient is the client API for Msg service.
@@ -51,6 +57,25 @@ type MsgClient interface {
 	// - they exist in the map
 	// - they are disabled
 	RemoveMarkets(ctx context.Context, in *MsgRemoveMarkets, opts ...grpc.CallOption) (*MsgRemoveMarketsResponse, error)
+	// ScheduleMarketChange schedules a change (enable, disable or update) of a
+	// market at a target block height or time.
+	ScheduleMarketChange(ctx context.Context, in *MsgScheduleMarketChange, opts ...grpc.CallOption) (*MsgScheduleMarketChangeResponse, error)
+	// CancelScheduledMarketChange cancels a pending scheduled market change.
+	CancelScheduledMarketChange(ctx context.Context, in *MsgCancelScheduledMarketChange, opts ...grpc.CallOption) (*MsgCancelScheduledMarketChangeResponse, error)
+	// GrantMarketAuthorityScope grants a scoped market authority, replacing any
+	// scope previously granted to the same authority. The signer must be the
+	// admin.
+	GrantMarketAuthorityScope(ctx context.Context, in *MsgGrantMarketAuthorityScope, opts ...grpc.CallOption) (*MsgGrantMarketAuthorityScopeResponse, error)
+	// RevokeMarketAuthorityScope revokes the scope of a scoped market authority.
+	// The signer must be the admin.
+	RevokeMarketAuthorityScope(ctx context.Context, in *MsgRevokeMarketAuthorityScope, opts ...grpc.CallOption) (*MsgRevokeMarketAuthorityScopeResponse, error)
+	// UpsertProviders registers the given providers in the provider registry,
+	// replacing any provider already registered under the same name. The signer
+	// must be a market authority.
+	UpsertProviders(ctx context.Context, in *MsgUpsertProviders, opts ...grpc.CallOption) (*MsgUpsertProvidersResponse, error)
+	// RemoveProviders removes the given providers from the provider registry if
+	// no market uses them. The signer must be a market authority.
+	RemoveProviders(ctx context.Context, in *MsgRemoveProviders, opts ...grpc.CallOption) (*MsgRemoveProvidersResponse, error)
 }
 
 type msgClient struct {
@@ -121,6 +146,66 @@ func (c *msgClient) RemoveMarkets(ctx context.Context, in *MsgRemoveMarkets, opt
 	return out, nil
 }
 
+func (c *msgClient) ScheduleMarketChange(ctx context.Context, in *MsgScheduleMarketChange, opts ...grpc.CallOption) (*MsgScheduleMarketChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgScheduleMarketChangeResponse)
+	err := c.cc.Invoke(ctx, Msg_ScheduleMarketChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelScheduledMarketChange(ctx context.Context, in *MsgCancelScheduledMarketChange, opts ...grpc.CallOption) (*MsgCancelScheduledMarketChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCancelScheduledMarketChangeResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelScheduledMarketChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GrantMarketAuthorityScope(ctx context.Context, in *MsgGrantMarketAuthorityScope, opts ...grpc.CallOption) (*MsgGrantMarketAuthorityScopeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgGrantMarketAuthorityScopeResponse)
+	err := c.cc.Invoke(ctx, Msg_GrantMarketAuthorityScope_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeMarketAuthorityScope(ctx context.Context, in *MsgRevokeMarketAuthorityScope, opts ...grpc.CallOption) (*MsgRevokeMarketAuthorityScopeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRevokeMarketAuthorityScopeResponse)
+	err := c.cc.Invoke(ctx, Msg_RevokeMarketAuthorityScope_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpsertProviders(ctx context.Context, in *MsgUpsertProviders, opts ...grpc.CallOption) (*MsgUpsertProvidersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpsertProvidersResponse)
+	err := c.cc.Invoke(ctx, Msg_UpsertProviders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveProviders(ctx context.Context, in *MsgRemoveProviders, opts ...grpc.CallOption) (*MsgRemoveProvidersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRemoveProvidersResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveProviders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -145,6 +230,25 @@ type MsgServer interface {
 	// - they exist in the map
 	// - they are disabled
 	RemoveMarkets(context.Context, *MsgRemoveMarkets) (*MsgRemoveMarketsResponse, error)
+	// ScheduleMarketChange schedules a change (enable, disable or update) of a
+	// market at a target block height or time.
+	ScheduleMarketChange(context.Context, *MsgScheduleMarketChange) (*MsgScheduleMarketChangeResponse, error)
+	// CancelScheduledMarketChange cancels a pending scheduled market change.
+	CancelScheduledMarketChange(context.Context, *MsgCancelScheduledMarketChange) (*MsgCancelScheduledMarketChangeResponse, error)
+	// GrantMarketAuthorityScope grants a scoped market authority, replacing any
+	// scope previously granted to the same authority. The signer must be the
+	// admin.
+	GrantMarketAuthorityScope(context.Context, *MsgGrantMarketAuthorityScope) (*MsgGrantMarketAuthorityScopeResponse, error)
+	// RevokeMarketAuthorityScope revokes the scope of a scoped market authority.
+	// The signer must be the admin.
+	RevokeMarketAuthorityScope(context.Context, *MsgRevokeMarketAuthorityScope) (*MsgRevokeMarketAuthorityScopeResponse, error)
+	// UpsertProviders registers the given providers in the provider registry,
+	// replacing any provider already registered under the same name. The signer
+	// must be a market authority.
+	UpsertProviders(context.Context, *MsgUpsertProviders) (*MsgUpsertProvidersResponse, error)
+	// RemoveProviders removes the given providers from the provider registry if
+	// no market uses them. The signer must be a market authority.
+	RemoveProviders(context.Context, *MsgRemoveProviders) (*MsgRemoveProvidersResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -173,6 +277,24 @@ func (UnimplementedMsgServer) UpsertMarkets(context.Context, *MsgUpsertMarkets)
 func (UnimplementedMsgServer) RemoveMarkets(context.Context, *MsgRemoveMarkets) (*MsgRemoveMarketsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveMarkets not implemented")
 }
+func (UnimplementedMsgServer) ScheduleMarketChange(context.Context, *MsgScheduleMarketChange) (*MsgScheduleMarketChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleMarketChange not implemented")
+}
+func (UnimplementedMsgServer) CancelScheduledMarketChange(context.Context, *MsgCancelScheduledMarketChange) (*MsgCancelScheduledMarketChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledMarketChange not implemented")
+}
+func (UnimplementedMsgServer) GrantMarketAuthorityScope(context.Context, *MsgGrantMarketAuthorityScope) (*MsgGrantMarketAuthorityScopeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantMarketAuthorityScope not implemented")
+}
+func (UnimplementedMsgServer) RevokeMarketAuthorityScope(context.Context, *MsgRevokeMarketAuthorityScope) (*MsgRevokeMarketAuthorityScopeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeMarketAuthorityScope not implemented")
+}
+func (UnimplementedMsgServer) UpsertProviders(context.Context, *MsgUpsertProviders) (*MsgUpsertProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertProviders not implemented")
+}
+func (UnimplementedMsgServer) RemoveProviders(context.Context, *MsgRemoveProviders) (*MsgRemoveProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveProviders not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -302,6 +424,114 @@ func _Msg_RemoveMarkets_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ScheduleMarketChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleMarketChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleMarketChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ScheduleMarketChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleMarketChange(ctx, req.(*MsgScheduleMarketChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelScheduledMarketChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelScheduledMarketChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelScheduledMarketChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelScheduledMarketChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelScheduledMarketChange(ctx, req.(*MsgCancelScheduledMarketChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GrantMarketAuthorityScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantMarketAuthorityScope)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantMarketAuthorityScope(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_GrantMarketAuthorityScope_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantMarketAuthorityScope(ctx, req.(*MsgGrantMarketAuthorityScope))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeMarketAuthorityScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeMarketAuthorityScope)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeMarketAuthorityScope(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevokeMarketAuthorityScope_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeMarketAuthorityScope(ctx, req.(*MsgRevokeMarketAuthorityScope))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpsertProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpsertProviders)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpsertProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpsertProviders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpsertProviders(ctx, req.(*MsgUpsertProviders))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveProviders)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveProviders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveProviders(ctx, req.(*MsgRemoveProviders))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -333,6 +563,30 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveMarkets",
 			Handler:    _Msg_RemoveMarkets_Handler,
 		},
+		{
+			MethodName: "ScheduleMarketChange",
+			Handler:    _Msg_ScheduleMarketChange_Handler,
+		},
+		{
+			MethodName: "CancelScheduledMarketChange",
+			Handler:    _Msg_CancelScheduledMarketChange_Handler,
+		},
+		{
+			MethodName: "GrantMarketAuthorityScope",
+			Handler:    _Msg_GrantMarketAuthorityScope_Handler,
+		},
+		{
+			MethodName: "RevokeMarketAuthorityScope",
+			Handler:    _Msg_RevokeMarketAuthorityScope_Handler,
+		},
+		{
+			MethodName: "UpsertProviders",
+			Handler:    _Msg_UpsertProviders_Handler,
+		},
+		{
+			MethodName: "RemoveProviders",
+			Handler:    _Msg_RemoveProviders_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "connect/marketmap/v2/tx.proto",
@@ -24,6 +24,7 @@ const (
 	Query_GetPrices_FullMethodName                  = "/connect.oracle.v2.Query/GetPrices"
 	Query_GetCurrencyPairMapping_FullMethodName     = "/connect.oracle.v2.Query/GetCurrencyPairMapping"
 	Query_GetCurrencyPairMappingList_FullMethodName = "/connect.oracle.v2.Query/GetCurrencyPairMappingList"
+	Query_GetCurrencyPairTombstones_FullMethodName  = "/connect.oracle.v2.Query/GetCurrencyPairTombstones"
 )
 
 // QueryClient is the client API for Query service.
@@ -46,6 +47,10 @@ type QueryClient interface {
 	// useful for indexers that have access to the ID of a currency pair, but no
 	// way to get the underlying currency pair from it.
 	GetCurrencyPairMappingList(ctx context.Context, in *GetCurrencyPairMappingListRequest, opts ...grpc.CallOption) (*GetCurrencyPairMappingListResponse, error)
+	// Get the tombstones of the removed CurrencyPairs, ordered by ID. IDs that
+	// are lower than the next ID and belong to neither a CurrencyPair nor a
+	// tombstone were removed before the start of the tombstone retention period.
+	GetCurrencyPairTombstones(ctx context.Context, in *GetCurrencyPairTombstonesRequest, opts ...grpc.CallOption) (*GetCurrencyPairTombstonesResponse, error)
 }
 
 type queryClient struct {
@@ -106,6 +111,16 @@ func (c *queryClient) GetCurrencyPairMappingList(ctx context.Context, in *GetCur
 	return out, nil
 }
 
+func (c *queryClient) GetCurrencyPairTombstones(ctx context.Context, in *GetCurrencyPairTombstonesRequest, opts ...grpc.CallOption) (*GetCurrencyPairTombstonesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCurrencyPairTombstonesResponse)
+	err := c.cc.Invoke(ctx, Query_GetCurrencyPairTombstones_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -126,6 +141,10 @@ type QueryServer interface {
 	// useful for indexers that have access to the ID of a currency pair, but no
 	// way to get the underlying currency pair from it.
 	GetCurrencyPairMappingList(context.Context, *GetCurrencyPairMappingListRequest) (*GetCurrencyPairMappingListResponse, error)
+	// Get the tombstones of the removed CurrencyPairs, ordered by ID. IDs that
+	// are lower than the next ID and belong to neither a CurrencyPair nor a
+	// tombstone were removed before the start of the tombstone retention period.
+	GetCurrencyPairTombstones(context.Context, *GetCurrencyPairTombstonesRequest) (*GetCurrencyPairTombstonesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -151,6 +170,9 @@ func (UnimplementedQueryServer) GetCurrencyPairMapping(context.Context, *GetCurr
 func (UnimplementedQueryServer) GetCurrencyPairMappingList(context.Context, *GetCurrencyPairMappingListRequest) (*GetCurrencyPairMappingListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCurrencyPairMappingList not implemented")
 }
+func (UnimplementedQueryServer) GetCurrencyPairTombstones(context.Context, *GetCurrencyPairTombstonesRequest) (*GetCurrencyPairTombstonesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCurrencyPairTombstones not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -262,6 +284,24 @@ func _Query_GetCurrencyPairMappingList_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetCurrencyPairTombstones_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCurrencyPairTombstonesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetCurrencyPairTombstones(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetCurrencyPairTombstones_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetCurrencyPairTombstones(ctx, req.(*GetCurrencyPairTombstonesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -289,6 +329,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCurrencyPairMappingList",
 			Handler:    _Query_GetCurrencyPairMappingList_Handler,
 		},
+		{
+			MethodName: "GetCurrencyPairTombstones",
+			Handler:    _Query_GetCurrencyPairTombstones_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "connect/oracle/v2/query.proto",
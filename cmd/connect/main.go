@@ -24,9 +24,11 @@ import (
 	"github.com/skip-mev/connect/v2/oracle"
 	"github.com/skip-mev/connect/v2/oracle/config"
 	oraclemetrics "github.com/skip-mev/connect/v2/oracle/metrics"
+	oracletypes "github.com/skip-mev/connect/v2/oracle/types"
 	"github.com/skip-mev/connect/v2/pkg/log"
 	oraclemath "github.com/skip-mev/connect/v2/pkg/math/oracle"
 	"github.com/skip-mev/connect/v2/providers/apis/marketmap"
+	apimetrics "github.com/skip-mev/connect/v2/providers/base/api/metrics"
 	oraclefactory "github.com/skip-mev/connect/v2/providers/factories/oracle"
 	mmservicetypes "github.com/skip-mev/connect/v2/service/clients/marketmap/types"
 	oracleserver "github.com/skip-mev/connect/v2/service/servers/oracle"
@@ -389,6 +391,11 @@ func runOracle() error {
 	}()
 	defer orc.Stop()
 
+	// warn about providers that are missing from either the oracle config or the on-chain provider registry.
+	if marketMapProvider == marketmap.Name {
+		watchProviderRegistry(ctx, logger, cfg, marketMapProvider)
+	}
+
 	srv := oracleserver.NewOracleServer(orc, logger)
 
 	// cancel oracle on interrupt or terminate
@@ -456,6 +463,25 @@ func runOracle() error {
 	return nil
 }
 
+// watchProviderRegistry starts checking the price providers of the oracle config against the provider registry of
+// the x/marketmap module of the market map provider's chain in the background.
+func watchProviderRegistry(ctx context.Context, logger *zap.Logger, cfg config.OracleConfig, marketMapProvider string) {
+	client, err := marketmap.NewGRPCClient(cfg.Providers[marketMapProvider].API, apimetrics.NewNopAPIMetrics())
+	if err != nil {
+		logger.Error("failed to create market map client for the provider registry", zap.Error(err))
+		return
+	}
+
+	configured := make([]string, 0, len(cfg.Providers))
+	for name, provider := range cfg.Providers {
+		if provider.Type == oracletypes.ConfigType {
+			configured = append(configured, name)
+		}
+	}
+
+	go marketmap.WatchProviderRegistry(ctx, logger, client, configured, marketmap.ProviderRegistryCheckInterval)
+}
+
 func overwriteMarketMapEndpoint(cfg config.OracleConfig, overwrite string) (config.OracleConfig, error) {
 	for providerName, provider := range cfg.Providers {
 		if provider.Type == mmservicetypes.ConfigType {
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+// DiffMarketMaps returns the differences between the existing and the generated MarketMap as sorted, human-readable
+// lines. Markets only in the existing MarketMap are prefixed with -, markets only in the generated MarketMap with +,
+// and the changes of markets in both with ~. Only the provider configs of the given providers are compared, as the
+// generated MarketMap only configures the providers whose symbol listings were given.
+func DiffMarketMaps(existing, generated mmtypes.MarketMap, providers []string) []string {
+	tickers := make(map[string]struct{}, len(existing.Markets)+len(generated.Markets))
+	for tickerStr := range existing.Markets {
+		tickers[tickerStr] = struct{}{}
+	}
+	for tickerStr := range generated.Markets {
+		tickers[tickerStr] = struct{}{}
+	}
+
+	sorted := make([]string, 0, len(tickers))
+	for tickerStr := range tickers {
+		sorted = append(sorted, tickerStr)
+	}
+	sort.Strings(sorted)
+
+	var lines []string
+	for _, tickerStr := range sorted {
+		existingMarket, inExisting := existing.Markets[tickerStr]
+		generatedMarket, inGenerated := generated.Markets[tickerStr]
+
+		switch {
+		case !inGenerated:
+			lines = append(lines, fmt.Sprintf("- %s", tickerStr))
+		case !inExisting:
+			lines = append(lines, fmt.Sprintf("+ %s", tickerStr))
+			for _, providerConfig := range generatedMarket.ProviderConfigs {
+				lines = append(lines, fmt.Sprintf("    + %s", formatProviderConfig(providerConfig)))
+			}
+		default:
+			changes := diffMarkets(existingMarket, generatedMarket, providers)
+			if len(changes) > 0 {
+				lines = append(lines, fmt.Sprintf("~ %s", tickerStr))
+				lines = append(lines, changes...)
+			}
+		}
+	}
+
+	return lines
+}
+
+// diffMarkets returns the changes from the existing to the generated Market, comparing only the provider configs of
+// the given providers.
+func diffMarkets(existing, generated mmtypes.Market, providers []string) []string {
+	var changes []string
+
+	if existing.Ticker.Decimals != generated.Ticker.Decimals {
+		changes = append(changes, fmt.Sprintf("    ~ decimals: %d -> %d", existing.Ticker.Decimals, generated.Ticker.Decimals))
+	}
+
+	if existing.Ticker.MinProviderCount != generated.Ticker.MinProviderCount {
+		changes = append(changes, fmt.Sprintf("    ~ min_provider_count: %d -> %d",
+			existing.Ticker.MinProviderCount, generated.Ticker.MinProviderCount))
+	}
+
+	if existing.Ticker.Enabled != generated.Ticker.Enabled {
+		changes = append(changes, fmt.Sprintf("    ~ enabled: %t -> %t", existing.Ticker.Enabled, generated.Ticker.Enabled))
+	}
+
+	existingConfigs := providerConfigsByName(existing, providers)
+	generatedConfigs := providerConfigsByName(generated, providers)
+
+	for _, provider := range providers {
+		existingConfig, inExisting := existingConfigs[provider]
+		generatedConfig, inGenerated := generatedConfigs[provider]
+
+		switch {
+		case inExisting && !inGenerated:
+			changes = append(changes, fmt.Sprintf("    - %s", formatProviderConfig(existingConfig)))
+		case !inExisting && inGenerated:
+			changes = append(changes, fmt.Sprintf("    + %s", formatProviderConfig(generatedConfig)))
+		case inExisting && inGenerated && !existingConfig.Equal(generatedConfig):
+			changes = append(changes, fmt.Sprintf("    ~ %s -> %s",
+				formatProviderConfig(existingConfig), formatProviderConfig(generatedConfig)))
+		}
+	}
+
+	return changes
+}
+
+// providerConfigsByName returns the provider configs of the given market of the given providers, by provider name.
+// Metadata is not generated, so it is not compared.
+func providerConfigsByName(market mmtypes.Market, providers []string) map[string]mmtypes.ProviderConfig {
+	configs := make(map[string]mmtypes.ProviderConfig, len(market.ProviderConfigs))
+	for _, providerConfig := range market.ProviderConfigs {
+		if slices.Contains(providers, providerConfig.Name) {
+			providerConfig.Metadata_JSON = ""
+			configs[providerConfig.Name] = providerConfig
+		}
+	}
+
+	return configs
+}
+
+// formatProviderConfig returns the provider name, off-chain ticker and normalize-by pair, if any, of the given
+// ProviderConfig.
+func formatProviderConfig(providerConfig mmtypes.ProviderConfig) string {
+	s := fmt.Sprintf("%s %s", providerConfig.Name, providerConfig.OffChainTicker)
+	if providerConfig.NormalizeByPair != nil {
+		s += fmt.Sprintf(" (normalized by %s)", providerConfig.NormalizeByPair.String())
+	}
+
+	if providerConfig.Invert {
+		s += " (inverted)"
+	}
+
+	return s
+}
@@ -0,0 +1,180 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+// Generator generates a MarketMap of the given tickers from the symbol listings of a set of providers.
+type Generator struct {
+	// listings is a map from provider name to a map from the pairs listed by its exchange to their listing.
+	listings map[string]map[connecttypes.CurrencyPair]Listing
+
+	// normalizeQuotes are the quote assets, in order of preference, through which a pair that is not listed by an
+	// exchange may be priced, e.g. BTC/USD through BTC/USDT normalized by USDT/USD.
+	normalizeQuotes []string
+}
+
+// NewGenerator returns a new Generator of the given listings, by provider name, which prices pairs that are not
+// listed through the given normalization quote assets, in order of preference.
+func NewGenerator(listings map[string][]Listing, normalizeQuotes []string) *Generator {
+	g := &Generator{
+		listings:        make(map[string]map[connecttypes.CurrencyPair]Listing, len(listings)),
+		normalizeQuotes: normalizeQuotes,
+	}
+
+	for provider, providerListings := range listings {
+		byPair := make(map[connecttypes.CurrencyPair]Listing, len(providerListings))
+		for _, listing := range providerListings {
+			// keep the smallest off-chain ticker of a pair listed more than once, so that generation is deterministic
+			if existing, ok := byPair[listing.Pair]; ok && existing.OffChainTicker <= listing.OffChainTicker {
+				continue
+			}
+
+			byPair[listing.Pair] = listing
+		}
+
+		g.listings[provider] = byPair
+	}
+
+	return g
+}
+
+// Providers returns the sorted names of the providers whose listings are used by the Generator.
+func (g *Generator) Providers() []string {
+	providers := make([]string, 0, len(g.listings))
+	for provider := range g.listings {
+		providers = append(providers, provider)
+	}
+	sort.Strings(providers)
+
+	return providers
+}
+
+// Generate returns a valid MarketMap of the given tickers. For each ticker, each provider that lists its pair is
+// configured with the off-chain ticker of the pair; otherwise, a provider that lists the inverse pair is configured
+// with the inverted off-chain ticker of the inverse pair; otherwise, a provider that lists the pair against one of the
+// normalization quotes is configured with the corresponding normalize-by pair, if that pair is one of the tickers.
+// Tickers that end up with fewer providers than their MinProviderCount are dropped, along with the provider configs
+// that are normalized by them, and returned.
+func (g *Generator) Generate(tickers []mmtypes.Ticker) (mmtypes.MarketMap, []string, error) {
+	mm := mmtypes.MarketMap{
+		Markets: make(map[string]mmtypes.Market, len(tickers)),
+	}
+
+	for _, ticker := range tickers {
+		if _, ok := mm.Markets[ticker.String()]; ok {
+			return mmtypes.MarketMap{}, nil, fmt.Errorf("duplicate ticker %s", ticker.String())
+		}
+
+		mm.Markets[ticker.String()] = mmtypes.Market{
+			Ticker: ticker,
+		}
+	}
+
+	providers := g.Providers()
+	for tickerStr, market := range mm.Markets {
+		for _, provider := range providers {
+			providerConfig, ok := g.providerConfig(mm, provider, market.Ticker)
+			if ok {
+				market.ProviderConfigs = append(market.ProviderConfigs, providerConfig)
+			}
+		}
+
+		mm.Markets[tickerStr] = market
+	}
+
+	dropped := pruneMarketMap(mm)
+
+	if err := mm.ValidateBasic(); err != nil {
+		return mmtypes.MarketMap{}, nil, fmt.Errorf("generated market map is invalid: %w", err)
+	}
+
+	return mm, dropped, nil
+}
+
+// providerConfig returns the ProviderConfig of the given provider for the given ticker, and false if the exchange of
+// the provider lists neither the pair, its inverse, nor the pair against any normalization quote.
+func (g *Generator) providerConfig(mm mmtypes.MarketMap, provider string, ticker mmtypes.Ticker) (mmtypes.ProviderConfig, bool) {
+	listings := g.listings[provider]
+
+	if listing, ok := listings[ticker.CurrencyPair]; ok {
+		return mmtypes.ProviderConfig{
+			Name:           provider,
+			OffChainTicker: listing.OffChainTicker,
+		}, true
+	}
+
+	if listing, ok := listings[connecttypes.NewCurrencyPair(ticker.CurrencyPair.Quote, ticker.CurrencyPair.Base)]; ok {
+		return mmtypes.ProviderConfig{
+			Name:           provider,
+			OffChainTicker: listing.OffChainTicker,
+			Invert:         true,
+		}, true
+	}
+
+	for _, normalizeQuote := range g.normalizeQuotes {
+		if normalizeQuote == ticker.CurrencyPair.Quote || normalizeQuote == ticker.CurrencyPair.Base {
+			continue
+		}
+
+		listing, ok := listings[connecttypes.NewCurrencyPair(ticker.CurrencyPair.Base, normalizeQuote)]
+		if !ok {
+			continue
+		}
+
+		normalizeByPair := connecttypes.NewCurrencyPair(normalizeQuote, ticker.CurrencyPair.Quote)
+		normalizeMarket, ok := mm.Markets[normalizeByPair.String()]
+		if !ok || (ticker.Enabled && !normalizeMarket.Ticker.Enabled) {
+			continue
+		}
+
+		return mmtypes.ProviderConfig{
+			Name:            provider,
+			OffChainTicker:  listing.OffChainTicker,
+			NormalizeByPair: &normalizeByPair,
+		}, true
+	}
+
+	return mmtypes.ProviderConfig{}, false
+}
+
+// pruneMarketMap removes the markets of the given MarketMap with fewer provider configs than their MinProviderCount,
+// and the provider configs normalized by a removed market, until no market is removed. It returns the sorted tickers
+// of the removed markets.
+func pruneMarketMap(mm mmtypes.MarketMap) []string {
+	var dropped []string
+
+	for pruned := true; pruned; {
+		pruned = false
+
+		for tickerStr, market := range mm.Markets {
+			providerConfigs := make([]mmtypes.ProviderConfig, 0, len(market.ProviderConfigs))
+			for _, providerConfig := range market.ProviderConfigs {
+				if providerConfig.NormalizeByPair != nil {
+					if _, ok := mm.Markets[providerConfig.NormalizeByPair.String()]; !ok {
+						continue
+					}
+				}
+
+				providerConfigs = append(providerConfigs, providerConfig)
+			}
+			market.ProviderConfigs = providerConfigs
+
+			if uint64(len(market.ProviderConfigs)) < market.Ticker.MinProviderCount || len(market.ProviderConfigs) == 0 {
+				delete(mm.Markets, tickerStr)
+				dropped = append(dropped, tickerStr)
+				pruned = true
+				continue
+			}
+
+			mm.Markets[tickerStr] = market
+		}
+	}
+
+	sort.Strings(dropped)
+	return dropped
+}
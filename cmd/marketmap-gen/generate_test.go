@@ -0,0 +1,262 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/cmd/constants/marketmaps"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+var (
+	btcusd  = connecttypes.NewCurrencyPair("BTC", "USD")
+	ethusd  = connecttypes.NewCurrencyPair("ETH", "USD")
+	usdtusd = connecttypes.NewCurrencyPair("USDT", "USD")
+)
+
+func readFixtureListings(t *testing.T, provider, file string) []Listing {
+	t.Helper()
+
+	bz, err := os.ReadFile(filepath.Join("testdata", file))
+	require.NoError(t, err)
+
+	listings, err := ParseListings(provider, bz)
+	require.NoError(t, err)
+
+	return listings
+}
+
+func TestParseListings(t *testing.T) {
+	tcs := []struct {
+		provider string
+		file     string
+		expected []Listing
+	}{
+		{
+			"binance_ws",
+			"binance_exchange_info.json",
+			[]Listing{
+				{Pair: connecttypes.NewCurrencyPair("BTC", "USDT"), OffChainTicker: "BTCUSDT"},
+				{Pair: connecttypes.NewCurrencyPair("ETH", "USDT"), OffChainTicker: "ETHUSDT"},
+				{Pair: connecttypes.NewCurrencyPair("ETH", "BTC"), OffChainTicker: "ETHBTC"},
+			},
+		},
+		{
+			"bybit_ws",
+			"bybit_instruments.json",
+			[]Listing{{Pair: connecttypes.NewCurrencyPair("BTC", "USDT"), OffChainTicker: "BTCUSDT"}},
+		},
+		{
+			"coinbase_ws",
+			"coinbase_products.json",
+			[]Listing{
+				{Pair: btcusd, OffChainTicker: "BTC-USD"},
+				{Pair: ethusd, OffChainTicker: "ETH-USD"},
+				{Pair: usdtusd, OffChainTicker: "USDT-USD"},
+			},
+		},
+		{
+			"crypto_dot_com_ws",
+			"crypto_dot_com_instruments.json",
+			[]Listing{{Pair: btcusd, OffChainTicker: "BTC_USD"}},
+		},
+		{
+			"gate_ws",
+			"gate_currency_pairs.json",
+			[]Listing{{Pair: connecttypes.NewCurrencyPair("BTC", "USDT"), OffChainTicker: "BTC_USDT"}},
+		},
+		{
+			"huobi_ws",
+			"huobi_symbols.json",
+			[]Listing{{Pair: connecttypes.NewCurrencyPair("BTC", "USDT"), OffChainTicker: "btcusdt"}},
+		},
+		{
+			"kraken_api",
+			"kraken_asset_pairs.json",
+			[]Listing{
+				{Pair: btcusd, OffChainTicker: "XXBTZUSD"},
+				{Pair: ethusd, OffChainTicker: "XETHZUSD"},
+				{Pair: usdtusd, OffChainTicker: "USDTZUSD"},
+			},
+		},
+		{
+			"kraken_ws",
+			"kraken_asset_pairs.json",
+			[]Listing{
+				{Pair: btcusd, OffChainTicker: "XBT/USD"},
+				{Pair: ethusd, OffChainTicker: "ETH/USD"},
+				{Pair: usdtusd, OffChainTicker: "USDT/USD"},
+			},
+		},
+		{
+			"kucoin_ws",
+			"kucoin_symbols.json",
+			[]Listing{{Pair: connecttypes.NewCurrencyPair("BTC", "USDT"), OffChainTicker: "BTC-USDT"}},
+		},
+		{
+			"mexc_ws",
+			"mexc_exchange_info.json",
+			[]Listing{{Pair: connecttypes.NewCurrencyPair("BTC", "USDT"), OffChainTicker: "BTCUSDT"}},
+		},
+		{
+			"okx_ws",
+			"okx_instruments.json",
+			[]Listing{
+				{Pair: connecttypes.NewCurrencyPair("BTC", "USDT"), OffChainTicker: "BTC-USDT"},
+				{Pair: connecttypes.NewCurrencyPair("ETH", "USDT"), OffChainTicker: "ETH-USDT"},
+				{Pair: connecttypes.NewCurrencyPair("USDC", "USDT"), OffChainTicker: "USDC-USDT"},
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.provider, func(t *testing.T) {
+			require.ElementsMatch(t, tc.expected, readFixtureListings(t, tc.provider, tc.file))
+		})
+	}
+
+	t.Run("unsupported provider", func(t *testing.T) {
+		_, err := ParseListings("unknown_api", []byte("{}"))
+		require.Error(t, err)
+	})
+
+	t.Run("invalid listing", func(t *testing.T) {
+		_, err := ParseListings("coinbase_ws", []byte("{"))
+		require.Error(t, err)
+	})
+}
+
+func TestGenerate(t *testing.T) {
+	listings := map[string][]Listing{
+		"binance_ws":  readFixtureListings(t, "binance_ws", "binance_exchange_info.json"),
+		"coinbase_ws": readFixtureListings(t, "coinbase_ws", "coinbase_products.json"),
+		"kraken_api":  readFixtureListings(t, "kraken_api", "kraken_asset_pairs.json"),
+		"okx_ws":      readFixtureListings(t, "okx_ws", "okx_instruments.json"),
+	}
+
+	tickers, err := readTickers(filepath.Join("testdata", "tickers.json"))
+	require.NoError(t, err)
+
+	t.Run("generates a valid market map with normalize-by pairs", func(t *testing.T) {
+		generator := NewGenerator(listings, []string{"USDT"})
+		mm, dropped, err := generator.Generate(tickers)
+		require.NoError(t, err)
+		require.NoError(t, mm.ValidateBasic())
+
+		require.Equal(t, []string{"FOO/USD"}, dropped)
+		require.Len(t, mm.Markets, 3)
+
+		require.Equal(t, []mmtypes.ProviderConfig{
+			{Name: "binance_ws", OffChainTicker: "BTCUSDT", NormalizeByPair: &usdtusd},
+			{Name: "coinbase_ws", OffChainTicker: "BTC-USD"},
+			{Name: "kraken_api", OffChainTicker: "XXBTZUSD"},
+			{Name: "okx_ws", OffChainTicker: "BTC-USDT", NormalizeByPair: &usdtusd},
+		}, mm.Markets[btcusd.String()].ProviderConfigs)
+
+		require.Equal(t, []mmtypes.ProviderConfig{
+			{Name: "coinbase_ws", OffChainTicker: "USDT-USD"},
+			{Name: "kraken_api", OffChainTicker: "USDTZUSD"},
+		}, mm.Markets[usdtusd.String()].ProviderConfigs)
+
+		// the generated markets match the market map constants for the given providers
+		require.Empty(t, DiffMarketMaps(mmtypes.MarketMap{
+			Markets: map[string]mmtypes.Market{
+				btcusd.String(): marketmaps.CoreMarketMap.Markets[btcusd.String()],
+				ethusd.String(): marketmaps.CoreMarketMap.Markets[ethusd.String()],
+			},
+		}, mmtypes.MarketMap{
+			Markets: map[string]mmtypes.Market{
+				btcusd.String(): mm.Markets[btcusd.String()],
+				ethusd.String(): mm.Markets[ethusd.String()],
+			},
+		}, generator.Providers()))
+	})
+
+	t.Run("inverse pairs are inverted", func(t *testing.T) {
+		generator := NewGenerator(map[string][]Listing{
+			"binance_ws": readFixtureListings(t, "binance_ws", "binance_exchange_info.json"),
+		}, nil)
+
+		mm, _, err := generator.Generate([]mmtypes.Ticker{
+			{CurrencyPair: connecttypes.NewCurrencyPair("BTC", "ETH"), Decimals: 8, MinProviderCount: 1},
+		})
+		require.NoError(t, err)
+
+		require.Equal(t, []mmtypes.ProviderConfig{
+			{Name: "binance_ws", OffChainTicker: "ETHBTC", Invert: true},
+		}, mm.Markets["BTC/ETH"].ProviderConfigs)
+	})
+
+	t.Run("provider configs normalized by dropped markets are dropped", func(t *testing.T) {
+		generator := NewGenerator(map[string][]Listing{
+			"binance_ws": readFixtureListings(t, "binance_ws", "binance_exchange_info.json"),
+			"okx_ws":     readFixtureListings(t, "okx_ws", "okx_instruments.json"),
+		}, []string{"USDT"})
+
+		// neither exchange lists USDT/USD, so BTC/USD cannot be normalized by it
+		mm, dropped, err := generator.Generate(tickers)
+		require.NoError(t, err)
+		require.Empty(t, mm.Markets)
+		require.Equal(t, []string{"BTC/USD", "ETH/USD", "FOO/USD", "USDT/USD"}, dropped)
+	})
+
+	t.Run("duplicate tickers are rejected", func(t *testing.T) {
+		_, _, err := NewGenerator(listings, nil).Generate(append(tickers, tickers[0]))
+		require.Error(t, err)
+	})
+}
+
+func TestDiffMarketMaps(t *testing.T) {
+	existing := mmtypes.MarketMap{
+		Markets: map[string]mmtypes.Market{
+			btcusd.String(): {
+				Ticker: mmtypes.Ticker{CurrencyPair: btcusd, Decimals: 5, MinProviderCount: 1, Enabled: true},
+				ProviderConfigs: []mmtypes.ProviderConfig{
+					{Name: "binance_ws", OffChainTicker: "BTCUSDT", NormalizeByPair: &usdtusd},
+					{Name: "coinbase_ws", OffChainTicker: "BTC-USD"},
+					{Name: "kraken_api", OffChainTicker: "XXBTZUSD"},
+				},
+			},
+			ethusd.String(): {
+				Ticker: mmtypes.Ticker{CurrencyPair: ethusd, Decimals: 6, MinProviderCount: 1, Enabled: true},
+				ProviderConfigs: []mmtypes.ProviderConfig{
+					{Name: "coinbase_ws", OffChainTicker: "ETH-USD"},
+				},
+			},
+		},
+	}
+
+	generated := mmtypes.MarketMap{
+		Markets: map[string]mmtypes.Market{
+			btcusd.String(): {
+				Ticker: mmtypes.Ticker{CurrencyPair: btcusd, Decimals: 8, MinProviderCount: 1, Enabled: true},
+				ProviderConfigs: []mmtypes.ProviderConfig{
+					{Name: "binance_ws", OffChainTicker: "BTCUSDC", NormalizeByPair: &usdtusd},
+					{Name: "okx_ws", OffChainTicker: "BTC-USDT", NormalizeByPair: &usdtusd},
+				},
+			},
+			usdtusd.String(): {
+				Ticker: mmtypes.Ticker{CurrencyPair: usdtusd, Decimals: 9, MinProviderCount: 1, Enabled: true},
+				ProviderConfigs: []mmtypes.ProviderConfig{
+					{Name: "coinbase_ws", OffChainTicker: "USDT-USD"},
+				},
+			},
+		},
+	}
+
+	// kraken_api is not compared, as its listing was not given
+	require.Equal(t, []string{
+		"~ BTC/USD",
+		"    ~ decimals: 5 -> 8",
+		"    ~ binance_ws BTCUSDT (normalized by USDT/USD) -> binance_ws BTCUSDC (normalized by USDT/USD)",
+		"    - coinbase_ws BTC-USD",
+		"    + okx_ws BTC-USDT (normalized by USDT/USD)",
+		"- ETH/USD",
+		"+ USDT/USD",
+		"    + coinbase_ws USDT-USD",
+	}, DiffMarketMaps(existing, generated, []string{"binance_ws", "coinbase_ws", "okx_ws"}))
+}
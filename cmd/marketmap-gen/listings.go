@@ -0,0 +1,347 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	binanceapi "github.com/skip-mev/connect/v2/providers/apis/binance"
+	coinbaseapi "github.com/skip-mev/connect/v2/providers/apis/coinbase"
+	krakenapi "github.com/skip-mev/connect/v2/providers/apis/kraken"
+	binancews "github.com/skip-mev/connect/v2/providers/websockets/binance"
+	"github.com/skip-mev/connect/v2/providers/websockets/bybit"
+	coinbasews "github.com/skip-mev/connect/v2/providers/websockets/coinbase"
+	"github.com/skip-mev/connect/v2/providers/websockets/cryptodotcom"
+	"github.com/skip-mev/connect/v2/providers/websockets/gate"
+	"github.com/skip-mev/connect/v2/providers/websockets/huobi"
+	krakenws "github.com/skip-mev/connect/v2/providers/websockets/kraken"
+	"github.com/skip-mev/connect/v2/providers/websockets/kucoin"
+	"github.com/skip-mev/connect/v2/providers/websockets/mexc"
+	"github.com/skip-mev/connect/v2/providers/websockets/okx"
+)
+
+// Listing is an active spot market of an exchange, as recorded in the symbol listing of the exchange.
+type Listing struct {
+	// Pair is the market, with the exchange's asset symbols normalized to the ones used in the market map.
+	Pair connecttypes.CurrencyPair
+
+	// OffChainTicker is the symbol of the market on the exchange, as expected by the provider.
+	OffChainTicker string
+}
+
+// ListingParser parses a recorded symbol-listing response of an exchange into its active spot listings.
+type ListingParser func(bz []byte) ([]Listing, error)
+
+// listingParsers is a map from provider name to the parser of the symbol listing of its exchange.
+var listingParsers = map[string]ListingParser{
+	binanceapi.Name:   parseExchangeInfo("TRADING"),
+	binancews.Name:    parseExchangeInfo("TRADING"),
+	bybit.Name:        parseBybitInstruments,
+	coinbaseapi.Name:  parseCoinbaseProducts,
+	coinbasews.Name:   parseCoinbaseProducts,
+	cryptodotcom.Name: parseCryptoDotComInstruments,
+	gate.Name:         parseGateCurrencyPairs,
+	huobi.Name:        parseHuobiSymbols,
+	krakenapi.Name:    parseKrakenAssetPairs(false),
+	krakenws.Name:     parseKrakenAssetPairs(true),
+	kucoin.Name:       parseKucoinSymbols,
+	mexc.Name:         parseExchangeInfo("1", "ENABLED"),
+	okx.Name:          parseOKXInstruments,
+}
+
+// assetAliases is a map from the exchange-specific symbol of an asset to its symbol in the market map.
+var assetAliases = map[string]string{
+	"XBT": "BTC",
+	"XDG": "DOGE",
+}
+
+// SupportedProviders returns the sorted names of the providers whose symbol listings can be parsed.
+func SupportedProviders() []string {
+	providers := make([]string, 0, len(listingParsers))
+	for provider := range listingParsers {
+		providers = append(providers, provider)
+	}
+	sort.Strings(providers)
+
+	return providers
+}
+
+// ParseListings parses the given symbol-listing response of the exchange of the given provider.
+func ParseListings(provider string, bz []byte) ([]Listing, error) {
+	parse, ok := listingParsers[provider]
+	if !ok {
+		return nil, fmt.Errorf("unsupported provider %s; supported providers are %s",
+			provider, strings.Join(SupportedProviders(), ", "))
+	}
+
+	listings, err := parse(bz)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse symbol listing of %s: %w", provider, err)
+	}
+
+	return listings, nil
+}
+
+// newListing returns a Listing of the given exchange base and quote assets.
+func newListing(base, quote, offChainTicker string) Listing {
+	return Listing{
+		Pair:           connecttypes.NewCurrencyPair(normalizeAsset(base), normalizeAsset(quote)),
+		OffChainTicker: offChainTicker,
+	}
+}
+
+// normalizeAsset returns the market map symbol of the given exchange asset symbol.
+func normalizeAsset(asset string) string {
+	asset = strings.ToUpper(asset)
+	if alias, ok := assetAliases[asset]; ok {
+		return alias
+	}
+
+	return asset
+}
+
+// parseExchangeInfo returns a ListingParser of the Binance-style exchangeInfo response, used by Binance and MEXC,
+// which only keeps the symbols with one of the given statuses.
+func parseExchangeInfo(activeStatuses ...string) ListingParser {
+	return func(bz []byte) ([]Listing, error) {
+		var resp struct {
+			Symbols []struct {
+				Symbol     string `json:"symbol"`
+				Status     string `json:"status"`
+				BaseAsset  string `json:"baseAsset"`
+				QuoteAsset string `json:"quoteAsset"`
+			} `json:"symbols"`
+		}
+		if err := json.Unmarshal(bz, &resp); err != nil {
+			return nil, err
+		}
+
+		var listings []Listing
+		for _, symbol := range resp.Symbols {
+			for _, status := range activeStatuses {
+				if symbol.Status == status {
+					listings = append(listings, newListing(symbol.BaseAsset, symbol.QuoteAsset, symbol.Symbol))
+					break
+				}
+			}
+		}
+
+		return listings, nil
+	}
+}
+
+// parseBybitInstruments parses the Bybit spot instruments-info response.
+func parseBybitInstruments(bz []byte) ([]Listing, error) {
+	var resp struct {
+		Result struct {
+			List []struct {
+				Symbol    string `json:"symbol"`
+				BaseCoin  string `json:"baseCoin"`
+				QuoteCoin string `json:"quoteCoin"`
+				Status    string `json:"status"`
+			} `json:"list"`
+		} `json:"result"`
+	}
+	if err := json.Unmarshal(bz, &resp); err != nil {
+		return nil, err
+	}
+
+	var listings []Listing
+	for _, instrument := range resp.Result.List {
+		if instrument.Status == "Trading" {
+			listings = append(listings, newListing(instrument.BaseCoin, instrument.QuoteCoin, instrument.Symbol))
+		}
+	}
+
+	return listings, nil
+}
+
+// parseCoinbaseProducts parses the Coinbase products response.
+func parseCoinbaseProducts(bz []byte) ([]Listing, error) {
+	var resp []struct {
+		ID              string `json:"id"`
+		BaseCurrency    string `json:"base_currency"`
+		QuoteCurrency   string `json:"quote_currency"`
+		Status          string `json:"status"`
+		TradingDisabled bool   `json:"trading_disabled"`
+	}
+	if err := json.Unmarshal(bz, &resp); err != nil {
+		return nil, err
+	}
+
+	var listings []Listing
+	for _, product := range resp {
+		if product.Status == "online" && !product.TradingDisabled {
+			listings = append(listings, newListing(product.BaseCurrency, product.QuoteCurrency, product.ID))
+		}
+	}
+
+	return listings, nil
+}
+
+// parseCryptoDotComInstruments parses the Crypto.com public/get-instruments response.
+func parseCryptoDotComInstruments(bz []byte) ([]Listing, error) {
+	var resp struct {
+		Result struct {
+			Data []struct {
+				Symbol   string `json:"symbol"`
+				InstType string `json:"inst_type"`
+				BaseCcy  string `json:"base_ccy"`
+				QuoteCcy string `json:"quote_ccy"`
+				Tradable bool   `json:"tradable"`
+			} `json:"data"`
+		} `json:"result"`
+	}
+	if err := json.Unmarshal(bz, &resp); err != nil {
+		return nil, err
+	}
+
+	var listings []Listing
+	for _, instrument := range resp.Result.Data {
+		if instrument.InstType == "CCY_PAIR" && instrument.Tradable {
+			listings = append(listings, newListing(instrument.BaseCcy, instrument.QuoteCcy, instrument.Symbol))
+		}
+	}
+
+	return listings, nil
+}
+
+// parseGateCurrencyPairs parses the Gate.io spot currency_pairs response.
+func parseGateCurrencyPairs(bz []byte) ([]Listing, error) {
+	var resp []struct {
+		ID          string `json:"id"`
+		Base        string `json:"base"`
+		Quote       string `json:"quote"`
+		TradeStatus string `json:"trade_status"`
+	}
+	if err := json.Unmarshal(bz, &resp); err != nil {
+		return nil, err
+	}
+
+	var listings []Listing
+	for _, pair := range resp {
+		if pair.TradeStatus == "tradable" {
+			listings = append(listings, newListing(pair.Base, pair.Quote, pair.ID))
+		}
+	}
+
+	return listings, nil
+}
+
+// parseHuobiSymbols parses the Huobi v1/common/symbols response.
+func parseHuobiSymbols(bz []byte) ([]Listing, error) {
+	var resp struct {
+		Data []struct {
+			Symbol        string `json:"symbol"`
+			BaseCurrency  string `json:"base-currency"`
+			QuoteCurrency string `json:"quote-currency"`
+			State         string `json:"state"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(bz, &resp); err != nil {
+		return nil, err
+	}
+
+	var listings []Listing
+	for _, symbol := range resp.Data {
+		if symbol.State == "online" {
+			listings = append(listings, newListing(symbol.BaseCurrency, symbol.QuoteCurrency, symbol.Symbol))
+		}
+	}
+
+	return listings, nil
+}
+
+// parseKrakenAssetPairs returns a ListingParser of the Kraken AssetPairs response. The off-chain ticker is the
+// websocket name of the pair, e.g. XBT/USD, if wsName is set, and the pair name, e.g. XXBTZUSD, otherwise.
+func parseKrakenAssetPairs(wsName bool) ListingParser {
+	return func(bz []byte) ([]Listing, error) {
+		var resp struct {
+			Error  []string `json:"error"`
+			Result map[string]struct {
+				WSName string `json:"wsname"`
+				Status string `json:"status"`
+			} `json:"result"`
+		}
+		if err := json.Unmarshal(bz, &resp); err != nil {
+			return nil, err
+		}
+
+		if len(resp.Error) > 0 {
+			return nil, fmt.Errorf("kraken returned errors: %s", strings.Join(resp.Error, ", "))
+		}
+
+		var listings []Listing
+		for name, pair := range resp.Result {
+			if pair.Status != "online" {
+				continue
+			}
+
+			// the websocket name is the only field with the base and quote separated
+			base, quote, ok := strings.Cut(pair.WSName, "/")
+			if !ok {
+				return nil, fmt.Errorf("invalid websocket name %q of pair %s", pair.WSName, name)
+			}
+
+			offChainTicker := name
+			if wsName {
+				offChainTicker = pair.WSName
+			}
+
+			listings = append(listings, newListing(base, quote, offChainTicker))
+		}
+
+		return listings, nil
+	}
+}
+
+// parseKucoinSymbols parses the KuCoin symbols response.
+func parseKucoinSymbols(bz []byte) ([]Listing, error) {
+	var resp struct {
+		Data []struct {
+			Symbol        string `json:"symbol"`
+			BaseCurrency  string `json:"baseCurrency"`
+			QuoteCurrency string `json:"quoteCurrency"`
+			EnableTrading bool   `json:"enableTrading"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(bz, &resp); err != nil {
+		return nil, err
+	}
+
+	var listings []Listing
+	for _, symbol := range resp.Data {
+		if symbol.EnableTrading {
+			listings = append(listings, newListing(symbol.BaseCurrency, symbol.QuoteCurrency, symbol.Symbol))
+		}
+	}
+
+	return listings, nil
+}
+
+// parseOKXInstruments parses the OKX public/instruments response of the SPOT instrument type.
+func parseOKXInstruments(bz []byte) ([]Listing, error) {
+	var resp struct {
+		Data []struct {
+			InstID   string `json:"instId"`
+			InstType string `json:"instType"`
+			BaseCcy  string `json:"baseCcy"`
+			QuoteCcy string `json:"quoteCcy"`
+			State    string `json:"state"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(bz, &resp); err != nil {
+		return nil, err
+	}
+
+	var listings []Listing
+	for _, instrument := range resp.Data {
+		if instrument.InstType == "SPOT" && instrument.State == "live" {
+			listings = append(listings, newListing(instrument.BaseCcy, instrument.QuoteCcy, instrument.InstID))
+		}
+	}
+
+	return listings, nil
+}
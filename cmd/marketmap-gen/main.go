@@ -0,0 +1,191 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/skip-mev/connect/v2/cmd/constants/marketmaps"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+var (
+	rootCmd = &cobra.Command{
+		Use:   "marketmap-gen",
+		Short: "Generate a market map from recorded exchange symbol listings and diff it against the market map constants",
+		Long: `Use as follows to generate a market map of the given tickers from recorded exchange symbol listings:
+
+		marketmap-gen --tickers <tickers.json> --listing binance_ws=<exchangeInfo.json> --listing coinbase_ws=<products.json> --compare core --output <markets.json>
+		Where:
+			--tickers: A JSON array of the tickers of the market map, e.g. [{"currency_pair":{"Base":"BTC","Quote":"USD"},"decimals":5,"min_provider_count":1,"enabled":true}]
+			--listing: A provider name and the file of the recorded symbol-listing response of its exchange, as <provider>=<file>. Can be repeated
+			--normalize-quotes: The quote assets through which pairs that are not listed by an exchange may be priced, in order of preference
+			--compare: The market map constants to diff the generated market map against, see cmd/constants/marketmaps. Empty disables the diff
+			--output: The file to write the generated market map to. If not provided, the market map is written to stdout
+
+		The dropped tickers and the diff are written to stderr.
+		`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			tickers, err := readTickers(tickersPath)
+			if err != nil {
+				return err
+			}
+
+			listings, err := readListings(listingFlags)
+			if err != nil {
+				return err
+			}
+
+			generator := NewGenerator(listings, normalizeQuotes)
+			mm, dropped, err := generator.Generate(tickers)
+			if err != nil {
+				return err
+			}
+
+			for _, tickerStr := range dropped {
+				fmt.Fprintf(cmd.ErrOrStderr(), "dropped %s: not enough providers\n", tickerStr)
+			}
+
+			if err := writeMarketMap(cmd.OutOrStdout(), outputPath, mm); err != nil {
+				return err
+			}
+
+			if compare == "" {
+				return nil
+			}
+
+			existing, ok := constantMarketMaps()[compare]
+			if !ok {
+				return fmt.Errorf("unknown market map constants %s; options are %s", compare, strings.Join(constantMarketMapNames(), ", "))
+			}
+
+			fmt.Fprintf(cmd.ErrOrStderr(), "diff against the %s market map:\n", compare)
+			for _, line := range DiffMarketMaps(existing, mm, generator.Providers()) {
+				fmt.Fprintln(cmd.ErrOrStderr(), line)
+			}
+
+			return nil
+		},
+	}
+
+	// Flags.
+	tickersPath     string
+	listingFlags    []string
+	normalizeQuotes []string
+	compare         string
+	outputPath      string
+)
+
+func init() {
+	rootCmd.Flags().StringVar(&tickersPath, "tickers", "", "A JSON array of the tickers of the market map")
+	rootCmd.Flags().StringArrayVar(&listingFlags, "listing", nil, fmt.Sprintf("A provider name and the file of the recorded symbol-listing response of its exchange, as <provider>=<file>. Supported providers are %s", strings.Join(SupportedProviders(), ", ")))
+	rootCmd.Flags().StringSliceVar(&normalizeQuotes, "normalize-quotes", []string{"USDT", "USDC"}, "The quote assets through which pairs that are not listed by an exchange may be priced, in order of preference")
+	rootCmd.Flags().StringVar(&compare, "compare", "core", fmt.Sprintf("The market map constants to diff the generated market map against. Options are %s. Empty disables the diff", strings.Join(constantMarketMapNames(), ", ")))
+	rootCmd.Flags().StringVar(&outputPath, "output", "", "The file to write the generated market map to. If not provided, the market map is written to stdout")
+
+	_ = rootCmd.MarkFlagRequired("tickers")
+	_ = rootCmd.MarkFlagRequired("listing")
+}
+
+func main() {
+	if err := rootCmd.Execute(); err != nil {
+		os.Exit(1)
+	}
+}
+
+// constantMarketMaps returns the market maps of cmd/constants/marketmaps by name.
+func constantMarketMaps() map[string]mmtypes.MarketMap {
+	return map[string]mmtypes.MarketMap{
+		"coingecko":      marketmaps.CoinGeckoMarketMap,
+		"coinmarketcap":  marketmaps.CoinMarketCapMarketMap,
+		"core":           marketmaps.CoreMarketMap,
+		"forex":          marketmaps.ForexMarketMap,
+		"osmosis":        marketmaps.OsmosisMarketMap,
+		"polymarket":     marketmaps.PolymarketMarketMap,
+		"raydium":        marketmaps.RaydiumMarketMap,
+		"uniswapv3-base": marketmaps.UniswapV3BaseMarketMap,
+	}
+}
+
+// constantMarketMapNames returns the sorted names of the market maps of cmd/constants/marketmaps.
+func constantMarketMapNames() []string {
+	names := make([]string, 0)
+	for name := range constantMarketMaps() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// readTickers reads the JSON array of tickers from the given file, and validates each of them.
+func readTickers(path string) ([]mmtypes.Ticker, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tickers: %w", err)
+	}
+
+	var tickers []mmtypes.Ticker
+	if err := json.Unmarshal(bz, &tickers); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal tickers: %w", err)
+	}
+
+	for _, ticker := range tickers {
+		if err := ticker.ValidateBasic(); err != nil {
+			return nil, fmt.Errorf("invalid ticker %s: %w", ticker.String(), err)
+		}
+	}
+
+	return tickers, nil
+}
+
+// readListings reads and parses the symbol listings of the given <provider>=<file> flags, by provider name.
+func readListings(flags []string) (map[string][]Listing, error) {
+	listings := make(map[string][]Listing, len(flags))
+	for _, flag := range flags {
+		provider, path, ok := strings.Cut(flag, "=")
+		if !ok || provider == "" || path == "" {
+			return nil, fmt.Errorf("invalid listing %q, expected <provider>=<file>", flag)
+		}
+
+		if _, ok := listings[provider]; ok {
+			return nil, fmt.Errorf("duplicate listing of provider %s", provider)
+		}
+
+		bz, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read symbol listing of %s: %w", provider, err)
+		}
+
+		providerListings, err := ParseListings(provider, bz)
+		if err != nil {
+			return nil, err
+		}
+
+		listings[provider] = providerListings
+	}
+
+	return listings, nil
+}
+
+// writeMarketMap writes the indented JSON of the given MarketMap to the given file, or to w if no file is given.
+func writeMarketMap(w io.Writer, path string, mm mmtypes.MarketMap) error {
+	bz, err := json.MarshalIndent(mm, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal market map: %w", err)
+	}
+	bz = append(bz, '\n')
+
+	if path == "" {
+		_, err := w.Write(bz)
+		return err
+	}
+
+	return os.WriteFile(path, bz, 0o600)
+}
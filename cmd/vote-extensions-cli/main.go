@@ -1,29 +1,42 @@
 package main
 
 import (
+	"encoding/hex"
 	"fmt"
-	"math/big"
 	"os"
 
-	cmtabci "github.com/cometbft/cometbft/abci/types"
 	cmthttp "github.com/cometbft/cometbft/rpc/client/http"
 	"github.com/spf13/cobra"
 
 	"github.com/skip-mev/connect/v2/abci/strategies/codec"
+	connectabci "github.com/skip-mev/connect/v2/abci/types"
 )
 
 var (
 	rootCmd = &cobra.Command{
 		Use:   "vote-extensions-cli",
-		Short: "Inspect the vote extensions of a given node, at a given height",
+		Short: "Inspect the vote extensions of a given node, at a given height or over a range of heights",
 		Long: `Use as follows to inspect the vote extensions of a given node, at a given height:
 		
 		vote-extensions-cli --node <http<s>://<url>:26657> --height <height> --extended-commit-codec <selector> --vote-extension-codec <selector>
+
+		Or as follows to audit the vote extensions of each validator over a range of heights:
+
+		vote-extensions-cli --node <http<s>://<url>:26657> --from-height <height> --to-height <height> --validator-stats --output json
 		Where:
 			--node: The node to query
 			--height: The height to query. If not provided, the latest height will be used
-			--extended-commit-codec: The codec to use to decode the extended commit. Options are 1: standard encoding (default), 2: z-lib compressed encoding, 3: zstd compressed encoding
-			--vote-extension-codec: The codec to use to decode the vote extension. Options are 1: standard encoding (default), 2: z-lib compressed encoding, 3: zstd compressed encoding
+			--from-height: The first height to scan (inclusive). Takes precedence over --height
+			--to-height: The last height to scan (inclusive)
+			--extended-commit-codec: The codec to use to decode the extended commit. Options are 1: standard encoding (default), 2: z-lib compressed encoding, 3: zstd compressed encoding, 4: zstd dictionary compressed encoding (requires --dictionary)
+			--vote-extension-codec: The codec to use to decode the vote extension. Options are 1: standard encoding (default), 2: z-lib compressed encoding, 3: zstd compressed encoding, 4: zstd dictionary compressed encoding (requires --dictionary)
+			--dictionary: The zstd dictionary file used by the zstd dictionary compressed encoding
+			--injection-index: The index at which the extended commit is injected into the proposal
+			--injection-marker: The hex-encoded marker prefixed to the injected extended commit, if any. If set, the extended commit is located by its marker
+			--price-strategy: The currency pair strategy used to encode prices. Options are default (default), delta, deviation
+			--heartbeat: The heartbeat of the deviation price strategy, used to report the prices validators omitted
+			--output: The output format. Options are text (default), json, csv
+			--validator-stats: Output per-validator participation, median deviation and missing pairs instead of prices
 		`,
 		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
@@ -33,70 +46,84 @@ var (
 				return err
 			}
 
-			// get the block-data
-			height := &height
-			if *height == 0 {
-				height = nil
+			from, to := fromHeight, toHeight
+			if from == 0 {
+				// get the block-data
+				height := &height
+				if *height == 0 {
+					height = nil
+				}
+
+				block, err := client.Block(cmd.Context(), height)
+				if err != nil {
+					return err
+				}
+
+				from, to = block.Block.Height, block.Block.Height
+			}
+
+			if from <= 0 || to < from {
+				return fmt.Errorf("invalid height range [%d, %d]", from, to)
 			}
 
-			block, err := client.Block(cmd.Context(), height)
+			extCommitCodec, veCodec, err := codecsFromFlags(extendedCommitCodec, voteExtensionCodec)
 			if err != nil {
 				return err
 			}
 
-			// decode the extended commit
-			var extCommit cmtabci.ExtendedCommitInfo
-			extCommitCodec, veCodec := codecsFromFlags(extendedCommitCodec, voteExtensionCodec)
-			extCommit, err = extCommitCodec.Decode(block.Block.Txs[0])
+			injection, err := injectionFromFlags()
 			if err != nil {
-				return fmt.Errorf("failed to decode extended commit: %w", err)
+				return err
 			}
 
-			// log the round of this extended commit
-			cmd.Println("Height:", block.Block.Height, "Round:", extCommit.Round)
-
-			// decode the vote extensions
-			for _, vote := range extCommit.Votes {
-				// decode the vote extension
-				ve, err := veCodec.Decode(vote.VoteExtension)
-				if err != nil {
-					return err
-				}
-
-				// per price, unmarshal via gob decoding
-				for priceID, priceBz := range ve.Prices {
-					cmd.Println("Price ID:", priceID)
-
-					price := new(big.Int)
-					if err := price.GobDecode(priceBz); err != nil {
-						return err
-					}
-					cmd.Println("Price:", price)
-				}
+			s, err := newScanner(client, injection, extCommitCodec, veCodec, priceStrategy, heartbeat)
+			if err != nil {
+				return err
+			}
 
-				// log the block id
-				cmd.Println("Block ID:", vote.BlockIdFlag)
+			reports, err := s.scan(cmd.Context(), from, to)
+			if err != nil {
+				return err
+			}
 
-				// log the validator
-				cmd.Println("Validator:", vote.Validator)
+			if validatorStatsOutput {
+				return writeValidatorStats(cmd.OutOrStdout(), outputFormat, computeValidatorStats(reports))
 			}
 
-			return nil
+			return writeVoteReports(cmd.OutOrStdout(), outputFormat, reports)
 		},
 	}
 
 	// Flags.
-	node                string
-	height              int64
-	extendedCommitCodec string
-	voteExtensionCodec  string
+	node                 string
+	height               int64
+	extendedCommitCodec  string
+	voteExtensionCodec   string
+	dictionaryPath       string
+	fromHeight           int64
+	toHeight             int64
+	priceStrategy        string
+	heartbeat            uint64
+	outputFormat         string
+	validatorStatsOutput bool
+	injectionIndex       int
+	injectionMarker      string
 )
 
 func init() {
 	rootCmd.PersistentFlags().StringVar(&node, "node", "", "The node to query")
 	rootCmd.PersistentFlags().Int64Var(&height, "height", 0, "The height to query. If not provided, the latest height will be used")
-	rootCmd.PersistentFlags().StringVar(&extendedCommitCodec, "extended-commit-codec", "1", "The codec to use to decode the extended commit. Options are 1: standard encoding (default), 2: z-lib compressed encoding, 3: zstd compressed encoding")
-	rootCmd.PersistentFlags().StringVar(&voteExtensionCodec, "vote-extension-codec", "1", "The codec to use to decode the vote extension. Options are 1: standard encoding (default), 2: z-lib compressed encoding, 3: zstd compressed encoding")
+	rootCmd.PersistentFlags().StringVar(&extendedCommitCodec, "extended-commit-codec", "1", "The codec to use to decode the extended commit. Options are 1: standard encoding (default), 2: z-lib compressed encoding, 3: zstd compressed encoding, 4: zstd dictionary compressed encoding (requires --dictionary)")
+	rootCmd.PersistentFlags().StringVar(&voteExtensionCodec, "vote-extension-codec", "1", "The codec to use to decode the vote extension. Options are 1: standard encoding (default), 2: z-lib compressed encoding, 3: zstd compressed encoding, 4: zstd dictionary compressed encoding (requires --dictionary)")
+	rootCmd.PersistentFlags().Int64Var(&fromHeight, "from-height", 0, "The first height to scan (inclusive). Takes precedence over --height")
+	rootCmd.PersistentFlags().Int64Var(&toHeight, "to-height", 0, "The last height to scan (inclusive)")
+	rootCmd.Flags().StringVar(&priceStrategy, "price-strategy", defaultPriceStrategy, "The currency pair strategy used to encode prices. Options are default (default), delta, deviation")
+	rootCmd.Flags().Uint64Var(&heartbeat, "heartbeat", 0, "The heartbeat of the deviation price strategy, used to report the prices validators omitted")
+	rootCmd.Flags().StringVar(&outputFormat, "output", textOutput, "The output format. Options are text (default), json, csv")
+	rootCmd.Flags().BoolVar(&validatorStatsOutput, "validator-stats", false, "Output per-validator participation, median deviation and missing pairs instead of prices")
+	rootCmd.PersistentFlags().StringVar(&dictionaryPath, "dictionary", "", "The zstd dictionary file used by the zstd dictionary compressed encoding")
+	rootCmd.PersistentFlags().IntVar(&injectionIndex, "injection-index", connectabci.OracleInfoIndex, "The index at which the extended commit is injected into the proposal")
+	rootCmd.PersistentFlags().StringVar(&injectionMarker, "injection-marker", "", "The hex-encoded marker prefixed to the injected extended commit, if any. If set, the extended commit is located by its marker")
 }
 
 func main() {
@@ -105,10 +132,24 @@ func main() {
 	}
 }
 
-func codecsFromFlags(extCommitCodecFlag, veCodecFlag string) (codec.ExtendedCommitCodec, codec.VoteExtensionCodec) {
+func codecsFromFlags(extCommitCodecFlag, veCodecFlag string) (codec.ExtendedCommitCodec, codec.VoteExtensionCodec, error) {
 	var extCommitCodec codec.ExtendedCommitCodec
 	var veCodec codec.VoteExtensionCodec
 
+	// only load the dictionary if one of the codecs requires it
+	var dictCompressor *codec.ZStdDictCompressor
+	if extCommitCodecFlag == "4" || veCodecFlag == "4" {
+		dictionary, err := os.ReadFile(dictionaryPath)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read zstd dictionary: %w", err)
+		}
+
+		dictCompressor, err = codec.NewZStdDictCompressor(dictionary)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
 	switch extCommitCodecFlag {
 	case "1":
 		extCommitCodec = codec.NewDefaultExtendedCommitCodec()
@@ -122,6 +163,13 @@ func codecsFromFlags(extCommitCodecFlag, veCodecFlag string) (codec.ExtendedComm
 			codec.NewDefaultExtendedCommitCodec(),
 			codec.NewZStdCompressor(),
 		)
+	case "4":
+		extCommitCodec = codec.NewCompressionExtendedCommitCodec(
+			codec.NewDefaultExtendedCommitCodec(),
+			dictCompressor,
+		)
+	default:
+		return nil, nil, fmt.Errorf("unknown extended commit codec %q", extCommitCodecFlag)
 	}
 
 	switch veCodecFlag {
@@ -137,7 +185,29 @@ func codecsFromFlags(extCommitCodecFlag, veCodecFlag string) (codec.ExtendedComm
 			codec.NewDefaultVoteExtensionCodec(),
 			codec.NewZStdCompressor(),
 		)
+	case "4":
+		veCodec = codec.NewCompressionVoteExtensionCodec(
+			codec.NewDefaultVoteExtensionCodec(),
+			dictCompressor,
+		)
+	default:
+		return nil, nil, fmt.Errorf("unknown vote extension codec %q", veCodecFlag)
+	}
+
+	return extCommitCodec, veCodec, nil
+}
+
+// injectionFromFlags returns the TxInjection used to locate the extended commit in a proposal.
+func injectionFromFlags() (connectabci.TxInjection, error) {
+	marker, err := hex.DecodeString(injectionMarker)
+	if err != nil {
+		return connectabci.TxInjection{}, fmt.Errorf("invalid injection marker: %w", err)
+	}
+
+	injection := connectabci.TxInjection{
+		Index:  injectionIndex,
+		Marker: marker,
 	}
 
-	return extCommitCodec, veCodec
+	return injection, injection.ValidateBasic()
 }
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	connectabci "github.com/skip-mev/connect/v2/abci/types"
+)
+
+func TestCodecsFromFlags(t *testing.T) {
+	t.Run("known selectors return codecs", func(t *testing.T) {
+		extCommitCodec, veCodec, err := codecsFromFlags("3", "2")
+		require.NoError(t, err)
+		require.NotNil(t, extCommitCodec)
+		require.NotNil(t, veCodec)
+	})
+
+	t.Run("unknown extended commit codec selector is rejected", func(t *testing.T) {
+		_, _, err := codecsFromFlags("5", "1")
+		require.Error(t, err)
+	})
+
+	t.Run("unknown vote extension codec selector is rejected", func(t *testing.T) {
+		_, _, err := codecsFromFlags("1", "")
+		require.Error(t, err)
+	})
+}
+
+func TestReadExtendedCommits(t *testing.T) {
+	dir := t.TempDir()
+
+	first := filepath.Join(dir, "first")
+	require.NoError(t, os.WriteFile(first, []byte(base64.StdEncoding.EncodeToString([]byte("first"))+"\n"), 0o600))
+
+	second := filepath.Join(dir, "second")
+	require.NoError(t, os.WriteFile(second, []byte(base64.StdEncoding.EncodeToString([]byte("second"))), 0o600))
+
+	t.Run("reads every file", func(t *testing.T) {
+		extendedCommits, err := readExtendedCommits([]string{first, second}, "base64", connectabci.DefaultTxInjection())
+		require.NoError(t, err)
+		require.Equal(t, [][]byte{[]byte("first"), []byte("second")}, extendedCommits)
+	})
+
+	t.Run("strips the injection marker", func(t *testing.T) {
+		marked := filepath.Join(dir, "marked")
+		require.NoError(t, os.WriteFile(marked, []byte("oracle"+"first"), 0o600))
+
+		extendedCommits, err := readExtendedCommits([]string{marked}, "raw", connectabci.NewMarkerTxInjection([]byte("oracle"), 1))
+		require.NoError(t, err)
+		require.Equal(t, [][]byte{[]byte("first")}, extendedCommits)
+	})
+
+	t.Run("missing files are reported", func(t *testing.T) {
+		_, err := readExtendedCommits([]string{first, filepath.Join(dir, "missing")}, "base64", connectabci.DefaultTxInjection())
+		require.ErrorContains(t, err, "missing")
+	})
+}
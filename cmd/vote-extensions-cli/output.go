@@ -0,0 +1,128 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	textOutput = "text"
+	jsonOutput = "json"
+	csvOutput  = "csv"
+)
+
+// writeVoteReports writes the vote reports in the given format.
+func writeVoteReports(w io.Writer, format string, reports []voteReport) error {
+	switch format {
+	case textOutput:
+		for _, report := range reports {
+			fmt.Fprintln(w, "Height:", report.Height, "Round:", report.Round)
+			fmt.Fprintln(w, "Validator:", report.Validator, "Block ID:", report.BlockIDFlag)
+			for _, price := range report.Prices {
+				if price.Omitted {
+					fmt.Fprintln(w, "Price ID:", price.ID, "Currency Pair:", price.CurrencyPair, "Price:", price.Price, "(omitted)")
+					continue
+				}
+
+				fmt.Fprintln(w, "Price ID:", price.ID, "Currency Pair:", price.CurrencyPair, "Price:", price.Price)
+			}
+		}
+
+		return nil
+	case jsonOutput:
+		return writeJSON(w, reports)
+	case csvOutput:
+		rows := [][]string{{"height", "round", "validator", "block_id_flag", "id", "currency_pair", "price", "raw_price", "omitted"}}
+		for _, report := range reports {
+			row := []string{
+				strconv.FormatInt(report.Height, 10),
+				strconv.FormatInt(int64(report.Round), 10),
+				report.Validator,
+				report.BlockIDFlag,
+			}
+
+			// validators without prices are still reported, so that missing vote extensions are visible
+			if len(report.Prices) == 0 {
+				rows = append(rows, append(row, "", "", "", "", ""))
+			}
+
+			for _, price := range report.Prices {
+				rows = append(rows, append(
+					row[:4:4],
+					strconv.FormatUint(price.ID, 10),
+					price.CurrencyPair,
+					price.Price,
+					price.RawPrice,
+					strconv.FormatBool(price.Omitted),
+				))
+			}
+		}
+
+		return writeCSV(w, rows)
+	default:
+		return fmt.Errorf("unknown output format %q", format)
+	}
+}
+
+// writeValidatorStats writes the validator statistics in the given format.
+func writeValidatorStats(w io.Writer, format string, stats []validatorStats) error {
+	switch format {
+	case textOutput:
+		for _, s := range stats {
+			fmt.Fprintln(w, "Validator:", s.Validator)
+			fmt.Fprintf(w, "  Participation: %.4f (%d heights)\n", s.Participation, s.Heights)
+			fmt.Fprintf(w, "  Median deviation: %.6f\n", s.MedianDeviation)
+			fmt.Fprintln(w, "  Missing pairs:", formatMissingPairs(s.MissingPairs))
+		}
+
+		return nil
+	case jsonOutput:
+		return writeJSON(w, stats)
+	case csvOutput:
+		rows := [][]string{{"validator", "heights", "participation", "median_deviation", "missing_pairs"}}
+		for _, s := range stats {
+			rows = append(rows, []string{
+				s.Validator,
+				strconv.Itoa(s.Heights),
+				strconv.FormatFloat(s.Participation, 'f', -1, 64),
+				strconv.FormatFloat(s.MedianDeviation, 'f', -1, 64),
+				formatMissingPairs(s.MissingPairs),
+			})
+		}
+
+		return writeCSV(w, rows)
+	default:
+		return fmt.Errorf("unknown output format %q", format)
+	}
+}
+
+// formatMissingPairs returns the missing pairs as a sorted list of pair=count entries.
+func formatMissingPairs(missing map[string]int) string {
+	entries := make([]string, 0, len(missing))
+	for pair, count := range missing {
+		entries = append(entries, fmt.Sprintf("%s=%d", pair, count))
+	}
+	sort.Strings(entries)
+
+	return strings.Join(entries, ";")
+}
+
+func writeJSON(w io.Writer, v any) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
+
+func writeCSV(w io.Writer, rows [][]string) error {
+	writer := csv.NewWriter(w)
+	if err := writer.WriteAll(rows); err != nil {
+		return err
+	}
+
+	return writer.Error()
+}
@@ -0,0 +1,371 @@
+package main
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/spf13/cobra"
+
+	"github.com/skip-mev/connect/v2/abci/replay"
+	"github.com/skip-mev/connect/v2/abci/strategies/currencypair"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+)
+
+var (
+	replayCmd = &cobra.Command{
+		Use:   "replay",
+		Short: "Replay the aggregation of a captured extended commit entirely in memory",
+		Long: `Use as follows to replay the decoding, validation and stake-weighted median aggregation of an extended commit:
+
+		vote-extensions-cli replay --extended-commit <file> --validators <file> --currency-pairs <file> --height <height>
+		Where:
+			--extended-commit: The file containing the extended commit, i.e. the first tx of the block
+			--extended-commit-encoding: The encoding of the extended commit file. Options are base64 (default, as returned by the block RPC), hex, raw
+			--validators: A JSON file containing the validator set, as [{"address": "<hex or bech32 consensus address>", "power": <power>}]
+			--currency-pairs: A JSON file containing the currency pair mapping, as {"<id>": "<BASE/QUOTE>"}
+			--prices: A JSON file containing the on-chain prices, as {"<BASE/QUOTE>": "<price>"}. Required by the delta price strategy
+			--num-removed-currency-pairs: The number of currency pairs removed in the block the vote extensions were created in
+			--height: The height of the block the extended commit is included in
+			--threshold: The voting power threshold a currency pair must meet to be assigned a price
+			--price-strategy: The currency pair strategy used to encode prices. Options are default (default), delta
+			--output: The output format. Options are text (default), json
+		`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			extendedCommit, err := readExtendedCommit(extendedCommitPath, extendedCommitEncoding)
+			if err != nil {
+				return err
+			}
+
+			snapshot, err := readSnapshot()
+			if err != nil {
+				return err
+			}
+
+			threshold, err := math.LegacyNewDecFromStr(powerThreshold)
+			if err != nil {
+				return fmt.Errorf("invalid threshold: %w", err)
+			}
+
+			extCommitCodec, veCodec, err := codecsFromFlags(extendedCommitCodec, voteExtensionCodec)
+			if err != nil {
+				return err
+			}
+
+			opts := []replay.Option{
+				replay.WithCodecs(veCodec, extCommitCodec),
+				replay.WithPowerThreshold(threshold),
+			}
+
+			switch replayPriceStrategy {
+			case defaultPriceStrategy:
+			case deltaPriceStrategy:
+				opts = append(opts, replay.WithCurrencyPairStrategy(
+					func(keeper currencypair.OracleKeeper) currencypair.CurrencyPairStrategy {
+						return currencypair.NewDeltaCurrencyPairStrategy(keeper)
+					},
+				))
+			default:
+				return fmt.Errorf("unknown price strategy %q", replayPriceStrategy)
+			}
+
+			result, err := replay.Replay(log.NewNopLogger(), extendedCommit, snapshot, opts...)
+			if err != nil {
+				return err
+			}
+
+			return writeReplayResult(cmd.OutOrStdout(), replayOutputFormat, result)
+		},
+	}
+
+	// Flags.
+	extendedCommitPath      string
+	extendedCommitEncoding  string
+	validatorsPath          string
+	currencyPairsPath       string
+	pricesPath              string
+	numRemovedCurrencyPairs uint64
+	powerThreshold          string
+	replayPriceStrategy     string
+	replayOutputFormat      string
+)
+
+func init() {
+	replayCmd.Flags().StringVar(&extendedCommitPath, "extended-commit", "", "The file containing the extended commit, i.e. the first tx of the block")
+	replayCmd.Flags().StringVar(&extendedCommitEncoding, "extended-commit-encoding", "base64", "The encoding of the extended commit file. Options are base64 (default), hex, raw")
+	replayCmd.Flags().StringVar(&validatorsPath, "validators", "", "A JSON file containing the validator set, as [{\"address\": \"<hex or bech32 consensus address>\", \"power\": <power>}]")
+	replayCmd.Flags().StringVar(&currencyPairsPath, "currency-pairs", "", "A JSON file containing the currency pair mapping, as {\"<id>\": \"<BASE/QUOTE>\"}")
+	replayCmd.Flags().StringVar(&pricesPath, "prices", "", "A JSON file containing the on-chain prices, as {\"<BASE/QUOTE>\": \"<price>\"}. Required by the delta price strategy")
+	replayCmd.Flags().Uint64Var(&numRemovedCurrencyPairs, "num-removed-currency-pairs", 0, "The number of currency pairs removed in the block the vote extensions were created in")
+	replayCmd.Flags().StringVar(&powerThreshold, "threshold", "0.667", "The voting power threshold a currency pair must meet to be assigned a price")
+	replayCmd.Flags().StringVar(&replayPriceStrategy, "price-strategy", defaultPriceStrategy, "The currency pair strategy used to encode prices. Options are default (default), delta")
+	replayCmd.Flags().StringVar(&replayOutputFormat, "output", textOutput, "The output format. Options are text (default), json")
+
+	rootCmd.AddCommand(replayCmd)
+}
+
+// readExtendedCommit reads the extended commit from the given file in the given encoding.
+func readExtendedCommit(path, encoding string) ([]byte, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read extended commit: %w", err)
+	}
+
+	switch encoding {
+	case "raw":
+		return bz, nil
+	case "base64":
+		return base64.StdEncoding.DecodeString(strings.TrimSpace(string(bz)))
+	case "hex":
+		return hex.DecodeString(strings.TrimSpace(string(bz)))
+	default:
+		return nil, fmt.Errorf("unknown extended commit encoding %q", encoding)
+	}
+}
+
+// readSnapshot reads the replay snapshot from the files given by the flags.
+func readSnapshot() (replay.Snapshot, error) {
+	snapshot := replay.Snapshot{
+		Height:                  height,
+		CurrencyPairs:           make(map[uint64]connecttypes.CurrencyPair),
+		NumRemovedCurrencyPairs: numRemovedCurrencyPairs,
+		Prices:                  make(map[connecttypes.CurrencyPair]*big.Int),
+	}
+
+	var validators []struct {
+		Address string `json:"address"`
+		Power   int64  `json:"power"`
+	}
+	if err := readJSON(validatorsPath, &validators); err != nil {
+		return replay.Snapshot{}, fmt.Errorf("failed to read validators: %w", err)
+	}
+
+	for _, val := range validators {
+		address, err := parseConsAddress(val.Address)
+		if err != nil {
+			return replay.Snapshot{}, err
+		}
+
+		snapshot.Validators = append(snapshot.Validators, replay.Validator{
+			ConsAddress: address,
+			Power:       math.NewInt(val.Power),
+		})
+	}
+
+	var currencyPairs map[string]string
+	if err := readJSON(currencyPairsPath, &currencyPairs); err != nil {
+		return replay.Snapshot{}, fmt.Errorf("failed to read currency pairs: %w", err)
+	}
+
+	for idStr, cpStr := range currencyPairs {
+		id, err := strconv.ParseUint(idStr, 10, 64)
+		if err != nil {
+			return replay.Snapshot{}, fmt.Errorf("invalid currency pair id %q: %w", idStr, err)
+		}
+
+		cp, err := connecttypes.CurrencyPairFromString(cpStr)
+		if err != nil {
+			return replay.Snapshot{}, err
+		}
+		snapshot.CurrencyPairs[id] = cp
+	}
+
+	if pricesPath == "" {
+		return snapshot, nil
+	}
+
+	var prices map[string]string
+	if err := readJSON(pricesPath, &prices); err != nil {
+		return replay.Snapshot{}, fmt.Errorf("failed to read prices: %w", err)
+	}
+
+	for cpStr, priceStr := range prices {
+		cp, err := connecttypes.CurrencyPairFromString(cpStr)
+		if err != nil {
+			return replay.Snapshot{}, err
+		}
+
+		price, ok := new(big.Int).SetString(priceStr, 10)
+		if !ok {
+			return replay.Snapshot{}, fmt.Errorf("invalid price %q for %s", priceStr, cp)
+		}
+		snapshot.Prices[cp] = price
+	}
+
+	return snapshot, nil
+}
+
+// parseConsAddress parses a consensus address given either in hex, as displayed by CometBFT, or in bech32 with
+// any prefix.
+func parseConsAddress(address string) (sdk.ConsAddress, error) {
+	if _, bz, err := bech32.DecodeAndConvert(address); err == nil {
+		return bz, nil
+	}
+
+	bz, err := hex.DecodeString(address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid consensus address %q", address)
+	}
+
+	return bz, nil
+}
+
+func readJSON(path string, v any) error {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(bz, v)
+}
+
+// writeReplayResult writes each step of the replay in the given format.
+func writeReplayResult(w io.Writer, format string, result replay.Result) error {
+	switch format {
+	case textOutput:
+		fmt.Fprintln(w, "Round:", result.Round)
+
+		fmt.Fprintln(w, "Votes:")
+		for _, vote := range result.Votes {
+			power := "unknown"
+			if vote.Power != nil {
+				power = vote.Power.String()
+			}
+
+			fmt.Fprintf(w, "  Validator: %s Power: %s Size: %d Prices: %d Included: %t\n",
+				vote.Validator, power, vote.Size, vote.NumPrices, vote.Included())
+			if vote.DecodeErr != nil {
+				fmt.Fprintln(w, "    Decode error:", vote.DecodeErr)
+			}
+			if vote.ValidateErr != nil {
+				fmt.Fprintln(w, "    Validation error:", vote.ValidateErr)
+			}
+		}
+
+		fmt.Fprintln(w, "Currency pairs:")
+		for _, cp := range result.CurrencyPairs {
+			price := "none"
+			if cp.Price != nil {
+				price = cp.Price.String()
+			}
+
+			fmt.Fprintf(w, "  %s: Submitted power: %s / %s (%s, threshold %s) Price: %s\n",
+				cp.CurrencyPair, cp.SubmittedPower, cp.TotalPower, cp.PercentSubmitted, cp.Threshold, price)
+			for _, report := range cp.Reports {
+				fmt.Fprintf(w, "    Validator: %s Price: %s Power: %s Cumulative power: %s\n",
+					report.Validator, report.Price, report.Power, report.CumulativePower)
+			}
+		}
+
+		return nil
+	case jsonOutput:
+		return writeJSON(w, replayResultJSON(result))
+	default:
+		return fmt.Errorf("unknown output format %q", format)
+	}
+}
+
+// replayResultJSON returns the JSON representation of a replay result.
+func replayResultJSON(result replay.Result) any {
+	type voteJSON struct {
+		Validator   string            `json:"validator"`
+		Power       string            `json:"power,omitempty"`
+		Size        int               `json:"size"`
+		NumPrices   int               `json:"num_prices"`
+		Included    bool              `json:"included"`
+		DecodeErr   string            `json:"decode_error,omitempty"`
+		ValidateErr string            `json:"validation_error,omitempty"`
+		Prices      map[string]string `json:"prices,omitempty"`
+	}
+
+	type reportJSON struct {
+		Validator       string `json:"validator"`
+		Price           string `json:"price"`
+		Power           string `json:"power"`
+		CumulativePower string `json:"cumulative_power"`
+	}
+
+	type currencyPairJSON struct {
+		CurrencyPair     string       `json:"currency_pair"`
+		SubmittedPower   string       `json:"submitted_power"`
+		TotalPower       string       `json:"total_power"`
+		PercentSubmitted string       `json:"percent_submitted"`
+		Threshold        string       `json:"threshold"`
+		Price            string       `json:"price,omitempty"`
+		Reports          []reportJSON `json:"reports"`
+	}
+
+	votes := make([]voteJSON, 0, len(result.Votes))
+	for _, vote := range result.Votes {
+		v := voteJSON{
+			Validator: vote.Validator.String(),
+			Size:      vote.Size,
+			NumPrices: vote.NumPrices,
+			Included:  vote.Included(),
+		}
+		if vote.Power != nil {
+			v.Power = vote.Power.String()
+		}
+		if vote.DecodeErr != nil {
+			v.DecodeErr = vote.DecodeErr.Error()
+		}
+		if vote.ValidateErr != nil {
+			v.ValidateErr = vote.ValidateErr.Error()
+		}
+		if len(vote.Prices) > 0 {
+			v.Prices = make(map[string]string, len(vote.Prices))
+			for cp, price := range vote.Prices {
+				v.Prices[cp.String()] = price.String()
+			}
+		}
+
+		votes = append(votes, v)
+	}
+
+	currencyPairs := make([]currencyPairJSON, 0, len(result.CurrencyPairs))
+	for _, cp := range result.CurrencyPairs {
+		c := currencyPairJSON{
+			CurrencyPair:     cp.CurrencyPair.String(),
+			SubmittedPower:   cp.SubmittedPower.String(),
+			TotalPower:       cp.TotalPower.String(),
+			PercentSubmitted: cp.PercentSubmitted.String(),
+			Threshold:        cp.Threshold.String(),
+			Reports:          make([]reportJSON, 0, len(cp.Reports)),
+		}
+		if cp.Price != nil {
+			c.Price = cp.Price.String()
+		}
+
+		for _, report := range cp.Reports {
+			c.Reports = append(c.Reports, reportJSON{
+				Validator:       report.Validator.String(),
+				Price:           report.Price.String(),
+				Power:           report.Power.String(),
+				CumulativePower: report.CumulativePower.String(),
+			})
+		}
+
+		currencyPairs = append(currencyPairs, c)
+	}
+
+	return struct {
+		Round         int32              `json:"round"`
+		Votes         []voteJSON         `json:"votes"`
+		CurrencyPairs []currencyPairJSON `json:"currency_pairs"`
+	}{
+		Round:         result.Round,
+		Votes:         votes,
+		CurrencyPairs: currencyPairs,
+	}
+}
@@ -0,0 +1,403 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	cmtrpcclient "github.com/cometbft/cometbft/rpc/client"
+	cmtrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+
+	"github.com/skip-mev/connect/v2/abci/strategies/codec"
+	connectabci "github.com/skip-mev/connect/v2/abci/types"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+const (
+	// defaultPriceStrategy decodes prices encoded by the DefaultCurrencyPairStrategy.
+	defaultPriceStrategy = "default"
+
+	// deltaPriceStrategy decodes prices encoded by the DeltaCurrencyPairStrategy.
+	deltaPriceStrategy = "delta"
+
+	// deviationPriceStrategy decodes prices encoded by the DeviationCurrencyPairStrategy, and reports the prices
+	// that validators omitted.
+	deviationPriceStrategy = "deviation"
+)
+
+// voteReport is the decoded vote extension of a single validator at a given height.
+type voteReport struct {
+	Height      int64         `json:"height"`
+	Round       int32         `json:"round"`
+	Validator   string        `json:"validator"`
+	BlockIDFlag string        `json:"block_id_flag"`
+	Prices      []priceReport `json:"prices"`
+}
+
+// priceReport is a single price included in a vote extension.
+type priceReport struct {
+	ID           uint64 `json:"id"`
+	CurrencyPair string `json:"currency_pair,omitempty"`
+
+	// Price is the price scaled by the currency pair's decimals, if they are known.
+	Price string `json:"price"`
+
+	// RawPrice is the unscaled price, as written to state.
+	RawPrice string `json:"raw_price"`
+
+	// Omitted is true if the validator omitted the price, agreeing with the on-chain price reported here.
+	Omitted bool `json:"omitted,omitempty"`
+
+	raw *big.Int
+}
+
+// pairKey returns the currency pair if it is known, and the id otherwise.
+func (p priceReport) pairKey() string {
+	if p.CurrencyPair != "" {
+		return p.CurrencyPair
+	}
+
+	return fmt.Sprintf("%d", p.ID)
+}
+
+// nodeClient is the subset of the CometBFT RPC client used by the scanner.
+type nodeClient interface {
+	Block(ctx context.Context, height *int64) (*cmtrpctypes.ResultBlock, error)
+	ABCIQueryWithOptions(
+		ctx context.Context,
+		path string,
+		data cmtbytes.HexBytes,
+		opts cmtrpcclient.ABCIQueryOptions,
+	) (*cmtrpctypes.ResultABCIQuery, error)
+}
+
+// onChainQuote is the price of a currency pair in the x/oracle state at a given height.
+type onChainQuote struct {
+	price       *big.Int
+	blockHeight uint64
+	decimals    uint64
+}
+
+// scanner decodes the vote extensions included in a range of blocks.
+type scanner struct {
+	client         nodeClient
+	injection      connectabci.TxInjection
+	extCommitCodec codec.ExtendedCommitCodec
+	veCodec        codec.VoteExtensionCodec
+	priceStrategy  string
+
+	// heartbeat is the heartbeat of the deviation strategy, i.e. the number of blocks after which validators
+	// can no longer omit a price.
+	heartbeat uint64
+
+	// currencyPairs caches the mapping of currency pair ids to currency pairs, by height.
+	currencyPairs map[int64]map[uint64]connecttypes.CurrencyPair
+
+	// decimals maps currency pairs to the number of decimals their prices are represented in.
+	decimals map[connecttypes.CurrencyPair]uint64
+
+	// quotes caches the on-chain prices, by height. Currency pairs without a price are cached as nil.
+	quotes map[int64]map[connecttypes.CurrencyPair]*onChainQuote
+}
+
+// newScanner returns a scanner that locates the extended commit of each block with the given injection, and
+// resolves currency pairs and prices from the x/oracle state the vote extensions were created against.
+func newScanner(
+	client nodeClient,
+	injection connectabci.TxInjection,
+	extCommitCodec codec.ExtendedCommitCodec,
+	veCodec codec.VoteExtensionCodec,
+	priceStrategy string,
+	heartbeat uint64,
+) (*scanner, error) {
+	switch priceStrategy {
+	case defaultPriceStrategy, deltaPriceStrategy:
+	case deviationPriceStrategy:
+		if heartbeat == 0 {
+			return nil, fmt.Errorf("the %s price strategy requires a positive heartbeat", deviationPriceStrategy)
+		}
+	default:
+		return nil, fmt.Errorf("unknown price strategy %q", priceStrategy)
+	}
+
+	return &scanner{
+		client:         client,
+		injection:      injection,
+		extCommitCodec: extCommitCodec,
+		veCodec:        veCodec,
+		priceStrategy:  priceStrategy,
+		heartbeat:      heartbeat,
+		currencyPairs:  make(map[int64]map[uint64]connecttypes.CurrencyPair),
+		decimals:       make(map[connecttypes.CurrencyPair]uint64),
+		quotes:         make(map[int64]map[connecttypes.CurrencyPair]*onChainQuote),
+	}, nil
+}
+
+// query performs an x/oracle gRPC query through the node's ABCI query endpoint at the given height.
+func (s *scanner) query(ctx context.Context, method string, height int64, req, resp gogoproto.Message) error {
+	bz, err := gogoproto.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	res, err := s.client.ABCIQueryWithOptions(ctx, "/connect.oracle.v2.Query/"+method, bz, cmtrpcclient.ABCIQueryOptions{Height: height})
+	if err != nil {
+		return err
+	}
+
+	if !res.Response.IsOK() {
+		return queryError{
+			method: method,
+			code:   res.Response.Code,
+			log:    res.Response.Log,
+		}
+	}
+
+	return gogoproto.Unmarshal(res.Response.Value, resp)
+}
+
+// queryError is returned when the application rejects a query, e.g. because the currency pair has no price.
+type queryError struct {
+	method string
+	code   uint32
+	log    string
+}
+
+func (e queryError) Error() string {
+	return fmt.Sprintf("query %s failed with code %d: %s", e.method, e.code, e.log)
+}
+
+// scan returns the vote extensions included in the blocks in [from, to].
+func (s *scanner) scan(ctx context.Context, from, to int64) ([]voteReport, error) {
+	var reports []voteReport
+	for h := from; h <= to; h++ {
+		block, err := s.client.Block(ctx, &h)
+		if err != nil {
+			return nil, err
+		}
+
+		// the extended commit is only injected once vote extensions are enabled
+		_, extCommitBz, err := s.injection.Locate(block.Block.Txs.ToSliceOfBytes())
+		if errors.As(err, &connectabci.MissingCommitInfoError{}) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		extCommit, err := codec.ExtendedCommitCodecForHeight(s.extCommitCodec, h).Decode(extCommitBz)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode extended commit at height %d: %w", h, err)
+		}
+
+		for _, vote := range extCommit.Votes {
+			report := voteReport{
+				Height:      h,
+				Round:       extCommit.Round,
+				Validator:   strings.ToUpper(hex.EncodeToString(vote.Validator.Address)),
+				BlockIDFlag: vote.BlockIdFlag.String(),
+			}
+
+			if len(vote.VoteExtension) > 0 {
+				// the vote extensions were created at the previous height
+				ve, err := codec.VoteExtensionCodecForHeight(s.veCodec, h-1).Decode(vote.VoteExtension)
+				if err != nil {
+					return nil, fmt.Errorf("failed to decode vote extension of %s at height %d: %w", report.Validator, h, err)
+				}
+
+				if report.Prices, err = s.decodePrices(ctx, h, ve.Prices); err != nil {
+					return nil, err
+				}
+			}
+
+			reports = append(reports, report)
+		}
+	}
+
+	return reports, nil
+}
+
+// decodePrices decodes the prices of a vote extension included in the block at the given height. If the
+// validator participated, the prices it omitted under the deviation strategy are reported as well.
+func (s *scanner) decodePrices(ctx context.Context, height int64, prices map[uint64][]byte) ([]priceReport, error) {
+	// the vote extensions were created against the state committed at the previous height
+	currencyPairs, err := s.currencyPairsAt(ctx, height-1)
+	if err != nil {
+		return nil, err
+	}
+
+	reports := make([]priceReport, 0, len(prices))
+	for id, priceBz := range prices {
+		price := new(big.Int)
+		if err := price.GobDecode(priceBz); err != nil {
+			return nil, fmt.Errorf("failed to decode price for id %d at height %d: %w", id, height, err)
+		}
+
+		report := priceReport{ID: id}
+		cp, ok := currencyPairs[id]
+		if ok {
+			report.CurrencyPair = cp.String()
+		}
+
+		if s.priceStrategy == deltaPriceStrategy || s.priceStrategy == deviationPriceStrategy {
+			if !ok {
+				return nil, fmt.Errorf("unknown currency pair id %d at height %d; cannot decode delta price", id, height)
+			}
+
+			reference, err := s.quote(ctx, height-1, cp)
+			if err != nil {
+				return nil, err
+			}
+			if reference != nil {
+				price.Add(price, reference.price)
+			}
+		}
+
+		if report.Price, err = s.formatPrice(ctx, &report, height, cp, price); err != nil {
+			return nil, err
+		}
+
+		reports = append(reports, report)
+	}
+
+	if s.priceStrategy == deviationPriceStrategy && len(prices) > 0 {
+		for id, cp := range currencyPairs {
+			if _, ok := prices[id]; ok {
+				continue
+			}
+
+			quote, err := s.quote(ctx, height-1, cp)
+			if err != nil {
+				return nil, err
+			}
+
+			if !s.omittable(quote, height) {
+				continue
+			}
+
+			report := priceReport{
+				ID:           id,
+				CurrencyPair: cp.String(),
+				Omitted:      true,
+			}
+			if report.Price, err = s.formatPrice(ctx, &report, height, cp, new(big.Int).Set(quote.price)); err != nil {
+				return nil, err
+			}
+
+			reports = append(reports, report)
+		}
+	}
+
+	sort.Slice(reports, func(i, j int) bool { return reports[i].ID < reports[j].ID })
+	return reports, nil
+}
+
+// formatPrice sets the raw price of the report, and returns the price scaled by the currency pair's decimals if
+// they are known.
+func (s *scanner) formatPrice(
+	ctx context.Context,
+	report *priceReport,
+	height int64,
+	cp connecttypes.CurrencyPair,
+	price *big.Int,
+) (string, error) {
+	report.raw = price
+	report.RawPrice = price.String()
+	if report.CurrencyPair == "" {
+		return report.RawPrice, nil
+	}
+
+	// currency pairs without a price in state have no decimals, their prices are reported unscaled
+	decimals, ok := s.decimals[cp]
+	if !ok {
+		quote, err := s.quote(ctx, height-1, cp)
+		if err != nil || quote == nil {
+			return report.RawPrice, err
+		}
+
+		decimals = quote.decimals
+		s.decimals[cp] = decimals
+	}
+
+	return scalePrice(price, decimals), nil
+}
+
+// omittable returns true if validators could omit the given on-chain price from the vote extensions included
+// in the block at the given height, i.e. if the price is younger than the heartbeat at that height.
+func (s *scanner) omittable(quote *onChainQuote, height int64) bool {
+	if quote == nil || quote.price.Sign() <= 0 {
+		return false
+	}
+
+	return quote.blockHeight <= uint64(height) && uint64(height)-quote.blockHeight < s.heartbeat //nolint:gosec
+}
+
+// currencyPairsAt returns the mapping of currency pair ids to currency pairs in the x/oracle state at the given
+// height.
+func (s *scanner) currencyPairsAt(ctx context.Context, height int64) (map[uint64]connecttypes.CurrencyPair, error) {
+	if height < 1 {
+		return nil, nil
+	}
+
+	if currencyPairs, ok := s.currencyPairs[height]; ok {
+		return currencyPairs, nil
+	}
+
+	var mapping oracletypes.GetCurrencyPairMappingResponse
+	if err := s.query(ctx, "GetCurrencyPairMapping", height, &oracletypes.GetCurrencyPairMappingRequest{}, &mapping); err != nil {
+		return nil, fmt.Errorf("failed to query currency pair mapping at height %d: %w", height, err)
+	}
+
+	s.currencyPairs[height] = mapping.CurrencyPairMapping
+	return mapping.CurrencyPairMapping, nil
+}
+
+// quote returns the on-chain price of the currency pair in the x/oracle state at the given height, or nil if the
+// currency pair has no price. Vote extensions included at height h are created at height h-1, after the prices
+// of the proposal at height h-1 have been applied, i.e. against the state committed at height h-1, which the
+// delta strategy uses as the reference of the encoded prices.
+func (s *scanner) quote(ctx context.Context, height int64, cp connecttypes.CurrencyPair) (*onChainQuote, error) {
+	if height < 1 {
+		return nil, nil
+	}
+
+	cache, ok := s.quotes[height]
+	if !ok {
+		cache = make(map[connecttypes.CurrencyPair]*onChainQuote)
+		s.quotes[height] = cache
+	}
+
+	if quote, ok := cache[cp]; ok {
+		return quote, nil
+	}
+
+	var quote *onChainQuote
+	var resp oracletypes.GetPriceResponse
+	err := s.query(ctx, "GetPrice", height, &oracletypes.GetPriceRequest{CurrencyPair: cp.String()}, &resp)
+	switch {
+	case err == nil && resp.Price != nil:
+		quote = &onChainQuote{
+			price:       resp.Price.Price.BigInt(),
+			blockHeight: resp.Price.BlockHeight,
+			decimals:    resp.Decimals,
+		}
+	case err != nil && !errors.As(err, &queryError{}):
+		return nil, fmt.Errorf("failed to query price for %s at height %d: %w", cp, height, err)
+	}
+
+	cache[cp] = quote
+	return quote, nil
+}
+
+// scalePrice returns the price divided by 10^decimals as a decimal string.
+func scalePrice(price *big.Int, decimals uint64) string {
+	scale := new(big.Int).Exp(big.NewInt(10), new(big.Int).SetUint64(decimals), nil)
+	return new(big.Rat).SetFrac(price, scale).FloatString(int(decimals)) //nolint:gosec
+}
@@ -0,0 +1,210 @@
+package main
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/math"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmtrpcclient "github.com/cometbft/cometbft/rpc/client"
+	cmtrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/abci/strategies/codec"
+	connectabci "github.com/skip-mev/connect/v2/abci/types"
+	vetypes "github.com/skip-mev/connect/v2/abci/ve/types"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+var (
+	btcUSD = connecttypes.NewCurrencyPair("BTC", "USD")
+	ethUSD = connecttypes.NewCurrencyPair("ETH", "USD")
+	solUSD = connecttypes.NewCurrencyPair("SOL", "USD")
+)
+
+// fakeNode serves blocks and x/oracle queries by height.
+type fakeNode struct {
+	txs      map[int64][][]byte
+	mappings map[int64]map[uint64]connecttypes.CurrencyPair
+	prices   map[int64]map[connecttypes.CurrencyPair]oracletypes.QuotePrice
+	decimals map[connecttypes.CurrencyPair]uint64
+}
+
+func (n *fakeNode) Block(_ context.Context, height *int64) (*cmtrpctypes.ResultBlock, error) {
+	block := &cmttypes.Block{}
+	for _, tx := range n.txs[*height] {
+		block.Data.Txs = append(block.Data.Txs, tx)
+	}
+
+	return &cmtrpctypes.ResultBlock{Block: block}, nil
+}
+
+func (n *fakeNode) ABCIQueryWithOptions(
+	_ context.Context,
+	path string,
+	data cmtbytes.HexBytes,
+	opts cmtrpcclient.ABCIQueryOptions,
+) (*cmtrpctypes.ResultABCIQuery, error) {
+	var resp gogoproto.Message
+	switch path {
+	case "/connect.oracle.v2.Query/GetCurrencyPairMapping":
+		resp = &oracletypes.GetCurrencyPairMappingResponse{CurrencyPairMapping: n.mappings[opts.Height]}
+	case "/connect.oracle.v2.Query/GetPrice":
+		var req oracletypes.GetPriceRequest
+		if err := gogoproto.Unmarshal(data, &req); err != nil {
+			return nil, err
+		}
+
+		cp, err := connecttypes.CurrencyPairFromString(req.CurrencyPair)
+		if err != nil {
+			return nil, err
+		}
+
+		price, ok := n.prices[opts.Height][cp]
+		if !ok {
+			return &cmtrpctypes.ResultABCIQuery{Response: cmtabci.ResponseQuery{Code: 1, Log: "no price"}}, nil
+		}
+		resp = &oracletypes.GetPriceResponse{Price: &price, Decimals: n.decimals[cp]}
+	}
+
+	bz, err := gogoproto.Marshal(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cmtrpctypes.ResultABCIQuery{Response: cmtabci.ResponseQuery{Value: bz}}, nil
+}
+
+// encodeExtendedCommit encodes an extended commit with a vote extension per validator, given as id -> price.
+func encodeExtendedCommit(t *testing.T, votes map[string]map[uint64]int64) []byte {
+	t.Helper()
+
+	var extCommit cmtabci.ExtendedCommitInfo
+	for _, validator := range []string{"A", "B"} {
+		prices, ok := votes[validator]
+		if !ok {
+			continue
+		}
+
+		ve := vetypes.OracleVoteExtension{Prices: make(map[uint64][]byte)}
+		for id, price := range prices {
+			bz, err := big.NewInt(price).GobEncode()
+			require.NoError(t, err)
+			ve.Prices[id] = bz
+		}
+
+		veBz, err := codec.NewDefaultVoteExtensionCodec().Encode(ve)
+		require.NoError(t, err)
+
+		extCommit.Votes = append(extCommit.Votes, cmtabci.ExtendedVoteInfo{
+			Validator:     cmtabci.Validator{Address: []byte(validator)},
+			VoteExtension: veBz,
+			BlockIdFlag:   cmtproto.BlockIDFlagCommit,
+		})
+	}
+
+	bz, err := codec.NewDefaultExtendedCommitCodec().Encode(extCommit)
+	require.NoError(t, err)
+
+	return bz
+}
+
+func TestScan(t *testing.T) {
+	injection := connectabci.NewMarkerTxInjection([]byte("oracle"), 1)
+
+	node := &fakeNode{
+		txs: map[int64][][]byte{
+			// vote extensions are not enabled at height 1
+			1: {[]byte("tx")},
+			2: {[]byte("tx"), injection.Wrap(encodeExtendedCommit(t, map[string]map[uint64]int64{
+				"A": {0: 5},
+				"B": {},
+			}))},
+			// the extended commit is located by its marker rather than its index
+			3: {injection.Wrap(encodeExtendedCommit(t, map[string]map[uint64]int64{
+				"A": {2: 50},
+			})), []byte("tx")},
+		},
+		mappings: map[int64]map[uint64]connecttypes.CurrencyPair{
+			1: {0: btcUSD, 1: ethUSD},
+			// SOL/USD is added at height 2, and ETH/USD removed
+			2: {0: btcUSD, 2: solUSD},
+		},
+		prices: map[int64]map[connecttypes.CurrencyPair]oracletypes.QuotePrice{
+			1: {
+				btcUSD: {Price: math.NewInt(100), BlockHeight: 1},
+				ethUSD: {Price: math.NewInt(200), BlockHeight: 1},
+			},
+			2: {
+				btcUSD: {Price: math.NewInt(105), BlockHeight: 2},
+			},
+		},
+		decimals: map[connecttypes.CurrencyPair]uint64{
+			btcUSD: 1,
+			ethUSD: 2,
+		},
+	}
+
+	t.Run("decodes delta prices against the state at the previous height and reports omitted prices", func(t *testing.T) {
+		s, err := newScanner(node, injection, codec.NewDefaultExtendedCommitCodec(), codec.NewDefaultVoteExtensionCodec(), deviationPriceStrategy, 5)
+		require.NoError(t, err)
+
+		reports, err := s.scan(context.Background(), 1, 3)
+		require.NoError(t, err)
+		require.Len(t, reports, 3)
+
+		// at height 2, A reports BTC/USD and omits ETH/USD, B has an empty vote extension
+		require.Equal(t, int64(2), reports[0].Height)
+		require.Equal(t, []priceReport{
+			{ID: 0, CurrencyPair: btcUSD.String(), Price: "10.5", RawPrice: "105", raw: big.NewInt(105)},
+			{ID: 1, CurrencyPair: ethUSD.String(), Price: "2.00", RawPrice: "200", raw: big.NewInt(200), Omitted: true},
+		}, reports[0].Prices)
+		require.Equal(t, "42", reports[1].Validator)
+		require.Empty(t, reports[1].Prices)
+
+		// at height 3, the id of SOL/USD is resolved with the mapping at height 2
+		require.Equal(t, int64(3), reports[2].Height)
+		require.Equal(t, []priceReport{
+			{ID: 0, CurrencyPair: btcUSD.String(), Price: "10.5", RawPrice: "105", raw: big.NewInt(105), Omitted: true},
+			{ID: 2, CurrencyPair: solUSD.String(), Price: "50", RawPrice: "50", raw: big.NewInt(50)},
+		}, reports[2].Prices)
+	})
+
+	t.Run("the default strategy does not report omitted prices", func(t *testing.T) {
+		s, err := newScanner(node, injection, codec.NewDefaultExtendedCommitCodec(), codec.NewDefaultVoteExtensionCodec(), defaultPriceStrategy, 0)
+		require.NoError(t, err)
+
+		reports, err := s.scan(context.Background(), 2, 2)
+		require.NoError(t, err)
+		require.Len(t, reports, 2)
+		require.Equal(t, []priceReport{
+			{ID: 0, CurrencyPair: btcUSD.String(), Price: "0.5", RawPrice: "5", raw: big.NewInt(5)},
+		}, reports[0].Prices)
+	})
+
+	t.Run("ids unknown at the previous height cannot be decoded as deltas", func(t *testing.T) {
+		node.txs[4] = [][]byte{injection.Wrap(encodeExtendedCommit(t, map[string]map[uint64]int64{
+			"A": {1: 5},
+		}))}
+		defer delete(node.txs, 4)
+		node.mappings[3] = node.mappings[2]
+		defer delete(node.mappings, 3)
+
+		s, err := newScanner(node, injection, codec.NewDefaultExtendedCommitCodec(), codec.NewDefaultVoteExtensionCodec(), deltaPriceStrategy, 0)
+		require.NoError(t, err)
+
+		_, err = s.scan(context.Background(), 4, 4)
+		require.ErrorContains(t, err, "unknown currency pair id 1")
+	})
+
+	t.Run("the deviation strategy requires a heartbeat", func(t *testing.T) {
+		_, err := newScanner(node, injection, codec.NewDefaultExtendedCommitCodec(), codec.NewDefaultVoteExtensionCodec(), deviationPriceStrategy, 0)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"math/big"
+	"sort"
+)
+
+// validatorStats summarizes the quality of a validator's vote extensions over a range of heights.
+type validatorStats struct {
+	Validator string `json:"validator"`
+
+	// Heights is the number of heights at which the validator is included in the extended commit.
+	Heights int `json:"heights"`
+
+	// Participation is the fraction of those heights at which the validator submitted a non-empty vote extension.
+	Participation float64 `json:"participation"`
+
+	// MedianDeviation is the median relative deviation of the validator's prices from the median price reported
+	// by all validators for the same currency pair and height.
+	MedianDeviation float64 `json:"median_deviation"`
+
+	// MissingPairs counts, per currency pair, the heights at which the validator submitted a vote extension
+	// without a price for a currency pair that other validators reported.
+	MissingPairs map[string]int `json:"missing_pairs"`
+}
+
+// priceKey identifies the prices reported for a currency pair at a given height.
+type priceKey struct {
+	height int64
+	pair   string
+}
+
+// computeValidatorStats computes the per-validator statistics of the given vote reports. The stats are sorted
+// by validator.
+func computeValidatorStats(reports []voteReport) []validatorStats {
+	// collect the prices reported for each currency pair at each height
+	reported := make(map[priceKey][]*big.Int)
+	pairsByHeight := make(map[int64]map[string]struct{})
+	for _, report := range reports {
+		for _, price := range report.Prices {
+			key := priceKey{height: report.Height, pair: price.pairKey()}
+			reported[key] = append(reported[key], price.raw)
+
+			if _, ok := pairsByHeight[report.Height]; !ok {
+				pairsByHeight[report.Height] = make(map[string]struct{})
+			}
+			pairsByHeight[report.Height][key.pair] = struct{}{}
+		}
+	}
+
+	medians := make(map[priceKey]*big.Rat, len(reported))
+	for key, prices := range reported {
+		medians[key] = medianPrice(prices)
+	}
+
+	stats := make(map[string]*validatorStats)
+	deviations := make(map[string][]float64)
+	for _, report := range reports {
+		s, ok := stats[report.Validator]
+		if !ok {
+			s = &validatorStats{
+				Validator:    report.Validator,
+				MissingPairs: make(map[string]int),
+			}
+			stats[report.Validator] = s
+		}
+
+		s.Heights++
+		if len(report.Prices) == 0 {
+			continue
+		}
+		s.Participation++
+
+		included := make(map[string]struct{}, len(report.Prices))
+		for _, price := range report.Prices {
+			key := priceKey{height: report.Height, pair: price.pairKey()}
+			included[key.pair] = struct{}{}
+
+			median := medians[key]
+			if median.Sign() == 0 {
+				continue
+			}
+
+			deviation := new(big.Rat).Sub(new(big.Rat).SetInt(price.raw), median)
+			deviation.Quo(deviation, median).Abs(deviation)
+			value, _ := deviation.Float64()
+			deviations[report.Validator] = append(deviations[report.Validator], value)
+		}
+
+		for pair := range pairsByHeight[report.Height] {
+			if _, ok := included[pair]; !ok {
+				s.MissingPairs[pair]++
+			}
+		}
+	}
+
+	result := make([]validatorStats, 0, len(stats))
+	for validator, s := range stats {
+		s.Participation /= float64(s.Heights)
+		s.MedianDeviation = medianFloat(deviations[validator])
+		result = append(result, *s)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Validator < result[j].Validator })
+
+	return result
+}
+
+// medianPrice returns the median of the given prices. If there is an even number of prices, the mean of the two
+// middle prices is returned.
+func medianPrice(prices []*big.Int) *big.Rat {
+	sorted := make([]*big.Int, len(prices))
+	copy(sorted, prices)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Cmp(sorted[j]) < 0 })
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return new(big.Rat).SetInt(sorted[mid])
+	}
+
+	sum := new(big.Int).Add(sorted[mid-1], sorted[mid])
+	return new(big.Rat).SetFrac(sum, big.NewInt(2))
+}
+
+// medianFloat returns the median of the given values, or zero if there are none.
+func medianFloat(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+
+	sorted := make([]float64, len(values))
+	copy(sorted, values)
+	sort.Float64s(sorted)
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[mid]
+	}
+
+	return (sorted[mid-1] + sorted[mid]) / 2
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func price(pair string, value int64) priceReport {
+	return priceReport{
+		CurrencyPair: pair,
+		raw:          big.NewInt(value),
+	}
+}
+
+func TestComputeValidatorStats(t *testing.T) {
+	reports := []voteReport{
+		{Height: 1, Validator: "A", Prices: []priceReport{price("BTC/USD", 100), price("ETH/USD", 10)}},
+		{Height: 1, Validator: "B", Prices: []priceReport{price("BTC/USD", 110), price("ETH/USD", 10)}},
+		{Height: 1, Validator: "C", Prices: []priceReport{price("BTC/USD", 90)}},
+		{Height: 2, Validator: "A", Prices: []priceReport{price("BTC/USD", 100)}},
+		{Height: 2, Validator: "B", Prices: []priceReport{price("BTC/USD", 100)}},
+		{Height: 2, Validator: "C"},
+	}
+
+	stats := computeValidatorStats(reports)
+	require.Len(t, stats, 3)
+
+	// A always reports the median
+	require.Equal(t, "A", stats[0].Validator)
+	require.Equal(t, 2, stats[0].Heights)
+	require.Equal(t, 1.0, stats[0].Participation)
+	require.Equal(t, 0.0, stats[0].MedianDeviation)
+	require.Empty(t, stats[0].MissingPairs)
+
+	// B deviates by 10% once out of three prices
+	require.Equal(t, "B", stats[1].Validator)
+	require.Equal(t, 1.0, stats[1].Participation)
+	require.Equal(t, 0.0, stats[1].MedianDeviation)
+
+	// C deviates by 10%, misses ETH/USD at height 1 and submits an empty vote extension at height 2
+	require.Equal(t, "C", stats[2].Validator)
+	require.Equal(t, 2, stats[2].Heights)
+	require.Equal(t, 0.5, stats[2].Participation)
+	require.InDelta(t, 0.1, stats[2].MedianDeviation, 1e-9)
+	require.Equal(t, map[string]int{"ETH/USD": 1}, stats[2].MissingPairs)
+}
+
+func TestMedianPrice(t *testing.T) {
+	require.Equal(t, big.NewRat(2, 1), medianPrice([]*big.Int{big.NewInt(3), big.NewInt(1), big.NewInt(2)}))
+	require.Equal(t, big.NewRat(5, 2), medianPrice([]*big.Int{big.NewInt(3), big.NewInt(1), big.NewInt(2), big.NewInt(4)}))
+}
+
+func TestScalePrice(t *testing.T) {
+	require.Equal(t, "1.00000000", scalePrice(big.NewInt(100000000), 8))
+	require.Equal(t, "-0.05", scalePrice(big.NewInt(-5), 2))
+	require.Equal(t, "42", scalePrice(big.NewInt(42), 0))
+}
+
+func TestWriteValidatorStats(t *testing.T) {
+	stats := []validatorStats{
+		{
+			Validator:       "A",
+			Heights:         2,
+			Participation:   0.5,
+			MedianDeviation: 0.1,
+			MissingPairs:    map[string]int{"ETH/USD": 1, "BTC/USD": 2},
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, writeValidatorStats(&buf, csvOutput, stats))
+	require.Equal(t, "validator,heights,participation,median_deviation,missing_pairs\nA,2,0.5,0.1,BTC/USD=2;ETH/USD=1\n", buf.String())
+
+	require.Error(t, writeValidatorStats(&buf, "xml", stats))
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+
+	cmthttp "github.com/cometbft/cometbft/rpc/client/http"
+	"github.com/spf13/cobra"
+
+	"github.com/skip-mev/connect/v2/abci/strategies/codec"
+	connectabci "github.com/skip-mev/connect/v2/abci/types"
+)
+
+var (
+	trainDictionaryCmd = &cobra.Command{
+		Use:   "train-dictionary",
+		Short: "Train a zstd dictionary from the vote extensions included in a range of blocks or in captured extended commits",
+		Long: `Use as follows to train a zstd dictionary from the vote extensions included in a range of blocks:
+
+		vote-extensions-cli train-dictionary --node <http<s>://<url>:26657> --from-height <height> --to-height <height> --out <file>
+
+		Or as follows to train it from captured extended commits, without a node:
+
+		vote-extensions-cli train-dictionary --extended-commits <file>,<file>,... --out <file>
+		Where:
+			--from-height: The first height to sample (inclusive)
+			--to-height: The last height to sample (inclusive)
+			--extended-commits: The files containing the captured extended commits to sample, i.e. the injected txs of the blocks. Takes precedence over the height range
+			--extended-commit-encoding: The encoding of the extended commit files. Options are base64 (default, as returned by the block RPC), hex, raw
+			--out: The file the dictionary is written to
+			--max-size: The maximum size of the dictionary in bytes
+			--dictionary-id: The id embedded in the dictionary. If 0, a random id is used
+
+		The resulting dictionary can be shipped with the binary and passed to codec.NewZStdDictCompressor.
+		`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			injection, err := injectionFromFlags()
+			if err != nil {
+				return err
+			}
+
+			var extendedCommits [][]byte
+			if len(extendedCommitPaths) > 0 {
+				extendedCommits, err = readExtendedCommits(extendedCommitPaths, trainExtendedCommitEncoding, injection)
+				if err != nil {
+					return err
+				}
+			} else {
+				if fromHeight <= 0 || toHeight < fromHeight {
+					return fmt.Errorf("invalid height range [%d, %d]", fromHeight, toHeight)
+				}
+
+				client, err := cmthttp.New(node, "/websocket")
+				if err != nil {
+					return err
+				}
+
+				// collect the extended commits injected in each block
+				extendedCommits = make([][]byte, 0, toHeight-fromHeight+1)
+				for h := fromHeight; h <= toHeight; h++ {
+					block, err := client.Block(cmd.Context(), &h)
+					if err != nil {
+						return err
+					}
+
+					// the extended commit is only injected once vote extensions are enabled
+					_, extendedCommit, err := injection.Locate(block.Block.Txs.ToSliceOfBytes())
+					if errors.As(err, &connectabci.MissingCommitInfoError{}) {
+						continue
+					}
+					if err != nil {
+						return err
+					}
+
+					extendedCommits = append(extendedCommits, extendedCommit)
+				}
+			}
+
+			extCommitCodec, veCodec, err := codecsFromFlags(extendedCommitCodec, voteExtensionCodec)
+			if err != nil {
+				return err
+			}
+
+			samples, err := codec.VoteExtensionSamplesFromExtendedCommits(extendedCommits, extCommitCodec, veCodec)
+			if err != nil {
+				return err
+			}
+
+			dictionary, err := codec.TrainZStdDictionary(samples, maxDictionarySize, dictionaryID)
+			if err != nil {
+				return fmt.Errorf("failed to train dictionary: %w", err)
+			}
+
+			if err := os.WriteFile(dictionaryOut, dictionary, 0o600); err != nil {
+				return err
+			}
+
+			cmd.Println("Samples:", len(samples), "Dictionary size:", len(dictionary))
+
+			return nil
+		},
+	}
+
+	// Flags.
+	dictionaryOut               string
+	maxDictionarySize           int
+	dictionaryID                uint32
+	extendedCommitPaths         []string
+	trainExtendedCommitEncoding string
+)
+
+func init() {
+	trainDictionaryCmd.Flags().StringVar(&dictionaryOut, "out", "vote_extensions.dict", "The file the dictionary is written to")
+	trainDictionaryCmd.Flags().IntVar(&maxDictionarySize, "max-size", codec.DefaultZStdDictionarySize, "The maximum size of the dictionary in bytes")
+	trainDictionaryCmd.Flags().Uint32Var(&dictionaryID, "dictionary-id", 0, "The id embedded in the dictionary. If 0, a random id is used")
+	trainDictionaryCmd.Flags().StringSliceVar(&extendedCommitPaths, "extended-commits", nil, "The files containing the captured extended commits to sample. Takes precedence over the height range")
+	trainDictionaryCmd.Flags().StringVar(&trainExtendedCommitEncoding, "extended-commit-encoding", "base64", "The encoding of the extended commit files. Options are base64 (default), hex, raw")
+
+	rootCmd.AddCommand(trainDictionaryCmd)
+}
+
+// readExtendedCommits reads the extended commits from the given files in the given encoding. The files contain
+// the injected txs, from which the injection marker, if any, is stripped.
+func readExtendedCommits(paths []string, encoding string, injection connectabci.TxInjection) ([][]byte, error) {
+	extendedCommits := make([][]byte, 0, len(paths))
+	for _, path := range paths {
+		extendedCommit, err := readExtendedCommit(path, encoding)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+
+		extendedCommits = append(extendedCommits, bytes.TrimPrefix(extendedCommit, injection.Marker))
+	}
+
+	return extendedCommits, nil
+}
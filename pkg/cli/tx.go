@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+)
+
+// FlagAuthority is the address of the authority (i.e. governance) that a message is executed by. It defaults to
+// the --from address.
+const FlagAuthority = "authority"
+
+// AddTxFlags adds the tx flags and the --authority flag to the command.
+func AddTxFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagAuthority, "", "the address the message is executed by, defaults to the --from address")
+	flags.AddTxFlagsToCmd(cmd)
+}
+
+// GetAuthority returns the --authority address of the command, or the --from address if it is not set.
+func GetAuthority(cmd *cobra.Command, clientCtx client.Context) (string, error) {
+	authority, err := cmd.Flags().GetString(FlagAuthority)
+	if err != nil {
+		return "", err
+	}
+
+	if authority == "" {
+		return clientCtx.GetFromAddress().String(), nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		return "", fmt.Errorf("invalid authority: %w", err)
+	}
+
+	return authority, nil
+}
+
+// ValidateAndBroadcast validates the message locally, then generates or broadcasts the tx.
+func ValidateAndBroadcast(cmd *cobra.Command, clientCtx client.Context, msg sdk.Msg) error {
+	if m, ok := msg.(sdk.HasValidateBasic); ok {
+		if err := m.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid message: %w", err)
+		}
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
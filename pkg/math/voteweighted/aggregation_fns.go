@@ -0,0 +1,252 @@
+package voteweighted
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/connect/v2/aggregator"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+)
+
+// DefaultTrimPercentage defines the default % of stake that is discarded from each end of the
+// sorted validator prices before computing the stake-weighted trimmed mean.
+var DefaultTrimPercentage = math.LegacyNewDecWithPrec(25, 2)
+
+// ValidateTrimPercentage returns an error if the trim percentage is not in [0, 0.5).
+func ValidateTrimPercentage(trim math.LegacyDec) error {
+	if trim.IsNil() {
+		return fmt.Errorf("trim percentage must be set")
+	}
+
+	if trim.IsNegative() || trim.GTE(math.LegacyNewDecWithPrec(5, 1)) {
+		return fmt.Errorf("trim percentage must be in [0, 0.5); got %s", trim)
+	}
+
+	return nil
+}
+
+// TrimmedMeanFromContext returns a new TrimmedMean aggregate function that is parametrized by the
+// latest state of the application. An error is returned if the trim percentage is invalid.
+func TrimmedMeanFromContext(
+	logger log.Logger,
+	validatorStore ValidatorStore,
+	threshold math.LegacyDec,
+	trim math.LegacyDec,
+	opts ...Option,
+) (aggregator.AggregateFnFromContext[string, map[connecttypes.CurrencyPair]*big.Int], error) {
+	if err := ValidateTrimPercentage(trim); err != nil {
+		return nil, err
+	}
+
+	return func(ctx sdk.Context) aggregator.AggregateFn[string, map[connecttypes.CurrencyPair]*big.Int] {
+		return TrimmedMean(ctx, logger, validatorStore, threshold, trim, opts...)
+	}, nil
+}
+
+// TrimmedMean returns an aggregation function that computes the stake-weighted trimmed mean price as the
+// final deterministic oracle price for any qualifying currency pair (base, quote). A currency pair qualifies
+// under the same power threshold as Median. Given the threshold is met, the prices are sorted and the lowest and
+// highest trim % of the submitted stake are discarded. The final price is the mean of the remaining prices
+// weighted by the stake of each validator that submitted them. The trim must be in [0, 0.5); if it is not,
+// no prices are returned. Use TrimmedMeanFromContext to validate the trim up front.
+func TrimmedMean(
+	ctx sdk.Context,
+	logger log.Logger,
+	validatorStore ValidatorStore,
+	threshold math.LegacyDec,
+	trim math.LegacyDec,
+	opts ...Option,
+) aggregator.AggregateFn[string, map[connecttypes.CurrencyPair]*big.Int] {
+	if err := ValidateTrimPercentage(trim); err != nil {
+		return func(aggregator.AggregatedProviderData[string, map[connecttypes.CurrencyPair]*big.Int]) map[connecttypes.CurrencyPair]*big.Int {
+			logger.Error("invalid trim percentage; no trimmed mean prices computed", "err", err)
+			return make(map[connecttypes.CurrencyPair]*big.Int)
+		}
+	}
+
+	return func(providers aggregator.AggregatedProviderData[string, map[connecttypes.CurrencyPair]*big.Int]) map[connecttypes.CurrencyPair]*big.Int {
+		priceInfo := GetPriceInfo(ctx, logger, validatorStore, threshold, providers, opts...)
+
+		prices := make(map[connecttypes.CurrencyPair]*big.Int, len(priceInfo))
+		for currencyPair, info := range priceInfo {
+			price := ComputeTrimmedMean(info, trim)
+			if price == nil {
+				continue
+			}
+			prices[currencyPair] = price
+
+			logger.Debug(
+				"computed stake-weighted trimmed mean price for currency pair",
+				"currency_pair", currencyPair.String(),
+				"trim", trim.String(),
+				"final_price", prices[currencyPair].String(),
+				"num_validators", len(info.Prices),
+			)
+		}
+
+		return prices
+	}
+}
+
+// MedianWithQuorumFromContext returns a new MedianWithQuorum aggregate function that is parametrized
+// by the latest state of the application.
+func MedianWithQuorumFromContext(
+	logger log.Logger,
+	validatorStore ValidatorStore,
+	threshold math.LegacyDec,
+	minValidators int,
+	opts ...Option,
+) aggregator.AggregateFnFromContext[string, map[connecttypes.CurrencyPair]*big.Int] {
+	return func(ctx sdk.Context) aggregator.AggregateFn[string, map[connecttypes.CurrencyPair]*big.Int] {
+		return MedianWithQuorum(ctx, logger, validatorStore, threshold, minValidators, opts...)
+	}
+}
+
+// MedianWithQuorum returns an aggregation function that computes the stake weighted median price in the same
+// manner as Median. In addition to the power threshold, a currency pair only qualifies if prices were submitted
+// by at least minValidators distinct validators. This prevents a small number of validators with a large
+// share of stake from determining the price on their own.
+func MedianWithQuorum(
+	ctx sdk.Context,
+	logger log.Logger,
+	validatorStore ValidatorStore,
+	threshold math.LegacyDec,
+	minValidators int,
+	opts ...Option,
+) aggregator.AggregateFn[string, map[connecttypes.CurrencyPair]*big.Int] {
+	return func(providers aggregator.AggregatedProviderData[string, map[connecttypes.CurrencyPair]*big.Int]) map[connecttypes.CurrencyPair]*big.Int {
+		priceInfo := GetPriceInfo(ctx, logger, validatorStore, threshold, providers, opts...)
+
+		prices := make(map[connecttypes.CurrencyPair]*big.Int, len(priceInfo))
+		for currencyPair, info := range priceInfo {
+			if len(info.Prices) < minValidators {
+				logger.Debug(
+					"not enough validators to compute stake-weighted median price for currency pair",
+					"currency_pair", currencyPair.String(),
+					"min_validators", minValidators,
+					"num_validators", len(info.Prices),
+				)
+
+				continue
+			}
+
+			prices[currencyPair] = ComputeMedian(info)
+
+			logger.Debug(
+				"computed stake-weighted median price with quorum for currency pair",
+				"currency_pair", currencyPair.String(),
+				"final_price", prices[currencyPair].String(),
+				"num_validators", len(info.Prices),
+			)
+		}
+
+		return prices
+	}
+}
+
+// EqualWeightMedianFromContext returns a new EqualWeightMedian aggregate function that is parametrized
+// by the latest state of the application.
+func EqualWeightMedianFromContext(
+	logger log.Logger,
+	validatorStore ValidatorStore,
+	threshold math.LegacyDec,
+	opts ...Option,
+) aggregator.AggregateFnFromContext[string, map[connecttypes.CurrencyPair]*big.Int] {
+	return func(ctx sdk.Context) aggregator.AggregateFn[string, map[connecttypes.CurrencyPair]*big.Int] {
+		return EqualWeightMedian(ctx, logger, validatorStore, threshold, opts...)
+	}
+}
+
+// EqualWeightMedian returns an aggregation function that computes the median price across validators where
+// every validator's price carries the same weight, regardless of its stake. This is intended for permissioned
+// chains where stake does not reflect the trust placed in each validator. A currency pair still only qualifies
+// if the power threshold is met.
+func EqualWeightMedian(
+	ctx sdk.Context,
+	logger log.Logger,
+	validatorStore ValidatorStore,
+	threshold math.LegacyDec,
+	opts ...Option,
+) aggregator.AggregateFn[string, map[connecttypes.CurrencyPair]*big.Int] {
+	return func(providers aggregator.AggregatedProviderData[string, map[connecttypes.CurrencyPair]*big.Int]) map[connecttypes.CurrencyPair]*big.Int {
+		priceInfo := GetPriceInfo(ctx, logger, validatorStore, threshold, providers, opts...)
+
+		prices := make(map[connecttypes.CurrencyPair]*big.Int, len(priceInfo))
+		for currencyPair, info := range priceInfo {
+			prices[currencyPair] = ComputeEqualWeightMedian(info)
+
+			logger.Debug(
+				"computed equal-weight median price for currency pair",
+				"currency_pair", currencyPair.String(),
+				"final_price", prices[currencyPair].String(),
+				"num_validators", len(info.Prices),
+			)
+		}
+
+		return prices
+	}
+}
+
+// ComputeEqualWeightMedian computes the median price for a given asset where every price carries the same
+// weight. In the case of an even number of prices, the lower of the two middle prices is selected, which is
+// consistent with ComputeMedian for validators with equal stake.
+func ComputeEqualWeightMedian(priceInfo PriceInfo) *big.Int {
+	if len(priceInfo.Prices) == 0 {
+		return nil
+	}
+
+	// Sort the prices by price.
+	sort.SliceStable(priceInfo.Prices, func(i, j int) bool {
+		return priceInfo.Prices[i].Price.Cmp(priceInfo.Prices[j].Price) < 0
+	})
+
+	return priceInfo.Prices[(len(priceInfo.Prices)-1)/2].Price
+}
+
+// ComputeTrimmedMean computes the stake-weighted trimmed mean price for a given asset. The lowest and
+// highest trim % of the total weight are discarded, where a validator's weight may be partially
+// discarded if it straddles a cut-off. The mean is truncated towards zero.
+func ComputeTrimmedMean(priceInfo PriceInfo, trim math.LegacyDec) *big.Int {
+	if len(priceInfo.Prices) == 0 {
+		return nil
+	}
+
+	// Sort the prices by price.
+	sort.SliceStable(priceInfo.Prices, func(i, j int) bool {
+		return priceInfo.Prices[i].Price.Cmp(priceInfo.Prices[j].Price) < 0
+	})
+
+	// Compute the range of cumulative weight that is retained.
+	lower := math.LegacyNewDecFromInt(priceInfo.TotalWeight).Mul(trim).TruncateInt()
+	upper := priceInfo.TotalWeight.Sub(lower)
+
+	weightedSum := new(big.Int)
+	retainedWeight := math.ZeroInt()
+
+	cumulative := math.ZeroInt()
+	for _, price := range priceInfo.Prices {
+		start := cumulative
+		cumulative = cumulative.Add(price.VoteWeight)
+
+		// The retained weight of this price is the overlap of [start, cumulative] with [lower, upper].
+		weight := math.MinInt(cumulative, upper).Sub(math.MaxInt(start, lower))
+		if !weight.IsPositive() {
+			continue
+		}
+
+		weightedSum.Add(weightedSum, new(big.Int).Mul(price.Price, weight.BigInt()))
+		retainedWeight = retainedWeight.Add(weight)
+	}
+
+	// If all weight was discarded (i.e. the total weight is zero), fall back to the median.
+	if retainedWeight.IsZero() {
+		return ComputeMedian(priceInfo)
+	}
+
+	return weightedSum.Quo(weightedSum, retainedWeight.BigInt())
+}
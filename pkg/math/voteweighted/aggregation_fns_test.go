@@ -0,0 +1,457 @@
+package voteweighted_test
+
+import (
+	"math/big"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/skip-mev/connect/v2/aggregator"
+	"github.com/skip-mev/connect/v2/pkg/math/voteweighted"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+)
+
+var (
+	btcUSD = connecttypes.CurrencyPair{
+		Base:  "BTC",
+		Quote: "USD",
+	}
+	ethUSD = connecttypes.CurrencyPair{
+		Base:  "ETH",
+		Quote: "USD",
+	}
+)
+
+func (s *MathTestSuite) TestTrimmedMean() {
+	cases := []struct {
+		name              string
+		providerPrices    aggregator.AggregatedProviderData[string, map[connecttypes.CurrencyPair]*big.Int]
+		validators        []validator
+		totalBondedTokens sdkmath.Int
+		trim              sdkmath.LegacyDec
+		expectedPrices    map[connecttypes.CurrencyPair]*big.Int
+	}{
+		{
+			name:           "no providers",
+			providerPrices: aggregator.AggregatedProviderData[string, map[connecttypes.CurrencyPair]*big.Int]{},
+			validators: []validator{
+				{
+					stake:    sdkmath.NewInt(100),
+					consAddr: validator1,
+				},
+			},
+			totalBondedTokens: sdkmath.NewInt(100),
+			trim:              voteweighted.DefaultTrimPercentage,
+			expectedPrices:    map[connecttypes.CurrencyPair]*big.Int{},
+		},
+		{
+			name: "single provider with not enough stake",
+			providerPrices: aggregator.AggregatedProviderData[string, map[connecttypes.CurrencyPair]*big.Int]{
+				validator1.String(): {
+					btcUSD: big.NewInt(100),
+				},
+			},
+			validators: []validator{
+				{
+					stake:    sdkmath.NewInt(50),
+					consAddr: validator1,
+				},
+				{
+					stake:    sdkmath.NewInt(50),
+					consAddr: validator2,
+				},
+			},
+			totalBondedTokens: sdkmath.NewInt(100),
+			trim:              voteweighted.DefaultTrimPercentage,
+			expectedPrices:    map[connecttypes.CurrencyPair]*big.Int{},
+		},
+		{
+			name: "single provider entire stake",
+			providerPrices: aggregator.AggregatedProviderData[string, map[connecttypes.CurrencyPair]*big.Int]{
+				validator1.String(): {
+					btcUSD: big.NewInt(100),
+				},
+			},
+			validators: []validator{
+				{
+					stake:    sdkmath.NewInt(100),
+					consAddr: validator1,
+				},
+			},
+			totalBondedTokens: sdkmath.NewInt(100),
+			trim:              voteweighted.DefaultTrimPercentage,
+			expectedPrices: map[connecttypes.CurrencyPair]*big.Int{
+				btcUSD: big.NewInt(100),
+			},
+		},
+		{
+			name: "no trim is the stake-weighted mean",
+			providerPrices: aggregator.AggregatedProviderData[string, map[connecttypes.CurrencyPair]*big.Int]{
+				validator1.String(): {
+					btcUSD: big.NewInt(100),
+				},
+				validator2.String(): {
+					btcUSD: big.NewInt(200),
+				},
+			},
+			validators: []validator{
+				{
+					stake:    sdkmath.NewInt(25),
+					consAddr: validator1,
+				},
+				{
+					stake:    sdkmath.NewInt(75),
+					consAddr: validator2,
+				},
+			},
+			totalBondedTokens: sdkmath.NewInt(100),
+			trim:              sdkmath.LegacyZeroDec(),
+			expectedPrices: map[connecttypes.CurrencyPair]*big.Int{
+				btcUSD: big.NewInt(175),
+			},
+		},
+		{
+			name: "outliers are trimmed with equal stake",
+			providerPrices: aggregator.AggregatedProviderData[string, map[connecttypes.CurrencyPair]*big.Int]{
+				validator1.String(): {
+					btcUSD: big.NewInt(1),
+				},
+				validator2.String(): {
+					btcUSD: big.NewInt(200),
+				},
+				validator3.String(): {
+					btcUSD: big.NewInt(300),
+				},
+				validator4.String(): {
+					btcUSD: big.NewInt(10000),
+				},
+			},
+			validators: []validator{
+				{
+					stake:    sdkmath.NewInt(25),
+					consAddr: validator1,
+				},
+				{
+					stake:    sdkmath.NewInt(25),
+					consAddr: validator2,
+				},
+				{
+					stake:    sdkmath.NewInt(25),
+					consAddr: validator3,
+				},
+				{
+					stake:    sdkmath.NewInt(25),
+					consAddr: validator4,
+				},
+			},
+			totalBondedTokens: sdkmath.NewInt(100),
+			trim:              voteweighted.DefaultTrimPercentage,
+			expectedPrices: map[connecttypes.CurrencyPair]*big.Int{
+				btcUSD: big.NewInt(250),
+			},
+		},
+		{
+			name: "stake straddling a cut-off is partially trimmed + multiple assets",
+			providerPrices: aggregator.AggregatedProviderData[string, map[connecttypes.CurrencyPair]*big.Int]{
+				validator1.String(): {
+					btcUSD: big.NewInt(100),
+					ethUSD: big.NewInt(10),
+				},
+				validator2.String(): {
+					btcUSD: big.NewInt(200),
+					ethUSD: big.NewInt(10),
+				},
+				validator3.String(): {
+					btcUSD: big.NewInt(300),
+					ethUSD: big.NewInt(10),
+				},
+			},
+			validators: []validator{
+				{
+					stake:    sdkmath.NewInt(10),
+					consAddr: validator1,
+				},
+				{
+					stake:    sdkmath.NewInt(40),
+					consAddr: validator2,
+				},
+				{
+					stake:    sdkmath.NewInt(50),
+					consAddr: validator3,
+				},
+			},
+			totalBondedTokens: sdkmath.NewInt(100),
+			trim:              voteweighted.DefaultTrimPercentage,
+			expectedPrices: map[connecttypes.CurrencyPair]*big.Int{
+				btcUSD: big.NewInt(250),
+				ethUSD: big.NewInt(10),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			mockValidatorStore := s.createMockValidatorStore(tc.validators, tc.totalBondedTokens)
+			ccvConsumerCompatKeeper := s.createMockCCVConsumerCompatKeeper(tc.validators)
+
+			defaultResult := voteweighted.TrimmedMean(s.ctx, log.NewTestLogger(s.T()), mockValidatorStore, voteweighted.DefaultPowerThreshold, tc.trim)(tc.providerPrices)
+			ccvResult := voteweighted.TrimmedMean(s.ctx, log.NewTestLogger(s.T()), ccvConsumerCompatKeeper, voteweighted.DefaultPowerThreshold, tc.trim)(tc.providerPrices)
+
+			s.Require().Len(defaultResult, len(tc.expectedPrices))
+			s.Require().Len(ccvResult, len(tc.expectedPrices))
+			for currencyPair, expectedPrice := range tc.expectedPrices {
+				s.Require().Equal(expectedPrice.String(), defaultResult[currencyPair].String())
+				s.Require().Equal(expectedPrice.String(), ccvResult[currencyPair].String())
+			}
+		})
+	}
+
+	s.Run("invalid trim returns an error", func() {
+		mockValidatorStore := s.createMockValidatorStore(nil, sdkmath.NewInt(100))
+
+		for _, trim := range []sdkmath.LegacyDec{sdkmath.LegacyNewDecWithPrec(5, 1), sdkmath.LegacyNewDec(-1), {}} {
+			_, err := voteweighted.TrimmedMeanFromContext(log.NewTestLogger(s.T()), mockValidatorStore, voteweighted.DefaultPowerThreshold, trim)
+			s.Require().Error(err)
+		}
+
+		fn, err := voteweighted.TrimmedMeanFromContext(log.NewTestLogger(s.T()), mockValidatorStore, voteweighted.DefaultPowerThreshold, voteweighted.DefaultTrimPercentage)
+		s.Require().NoError(err)
+		s.Require().NotNil(fn)
+	})
+
+	s.Run("invalid trim computes no prices", func() {
+		mockValidatorStore := s.createMockValidatorStore(nil, sdkmath.NewInt(100))
+
+		s.Require().NotPanics(func() {
+			prices := voteweighted.TrimmedMean(s.ctx, log.NewTestLogger(s.T()), mockValidatorStore, voteweighted.DefaultPowerThreshold, sdkmath.LegacyNewDecWithPrec(5, 1))(nil)
+			s.Require().Empty(prices)
+		})
+	})
+}
+
+func (s *MathTestSuite) TestMedianWithQuorum() {
+	cases := []struct {
+		name              string
+		providerPrices    aggregator.AggregatedProviderData[string, map[connecttypes.CurrencyPair]*big.Int]
+		validators        []validator
+		totalBondedTokens sdkmath.Int
+		minValidators     int
+		expectedPrices    map[connecttypes.CurrencyPair]*big.Int
+	}{
+		{
+			name: "single provider entire stake but not enough validators",
+			providerPrices: aggregator.AggregatedProviderData[string, map[connecttypes.CurrencyPair]*big.Int]{
+				validator1.String(): {
+					btcUSD: big.NewInt(100),
+				},
+			},
+			validators: []validator{
+				{
+					stake:    sdkmath.NewInt(100),
+					consAddr: validator1,
+				},
+			},
+			totalBondedTokens: sdkmath.NewInt(100),
+			minValidators:     2,
+			expectedPrices:    map[connecttypes.CurrencyPair]*big.Int{},
+		},
+		{
+			name: "enough validators but not enough stake",
+			providerPrices: aggregator.AggregatedProviderData[string, map[connecttypes.CurrencyPair]*big.Int]{
+				validator1.String(): {
+					btcUSD: big.NewInt(100),
+				},
+				validator2.String(): {
+					btcUSD: big.NewInt(200),
+				},
+			},
+			validators: []validator{
+				{
+					stake:    sdkmath.NewInt(10),
+					consAddr: validator1,
+				},
+				{
+					stake:    sdkmath.NewInt(10),
+					consAddr: validator2,
+				},
+				{
+					stake:    sdkmath.NewInt(80),
+					consAddr: validator3,
+				},
+			},
+			totalBondedTokens: sdkmath.NewInt(100),
+			minValidators:     2,
+			expectedPrices:    map[connecttypes.CurrencyPair]*big.Int{},
+		},
+		{
+			name: "quorum is met for one asset but not the other",
+			providerPrices: aggregator.AggregatedProviderData[string, map[connecttypes.CurrencyPair]*big.Int]{
+				validator1.String(): {
+					btcUSD: big.NewInt(100),
+					ethUSD: big.NewInt(10),
+				},
+				validator2.String(): {
+					btcUSD: big.NewInt(200),
+				},
+				validator3.String(): {
+					btcUSD: big.NewInt(300),
+				},
+			},
+			validators: []validator{
+				{
+					stake:    sdkmath.NewInt(80),
+					consAddr: validator1,
+				},
+				{
+					stake:    sdkmath.NewInt(10),
+					consAddr: validator2,
+				},
+				{
+					stake:    sdkmath.NewInt(10),
+					consAddr: validator3,
+				},
+			},
+			totalBondedTokens: sdkmath.NewInt(100),
+			minValidators:     3,
+			expectedPrices: map[connecttypes.CurrencyPair]*big.Int{
+				btcUSD: big.NewInt(100),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			mockValidatorStore := s.createMockValidatorStore(tc.validators, tc.totalBondedTokens)
+			ccvConsumerCompatKeeper := s.createMockCCVConsumerCompatKeeper(tc.validators)
+
+			defaultResult := voteweighted.MedianWithQuorum(s.ctx, log.NewTestLogger(s.T()), mockValidatorStore, voteweighted.DefaultPowerThreshold, tc.minValidators)(tc.providerPrices)
+			ccvResult := voteweighted.MedianWithQuorum(s.ctx, log.NewTestLogger(s.T()), ccvConsumerCompatKeeper, voteweighted.DefaultPowerThreshold, tc.minValidators)(tc.providerPrices)
+
+			s.Require().Len(defaultResult, len(tc.expectedPrices))
+			s.Require().Len(ccvResult, len(tc.expectedPrices))
+			for currencyPair, expectedPrice := range tc.expectedPrices {
+				s.Require().Equal(expectedPrice, defaultResult[currencyPair])
+				s.Require().Equal(expectedPrice, ccvResult[currencyPair])
+			}
+		})
+	}
+}
+
+func (s *MathTestSuite) TestEqualWeightMedian() {
+	cases := []struct {
+		name              string
+		providerPrices    aggregator.AggregatedProviderData[string, map[connecttypes.CurrencyPair]*big.Int]
+		validators        []validator
+		totalBondedTokens sdkmath.Int
+		expectedPrices    map[connecttypes.CurrencyPair]*big.Int
+	}{
+		{
+			name: "single provider with not enough stake",
+			providerPrices: aggregator.AggregatedProviderData[string, map[connecttypes.CurrencyPair]*big.Int]{
+				validator1.String(): {
+					btcUSD: big.NewInt(100),
+				},
+			},
+			validators: []validator{
+				{
+					stake:    sdkmath.NewInt(50),
+					consAddr: validator1,
+				},
+				{
+					stake:    sdkmath.NewInt(50),
+					consAddr: validator2,
+				},
+			},
+			totalBondedTokens: sdkmath.NewInt(100),
+			expectedPrices:    map[connecttypes.CurrencyPair]*big.Int{},
+		},
+		{
+			name: "stake does not affect the selected price",
+			providerPrices: aggregator.AggregatedProviderData[string, map[connecttypes.CurrencyPair]*big.Int]{
+				validator1.String(): {
+					btcUSD: big.NewInt(100),
+				},
+				validator2.String(): {
+					btcUSD: big.NewInt(200),
+				},
+				validator3.String(): {
+					btcUSD: big.NewInt(300),
+				},
+			},
+			validators: []validator{
+				{
+					stake:    sdkmath.NewInt(10),
+					consAddr: validator1,
+				},
+				{
+					stake:    sdkmath.NewInt(10),
+					consAddr: validator2,
+				},
+				{
+					stake:    sdkmath.NewInt(80),
+					consAddr: validator3,
+				},
+			},
+			totalBondedTokens: sdkmath.NewInt(100),
+			expectedPrices: map[connecttypes.CurrencyPair]*big.Int{
+				btcUSD: big.NewInt(200),
+			},
+		},
+		{
+			name: "even number of validators selects the lower middle price",
+			providerPrices: aggregator.AggregatedProviderData[string, map[connecttypes.CurrencyPair]*big.Int]{
+				validator1.String(): {
+					btcUSD: big.NewInt(400),
+				},
+				validator2.String(): {
+					btcUSD: big.NewInt(300),
+				},
+				validator3.String(): {
+					btcUSD: big.NewInt(200),
+				},
+				validator4.String(): {
+					btcUSD: big.NewInt(100),
+				},
+			},
+			validators: []validator{
+				{
+					stake:    sdkmath.NewInt(70),
+					consAddr: validator1,
+				},
+				{
+					stake:    sdkmath.NewInt(10),
+					consAddr: validator2,
+				},
+				{
+					stake:    sdkmath.NewInt(10),
+					consAddr: validator3,
+				},
+				{
+					stake:    sdkmath.NewInt(10),
+					consAddr: validator4,
+				},
+			},
+			totalBondedTokens: sdkmath.NewInt(100),
+			expectedPrices: map[connecttypes.CurrencyPair]*big.Int{
+				btcUSD: big.NewInt(200),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			mockValidatorStore := s.createMockValidatorStore(tc.validators, tc.totalBondedTokens)
+			ccvConsumerCompatKeeper := s.createMockCCVConsumerCompatKeeper(tc.validators)
+
+			defaultResult := voteweighted.EqualWeightMedian(s.ctx, log.NewTestLogger(s.T()), mockValidatorStore, voteweighted.DefaultPowerThreshold)(tc.providerPrices)
+			ccvResult := voteweighted.EqualWeightMedian(s.ctx, log.NewTestLogger(s.T()), ccvConsumerCompatKeeper, voteweighted.DefaultPowerThreshold)(tc.providerPrices)
+
+			s.Require().Len(defaultResult, len(tc.expectedPrices))
+			s.Require().Len(ccvResult, len(tc.expectedPrices))
+			for currencyPair, expectedPrice := range tc.expectedPrices {
+				s.Require().Equal(expectedPrice, defaultResult[currencyPair])
+				s.Require().Equal(expectedPrice, ccvResult[currencyPair])
+			}
+		})
+	}
+}
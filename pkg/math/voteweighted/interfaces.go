@@ -7,6 +7,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/cosmos/interchain-security/v6/x/ccv/consumer/types"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
 )
 
 // ValidatorStore defines the interface contract required for calculating stake-weighted median
@@ -26,3 +28,12 @@ type CCValidatorStore interface {
 	GetAllCCValidator(ctx sdk.Context) []types.CrossChainValidator
 	GetCCValidator(ctx sdk.Context, addr []byte) (types.CrossChainValidator, bool)
 }
+
+// PowerThresholdStore defines the interface contract required for retrieving a per-market voting power
+// threshold. The boolean returned is false if no threshold is configured for the given currency pair, and
+// an error is returned if the threshold cannot be retrieved.
+//
+//go:generate mockery --name PowerThresholdStore --filename mock_power_threshold_store.go
+type PowerThresholdStore interface {
+	GetPowerThreshold(ctx context.Context, cp connecttypes.CurrencyPair) (math.LegacyDec, bool, error)
+}
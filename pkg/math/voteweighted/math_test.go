@@ -2,6 +2,7 @@ package voteweighted_test
 
 import (
 	"crypto"
+	"fmt"
 	"math/big"
 	"testing"
 
@@ -35,6 +36,7 @@ var (
 	validator1 = sdk.ConsAddress("validator1")
 	validator2 = sdk.ConsAddress("validator2")
 	validator3 = sdk.ConsAddress("validator3")
+	validator4 = sdk.ConsAddress("validator4")
 )
 
 func (s *MathTestSuite) SetupTest() {
@@ -304,6 +306,137 @@ func (s *MathTestSuite) TestMedian() {
 	}
 }
 
+func (s *MathTestSuite) TestMedianWithPowerThresholdStore() {
+	validators := []validator{
+		{
+			stake:    sdkmath.NewInt(50),
+			consAddr: validator1,
+		},
+		{
+			stake:    sdkmath.NewInt(30),
+			consAddr: validator2,
+		},
+		{
+			stake:    sdkmath.NewInt(20),
+			consAddr: validator3,
+		},
+	}
+
+	cases := []struct {
+		name           string
+		providerPrices aggregator.AggregatedProviderData[string, map[connecttypes.CurrencyPair]*big.Int]
+		thresholds     map[connecttypes.CurrencyPair]sdkmath.LegacyDec
+		thresholdErrs  map[connecttypes.CurrencyPair]error
+		expectedPrices map[connecttypes.CurrencyPair]*big.Int
+	}{
+		{
+			name: "no per-market threshold falls back to the global threshold",
+			providerPrices: aggregator.AggregatedProviderData[string, map[connecttypes.CurrencyPair]*big.Int]{
+				validator1.String(): {
+					btcUSD: big.NewInt(100),
+					ethUSD: big.NewInt(200),
+				},
+				validator2.String(): {
+					btcUSD: big.NewInt(110),
+				},
+			},
+			thresholds: map[connecttypes.CurrencyPair]sdkmath.LegacyDec{},
+			expectedPrices: map[connecttypes.CurrencyPair]*big.Int{ // eth/usd only has 50% of stake
+				btcUSD: big.NewInt(100),
+			},
+		},
+		{
+			name: "lower per-market threshold includes a price the global threshold would exclude",
+			providerPrices: aggregator.AggregatedProviderData[string, map[connecttypes.CurrencyPair]*big.Int]{
+				validator1.String(): {
+					btcUSD: big.NewInt(100),
+					ethUSD: big.NewInt(200),
+				},
+			},
+			thresholds: map[connecttypes.CurrencyPair]sdkmath.LegacyDec{
+				ethUSD: sdkmath.LegacyNewDecWithPrec(5, 1),
+			},
+			expectedPrices: map[connecttypes.CurrencyPair]*big.Int{
+				ethUSD: big.NewInt(200),
+			},
+		},
+		{
+			name: "higher per-market threshold excludes a price the global threshold would include",
+			providerPrices: aggregator.AggregatedProviderData[string, map[connecttypes.CurrencyPair]*big.Int]{
+				validator1.String(): {
+					btcUSD: big.NewInt(100),
+					ethUSD: big.NewInt(200),
+				},
+				validator2.String(): {
+					btcUSD: big.NewInt(110),
+					ethUSD: big.NewInt(210),
+				},
+			},
+			thresholds: map[connecttypes.CurrencyPair]sdkmath.LegacyDec{
+				btcUSD: sdkmath.LegacyNewDecWithPrec(9, 1),
+			},
+			expectedPrices: map[connecttypes.CurrencyPair]*big.Int{
+				ethUSD: big.NewInt(200),
+			},
+		},
+		{
+			name: "currency pairs whose threshold cannot be retrieved are skipped",
+			providerPrices: aggregator.AggregatedProviderData[string, map[connecttypes.CurrencyPair]*big.Int]{
+				validator1.String(): {
+					btcUSD: big.NewInt(100),
+					ethUSD: big.NewInt(200),
+				},
+				validator2.String(): {
+					btcUSD: big.NewInt(110),
+					ethUSD: big.NewInt(210),
+				},
+			},
+			thresholds: map[connecttypes.CurrencyPair]sdkmath.LegacyDec{},
+			thresholdErrs: map[connecttypes.CurrencyPair]error{
+				btcUSD: fmt.Errorf("store error"),
+			},
+			expectedPrices: map[connecttypes.CurrencyPair]*big.Int{
+				ethUSD: big.NewInt(200),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			thresholdStore := mocks.NewPowerThresholdStore(s.T())
+			for _, cp := range []connecttypes.CurrencyPair{btcUSD, ethUSD} {
+				threshold, ok := tc.thresholds[cp]
+				thresholdStore.On("GetPowerThreshold", s.ctx, cp).Return(threshold, ok, tc.thresholdErrs[cp]).Maybe()
+			}
+
+			mockValidatorStore := s.createMockValidatorStore(validators, sdkmath.NewInt(100))
+			ccvConsumerCompatKeeper := s.createMockCCVConsumerCompatKeeper(validators)
+
+			defaultResult := voteweighted.Median(
+				s.ctx,
+				log.NewTestLogger(s.T()),
+				mockValidatorStore,
+				voteweighted.DefaultPowerThreshold,
+				voteweighted.WithPowerThresholdStore(thresholdStore),
+			)(tc.providerPrices)
+			ccvResult := voteweighted.Median(
+				s.ctx,
+				log.NewTestLogger(s.T()),
+				ccvConsumerCompatKeeper,
+				voteweighted.DefaultPowerThreshold,
+				voteweighted.WithPowerThresholdStore(thresholdStore),
+			)(tc.providerPrices)
+
+			s.Require().Len(defaultResult, len(tc.expectedPrices))
+			s.Require().Len(ccvResult, len(tc.expectedPrices))
+			for currencyPair, expectedPrice := range tc.expectedPrices {
+				s.Require().Equal(expectedPrice, defaultResult[currencyPair])
+				s.Require().Equal(expectedPrice, ccvResult[currencyPair])
+			}
+		})
+	}
+}
+
 func (s *MathTestSuite) TestComputeMedian() {
 	cases := []struct {
 		name      string
@@ -405,6 +538,249 @@ func (s *MathTestSuite) TestComputeMedian() {
 	}
 }
 
+func (s *MathTestSuite) TestSpread() {
+	btcusd := connecttypes.NewCurrencyPair("BTC", "USD")
+
+	cases := []struct {
+		name              string
+		providerPrices    aggregator.AggregatedProviderData[string, map[connecttypes.CurrencyPair]*big.Int]
+		validators        []validator
+		totalBondedTokens sdkmath.Int
+		expectedSpreads   map[connecttypes.CurrencyPair]*big.Int
+	}{
+		{
+			name:           "no providers",
+			providerPrices: aggregator.AggregatedProviderData[string, map[connecttypes.CurrencyPair]*big.Int]{},
+			validators: []validator{
+				{
+					stake:    sdkmath.NewInt(100),
+					consAddr: validator1,
+				},
+			},
+			totalBondedTokens: sdkmath.NewInt(100),
+			expectedSpreads:   map[connecttypes.CurrencyPair]*big.Int{},
+		},
+		{
+			name: "single provider entire stake has no spread",
+			providerPrices: aggregator.AggregatedProviderData[string, map[connecttypes.CurrencyPair]*big.Int]{
+				validator1.String(): {
+					btcusd: big.NewInt(100),
+				},
+			},
+			validators: []validator{
+				{
+					stake:    sdkmath.NewInt(100),
+					consAddr: validator1,
+				},
+			},
+			totalBondedTokens: sdkmath.NewInt(100),
+			expectedSpreads: map[connecttypes.CurrencyPair]*big.Int{
+				btcusd: big.NewInt(0),
+			},
+		},
+		{
+			name: "single provider with not enough stake",
+			providerPrices: aggregator.AggregatedProviderData[string, map[connecttypes.CurrencyPair]*big.Int]{
+				validator1.String(): {
+					btcusd: big.NewInt(100),
+				},
+			},
+			validators: []validator{
+				{
+					stake:    sdkmath.NewInt(50),
+					consAddr: validator1,
+				},
+				{
+					stake:    sdkmath.NewInt(50),
+					consAddr: validator2,
+				},
+			},
+			totalBondedTokens: sdkmath.NewInt(100),
+			expectedSpreads:   map[connecttypes.CurrencyPair]*big.Int{},
+		},
+		{
+			name: "4 providers with equal stake",
+			providerPrices: aggregator.AggregatedProviderData[string, map[connecttypes.CurrencyPair]*big.Int]{
+				validator1.String(): {
+					btcusd: big.NewInt(100),
+				},
+				validator2.String(): {
+					btcusd: big.NewInt(200),
+				},
+				validator3.String(): {
+					btcusd: big.NewInt(300),
+				},
+				validator4.String(): {
+					btcusd: big.NewInt(400),
+				},
+			},
+			validators: []validator{
+				{
+					stake:    sdkmath.NewInt(25),
+					consAddr: validator1,
+				},
+				{
+					stake:    sdkmath.NewInt(25),
+					consAddr: validator2,
+				},
+				{
+					stake:    sdkmath.NewInt(25),
+					consAddr: validator3,
+				},
+				{
+					stake:    sdkmath.NewInt(25),
+					consAddr: validator4,
+				},
+			},
+			totalBondedTokens: sdkmath.NewInt(100),
+			expectedSpreads: map[connecttypes.CurrencyPair]*big.Int{
+				btcusd: big.NewInt(200),
+			},
+		},
+		{
+			name: "4 providers with one dominant stake",
+			providerPrices: aggregator.AggregatedProviderData[string, map[connecttypes.CurrencyPair]*big.Int]{
+				validator1.String(): {
+					btcusd: big.NewInt(100),
+				},
+				validator2.String(): {
+					btcusd: big.NewInt(200),
+				},
+				validator3.String(): {
+					btcusd: big.NewInt(300),
+				},
+				validator4.String(): {
+					btcusd: big.NewInt(400),
+				},
+			},
+			validators: []validator{
+				{
+					stake:    sdkmath.NewInt(10),
+					consAddr: validator1,
+				},
+				{
+					stake:    sdkmath.NewInt(70),
+					consAddr: validator2,
+				},
+				{
+					stake:    sdkmath.NewInt(10),
+					consAddr: validator3,
+				},
+				{
+					stake:    sdkmath.NewInt(10),
+					consAddr: validator4,
+				},
+			},
+			totalBondedTokens: sdkmath.NewInt(100),
+			expectedSpreads: map[connecttypes.CurrencyPair]*big.Int{
+				btcusd: big.NewInt(0),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			mockValidatorStore := s.createMockValidatorStore(tc.validators, tc.totalBondedTokens)
+
+			aggregateFn := voteweighted.Spread(s.ctx, log.NewTestLogger(s.T()), mockValidatorStore, voteweighted.DefaultPowerThreshold)
+			result := aggregateFn(tc.providerPrices)
+
+			s.Require().Len(result, len(tc.expectedSpreads))
+			for currencyPair, expectedSpread := range tc.expectedSpreads {
+				s.Require().Zero(expectedSpread.Cmp(result[currencyPair]))
+			}
+		})
+	}
+}
+
+func (s *MathTestSuite) TestComputeSpread() {
+	cases := []struct {
+		name      string
+		priceInfo voteweighted.PriceInfo
+		expected  *big.Int
+	}{
+		{
+			name: "no prices",
+			priceInfo: voteweighted.PriceInfo{
+				Prices:      []voteweighted.PricePerValidator{},
+				TotalWeight: sdkmath.ZeroInt(),
+			},
+			expected: nil,
+		},
+		{
+			name: "single price",
+			priceInfo: voteweighted.PriceInfo{
+				Prices: []voteweighted.PricePerValidator{
+					{
+						VoteWeight: sdkmath.NewInt(1),
+						Price:      big.NewInt(100),
+					},
+				},
+				TotalWeight: sdkmath.NewInt(1),
+			},
+			expected: big.NewInt(0),
+		},
+		{
+			name: "unsorted prices with equal weights",
+			priceInfo: voteweighted.PriceInfo{
+				Prices: []voteweighted.PricePerValidator{
+					{
+						VoteWeight: sdkmath.NewInt(1),
+						Price:      big.NewInt(400),
+					},
+					{
+						VoteWeight: sdkmath.NewInt(1),
+						Price:      big.NewInt(100),
+					},
+					{
+						VoteWeight: sdkmath.NewInt(1),
+						Price:      big.NewInt(300),
+					},
+					{
+						VoteWeight: sdkmath.NewInt(1),
+						Price:      big.NewInt(200),
+					},
+				},
+				TotalWeight: sdkmath.NewInt(4),
+			},
+			expected: big.NewInt(200),
+		},
+		{
+			name: "outlier with little weight does not widen the spread",
+			priceInfo: voteweighted.PriceInfo{
+				Prices: []voteweighted.PricePerValidator{
+					{
+						VoteWeight: sdkmath.NewInt(45),
+						Price:      big.NewInt(100),
+					},
+					{
+						VoteWeight: sdkmath.NewInt(45),
+						Price:      big.NewInt(110),
+					},
+					{
+						VoteWeight: sdkmath.NewInt(10),
+						Price:      big.NewInt(1000),
+					},
+				},
+				TotalWeight: sdkmath.NewInt(100),
+			},
+			expected: big.NewInt(10),
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			result := voteweighted.ComputeSpread(tc.priceInfo)
+			if tc.expected == nil {
+				s.Require().Nil(result)
+				return
+			}
+
+			s.Require().Zero(tc.expected.Cmp(result))
+		})
+	}
+}
+
 func (s *MathTestSuite) createMockValidatorStore(
 	validators []validator,
 	totalTokens sdkmath.Int,
@@ -0,0 +1,73 @@
+package voteweighted
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+)
+
+// Option is a function that enables optional configuration of the aggregation functions.
+type Option func(*config)
+
+// config defines the optional configuration shared by the aggregation functions.
+type config struct {
+	// thresholdStore is used to retrieve per-market voting power thresholds.
+	thresholdStore PowerThresholdStore
+}
+
+// WithPowerThresholdStore sets the store used to retrieve a per-market voting power threshold. If a
+// threshold is configured for a currency pair, it is used instead of the global threshold. Otherwise,
+// the global threshold is used.
+func WithPowerThresholdStore(store PowerThresholdStore) Option {
+	return func(c *config) {
+		if store == nil {
+			panic("power threshold store cannot be nil")
+		}
+
+		c.thresholdStore = store
+	}
+}
+
+// newConfig returns a config with the given options applied.
+func newConfig(opts ...Option) config {
+	var c config
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	return c
+}
+
+// GetPowerThreshold returns the voting power threshold the given currency pair must meet when aggregated
+// with the given options. This is the per-market threshold if a PowerThresholdStore is configured and
+// defines one, and the global threshold otherwise. An error is returned if the per-market threshold
+// cannot be retrieved.
+func GetPowerThreshold(
+	ctx sdk.Context,
+	cp connecttypes.CurrencyPair,
+	threshold math.LegacyDec,
+	opts ...Option,
+) (math.LegacyDec, error) {
+	cfg := newConfig(opts...)
+	return cfg.powerThreshold(ctx, cp, threshold)
+}
+
+// powerThreshold returns the per-market threshold for the given currency pair if one is configured, and
+// the global threshold otherwise.
+func (c config) powerThreshold(ctx sdk.Context, cp connecttypes.CurrencyPair, threshold math.LegacyDec) (math.LegacyDec, error) {
+	if c.thresholdStore == nil {
+		return threshold, nil
+	}
+
+	t, ok, err := c.thresholdStore.GetPowerThreshold(ctx, cp)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	if ok {
+		return t, nil
+	}
+
+	return threshold, nil
+}
@@ -38,9 +38,10 @@ func MedianFromContext(
 	logger log.Logger,
 	validatorStore ValidatorStore,
 	threshold math.LegacyDec,
+	opts ...Option,
 ) aggregator.AggregateFnFromContext[string, map[connecttypes.CurrencyPair]*big.Int] {
 	return func(ctx sdk.Context) aggregator.AggregateFn[string, map[connecttypes.CurrencyPair]*big.Int] {
-		return Median(ctx, logger, validatorStore, threshold)
+		return Median(ctx, logger, validatorStore, threshold, opts...)
 	}
 }
 
@@ -61,105 +62,195 @@ func Median(
 	logger log.Logger,
 	validatorStore ValidatorStore,
 	threshold math.LegacyDec,
+	opts ...Option,
 ) aggregator.AggregateFn[string, map[connecttypes.CurrencyPair]*big.Int] {
 	return func(providers aggregator.AggregatedProviderData[string, map[connecttypes.CurrencyPair]*big.Int]) map[connecttypes.CurrencyPair]*big.Int {
-		priceInfo := make(map[connecttypes.CurrencyPair]PriceInfo)
-
-		// Iterate through all providers and store stake weight + price for each currency pair.
-		for valAddress, validatorPrices := range providers {
-			// Retrieve the validator from the validator store and get its vote weight.
-			address, err := sdk.ConsAddressFromBech32(valAddress)
-			if err != nil {
-				logger.Error(
-					"failed to parse validator address; skipping validator prices",
-					"validator_address", valAddress,
-					"err", err,
-				)
+		priceInfo := GetPriceInfo(ctx, logger, validatorStore, threshold, providers, opts...)
 
-				continue
-			}
+		// Iterate through all prices and compute the median price for each asset.
+		prices := make(map[connecttypes.CurrencyPair]*big.Int, len(priceInfo))
+		for currencyPair, info := range priceInfo {
+			prices[currencyPair] = ComputeMedian(info)
 
-			validator, err := validatorStore.ValidatorByConsAddr(ctx, address)
-			if err != nil {
-				logger.Error(
-					"failed to retrieve validator from store; skipping validator prices",
-					"validator_address", valAddress,
-					"err", err,
-				)
+			logger.Debug(
+				"computed stake-weighted median price for currency pair",
+				"currency_pair", currencyPair.String(),
+				"final_price", prices[currencyPair].String(),
+				"num_validators", len(info.Prices),
+			)
+		}
 
-				continue
-			}
+		return prices
+	}
+}
+
+// SpreadFromContext returns a new Spread aggregate function that is parametrized by the
+// latest state of the application.
+func SpreadFromContext(
+	logger log.Logger,
+	validatorStore ValidatorStore,
+	threshold math.LegacyDec,
+	opts ...Option,
+) aggregator.AggregateFnFromContext[string, map[connecttypes.CurrencyPair]*big.Int] {
+	return func(ctx sdk.Context) aggregator.AggregateFn[string, map[connecttypes.CurrencyPair]*big.Int] {
+		return Spread(ctx, logger, validatorStore, threshold, opts...)
+	}
+}
 
-			voteWeight := validator.GetBondedTokens()
+// Spread returns an aggregation function that computes the stake-weighted interquartile range of
+// the prices submitted for any qualifying currency pair. It is the dispersion counterpart of Median:
+// the same validator set and power threshold are used to determine which currency pairs qualify, so
+// a spread is returned for a currency pair if and only if Median returns a price for it.
+func Spread(
+	ctx sdk.Context,
+	logger log.Logger,
+	validatorStore ValidatorStore,
+	threshold math.LegacyDec,
+	opts ...Option,
+) aggregator.AggregateFn[string, map[connecttypes.CurrencyPair]*big.Int] {
+	return func(providers aggregator.AggregatedProviderData[string, map[connecttypes.CurrencyPair]*big.Int]) map[connecttypes.CurrencyPair]*big.Int {
+		priceInfo := GetPriceInfo(ctx, logger, validatorStore, threshold, providers, opts...)
 
-			// Iterate through all prices and store the price + vote weight for each currency pair.
-			for currencyPair, price := range validatorPrices {
-				// Only include prices that are not nil.
-				if price == nil {
-					logger.Debug(
-						"price is nil",
-						"currency_pair", currencyPair.String(),
-						"validator_address", valAddress,
-					)
+		spreads := make(map[connecttypes.CurrencyPair]*big.Int, len(priceInfo))
+		for currencyPair, info := range priceInfo {
+			spreads[currencyPair] = ComputeSpread(info)
 
-					continue
-				}
+			logger.Debug(
+				"computed stake-weighted spread for currency pair",
+				"currency_pair", currencyPair.String(),
+				"spread", spreads[currencyPair].String(),
+				"num_validators", len(info.Prices),
+			)
+		}
 
-				// Initialize the price info if it does not exist for the given currency pair.
-				if _, ok := priceInfo[currencyPair]; !ok {
-					priceInfo[currencyPair] = PriceInfo{
-						Prices:      make([]PricePerValidator, 0),
-						TotalWeight: math.ZeroInt(),
-					}
-				}
+		return spreads
+	}
+}
 
-				// Update the price info.
-				cpInfo := priceInfo[currencyPair]
-				priceInfo[currencyPair] = PriceInfo{
-					Prices: append(cpInfo.Prices, PricePerValidator{
-						VoteWeight: voteWeight,
-						Price:      price,
-					}),
-					TotalWeight: cpInfo.TotalWeight.Add(voteWeight),
-				}
-			}
+// GetPriceInfo associates each price submitted by a validator with the validator's stake, and returns
+// the resulting price info for every currency pair for which the total voting power % that submitted
+// a price is greater than or equal to the threshold. If a PowerThresholdStore is configured and defines
+// a threshold for a currency pair, that threshold is used in place of the global one, and currency pairs
+// whose threshold cannot be retrieved are skipped. Validators that cannot be found in the validator store
+// are skipped.
+func GetPriceInfo(
+	ctx sdk.Context,
+	logger log.Logger,
+	validatorStore ValidatorStore,
+	threshold math.LegacyDec,
+	providers aggregator.AggregatedProviderData[string, map[connecttypes.CurrencyPair]*big.Int],
+	opts ...Option,
+) map[connecttypes.CurrencyPair]PriceInfo {
+	cfg := newConfig(opts...)
+	priceInfo := make(map[connecttypes.CurrencyPair]PriceInfo)
+
+	// Iterate through all providers and store stake weight + price for each currency pair.
+	for valAddress, validatorPrices := range providers {
+		// Retrieve the validator from the validator store and get its vote weight.
+		address, err := sdk.ConsAddressFromBech32(valAddress)
+		if err != nil {
+			logger.Error(
+				"failed to parse validator address; skipping validator prices",
+				"validator_address", valAddress,
+				"err", err,
+			)
+
+			continue
 		}
 
-		// Iterate through all prices and compute the median price for each asset.
-		prices := make(map[connecttypes.CurrencyPair]*big.Int)
-		totalBondedTokens, err := validatorStore.TotalBondedTokens(ctx)
+		validator, err := validatorStore.ValidatorByConsAddr(ctx, address)
 		if err != nil {
-			// This should never error.
-			panic(err)
+			logger.Error(
+				"failed to retrieve validator from store; skipping validator prices",
+				"validator_address", valAddress,
+				"err", err,
+			)
+
+			continue
 		}
 
-		for currencyPair, info := range priceInfo {
-			// The total voting power % that submitted a price update for the given currency pair must be
-			// greater than the threshold to be included in the final oracle price.
-			if percentSubmitted := math.LegacyNewDecFromInt(info.TotalWeight).Quo(math.LegacyNewDecFromInt(totalBondedTokens)); percentSubmitted.GTE(threshold) {
-				prices[currencyPair] = ComputeMedian(info)
+		voteWeight := validator.GetBondedTokens()
 
+		// Iterate through all prices and store the price + vote weight for each currency pair.
+		for currencyPair, price := range validatorPrices {
+			// Only include prices that are not nil.
+			if price == nil {
 				logger.Debug(
-					"computed stake-weighted median price for currency pair",
-					"currency_pair", currencyPair.String(),
-					"percent_submitted", percentSubmitted.String(),
-					"threshold", threshold.String(),
-					"final_price", prices[currencyPair].String(),
-					"num_validators", len(info.Prices),
-				)
-			} else {
-				logger.Debug(
-					"not enough voting power to compute stake-weighted median price price for currency pair",
+					"price is nil",
 					"currency_pair", currencyPair.String(),
-					"threshold", threshold.String(),
-					"percent_submitted", percentSubmitted.String(),
-					"num_validators", len(info.Prices),
+					"validator_address", valAddress,
 				)
+
+				continue
+			}
+
+			// Initialize the price info if it does not exist for the given currency pair.
+			if _, ok := priceInfo[currencyPair]; !ok {
+				priceInfo[currencyPair] = PriceInfo{
+					Prices:      make([]PricePerValidator, 0),
+					TotalWeight: math.ZeroInt(),
+				}
+			}
+
+			// Update the price info.
+			cpInfo := priceInfo[currencyPair]
+			priceInfo[currencyPair] = PriceInfo{
+				Prices: append(cpInfo.Prices, PricePerValidator{
+					VoteWeight: voteWeight,
+					Price:      price,
+				}),
+				TotalWeight: cpInfo.TotalWeight.Add(voteWeight),
 			}
 		}
+	}
 
-		return prices
+	totalBondedTokens, err := validatorStore.TotalBondedTokens(ctx)
+	if err != nil {
+		// This should never error.
+		panic(err)
+	}
+
+	for currencyPair, info := range priceInfo {
+		// Use the per-market threshold if one is configured, otherwise fall back to the global threshold.
+		marketThreshold, err := cfg.powerThreshold(ctx, currencyPair, threshold)
+		if err != nil {
+			logger.Error(
+				"failed to retrieve power threshold for currency pair; skipping currency pair",
+				"currency_pair", currencyPair.String(),
+				"err", err,
+			)
+
+			delete(priceInfo, currencyPair)
+			continue
+		}
+
+		// The total voting power % that submitted a price update for the given currency pair must be
+		// greater than the threshold to be included in the final oracle price.
+		percentSubmitted := math.LegacyNewDecFromInt(info.TotalWeight).Quo(math.LegacyNewDecFromInt(totalBondedTokens))
+		if percentSubmitted.GTE(marketThreshold) {
+			logger.Debug(
+				"enough voting power submitted a price for currency pair",
+				"currency_pair", currencyPair.String(),
+				"percent_submitted", percentSubmitted.String(),
+				"threshold", marketThreshold.String(),
+				"num_validators", len(info.Prices),
+			)
+
+			continue
+		}
+
+		logger.Debug(
+			"not enough voting power to compute stake-weighted median price price for currency pair",
+			"currency_pair", currencyPair.String(),
+			"threshold", marketThreshold.String(),
+			"percent_submitted", percentSubmitted.String(),
+			"num_validators", len(info.Prices),
+		)
+
+		delete(priceInfo, currencyPair)
 	}
+
+	return priceInfo
 }
 
 // ComputeMedian computes the stake-weighted median price for a given asset.
@@ -196,3 +287,41 @@ func ComputeMedian(priceInfo PriceInfo) *big.Int {
 
 	return nil
 }
+
+// ComputeSpread computes the stake-weighted interquartile range (IQR) of the prices for a given asset,
+// i.e. the difference between the price at the 75th and the 25th percentile of stake. The percentiles
+// are selected in the same manner as ComputeMedian selects the 50th percentile.
+func ComputeSpread(priceInfo PriceInfo) *big.Int {
+	if len(priceInfo.Prices) == 0 {
+		return nil
+	}
+
+	// Sort the prices by price.
+	sort.SliceStable(priceInfo.Prices, func(i, j int) bool {
+		return priceInfo.Prices[i].Price.Cmp(priceInfo.Prices[j].Price) < 0
+	})
+
+	lower := computeWeightedPercentile(priceInfo, math.LegacyNewDecWithPrec(25, 2))
+	upper := computeWeightedPercentile(priceInfo, math.LegacyNewDecWithPrec(75, 2))
+
+	return new(big.Int).Sub(upper, lower)
+}
+
+// computeWeightedPercentile returns the first price (in ascending order) at which the cumulative
+// vote weight is greater than or equal to the given percentile of the total weight. The prices
+// are expected to be sorted.
+func computeWeightedPercentile(priceInfo PriceInfo, percentile math.LegacyDec) *big.Int {
+	target := math.LegacyNewDecFromInt(priceInfo.TotalWeight).Mul(percentile).TruncateInt()
+
+	sum := math.ZeroInt()
+	for _, price := range priceInfo.Prices {
+		sum = sum.Add(price.VoteWeight)
+
+		if sum.GTE(target) {
+			return price.Price
+		}
+	}
+
+	// If we reached the end of the list, return the last price.
+	return priceInfo.Prices[len(priceInfo.Prices)-1].Price
+}
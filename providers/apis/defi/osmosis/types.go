@@ -10,6 +10,7 @@ import (
 
 	"github.com/skip-mev/connect/v2/oracle/config"
 	"github.com/skip-mev/connect/v2/oracle/types"
+	"github.com/skip-mev/connect/v2/x/marketmap/types/providermetadata"
 )
 
 const (
@@ -79,26 +80,8 @@ func (mc *metadataCache) getMetadataPerTicker(ticker types.ProviderTicker) (Tick
 }
 
 // TickerMetadata represents the metadata associated with a ticker's corresponding
-// raydium pool.
-type TickerMetadata struct {
-	// PoolID is the unique uint ID of the osmosis pool.
-	PoolID uint64 `json:"pool_id"`
-
-	// BaseTokenDenom is the identifier (on osmosis) of the quote token.
-	BaseTokenDenom string `json:"base_token_denom"`
-
-	// QuoteTokenDenom is the identifier (on osmosis) of the quote token.
-	QuoteTokenDenom string `json:"quote_token_denom"`
-}
-
-// ValidateBasic checks that the pool and token information is formatted properly.
-func (metadata TickerMetadata) ValidateBasic() error {
-	if metadata.BaseTokenDenom == "" || metadata.QuoteTokenDenom == "" {
-		return fmt.Errorf("base token denom or quote token denom cannot be empty")
-	}
-
-	return nil
-}
+// osmosis pool.
+type TickerMetadata = providermetadata.Osmosis
 
 // unmarshalMetadataJSON unmarshals the given metadata string into a TickerMetadata,
 // this method assumes that the metadata string is valid json, otherwise an error is returned.
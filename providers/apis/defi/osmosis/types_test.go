@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/skip-mev/connect/v2/providers/apis/defi/osmosis"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
 )
 
 func TestCreateURL(t *testing.T) {
@@ -47,3 +48,15 @@ func TestCreateURL(t *testing.T) {
 		})
 	}
 }
+
+func TestMetadataSchema(t *testing.T) {
+	_, ok := mmtypes.DefaultMetadataSchemaRegistry.ProviderMetadataSchema(osmosis.Name)
+	require.True(t, ok)
+
+	require.NoError(t, mmtypes.DefaultMetadataSchemaRegistry.ValidateProviderMetadata(
+		osmosis.Name,
+		`{"pool_id":1,"base_token_denom":"uosmo","quote_token_denom":"uusdc"}`,
+	))
+	require.Error(t, mmtypes.DefaultMetadataSchemaRegistry.ValidateProviderMetadata(osmosis.Name, `{"pool_id":1}`))
+	require.Error(t, mmtypes.DefaultMetadataSchemaRegistry.ValidateProviderMetadata(osmosis.Name, `{"pool_id":"1"}`))
+}
@@ -6,10 +6,9 @@ import (
 	"sync"
 	"time"
 
-	"github.com/gagliardetto/solana-go"
-
 	"github.com/skip-mev/connect/v2/oracle/config"
 	"github.com/skip-mev/connect/v2/oracle/types"
+	"github.com/skip-mev/connect/v2/x/marketmap/types/providermetadata"
 )
 
 const (
@@ -65,53 +64,12 @@ func (mc *metadataCache) getMetadataPerTicker(ticker types.ProviderTicker) (Tick
 
 // TickerMetadata represents the metadata associated with a ticker's corresponding
 // raydium pool.
-type TickerMetadata struct {
-	// BaseTokenVault is the metadata associated with the base token's token vault
-	BaseTokenVault AMMTokenVaultMetadata `json:"base_token_vault"`
-
-	// QuoteTokenVault is the metadata associated with the quote token's token vault
-	QuoteTokenVault AMMTokenVaultMetadata `json:"quote_token_vault"`
-
-	// AMMInfoAddress is the address of the AMMInfo account for this raydium pool
-	AMMInfoAddress string `json:"amm_info_address"`
-
-	// OpenOrdersAddress is the address of the open orders account for this raydium pool
-	OpenOrdersAddress string `json:"open_orders_address"`
-}
-
-// ValidateBasic checks that the solana token vault addresses are valid.
-func (metadata TickerMetadata) ValidateBasic() error {
-	if _, err := solana.PublicKeyFromBase58(metadata.BaseTokenVault.TokenVaultAddress); err != nil {
-		return err
-	}
-
-	if _, err := solana.PublicKeyFromBase58(metadata.QuoteTokenVault.TokenVaultAddress); err != nil {
-		return err
-	}
-
-	if _, err := solana.PublicKeyFromBase58(metadata.AMMInfoAddress); err != nil {
-		return err
-	}
-
-	if _, err := solana.PublicKeyFromBase58(metadata.OpenOrdersAddress); err != nil {
-		return err
-	}
-
-	return nil
-}
+type TickerMetadata = providermetadata.Raydium
 
 // AMMTokenVaultMetadata represents the metadata associated with a raydium AMM pool's
 // token vault. Specifically, we require the token vault address and the token decimals
 // for the token that the vault is associated with.
-type AMMTokenVaultMetadata struct {
-	// QuoteTokenAddress is the base58 encoded address of the serum token corresponding
-	// to this market's quote address
-	TokenVaultAddress string `json:"token_vault_address"`
-
-	// TokenDecimals is the number of decimals used for the token, we use this for
-	// normalizing the balance of tokens at the designated vault address
-	TokenDecimals uint64 `json:"token_decimals"`
-}
+type AMMTokenVaultMetadata = providermetadata.RaydiumTokenVault
 
 // unmarshalMetadataJSON unmarshals the given metadata string into a TickerMetadata,
 // this method assumes that the metadata string is valid json, otherwise an error is returned.
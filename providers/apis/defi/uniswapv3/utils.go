@@ -1,15 +1,13 @@
 package uniswapv3
 
 import (
-	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
 
-	"github.com/ethereum/go-ethereum/common"
-
 	"github.com/skip-mev/connect/v2/oracle/config"
 	"github.com/skip-mev/connect/v2/oracle/constants"
+	"github.com/skip-mev/connect/v2/x/marketmap/types/providermetadata"
 )
 
 const (
@@ -46,47 +44,9 @@ func IsValidProviderName(name string) bool {
 	return false
 }
 
-// PoolConfig is the configuration for a Uniswap V3 pool. This is specific to each pair of tokens.
-type PoolConfig struct {
-	// Address is the Uniswap V3 pool address.
-	Address string `json:"address"`
-	// BaseDecimals is the number of decimals for the base token. This should be derived from the
-	// token contract.
-	BaseDecimals int64 `json:"base_decimals"`
-	// QuoteDecimals is the number of decimals for the quote token. This should be derived from the
-	// token contract.
-	QuoteDecimals int64 `json:"quote_decimals"`
-	// Invert is utilized to invert the price of a pool's reserves. This may be required for certain
-	// pools as the price is derived based on the sorted order of the ERC20 addresses of the tokens
-	// in the pool.
-	Invert bool `json:"invert"`
-}
-
-// ValidateBasic validates the pool configuration.
-func (pc *PoolConfig) ValidateBasic() error {
-	if !common.IsHexAddress(pc.Address) {
-		return fmt.Errorf("pool address is not a valid ethereum address")
-	}
-
-	if pc.BaseDecimals < 0 {
-		return fmt.Errorf("base decimals must be non-negative")
-	}
-
-	if pc.QuoteDecimals < 0 {
-		return fmt.Errorf("quote decimals must be non-negative")
-	}
-
-	return nil
-}
-
-// MustToJSON converts the pool configuration to JSON.
-func (pc *PoolConfig) MustToJSON() string {
-	b, err := json.Marshal(pc)
-	if err != nil {
-		panic(err)
-	}
-	return string(b)
-}
+// PoolConfig is the configuration for a Uniswap V3 pool. This is specific to each pair of tokens, and is the
+// metadata of the provider's markets.
+type PoolConfig = providermetadata.UniswapV3
 
 var (
 	// DefaultETHAPIConfig is the default configuration for the Uniswap API. Specifically this is for
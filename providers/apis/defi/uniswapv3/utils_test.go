@@ -8,6 +8,7 @@ import (
 
 	"github.com/skip-mev/connect/v2/oracle/constants"
 	"github.com/skip-mev/connect/v2/providers/apis/defi/uniswapv3"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
 )
 
 func TestPoolConfig(t *testing.T) {
@@ -97,3 +98,19 @@ func TestIsValidProviderName(t *testing.T) {
 		})
 	}
 }
+
+func TestMetadataSchema(t *testing.T) {
+	valid := uniswapv3.PoolConfig{
+		Address:       "0x8ad599c3A0ff1De082011EFDDc58f1908eb6e6D8",
+		BaseDecimals:  18,
+		QuoteDecimals: 6,
+	}
+
+	for _, providerName := range uniswapv3.ProviderNames {
+		_, ok := mmtypes.DefaultMetadataSchemaRegistry.ProviderMetadataSchema(providerName)
+		require.True(t, ok)
+
+		require.NoError(t, mmtypes.DefaultMetadataSchemaRegistry.ValidateProviderMetadata(providerName, valid.MustToJSON()))
+		require.Error(t, mmtypes.DefaultMetadataSchemaRegistry.ValidateProviderMetadata(providerName, `{"address":"invalid"}`))
+	}
+}
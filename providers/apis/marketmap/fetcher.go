@@ -3,9 +3,13 @@ package marketmap
 import (
 	"context"
 	"fmt"
+	"maps"
+	"sync"
 	"time"
 
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/skip-mev/connect/v2/oracle/config"
 	"github.com/skip-mev/connect/v2/providers/base/api/metrics"
@@ -16,13 +20,23 @@ import (
 
 // MarketMapFetcher is the x/marketmap fetcher. This fetcher is responsible for querying the
 // x/marketmap module and returning the market map data. The fetcher utilizes the QueryClient
-// to query the x/marketmap module.
+// to query the x/marketmap module. After the first full market map query, the fetcher only
+// queries the markets that changed since the last update of the fetched market map, and falls
+// back to a full query if the changes cannot be queried. If the node does not implement the
+// changes query, the fetcher stops querying the changes and always queries the full market map.
 type MarketMapFetcher struct { //nolint
 	logger *zap.Logger
 
 	// client is the QueryClient implementation. This is used to interact with the x/marketmap
 	// module.
 	client mmtypes.QueryClient
+
+	mtx sync.Mutex
+	// last is the last fetched market map response, which is updated with the changed markets.
+	last *mmtypes.MarketMapResponse
+	// changesUnimplemented is set once the node reports that it does not implement the
+	// MarketsSince query, after which the changes are no longer queried.
+	changesUnimplemented bool
 }
 
 // NewMarketMapFetcher returns a new MarketMap fetcher with the standard grpc client.
@@ -98,6 +112,24 @@ func (f *MarketMapFetcher) Fetch(
 		)
 	}
 
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	if f.last != nil && !f.changesUnimplemented {
+		resp, err := f.fetchChanges(ctx)
+		if err == nil {
+			f.last = resp
+
+			resolved := make(types.ResolvedMarketMap)
+			resolved[chains[0]] = types.NewMarketMapResult(copyMarketMapResponse(resp), time.Now())
+
+			f.logger.Info("successfully fetched market map changes from module; checking if market map has changed")
+			return types.NewMarketMapResponse(resolved, nil)
+		}
+
+		f.logger.Debug("failed to fetch market map changes; fetching full market map", zap.Error(err))
+	}
+
 	// Query the x/marketmap module for the market map data.
 	resp, err := f.client.MarketMap(ctx, &mmtypes.MarketMapRequest{})
 	if err != nil {
@@ -122,9 +154,76 @@ func (f *MarketMapFetcher) Fetch(
 		)
 	}
 
+	f.last = copyMarketMapResponse(resp)
+
 	resolved := make(types.ResolvedMarketMap)
 	resolved[chains[0]] = types.NewMarketMapResult(resp, time.Now())
 
 	f.logger.Info("successfully fetched market map data from module; checking if market map has changed")
 	return types.NewMarketMapResponse(resolved, nil)
 }
+
+// fetchChanges returns the last fetched market map response updated with the markets that changed
+// since its last update. The changes are only queried if the last update height of the market map
+// has moved.
+func (f *MarketMapFetcher) fetchChanges(ctx context.Context) (*mmtypes.MarketMapResponse, error) {
+	lastUpdated, err := f.client.LastUpdated(ctx, &mmtypes.LastUpdatedRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query last updated: %w", err)
+	}
+
+	if lastUpdated == nil {
+		return nil, fmt.Errorf("nil response from last updated query")
+	}
+
+	if lastUpdated.LastUpdated == f.last.LastUpdated {
+		return f.last, nil
+	}
+
+	changes, err := f.client.MarketsSince(ctx, &mmtypes.MarketsSinceRequest{Height: f.last.LastUpdated})
+	if status.Code(err) == codes.Unimplemented {
+		f.logger.Info("market map changes query is not implemented by the node; only fetching the full market map")
+		f.changesUnimplemented = true
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to query markets since height %d: %w", f.last.LastUpdated, err)
+	}
+
+	if changes == nil {
+		return nil, fmt.Errorf("nil response from markets since query")
+	}
+
+	resp := copyMarketMapResponse(f.last)
+	for _, market := range changes.Markets {
+		resp.MarketMap.Markets[market.Ticker.String()] = market
+	}
+
+	for _, ticker := range changes.RemovedMarkets {
+		delete(resp.MarketMap.Markets, ticker)
+	}
+
+	resp.LastUpdated = changes.LastUpdated
+	resp.ChainId = changes.ChainId
+
+	f.logger.Debug(
+		"applied market map changes",
+		zap.Int("updated", len(changes.Markets)),
+		zap.Int("removed", len(changes.RemovedMarkets)),
+		zap.Uint64("last_updated", resp.LastUpdated),
+	)
+
+	return resp, nil
+}
+
+// copyMarketMapResponse returns a copy of the response whose markets can be modified without
+// modifying the markets of the given response.
+func copyMarketMapResponse(resp *mmtypes.MarketMapResponse) *mmtypes.MarketMapResponse {
+	cpy := *resp
+	cpy.MarketMap.Markets = maps.Clone(resp.MarketMap.Markets)
+	if cpy.MarketMap.Markets == nil {
+		cpy.MarketMap.Markets = make(map[string]mmtypes.Market)
+	}
+
+	return &cpy
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
 	"github.com/skip-mev/connect/v2/providers/apis/coinbase"
@@ -197,3 +199,115 @@ func TestFetch(t *testing.T) {
 		})
 	}
 }
+
+func TestFetchChanges(t *testing.T) {
+	ethusd := connecttypes.NewCurrencyPair("ETH", "USD")
+	ethusdMarket := mmtypes.Market{
+		Ticker: mmtypes.Ticker{
+			CurrencyPair:     ethusd,
+			Decimals:         8,
+			MinProviderCount: 1,
+		},
+		ProviderConfigs: []mmtypes.ProviderConfig{
+			{
+				Name:           coinbase.Name,
+				OffChainTicker: "ETH-USD",
+			},
+		},
+	}
+
+	fullResponse := func() *mmtypes.MarketMapResponse {
+		return &mmtypes.MarketMapResponse{
+			MarketMap: mmtypes.MarketMap{
+				Markets: map[string]mmtypes.Market{
+					btcusd.String(): goodMarketMap.Markets[btcusd.String()],
+				},
+			},
+			ChainId:     chains[0].ChainID,
+			LastUpdated: 10,
+		}
+	}
+
+	fetch := func(t *testing.T, fetcher *marketmap.MarketMapFetcher) *mmtypes.MarketMapResponse {
+		t.Helper()
+
+		resp := fetcher.Fetch(context.TODO(), chains[:1])
+		require.Len(t, resp.Resolved, 1)
+		return resp.Resolved[chains[0]].Value
+	}
+
+	t.Run("does not query changes when the market map is not updated", func(t *testing.T) {
+		c := mocks.NewQueryClient(t)
+		c.On("MarketMap", mock.Anything, mock.Anything).Return(fullResponse(), nil).Once()
+		c.On("LastUpdated", mock.Anything, mock.Anything).Return(&mmtypes.LastUpdatedResponse{LastUpdated: 10}, nil).Once()
+
+		fetcher, err := marketmap.NewMarketMapFetcherWithClient(logger, c)
+		require.NoError(t, err)
+
+		require.Equal(t, fullResponse(), fetch(t, fetcher))
+		require.Equal(t, fullResponse(), fetch(t, fetcher))
+	})
+
+	t.Run("applies the changes when the market map is updated", func(t *testing.T) {
+		c := mocks.NewQueryClient(t)
+		c.On("MarketMap", mock.Anything, mock.Anything).Return(fullResponse(), nil).Once()
+		c.On("LastUpdated", mock.Anything, mock.Anything).Return(&mmtypes.LastUpdatedResponse{LastUpdated: 12}, nil).Once()
+		c.On("MarketsSince", mock.Anything, &mmtypes.MarketsSinceRequest{Height: 10}).Return(
+			&mmtypes.MarketsSinceResponse{
+				Markets:        []mmtypes.Market{ethusdMarket},
+				RemovedMarkets: []string{btcusd.String()},
+				LastUpdated:    12,
+				ChainId:        chains[0].ChainID,
+			},
+			nil,
+		).Once()
+
+		fetcher, err := marketmap.NewMarketMapFetcherWithClient(logger, c)
+		require.NoError(t, err)
+
+		first := fetch(t, fetcher)
+		require.Equal(t, fullResponse(), first)
+
+		// modifying a returned response does not modify the fetched market map
+		delete(first.MarketMap.Markets, btcusd.String())
+
+		require.Equal(t, &mmtypes.MarketMapResponse{
+			MarketMap: mmtypes.MarketMap{
+				Markets: map[string]mmtypes.Market{
+					ethusd.String(): ethusdMarket,
+				},
+			},
+			ChainId:     chains[0].ChainID,
+			LastUpdated: 12,
+		}, fetch(t, fetcher))
+	})
+
+	t.Run("falls back to the full market map when the changes cannot be queried", func(t *testing.T) {
+		c := mocks.NewQueryClient(t)
+		c.On("MarketMap", mock.Anything, mock.Anything).Return(fullResponse(), nil).Twice()
+		c.On("LastUpdated", mock.Anything, mock.Anything).Return(&mmtypes.LastUpdatedResponse{LastUpdated: 12}, nil).Once()
+		c.On("MarketsSince", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("pruned")).Once()
+
+		fetcher, err := marketmap.NewMarketMapFetcherWithClient(logger, c)
+		require.NoError(t, err)
+
+		require.Equal(t, fullResponse(), fetch(t, fetcher))
+		require.Equal(t, fullResponse(), fetch(t, fetcher))
+	})
+	t.Run("stops querying the changes when the node does not implement them", func(t *testing.T) {
+		c := mocks.NewQueryClient(t)
+		c.On("MarketMap", mock.Anything, mock.Anything).Return(fullResponse(), nil).Times(3)
+		c.On("LastUpdated", mock.Anything, mock.Anything).Return(&mmtypes.LastUpdatedResponse{LastUpdated: 12}, nil).Once()
+		c.On("MarketsSince", mock.Anything, mock.Anything).Return(
+			nil,
+			status.Error(codes.Unimplemented, "unknown method MarketsSince"),
+		).Once()
+
+		fetcher, err := marketmap.NewMarketMapFetcherWithClient(logger, c)
+		require.NoError(t, err)
+
+		require.Equal(t, fullResponse(), fetch(t, fetcher))
+		require.Equal(t, fullResponse(), fetch(t, fetcher))
+		require.Equal(t, fullResponse(), fetch(t, fetcher))
+	})
+}
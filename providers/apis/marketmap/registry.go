@@ -0,0 +1,106 @@
+package marketmap
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"go.uber.org/zap"
+
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+// ProviderRegistryCheckInterval is the interval at which the provider registry of the x/marketmap module is
+// checked against the providers configured in the oracle.
+const ProviderRegistryCheckInterval = 5 * time.Minute
+
+// CheckProviderRegistry queries the provider registry of the x/marketmap module and compares it against the given
+// names of the providers configured in the oracle. It returns the configured providers that are not registered, and
+// the active registered providers that are not configured, both sorted. Nothing is returned if the registry is
+// empty, as the chain does not use it.
+func CheckProviderRegistry(
+	ctx context.Context,
+	client mmtypes.QueryClient,
+	configured []string,
+) (unknown []string, unconfigured []string, err error) {
+	resp, err := client.Providers(ctx, &mmtypes.ProvidersRequest{})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(resp.Providers) == 0 {
+		return nil, nil, nil
+	}
+
+	registered := make(map[string]struct{}, len(resp.Providers))
+	for _, provider := range resp.Providers {
+		registered[provider.Name] = struct{}{}
+
+		if provider.Status == mmtypes.PROVIDER_STATUS_ACTIVE && !slices.Contains(configured, provider.Name) {
+			unconfigured = append(unconfigured, provider.Name)
+		}
+	}
+
+	for _, name := range configured {
+		if _, ok := registered[name]; !ok {
+			unknown = append(unknown, name)
+		}
+	}
+
+	slices.Sort(unknown)
+	slices.Sort(unconfigured)
+	return unknown, unconfigured, nil
+}
+
+// WatchProviderRegistry checks the provider registry of the x/marketmap module against the given names of the
+// providers configured in the oracle every interval until the context is cancelled. Configured providers that are
+// not registered cannot be used by any market, and active registered providers that are not configured cannot be
+// priced by this oracle, so both are logged as warnings whenever they change.
+func WatchProviderRegistry(
+	ctx context.Context,
+	logger *zap.Logger,
+	client mmtypes.QueryClient,
+	configured []string,
+	interval time.Duration,
+) {
+	logger = logger.With(zap.String("fetcher", Name))
+
+	var lastUnknown, lastUnconfigured []string
+	check := func() {
+		unknown, unconfigured, err := CheckProviderRegistry(ctx, client, configured)
+		if err != nil {
+			logger.Debug("failed to query provider registry", zap.Error(err))
+			return
+		}
+
+		if len(unknown) > 0 && !slices.Equal(unknown, lastUnknown) {
+			logger.Warn(
+				"configured providers are not registered in the on-chain provider registry",
+				zap.Strings("providers", unknown),
+			)
+		}
+
+		if len(unconfigured) > 0 && !slices.Equal(unconfigured, lastUnconfigured) {
+			logger.Warn(
+				"providers registered in the on-chain provider registry are not configured",
+				zap.Strings("providers", unconfigured),
+			)
+		}
+
+		lastUnknown, lastUnconfigured = unknown, unconfigured
+	}
+
+	check()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			check()
+		}
+	}
+}
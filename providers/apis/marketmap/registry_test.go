@@ -0,0 +1,54 @@
+package marketmap_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/providers/apis/marketmap"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+	"github.com/skip-mev/connect/v2/x/marketmap/types/mocks"
+)
+
+func TestCheckProviderRegistry(t *testing.T) {
+	configured := []string{"okx_ws", "binance_api", "coinbase_api"}
+
+	t.Run("errors when the registry cannot be queried", func(t *testing.T) {
+		client := mocks.NewQueryClient(t)
+		client.On("Providers", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("unimplemented"))
+
+		_, _, err := marketmap.CheckProviderRegistry(context.Background(), client, configured)
+		require.Error(t, err)
+	})
+
+	t.Run("an empty registry is not checked", func(t *testing.T) {
+		client := mocks.NewQueryClient(t)
+		client.On("Providers", mock.Anything, mock.Anything).Return(&mmtypes.ProvidersResponse{}, nil)
+
+		unknown, unconfigured, err := marketmap.CheckProviderRegistry(context.Background(), client, configured)
+		require.NoError(t, err)
+		require.Empty(t, unknown)
+		require.Empty(t, unconfigured)
+	})
+
+	t.Run("unknown and unconfigured providers are returned", func(t *testing.T) {
+		client := mocks.NewQueryClient(t)
+		client.On("Providers", mock.Anything, mock.Anything).Return(&mmtypes.ProvidersResponse{
+			Providers: []mmtypes.ProviderInfo{
+				{Name: "binance_api", Type: mmtypes.PROVIDER_TYPE_CEX, Status: mmtypes.PROVIDER_STATUS_ACTIVE},
+				{Name: "kraken_api", Type: mmtypes.PROVIDER_TYPE_CEX, Status: mmtypes.PROVIDER_STATUS_ACTIVE},
+				{Name: "huobi_ws", Type: mmtypes.PROVIDER_TYPE_CEX, Status: mmtypes.PROVIDER_STATUS_DEPRECATED},
+				{Name: "uniswapv3_api-ethereum", Type: mmtypes.PROVIDER_TYPE_DEX, Status: mmtypes.PROVIDER_STATUS_ACTIVE},
+			},
+		}, nil)
+
+		unknown, unconfigured, err := marketmap.CheckProviderRegistry(context.Background(), client, configured)
+		require.NoError(t, err)
+		require.Equal(t, []string{"coinbase_api", "okx_ws"}, unknown)
+		// deprecated providers need not be configured
+		require.Equal(t, []string{"kraken_api", "uniswapv3_api-ethereum"}, unconfigured)
+	})
+}
@@ -28,3 +28,4 @@ echo "fixing market.pulsar.go"
 sed -i.bak 's|cosmossdk.io/api/connect/types/v2|github.com/skip-mev/connect/v2/api/connect/types/v2|g' ./api/connect/marketmap/v2/market.pulsar.go && rm ./api/connect/marketmap/v2/market.pulsar.go.bak
 sed -i.bak 's|cosmossdk.io/api/connect/types/v2|github.com/skip-mev/connect/v2/api/connect/types/v2|g' ./api/connect/marketmap/v2/query.pulsar.go && rm ./api/connect/marketmap/v2/query.pulsar.go.bak
 sed -i.bak 's|cosmossdk.io/api/connect/oracle/v2|github.com/skip-mev/connect/v2/api/connect/oracle/v2|g' ./api/connect/marketmap/v2/market.pulsar.go && rm ./api/connect/marketmap/v2/market.pulsar.go.bak
+sed -i.bak 's|cosmossdk.io/api/connect/types/v2|github.com/skip-mev/connect/v2/api/connect/types/v2|g' ./api/connect/marketmap/v2/schedule.pulsar.go && rm ./api/connect/marketmap/v2/schedule.pulsar.go.bak
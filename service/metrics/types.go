@@ -36,6 +36,12 @@ type MessageType int
 const (
 	ExtendedCommit MessageType = iota
 	VoteExtension
+	// UnbudgetedVoteExtension is the size of a locally created vote extension before any prices
+	// were deferred to fit within the vote extension budget.
+	UnbudgetedVoteExtension
+	// DeferredVoteExtension is the number of bytes that were removed from a locally created vote
+	// extension by deferring prices to fit within the vote extension budget.
+	DeferredVoteExtension
 )
 
 func (m MessageType) String() string {
@@ -44,6 +50,10 @@ func (m MessageType) String() string {
 		return "extended_commit"
 	case VoteExtension:
 		return "vote_extension"
+	case UnbudgetedVoteExtension:
+		return "unbudgeted_vote_extension"
+	case DeferredVoteExtension:
+		return "deferred_vote_extension"
 	default:
 		return notImplemented
 	}
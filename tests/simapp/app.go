@@ -330,11 +330,27 @@ func NewSimApp(
 	app.SetProcessProposal(proposalHandler.ProcessProposalHandler())
 
 	// Create the aggregation function that will be used to aggregate oracle data
-	// from each validator.
+	// from each validator. Alternatives to the stake-weighted median are provided by
+	// voteweighted.TrimmedMeanFromContext, voteweighted.MedianWithQuorumFromContext and
+	// voteweighted.EqualWeightMedianFromContext. Markets may override the global power
+	// threshold via their Ticker in the market map.
+	powerThresholdOpt := voteweighted.WithPowerThresholdStore(app.MarketMapKeeper)
 	aggregatorFn := voteweighted.MedianFromContext(
 		app.Logger(),
 		app.StakingKeeper,
 		voteweighted.DefaultPowerThreshold,
+		powerThresholdOpt,
+	)
+
+	// Create the spread function that will be used to compute the dispersion of the
+	// oracle data reported by each validator. The spread is stored alongside each price.
+	spreadFn := aggregator.WithSpreadFnFromContext(
+		voteweighted.SpreadFromContext(
+			app.Logger(),
+			app.StakingKeeper,
+			voteweighted.DefaultPowerThreshold,
+			powerThresholdOpt,
+		),
 	)
 
 	// Create the pre-finalize block hook that will be used to apply oracle data
@@ -353,6 +369,7 @@ func NewSimApp(
 			compression.NewDefaultExtendedCommitCodec(),
 			compression.NewZStdCompressor(),
 		),
+		spreadFn,
 	)
 
 	app.SetPreBlocker(oraclePreBlockHandler.WrappedPreBlocker(app.ModuleManager))
@@ -381,6 +398,7 @@ func NewSimApp(
 				// we need a separate price strategy here, so that we can optimistically apply the latest prices
 				// and extend our vote based on these prices
 				currencypair.NewDeltaCurrencyPairStrategy(app.OracleKeeper),
+				spreadFn,
 			),
 			app.OracleKeeper,
 			veCodec,
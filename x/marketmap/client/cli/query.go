@@ -1,14 +1,25 @@
 package cli
 
 import (
+	"fmt"
+	"strconv"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/spf13/cobra"
 
 	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
 	"github.com/skip-mev/connect/v2/x/marketmap/types"
 )
 
+const (
+	FlagEnabledFilter = "enabled"
+	FlagProvider      = "provider"
+	FlagBase          = "base"
+	FlagQuote         = "quote"
+)
+
 // GetQueryCmd returns the parent command for all x/marketmap cli query commands.
 func GetQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -24,6 +35,12 @@ func GetQueryCmd() *cobra.Command {
 		CmdQueryMarketMap(),
 		CmdQueryLastUpdated(),
 		CmdQueryMarket(),
+		CmdQueryScheduledMarketChanges(),
+		CmdQueryMarketAuthorityScopes(),
+		CmdQueryMarketHistory(),
+		CmdQueryMarketMapAtHeight(),
+		CmdQueryMarketsSince(),
+		CmdQueryProviders(),
 	)
 
 	return cmd
@@ -59,15 +76,32 @@ func CmdQueryMarketMap() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "market-map",
 		Short: "Query the current market map",
-		Args:  cobra.NoArgs,
+		Long: `Query the current market map. The markets can be filtered by enabled state, provider name and base or
+quote asset, and are paginated if pagination flags are given.`,
+		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
 			}
 
+			filter, err := readMarketFilter(cmd)
+			if err != nil {
+				return err
+			}
+
+			var pageReq *query.PageRequest
+			if paginationFlagsChanged(cmd) {
+				if pageReq, err = client.ReadPageRequest(cmd.Flags()); err != nil {
+					return err
+				}
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
-			res, err := queryClient.MarketMap(cmd.Context(), &types.MarketMapRequest{})
+			res, err := queryClient.MarketMap(cmd.Context(), &types.MarketMapRequest{
+				Pagination: pageReq,
+				Filter:     filter,
+			})
 			if err != nil {
 				return err
 			}
@@ -76,6 +110,11 @@ func CmdQueryMarketMap() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagEnabledFilter, "", "only return markets with the given enabled state (enabled|disabled)")
+	cmd.Flags().String(FlagProvider, "", "only return markets with a provider config of the given provider name")
+	cmd.Flags().String(FlagBase, "", "only return markets with the given base asset")
+	cmd.Flags().String(FlagQuote, "", "only return markets with the given quote asset")
+	flags.AddPaginationFlagsToCmd(cmd, "market-map")
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -133,3 +172,224 @@ func CmdQueryLastUpdated() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdQueryScheduledMarketChanges() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scheduled-market-changes",
+		Short: "Query the pending scheduled market changes",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ScheduledMarketChanges(cmd.Context(), &types.ScheduledMarketChangesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryMarketAuthorityScopes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "market-authority-scopes",
+		Short: "Query the scopes of the scoped market authorities",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.MarketAuthorityScopes(cmd.Context(), &types.MarketAuthorityScopesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryMarketHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "market-history [base] [quote]",
+		Short: "Query the recorded changes made to the market of the given currency pair",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cp := connecttypes.NewCurrencyPair(args[0], args[1])
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.MarketHistory(cmd.Context(), &types.MarketHistoryRequest{
+				CurrencyPair: cp,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryMarketMapAtHeight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "market-map-at-height [height]",
+		Short: "Query the market map as it was at the end of the given height",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height %s: %w", args[0], err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.MarketMapAtHeight(cmd.Context(), &types.MarketMapAtHeightRequest{
+				Height: height,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryMarketsSince() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "markets-since [height]",
+		Short: "Query the markets that were created, updated or removed after the given height",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height %s: %w", args[0], err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.MarketsSince(cmd.Context(), &types.MarketsSinceRequest{
+				Height: height,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryProviders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "providers",
+		Short: "Query the providers of the provider registry",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Providers(cmd.Context(), &types.ProvidersRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// readMarketFilter reads the market filter from the filter flags of the command.
+func readMarketFilter(cmd *cobra.Command) (types.MarketFilter, error) {
+	var filter types.MarketFilter
+
+	enabled, err := cmd.Flags().GetString(FlagEnabledFilter)
+	if err != nil {
+		return filter, err
+	}
+
+	switch enabled {
+	case "":
+	case "enabled":
+		filter.Enabled = types.MARKET_ENABLED_FILTER_ENABLED
+	case "disabled":
+		filter.Enabled = types.MARKET_ENABLED_FILTER_DISABLED
+	default:
+		return filter, fmt.Errorf("invalid enabled filter %s; expected enabled or disabled", enabled)
+	}
+
+	if filter.Provider, err = cmd.Flags().GetString(FlagProvider); err != nil {
+		return filter, err
+	}
+
+	if filter.Base, err = cmd.Flags().GetString(FlagBase); err != nil {
+		return filter, err
+	}
+
+	if filter.Quote, err = cmd.Flags().GetString(FlagQuote); err != nil {
+		return filter, err
+	}
+
+	return filter, nil
+}
+
+// paginationFlagsChanged returns true if any of the pagination flags of the command is set, in which case the
+// query is paginated. Otherwise all results are returned.
+func paginationFlagsChanged(cmd *cobra.Command) bool {
+	for _, flag := range []string{
+		flags.FlagPage,
+		flags.FlagPageKey,
+		flags.FlagOffset,
+		flags.FlagLimit,
+		flags.FlagCountTotal,
+		flags.FlagReverse,
+	} {
+		if cmd.Flags().Changed(flag) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,671 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	connectcli "github.com/skip-mev/connect/v2/pkg/cli"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+const (
+	// FlagAuthority is the address of the market authority (or governance) that the messages are executed by. It
+	// defaults to the --from address.
+	FlagAuthority = connectcli.FlagAuthority
+
+	FlagDecimals           = "decimals"
+	FlagMinProviderCount   = "min-provider-count"
+	FlagEnabled            = "enabled"
+	FlagMetadataJSON       = "metadata-json"
+	FlagPowerThreshold     = "power-threshold"
+	FlagMaxPriceAgeBlocks  = "max-price-age-blocks"
+	FlagMaxPriceAgeSeconds = "max-price-age-seconds"
+
+	FlagAdmin                        = "admin"
+	FlagMarketAuthorities            = "market-authorities"
+	FlagMarketHistoryRetentionBlocks = "market-history-retention-blocks"
+
+	FlagHeight     = "height"
+	FlagTime       = "time"
+	FlagMarketFile = "market-file"
+
+	FlagCurrencyPairs = "currency-pairs"
+	FlagMetadataTags  = "metadata-tags"
+	FlagPermissions   = "permissions"
+
+	FlagProviderType        = "type"
+	FlagProviderStatus      = "status"
+	FlagDefaultMetadataJSON = "default-metadata-json"
+)
+
+// GetTxCmd returns the parent command for all x/marketmap cli tx commands. All messages can only be executed by the
+// market authorities, the admin, or governance. Use --generate-only (with --authority set to the governance module
+// account) to generate the messages of a governance proposal.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Transaction commands for the marketmap module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdUpsertMarkets(),
+		CmdCreateMarkets(),
+		CmdUpdateMarkets(),
+		CmdRemoveMarkets(),
+		CmdEditTicker(),
+		CmdUpdateParams(),
+		CmdRemoveMarketAuthorities(),
+		CmdScheduleMarketChange(),
+		CmdCancelScheduledMarketChange(),
+		CmdGrantMarketAuthorityScope(),
+		CmdRevokeMarketAuthorityScope(),
+		CmdUpsertProvider(),
+		CmdRemoveProviders(),
+	)
+
+	return cmd
+}
+
+// CmdUpsertMarkets returns the command that creates or updates the markets of a market map file.
+func CmdUpsertMarkets() *cobra.Command {
+	return newMarketsFileCmd(
+		"upsert-markets [markets-file]",
+		"Create or update the markets of a market map JSON file",
+		func(authority string, markets []types.Market) sdk.Msg {
+			return &types.MsgUpsertMarkets{Authority: authority, Markets: markets}
+		},
+	)
+}
+
+// CmdCreateMarkets returns the command that creates the markets of a market map file.
+func CmdCreateMarkets() *cobra.Command {
+	return newMarketsFileCmd(
+		"create-markets [markets-file]",
+		"Create the markets of a market map JSON file",
+		func(authority string, markets []types.Market) sdk.Msg {
+			return &types.MsgCreateMarkets{Authority: authority, CreateMarkets: markets}
+		},
+	)
+}
+
+// CmdUpdateMarkets returns the command that updates the markets of a market map file.
+func CmdUpdateMarkets() *cobra.Command {
+	return newMarketsFileCmd(
+		"update-markets [markets-file]",
+		"Update the markets of a market map JSON file",
+		func(authority string, markets []types.Market) sdk.Msg {
+			return &types.MsgUpdateMarkets{Authority: authority, UpdateMarkets: markets}
+		},
+	)
+}
+
+// newMarketsFileCmd returns a command that reads the markets of a (partial) market map JSON file, validates each
+// market locally, and generates or broadcasts the message built from them.
+func newMarketsFileCmd(use, short string, newMsg func(authority string, markets []types.Market) sdk.Msg) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Long: fmt.Sprintf(`%s.
+
+The file is a market map JSON file, i.e. {"markets": {"BTC/USD": {"ticker": {...}, "provider_configs": [...]}}}.
+It may only contain the markets to change, each market is validated locally before the message is generated.`, short),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			markets, err := types.ReadMarketsFromFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			authority, err := connectcli.GetAuthority(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+
+			return connectcli.ValidateAndBroadcast(cmd, clientCtx, newMsg(authority, markets))
+		},
+	}
+
+	connectcli.AddTxFlags(cmd)
+	return cmd
+}
+
+// CmdRemoveMarkets returns the command that removes the given markets.
+func CmdRemoveMarkets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-markets [base/quote]...",
+		Short: "Remove the given markets from the market map",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := connectcli.GetAuthority(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+
+			return connectcli.ValidateAndBroadcast(cmd, clientCtx, &types.MsgRemoveMarkets{
+				Authority: authority,
+				Markets:   args,
+			})
+		},
+	}
+
+	connectcli.AddTxFlags(cmd)
+	return cmd
+}
+
+// CmdEditTicker returns the command that updates the ticker of an existing market from flags. The market is queried
+// from the chain, and only the fields of the given flags are changed.
+func CmdEditTicker() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "edit-ticker [base/quote]",
+		Short: "Update the ticker of an existing market from flags",
+		Long: `Update the ticker of an existing market from flags. The market is queried from the chain, and only the
+fields of the given flags are changed. Use --power-threshold="" to unset the market's power threshold.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cp, err := connecttypes.CurrencyPairFromString(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).Market(cmd.Context(), &types.MarketRequest{
+				CurrencyPair: cp,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to query market %s: %w", cp, err)
+			}
+
+			market := res.Market
+			if err := editTicker(cmd, &market.Ticker); err != nil {
+				return err
+			}
+
+			authority, err := connectcli.GetAuthority(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+
+			return connectcli.ValidateAndBroadcast(cmd, clientCtx, &types.MsgUpdateMarkets{
+				Authority:     authority,
+				UpdateMarkets: []types.Market{market},
+			})
+		},
+	}
+
+	cmd.Flags().Uint64(FlagDecimals, 0, "the number of decimals of the ticker's price")
+	cmd.Flags().Uint64(FlagMinProviderCount, 0, "the minimum number of providers required to consider the ticker valid")
+	cmd.Flags().Bool(FlagEnabled, false, "whether the ticker is enabled for price fetching")
+	cmd.Flags().String(FlagMetadataJSON, "", "the JSON metadata of the ticker")
+	cmd.Flags().String(FlagPowerThreshold, "", "the per-market voting power threshold, in (0, 1]")
+	cmd.Flags().Uint64(FlagMaxPriceAgeBlocks, 0, "the maximum age, in blocks, of the ticker's price (0 for no limit)")
+	cmd.Flags().Uint64(FlagMaxPriceAgeSeconds, 0, "the maximum age, in seconds, of the ticker's price (0 for no limit)")
+	connectcli.AddTxFlags(cmd)
+	return cmd
+}
+
+// editTicker applies the changed ticker flags of the command to the ticker.
+func editTicker(cmd *cobra.Command, ticker *types.Ticker) error {
+	fs := cmd.Flags()
+
+	var err error
+	if fs.Changed(FlagDecimals) {
+		if ticker.Decimals, err = fs.GetUint64(FlagDecimals); err != nil {
+			return err
+		}
+	}
+
+	if fs.Changed(FlagMinProviderCount) {
+		if ticker.MinProviderCount, err = fs.GetUint64(FlagMinProviderCount); err != nil {
+			return err
+		}
+	}
+
+	if fs.Changed(FlagEnabled) {
+		if ticker.Enabled, err = fs.GetBool(FlagEnabled); err != nil {
+			return err
+		}
+	}
+
+	if fs.Changed(FlagMetadataJSON) {
+		if ticker.Metadata_JSON, err = fs.GetString(FlagMetadataJSON); err != nil {
+			return err
+		}
+	}
+
+	if fs.Changed(FlagPowerThreshold) {
+		threshold, err := fs.GetString(FlagPowerThreshold)
+		if err != nil {
+			return err
+		}
+
+		ticker.PowerThreshold = nil
+		if threshold != "" {
+			dec, err := math.LegacyNewDecFromStr(threshold)
+			if err != nil {
+				return fmt.Errorf("invalid power threshold: %w", err)
+			}
+			ticker.PowerThreshold = &dec
+		}
+	}
+
+	if fs.Changed(FlagMaxPriceAgeBlocks) {
+		if ticker.MaxPriceAgeBlocks, err = fs.GetUint64(FlagMaxPriceAgeBlocks); err != nil {
+			return err
+		}
+	}
+
+	if fs.Changed(FlagMaxPriceAgeSeconds) {
+		if ticker.MaxPriceAgeSeconds, err = fs.GetUint64(FlagMaxPriceAgeSeconds); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CmdUpdateParams returns the command that updates the module's parameters from flags. The current parameters are
+// queried from the chain, and only the parameters of the given flags are changed.
+func CmdUpdateParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-params",
+		Short: "Update the marketmap module parameters from flags",
+		Long: `Update the marketmap module parameters from flags. The current parameters are queried from the chain,
+and only the parameters of the given flags are changed. This message can only be executed by governance.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).Params(cmd.Context(), &types.ParamsRequest{})
+			if err != nil {
+				return fmt.Errorf("failed to query params: %w", err)
+			}
+
+			params := res.Params
+			if cmd.Flags().Changed(FlagAdmin) {
+				if params.Admin, err = cmd.Flags().GetString(FlagAdmin); err != nil {
+					return err
+				}
+			}
+
+			if cmd.Flags().Changed(FlagMarketAuthorities) {
+				if params.MarketAuthorities, err = cmd.Flags().GetStringSlice(FlagMarketAuthorities); err != nil {
+					return err
+				}
+			}
+
+			if cmd.Flags().Changed(FlagMarketHistoryRetentionBlocks) {
+				if params.MarketHistoryRetentionBlocks, err = cmd.Flags().GetUint64(FlagMarketHistoryRetentionBlocks); err != nil {
+					return err
+				}
+			}
+
+			authority, err := connectcli.GetAuthority(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+
+			return connectcli.ValidateAndBroadcast(cmd, clientCtx, &types.MsgParams{
+				Params:    params,
+				Authority: authority,
+			})
+		},
+	}
+
+	cmd.Flags().String(FlagAdmin, "", "the address of the admin")
+	cmd.Flags().StringSlice(FlagMarketAuthorities, nil, "the comma-separated addresses of the market authorities")
+	cmd.Flags().Uint64(FlagMarketHistoryRetentionBlocks, 0, "the number of blocks the market history is kept for (0 keeps it forever)")
+	connectcli.AddTxFlags(cmd)
+	return cmd
+}
+
+// CmdRemoveMarketAuthorities returns the command that removes the given market authorities. The message is signed
+// by the admin, i.e. the --from address.
+func CmdRemoveMarketAuthorities() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-market-authorities [address]...",
+		Short: "Remove the given addresses from the market authorities",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			return connectcli.ValidateAndBroadcast(cmd, clientCtx, &types.MsgRemoveMarketAuthorities{
+				RemoveAddresses: args,
+				Admin:           clientCtx.GetFromAddress().String(),
+			})
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdScheduleMarketChange returns the command that schedules a change of an existing market at a target height or
+// time.
+func CmdScheduleMarketChange() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule-market-change [enable|disable|update] [base/quote]",
+		Short: "Schedule the enabling, disabling or update of a market at a target height or time",
+		Long: `Schedule the enabling, disabling or update of a market at a target height or time.
+
+Exactly one of --height and --time (RFC3339) must be set. An update replaces the market with the single market
+of the market map JSON file given by --market-file.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			action, ok := types.ScheduledMarketAction_value["SCHEDULED_MARKET_ACTION_"+strings.ToUpper(args[0])]
+			if !ok {
+				return fmt.Errorf("invalid action %s", args[0])
+			}
+
+			cp, err := connecttypes.CurrencyPairFromString(args[1])
+			if err != nil {
+				return err
+			}
+
+			change := types.ScheduledMarketChange{
+				Action:       types.ScheduledMarketAction(action),
+				CurrencyPair: cp,
+			}
+
+			if change.Height, err = cmd.Flags().GetUint64(FlagHeight); err != nil {
+				return err
+			}
+
+			targetTime, err := cmd.Flags().GetString(FlagTime)
+			if err != nil {
+				return err
+			}
+			if targetTime != "" {
+				t, err := time.Parse(time.RFC3339, targetTime)
+				if err != nil {
+					return fmt.Errorf("invalid time: %w", err)
+				}
+				change.Time = &t
+			}
+
+			marketFile, err := cmd.Flags().GetString(FlagMarketFile)
+			if err != nil {
+				return err
+			}
+			if marketFile != "" {
+				markets, err := types.ReadMarketsFromFile(marketFile)
+				if err != nil {
+					return err
+				}
+				if len(markets) != 1 {
+					return fmt.Errorf("market file must contain exactly one market, got %d", len(markets))
+				}
+				change.Market = &markets[0]
+			}
+
+			authority, err := connectcli.GetAuthority(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+
+			return connectcli.ValidateAndBroadcast(cmd, clientCtx, &types.MsgScheduleMarketChange{
+				Authority: authority,
+				Change:    change,
+			})
+		},
+	}
+
+	cmd.Flags().Uint64(FlagHeight, 0, "the block height at which the change is executed")
+	cmd.Flags().String(FlagTime, "", "the block time (RFC3339) at which the change is executed")
+	cmd.Flags().String(FlagMarketFile, "", "the market map JSON file containing the updated market")
+	connectcli.AddTxFlags(cmd)
+	return cmd
+}
+
+// CmdCancelScheduledMarketChange returns the command that cancels a pending scheduled market change.
+func CmdCancelScheduledMarketChange() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-scheduled-market-change [id]",
+		Short: "Cancel a pending scheduled market change",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid id: %w", err)
+			}
+
+			authority, err := connectcli.GetAuthority(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+
+			return connectcli.ValidateAndBroadcast(cmd, clientCtx, &types.MsgCancelScheduledMarketChange{
+				Authority: authority,
+				Id:        id,
+			})
+		},
+	}
+
+	connectcli.AddTxFlags(cmd)
+	return cmd
+}
+
+// CmdGrantMarketAuthorityScope returns the command that grants a scope to a market authority. The message is signed
+// by the admin, i.e. the --from address.
+func CmdGrantMarketAuthorityScope() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-market-authority-scope [address]",
+		Short: "Grant a scope of markets and permissions to a market authority",
+		Long: `Grant a scope of markets and permissions to a market authority, replacing any scope previously granted to it.
+
+The scope matches the markets whose ticker matches one of --currency-pairs, or whose metadata "tags" contain one of
+--metadata-tags. A currency pair matcher is of the form BASE/QUOTE, where either the base or the quote may be the
+wildcard *, e.g. BTC/* or */USDT. --permissions is a comma-separated list of provider-configs, enable and all.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			scope := types.MarketAuthorityScope{
+				Authority: args[0],
+			}
+
+			matchers, err := cmd.Flags().GetStringSlice(FlagCurrencyPairs)
+			if err != nil {
+				return err
+			}
+
+			for _, matcher := range matchers {
+				m, err := types.CurrencyPairMatcherFromString(matcher)
+				if err != nil {
+					return err
+				}
+				scope.CurrencyPairs = append(scope.CurrencyPairs, m)
+			}
+
+			if scope.MetadataTags, err = cmd.Flags().GetStringSlice(FlagMetadataTags); err != nil {
+				return err
+			}
+
+			permissions, err := cmd.Flags().GetStringSlice(FlagPermissions)
+			if err != nil {
+				return err
+			}
+
+			for _, permission := range permissions {
+				name := "MARKET_PERMISSION_" + strings.ToUpper(strings.ReplaceAll(permission, "-", "_"))
+				value, ok := types.MarketPermission_value[name]
+				if !ok {
+					return fmt.Errorf("invalid permission %s", permission)
+				}
+				scope.Permissions = append(scope.Permissions, types.MarketPermission(value))
+			}
+
+			return connectcli.ValidateAndBroadcast(cmd, clientCtx, &types.MsgGrantMarketAuthorityScope{
+				Admin: clientCtx.GetFromAddress().String(),
+				Scope: scope,
+			})
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagCurrencyPairs, nil, "the comma-separated currency pair matchers of the scope, e.g. BTC/*,*/USDT")
+	cmd.Flags().StringSlice(FlagMetadataTags, nil, "the comma-separated metadata tags of the scope")
+	cmd.Flags().StringSlice(FlagPermissions, nil, "the comma-separated permissions of the scope")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdRevokeMarketAuthorityScope returns the command that revokes the scope of a market authority. The message is
+// signed by the admin, i.e. the --from address.
+func CmdRevokeMarketAuthorityScope() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-market-authority-scope [address]",
+		Short: "Revoke the scope of a market authority",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			return connectcli.ValidateAndBroadcast(cmd, clientCtx, &types.MsgRevokeMarketAuthorityScope{
+				Admin:     clientCtx.GetFromAddress().String(),
+				Authority: args[0],
+			})
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdUpsertProvider returns the command that registers a provider in the provider registry.
+func CmdUpsertProvider() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upsert-provider [name]",
+		Short: "Register a provider in the provider registry",
+		Long: `Register a provider in the provider registry, replacing any provider already registered under the same name.
+
+The name must match the name of the provider in the oracle configuration of the sidecar. --type is one of cex, dex
+and aggregator, and --status is one of active and deprecated.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			provider := types.ProviderInfo{
+				Name: args[0],
+			}
+
+			providerType, err := cmd.Flags().GetString(FlagProviderType)
+			if err != nil {
+				return err
+			}
+
+			value, ok := types.ProviderType_value["PROVIDER_TYPE_"+strings.ToUpper(providerType)]
+			if !ok {
+				return fmt.Errorf("invalid provider type %s", providerType)
+			}
+			provider.Type = types.ProviderType(value)
+
+			status, err := cmd.Flags().GetString(FlagProviderStatus)
+			if err != nil {
+				return err
+			}
+
+			value, ok = types.ProviderStatus_value["PROVIDER_STATUS_"+strings.ToUpper(status)]
+			if !ok {
+				return fmt.Errorf("invalid provider status %s", status)
+			}
+			provider.Status = types.ProviderStatus(value)
+
+			if provider.DefaultMetadata_JSON, err = cmd.Flags().GetString(FlagDefaultMetadataJSON); err != nil {
+				return err
+			}
+
+			authority, err := connectcli.GetAuthority(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+
+			return connectcli.ValidateAndBroadcast(cmd, clientCtx, &types.MsgUpsertProviders{
+				Authority: authority,
+				Providers: []types.ProviderInfo{provider},
+			})
+		},
+	}
+
+	cmd.Flags().String(FlagProviderType, "", "the type of the provider: cex, dex or aggregator")
+	cmd.Flags().String(FlagProviderStatus, "active", "the status of the provider: active or deprecated")
+	cmd.Flags().String(FlagDefaultMetadataJSON, "", "the metadata JSON given to provider configs without metadata")
+	connectcli.AddTxFlags(cmd)
+	return cmd
+}
+
+// CmdRemoveProviders returns the command that removes the given providers from the provider registry.
+func CmdRemoveProviders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-providers [name]...",
+		Short: "Remove the given providers from the provider registry",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := connectcli.GetAuthority(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+
+			return connectcli.ValidateAndBroadcast(cmd, clientCtx, &types.MsgRemoveProviders{
+				Authority: authority,
+				Names:     args,
+			})
+		},
+	}
+
+	connectcli.AddTxFlags(cmd)
+	return cmd
+}
@@ -0,0 +1,53 @@
+package cli_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/x/marketmap"
+	"github.com/skip-mev/connect/v2/x/marketmap/client/cli"
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+func TestRemoveMarketsGenerateOnly(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(marketmap.AppModuleBasic{})
+	clientCtx := client.Context{}.
+		WithTxConfig(encCfg.TxConfig).
+		WithCodec(encCfg.Codec).
+		WithInterfaceRegistry(encCfg.InterfaceRegistry)
+
+	from := sdk.AccAddress("from")
+	authority := sdk.AccAddress("authority")
+
+	cmd := cli.CmdRemoveMarkets()
+	cmd.SetArgs([]string{
+		"BTC/USD",
+		"ETH/USD",
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, from),
+		fmt.Sprintf("--%s=%s", cli.FlagAuthority, authority),
+		fmt.Sprintf("--%s", flags.FlagGenerateOnly),
+		fmt.Sprintf("--%s=test-chain", flags.FlagChainID),
+	})
+	_, out := testutil.ApplyMockIO(cmd)
+	clientCtx = clientCtx.WithOutput(out)
+
+	require.NoError(t, cmd.ExecuteContext(context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)))
+
+	tx, err := encCfg.TxConfig.TxJSONDecoder()(out.Bytes())
+	require.NoError(t, err)
+
+	msgs := tx.GetMsgs()
+	require.Len(t, msgs, 1)
+	require.Equal(t, &types.MsgRemoveMarkets{
+		Authority: authority.String(),
+		Markets:   []string{"BTC/USD", "ETH/USD"},
+	}, msgs[0])
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+// BeginBlocker is called at the beginning of every block. It prunes the market history, then executes, in
+// order of ID, every scheduled market change whose target height or time has been reached. Each change is
+// executed in isolation: if the change, its hooks or the validation of the resulting market map fail, the
+// change is discarded and a failure event is emitted. Executed and failed changes are removed from the queue.
+func (k *Keeper) BeginBlocker(goCtx context.Context) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.PruneMarketHistory(ctx); err != nil {
+		return err
+	}
+
+	height := uint64(ctx.BlockHeight()) //nolint:gosec
+	changes, err := k.GetDueScheduledMarketChanges(ctx, height, ctx.BlockTime())
+	if err != nil {
+		return err
+	}
+
+	updated := false
+	for _, change := range changes {
+		if err := k.removeScheduledMarketChange(ctx, change); err != nil {
+			return err
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		market, err := k.executeScheduledMarketChange(cacheCtx, change)
+		if err != nil {
+			ctx.Logger().Error(
+				"failed to execute scheduled market change",
+				"id", change.Id,
+				"currency_pair", change.CurrencyPair.String(),
+				"err", err,
+			)
+
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeScheduledMarketChangeFailed,
+				sdk.NewAttribute(types.AttributeKeyScheduledID, strconv.FormatUint(change.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyCurrencyPair, change.CurrencyPair.String()),
+				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+			))
+			continue
+		}
+
+		write()
+		updated = true
+
+		ctx.Logger().Info(fmt.Sprintf("executed scheduled market change %d for market %s", change.Id, market.Ticker.String()))
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeUpdateMarket,
+			sdk.NewAttribute(types.AttributeKeyCurrencyPair, market.Ticker.String()),
+			sdk.NewAttribute(types.AttributeKeyDecimals, strconv.FormatUint(market.Ticker.Decimals, 10)),
+			sdk.NewAttribute(types.AttributeKeyMinProviderCount, strconv.FormatUint(market.Ticker.MinProviderCount, 10)),
+			sdk.NewAttribute(types.AttributeKeyMetadata, market.Ticker.Metadata_JSON),
+		))
+	}
+
+	if !updated {
+		return nil
+	}
+
+	return k.SetLastUpdated(ctx, height)
+}
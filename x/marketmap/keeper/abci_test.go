@@ -0,0 +1,315 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+func (s *KeeperTestSuite) TestBeginBlockerScheduledMarketChanges() {
+	enabled := func(market types.Market) types.Market {
+		market.Ticker.Enabled = true
+		return market
+	}
+
+	btcusdtViaUSDT := btcusdt
+	btcusdtViaUSDT.ProviderConfigs = []types.ProviderConfig{
+		{
+			Name:            "kucoin",
+			OffChainTicker:  "btc-usdt",
+			NormalizeByPair: &usdtusd.Ticker.CurrencyPair,
+		},
+	}
+
+	requireEnabled := func(market types.Market, expected bool) {
+		got, err := s.keeper.GetMarket(s.ctx, market.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().Equal(expected, got.Ticker.Enabled)
+	}
+
+	requireEvent := func(eventType string) {
+		for _, event := range s.ctx.EventManager().Events() {
+			if event.Type == eventType {
+				return
+			}
+		}
+		s.Fail("event not emitted", eventType)
+	}
+
+	s.Run("no scheduled changes is a no-op", func() {
+		s.SetupTest()
+		s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+	})
+
+	s.Run("enable at height", func() {
+		s.SetupTest()
+		s.Require().NoError(s.keeper.CreateMarket(s.ctx, btcusdt))
+
+		id, err := s.keeper.ScheduleMarketChange(s.ctx, types.ScheduledMarketChange{
+			Action:       types.SCHEDULED_MARKET_ACTION_ENABLE,
+			CurrencyPair: btcusdt.Ticker.CurrencyPair,
+			Height:       12,
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(1), id)
+
+		s.ctx = s.ctx.WithBlockHeight(11)
+		s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+		requireEnabled(btcusdt, false)
+
+		s.ctx = s.ctx.WithBlockHeight(12).WithEventManager(sdk.NewEventManager())
+		s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+		requireEnabled(btcusdt, true)
+		requireEvent(types.EventTypeUpdateMarket)
+
+		lastUpdated, err := s.keeper.GetLastUpdated(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(12), lastUpdated)
+
+		changes, err := s.keeper.GetAllScheduledMarketChanges(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Empty(changes)
+	})
+
+	s.Run("disable at time", func() {
+		s.SetupTest()
+		s.Require().NoError(s.keeper.CreateMarket(s.ctx, enabled(btcusdt)))
+
+		target := s.ctx.BlockTime().Add(time.Hour)
+		_, err := s.keeper.ScheduleMarketChange(s.ctx, types.ScheduledMarketChange{
+			Action:       types.SCHEDULED_MARKET_ACTION_DISABLE,
+			CurrencyPair: btcusdt.Ticker.CurrencyPair,
+			Time:         &target,
+		})
+		s.Require().NoError(err)
+
+		s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+		requireEnabled(btcusdt, true)
+
+		s.ctx = s.ctx.WithBlockTime(target)
+		s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+		requireEnabled(btcusdt, false)
+	})
+
+	s.Run("update replaces the market", func() {
+		s.SetupTest()
+		s.Require().NoError(s.keeper.CreateMarket(s.ctx, btcusdt))
+
+		updated := enabled(btcusdt)
+		updated.Ticker.Decimals = 12
+		_, err := s.keeper.ScheduleMarketChange(s.ctx, types.ScheduledMarketChange{
+			Action:       types.SCHEDULED_MARKET_ACTION_UPDATE,
+			CurrencyPair: btcusdt.Ticker.CurrencyPair,
+			Market:       &updated,
+			Height:       uint64(s.ctx.BlockHeight()), //nolint:gosec
+		})
+		s.Require().NoError(err)
+
+		s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+
+		got, err := s.keeper.GetMarket(s.ctx, btcusdt.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().Equal(updated, got)
+	})
+
+	s.Run("changes are executed in order of id", func() {
+		s.SetupTest()
+		s.Require().NoError(s.keeper.CreateMarket(s.ctx, btcusdt))
+
+		for _, action := range []types.ScheduledMarketAction{
+			types.SCHEDULED_MARKET_ACTION_DISABLE,
+			types.SCHEDULED_MARKET_ACTION_ENABLE,
+		} {
+			_, err := s.keeper.ScheduleMarketChange(s.ctx, types.ScheduledMarketChange{
+				Action:       action,
+				CurrencyPair: btcusdt.Ticker.CurrencyPair,
+				Height:       uint64(s.ctx.BlockHeight()), //nolint:gosec
+			})
+			s.Require().NoError(err)
+		}
+
+		s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+		requireEnabled(btcusdt, true)
+	})
+
+	s.Run("failed change is discarded without affecting state", func() {
+		s.SetupTest()
+		s.Require().NoError(s.keeper.CreateMarket(s.ctx, usdtusd))
+		s.Require().NoError(s.keeper.CreateMarket(s.ctx, btcusdtViaUSDT))
+		s.Require().NoError(s.keeper.SetLastUpdated(s.ctx, 1))
+
+		// the normalization market of btc/usdt is disabled
+		_, err := s.keeper.ScheduleMarketChange(s.ctx, types.ScheduledMarketChange{
+			Action:       types.SCHEDULED_MARKET_ACTION_ENABLE,
+			CurrencyPair: btcusdt.Ticker.CurrencyPair,
+			Height:       uint64(s.ctx.BlockHeight()), //nolint:gosec
+		})
+		s.Require().NoError(err)
+
+		s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+		s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+		requireEnabled(btcusdt, false)
+		requireEvent(types.EventTypeScheduledMarketChangeFailed)
+
+		lastUpdated, err := s.keeper.GetLastUpdated(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(1), lastUpdated)
+
+		changes, err := s.keeper.GetAllScheduledMarketChanges(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Empty(changes)
+	})
+
+	s.Run("disabling a normalization market of an enabled market fails", func() {
+		s.SetupTest()
+		s.Require().NoError(s.keeper.CreateMarket(s.ctx, enabled(usdtusd)))
+		s.Require().NoError(s.keeper.CreateMarket(s.ctx, enabled(btcusdtViaUSDT)))
+
+		_, err := s.keeper.ScheduleMarketChange(s.ctx, types.ScheduledMarketChange{
+			Action:       types.SCHEDULED_MARKET_ACTION_DISABLE,
+			CurrencyPair: usdtusd.Ticker.CurrencyPair,
+			Height:       uint64(s.ctx.BlockHeight()), //nolint:gosec
+		})
+		s.Require().NoError(err)
+
+		s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+		requireEnabled(usdtusd, true)
+	})
+
+	s.Run("scheduled update is re-authorized against the current market", func() {
+		s.SetupTest()
+		s.Require().NoError(s.keeper.CreateMarket(s.ctx, btcusdt))
+
+		configurer := sdk.AccAddress("configurer").String()
+		s.Require().NoError(s.keeper.SetMarketAuthorityScope(s.ctx, types.MarketAuthorityScope{
+			Authority:     configurer,
+			CurrencyPairs: []types.CurrencyPairMatcher{{Base: "BITCOIN"}},
+			Permissions:   []types.MarketPermission{types.MARKET_PERMISSION_PROVIDER_CONFIGS},
+		}))
+
+		// the scope permits the update of the provider configs of the market as it is now
+		updated := btcusdt
+		updated.ProviderConfigs = []types.ProviderConfig{{Name: "okx", OffChainTicker: "BTC-USDT"}}
+		_, err := s.keeper.ScheduleMarketChange(s.ctx, types.ScheduledMarketChange{
+			Action:       types.SCHEDULED_MARKET_ACTION_UPDATE,
+			CurrencyPair: btcusdt.Ticker.CurrencyPair,
+			Market:       &updated,
+			Height:       uint64(s.ctx.BlockHeight()), //nolint:gosec
+			Authority:    configurer,
+		})
+		s.Require().NoError(err)
+
+		// the ticker is changed in the meantime, so the update would revert it
+		changedTicker := btcusdt
+		changedTicker.Ticker.Decimals = 12
+		s.Require().NoError(s.keeper.UpdateMarket(s.ctx, changedTicker))
+
+		s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+		s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+		requireEvent(types.EventTypeScheduledMarketChangeFailed)
+
+		got, err := s.keeper.GetMarket(s.ctx, btcusdt.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().Equal(changedTicker, got)
+	})
+
+	s.Run("changes of a revoked scope fail", func() {
+		s.SetupTest()
+		s.Require().NoError(s.keeper.CreateMarket(s.ctx, btcusdt))
+
+		enabler := sdk.AccAddress("enabler").String()
+		s.Require().NoError(s.keeper.SetMarketAuthorityScope(s.ctx, types.MarketAuthorityScope{
+			Authority:     enabler,
+			CurrencyPairs: []types.CurrencyPairMatcher{{Base: "BITCOIN"}},
+			Permissions:   []types.MarketPermission{types.MARKET_PERMISSION_ENABLE},
+		}))
+
+		_, err := s.keeper.ScheduleMarketChange(s.ctx, types.ScheduledMarketChange{
+			Action:       types.SCHEDULED_MARKET_ACTION_ENABLE,
+			CurrencyPair: btcusdt.Ticker.CurrencyPair,
+			Height:       uint64(s.ctx.BlockHeight()), //nolint:gosec
+			Authority:    enabler,
+		})
+		s.Require().NoError(err)
+		s.Require().NoError(s.keeper.RemoveMarketAuthorityScope(s.ctx, enabler))
+
+		s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+		s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+		requireEvent(types.EventTypeScheduledMarketChangeFailed)
+		requireEnabled(btcusdt, false)
+	})
+
+	s.Run("change of a removed market fails", func() {
+		s.SetupTest()
+		s.Require().NoError(s.keeper.CreateMarket(s.ctx, btcusdt))
+
+		_, err := s.keeper.ScheduleMarketChange(s.ctx, types.ScheduledMarketChange{
+			Action:       types.SCHEDULED_MARKET_ACTION_ENABLE,
+			CurrencyPair: btcusdt.Ticker.CurrencyPair,
+			Height:       uint64(s.ctx.BlockHeight()), //nolint:gosec
+		})
+		s.Require().NoError(err)
+
+		deleted, err := s.keeper.DeleteMarket(s.ctx, btcusdt.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().True(deleted)
+
+		s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+		s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+		requireEvent(types.EventTypeScheduledMarketChangeFailed)
+
+		found, err := s.keeper.HasMarket(s.ctx, btcusdt.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().False(found)
+	})
+}
+
+func (s *KeeperTestSuite) TestGetDueScheduledMarketChanges() {
+	s.SetupTest()
+
+	height := uint64(s.ctx.BlockHeight()) //nolint:gosec
+	now := s.ctx.BlockTime()
+	later := now.Add(time.Hour)
+
+	schedule := func(change types.ScheduledMarketChange) uint64 {
+		change.Action = types.SCHEDULED_MARKET_ACTION_ENABLE
+		change.CurrencyPair = btcusdt.Ticker.CurrencyPair
+		id, err := s.keeper.ScheduleMarketChange(s.ctx, change)
+		s.Require().NoError(err)
+		return id
+	}
+
+	dueIDs := func(height uint64, blockTime time.Time) []uint64 {
+		changes, err := s.keeper.GetDueScheduledMarketChanges(s.ctx, height, blockTime)
+		s.Require().NoError(err)
+
+		ids := make([]uint64, 0, len(changes))
+		for _, change := range changes {
+			ids = append(ids, change.Id)
+		}
+		return ids
+	}
+
+	atLaterTime := schedule(types.ScheduledMarketChange{Time: &later})
+	atNextHeight := schedule(types.ScheduledMarketChange{Height: height + 1})
+	atHeight := schedule(types.ScheduledMarketChange{Height: height})
+	atTime := schedule(types.ScheduledMarketChange{Time: &now})
+
+	s.Require().Empty(dueIDs(height-1, now.Add(-time.Second)))
+	s.Require().Equal([]uint64{atHeight, atTime}, dueIDs(height, now))
+	s.Require().Equal([]uint64{atNextHeight, atHeight, atTime}, dueIDs(height+1, now))
+	s.Require().Equal([]uint64{atLaterTime, atNextHeight, atHeight, atTime}, dueIDs(height+1, later))
+
+	// cancelled changes are removed from the queue
+	s.Require().NoError(s.keeper.CancelScheduledMarketChange(s.ctx, atHeight))
+	s.Require().NoError(s.keeper.CancelScheduledMarketChange(s.ctx, atLaterTime))
+	s.Require().Equal([]uint64{atNextHeight, atTime}, dueIDs(height+1, later))
+
+	// imported changes are queued
+	gs := s.keeper.ExportGenesis(s.ctx)
+	s.SetupTest()
+	s.keeper.InitGenesis(s.ctx, *gs)
+	s.Require().Equal([]uint64{atNextHeight, atTime}, dueIDs(height+1, later))
+}
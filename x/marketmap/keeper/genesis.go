@@ -17,11 +17,17 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) {
 	}
 
 	for _, market := range gs.MarketMap.Markets {
-		if err := k.CreateMarket(ctx, market); err != nil {
+		if err := k.createMarket(ctx, market); err != nil {
 			panic(err)
 		}
 	}
 
+	// The market history starts at genesis and is not part of the genesis state, as its heights are meaningless
+	// on a chain started from an exported genesis.
+	if err := k.historyStartHeight.Set(ctx, uint64(ctx.BlockHeight())); err != nil { //nolint:gosec
+		panic(err)
+	}
+
 	if err := k.SetLastUpdated(ctx, gs.LastUpdated); err != nil {
 		panic(err)
 	}
@@ -30,6 +36,29 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) {
 		panic(err)
 	}
 
+	for _, change := range gs.ScheduledMarketChanges {
+		if err := k.setScheduledMarketChange(ctx, change); err != nil {
+			panic(err)
+		}
+	}
+
+	// IDs start at 1 so that the zero ID denotes an unassigned ID.
+	if err := k.SetNextScheduledMarketChangeID(ctx, max(gs.NextScheduledMarketChangeId, 1)); err != nil {
+		panic(err)
+	}
+
+	for _, scope := range gs.MarketAuthorityScopes {
+		if err := k.SetMarketAuthorityScope(ctx, scope); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, provider := range gs.Providers {
+		if err := k.SetProvider(ctx, provider); err != nil {
+			panic(err)
+		}
+	}
+
 	if k.hooks != nil {
 		if err := k.hooks.AfterMarketGenesis(ctx, gs.MarketMap.Markets); err != nil {
 			panic(err)
@@ -54,12 +83,36 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	changes, err := k.GetAllScheduledMarketChanges(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	nextChangeID, err := k.GetNextScheduledMarketChangeID(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	scopes, err := k.GetAllMarketAuthorityScopes(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	providers, err := k.GetAllProviders(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return &types.GenesisState{
 		MarketMap: types.MarketMap{
 			Markets: markets,
 		},
-		LastUpdated: lastUpdated,
-		Params:      params,
+		LastUpdated:                 lastUpdated,
+		Params:                      params,
+		ScheduledMarketChanges:      changes,
+		NextScheduledMarketChangeId: nextChangeID,
+		MarketAuthorityScopes:       scopes,
+		Providers:                   providers,
 	}
 }
 
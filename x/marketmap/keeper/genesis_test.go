@@ -114,4 +114,53 @@ func (s *KeeperTestSuite) TestInitExportGenesisValid() {
 
 		s.Require().Equal(gs, gotState)
 	})
+
+	s.Run("init valid genesis with scheduled changes, scopes and providers", func() {
+		s.SetupTest()
+
+		gs := types.DefaultGenesisState()
+		gs.ScheduledMarketChanges = []types.ScheduledMarketChange{
+			{
+				Id:           2,
+				Action:       types.SCHEDULED_MARKET_ACTION_ENABLE,
+				CurrencyPair: btcusdt.Ticker.CurrencyPair,
+				Height:       100,
+			},
+		}
+		gs.NextScheduledMarketChangeId = 3
+		gs.MarketAuthorityScopes = []types.MarketAuthorityScope{
+			{
+				Authority:     s.marketAuthorities[0],
+				CurrencyPairs: []types.CurrencyPairMatcher{{Base: "BITCOIN"}},
+				Permissions:   []types.MarketPermission{types.MARKET_PERMISSION_ENABLE},
+			},
+		}
+		gs.Providers = []types.ProviderInfo{
+			{
+				Name:   "kucoin",
+				Type:   types.PROVIDER_TYPE_CEX,
+				Status: types.PROVIDER_STATUS_ACTIVE,
+			},
+		}
+
+		s.Require().NotPanics(func() {
+			s.keeper.InitGenesis(s.ctx, *gs)
+		})
+
+		var gotState *types.GenesisState
+		s.Require().NotPanics(func() {
+			gotState = s.keeper.ExportGenesis(s.ctx)
+		})
+
+		s.Require().Equal(gs, gotState)
+
+		// the next scheduled change is assigned the next id from genesis
+		id, err := s.keeper.ScheduleMarketChange(s.ctx, types.ScheduledMarketChange{
+			Action:       types.SCHEDULED_MARKET_ACTION_DISABLE,
+			CurrencyPair: btcusdt.Ticker.CurrencyPair,
+			Height:       200,
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(3), id)
+	})
 }
@@ -0,0 +1,234 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"golang.org/x/exp/maps"
+
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+// recordMarketChange records the change of the before market into the after market at the current height in the
+// market history. Changes that leave the market untouched are not recorded. The first recorded change starts the
+// market history if it has not been started at genesis.
+func (k *Keeper) recordMarketChange(ctx context.Context, before, after *types.Market) error {
+	if before != nil && after != nil && before.Equal(*after) {
+		return nil
+	}
+
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()) //nolint:gosec
+
+	started, err := k.historyStartHeight.Has(ctx)
+	if err != nil {
+		return err
+	}
+	if !started {
+		if err := k.historyStartHeight.Set(ctx, height); err != nil {
+			return err
+		}
+	}
+
+	id, err := k.nextMarketChangeID.Next(ctx)
+	if err != nil {
+		return err
+	}
+
+	change := types.NewMarketChange(height, before, after)
+	change.Id = id
+
+	return k.marketChanges.Set(ctx, id, change)
+}
+
+// GetMarketHistory returns the changes recorded in the market history for the market of the given ticker string,
+// ordered by the height at which they were made.
+func (k *Keeper) GetMarketHistory(ctx context.Context, tickerStr string) ([]types.MarketChange, error) {
+	iter, err := k.marketChanges.Indexes.ticker.MatchExact(ctx, tickerStr)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	changes := make([]types.MarketChange, 0)
+	for ; iter.Valid(); iter.Next() {
+		id, err := iter.PrimaryKey()
+		if err != nil {
+			return nil, err
+		}
+
+		change, err := k.marketChanges.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, change)
+	}
+
+	return changes, nil
+}
+
+// GetMarketHistoryStartHeight returns the earliest height from which the market history is complete, i.e. the
+// earliest height at which the market map can be reconstructed. If the market history has not been started, the
+// current height is returned.
+func (k *Keeper) GetMarketHistoryStartHeight(ctx context.Context) (uint64, error) {
+	height, err := k.historyStartHeight.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()), nil //nolint:gosec
+	}
+
+	return height, err
+}
+
+// GetMarketMapAtHeight returns the markets as they were at the end of the given height. The market map is
+// reconstructed by reverting, from the newest to the oldest, every change recorded after the height. An error is
+// returned if the height is before the start of the market history or after the current height.
+func (k *Keeper) GetMarketMapAtHeight(ctx context.Context, height uint64) (types.MarketMap, error) {
+	startHeight, err := k.GetMarketHistoryStartHeight(ctx)
+	if err != nil {
+		return types.MarketMap{}, err
+	}
+
+	currentHeight := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()) //nolint:gosec
+	if height < startHeight || height > currentHeight {
+		return types.MarketMap{}, fmt.Errorf(
+			"height %d is outside of the market history, which covers heights %d to %d",
+			height, startHeight, currentHeight,
+		)
+	}
+
+	markets, err := k.GetAllMarkets(ctx)
+	if err != nil {
+		return types.MarketMap{}, err
+	}
+
+	iter, err := k.marketChanges.Iterate(ctx, new(collections.Range[uint64]).Descending())
+	if err != nil {
+		return types.MarketMap{}, err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		change, err := iter.Value()
+		if err != nil {
+			return types.MarketMap{}, err
+		}
+
+		if change.Height <= height {
+			break
+		}
+
+		change.Revert(markets)
+	}
+
+	return types.MarketMap{Markets: markets}, nil
+}
+
+// GetMarketsChangedSince returns the markets that were created or updated after the given height, as they
+// currently are, and the ticker strings of the markets that were removed after the height. Both are sorted by ticker
+// string. An error is returned if the height is before the start of the market history.
+func (k *Keeper) GetMarketsChangedSince(ctx context.Context, height uint64) ([]types.Market, []string, error) {
+	startHeight, err := k.GetMarketHistoryStartHeight(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if height < startHeight {
+		return nil, nil, fmt.Errorf(
+			"height %d is before the start of the market history at height %d",
+			height, startHeight,
+		)
+	}
+
+	iter, err := k.marketChanges.Iterate(ctx, new(collections.Range[uint64]).Descending())
+	if err != nil {
+		return nil, nil, err
+	}
+	defer iter.Close()
+
+	changed := make(map[string]struct{})
+	for ; iter.Valid(); iter.Next() {
+		change, err := iter.Value()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if change.Height <= height {
+			break
+		}
+
+		changed[change.TickerString()] = struct{}{}
+	}
+
+	tickers := maps.Keys(changed)
+	slices.Sort(tickers)
+
+	markets := make([]types.Market, 0, len(tickers))
+	removed := make([]string, 0)
+	for _, ticker := range tickers {
+		market, err := k.GetMarket(ctx, ticker)
+		switch {
+		case errors.Is(err, collections.ErrNotFound):
+			removed = append(removed, ticker)
+		case err != nil:
+			return nil, nil, err
+		default:
+			markets = append(markets, market)
+		}
+	}
+
+	return markets, removed, nil
+}
+
+// PruneMarketHistory removes the changes that are older than the market history retention period of the params.
+// The start of the market history is moved to the height of the newest pruned change, which is the earliest height
+// at which the market map can still be reconstructed. The market history is kept forever if the retention period
+// is zero.
+func (k *Keeper) PruneMarketHistory(ctx context.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()) //nolint:gosec
+	if params.MarketHistoryRetentionBlocks == 0 || height <= params.MarketHistoryRetentionBlocks {
+		return nil
+	}
+	cutoff := height - params.MarketHistoryRetentionBlocks
+
+	iter, err := k.marketChanges.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	var pruned []types.MarketChange
+	for ; iter.Valid(); iter.Next() {
+		change, err := iter.Value()
+		if err != nil {
+			iter.Close()
+			return err
+		}
+
+		if change.Height > cutoff {
+			break
+		}
+
+		pruned = append(pruned, change)
+	}
+	iter.Close()
+
+	if len(pruned) == 0 {
+		return nil
+	}
+
+	for _, change := range pruned {
+		if err := k.marketChanges.Remove(ctx, change.Id); err != nil {
+			return err
+		}
+	}
+
+	return k.historyStartHeight.Set(ctx, pruned[len(pruned)-1].Height)
+}
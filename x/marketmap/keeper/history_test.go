@@ -0,0 +1,174 @@
+package keeper_test
+
+import (
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+func (s *KeeperTestSuite) TestMarketHistory() {
+	enabled := btcusdt
+	enabled.Ticker.Enabled = true
+
+	updated := btcusdt
+	updated.ProviderConfigs = []types.ProviderConfig{
+		{
+			Name:           "okx",
+			OffChainTicker: "BTC-USDT",
+		},
+	}
+
+	s.Run("mutations are recorded by ticker", func() {
+		s.SetupTest()
+
+		s.Require().NoError(s.keeper.CreateMarket(s.ctx, btcusdt))
+		s.Require().NoError(s.keeper.CreateMarket(s.ctx, usdtusd))
+
+		s.ctx = s.ctx.WithBlockHeight(11)
+		s.Require().NoError(s.keeper.EnableMarket(s.ctx, btcusdt.Ticker.String()))
+
+		s.ctx = s.ctx.WithBlockHeight(12)
+		s.Require().NoError(s.keeper.DisableMarket(s.ctx, btcusdt.Ticker.String()))
+
+		s.ctx = s.ctx.WithBlockHeight(13)
+		s.Require().NoError(s.keeper.UpdateMarket(s.ctx, updated))
+		// an update that leaves the market untouched is not recorded
+		s.Require().NoError(s.keeper.UpdateMarket(s.ctx, updated))
+
+		s.ctx = s.ctx.WithBlockHeight(14)
+		deleted, err := s.keeper.DeleteMarket(s.ctx, btcusdt.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().True(deleted)
+
+		history, err := s.keeper.GetMarketHistory(s.ctx, btcusdt.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().Len(history, 5)
+
+		expected := []struct {
+			height uint64
+			typ    types.MarketChangeType
+			before *types.Market
+			after  *types.Market
+		}{
+			{10, types.MARKET_CHANGE_TYPE_CREATE, nil, &btcusdt},
+			{11, types.MARKET_CHANGE_TYPE_ENABLE, &btcusdt, &enabled},
+			{12, types.MARKET_CHANGE_TYPE_DISABLE, &enabled, &btcusdt},
+			{13, types.MARKET_CHANGE_TYPE_UPDATE, &btcusdt, &updated},
+			{14, types.MARKET_CHANGE_TYPE_REMOVE, &updated, nil},
+		}
+		for i, change := range history {
+			s.Require().Equal(expected[i].height, change.Height)
+			s.Require().Equal(expected[i].typ, change.Type)
+			s.Require().Equal(expected[i].before, change.Before)
+			s.Require().Equal(expected[i].after, change.After)
+		}
+
+		history, err = s.keeper.GetMarketHistory(s.ctx, usdtusd.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().Len(history, 1)
+
+		history, err = s.keeper.GetMarketHistory(s.ctx, usdcusd.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().Empty(history)
+	})
+
+	s.Run("market map is reconstructed at past heights", func() {
+		s.SetupTest()
+
+		s.Require().NoError(s.keeper.CreateMarket(s.ctx, btcusdt))
+
+		s.ctx = s.ctx.WithBlockHeight(11)
+		s.Require().NoError(s.keeper.CreateMarket(s.ctx, usdtusd))
+		s.Require().NoError(s.keeper.UpdateMarket(s.ctx, updated))
+
+		s.ctx = s.ctx.WithBlockHeight(12)
+		_, err := s.keeper.DeleteMarket(s.ctx, usdtusd.Ticker.String())
+		s.Require().NoError(err)
+
+		s.ctx = s.ctx.WithBlockHeight(13)
+
+		mm, err := s.keeper.GetMarketMapAtHeight(s.ctx, 10)
+		s.Require().NoError(err)
+		s.Require().Equal(map[string]types.Market{btcusdt.Ticker.String(): btcusdt}, mm.Markets)
+
+		mm, err = s.keeper.GetMarketMapAtHeight(s.ctx, 11)
+		s.Require().NoError(err)
+		s.Require().Equal(map[string]types.Market{
+			updated.Ticker.String(): updated,
+			usdtusd.Ticker.String(): usdtusd,
+		}, mm.Markets)
+
+		mm, err = s.keeper.GetMarketMapAtHeight(s.ctx, 13)
+		s.Require().NoError(err)
+		s.Require().Equal(map[string]types.Market{updated.Ticker.String(): updated}, mm.Markets)
+
+		// the history starts at the first recorded change
+		_, err = s.keeper.GetMarketMapAtHeight(s.ctx, 9)
+		s.Require().Error(err)
+
+		_, err = s.keeper.GetMarketMapAtHeight(s.ctx, 14)
+		s.Require().Error(err)
+	})
+
+	s.Run("history is pruned after the retention period", func() {
+		s.SetupTest()
+
+		params, err := s.keeper.GetParams(s.ctx)
+		s.Require().NoError(err)
+		params.MarketHistoryRetentionBlocks = 5
+		s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+
+		s.Require().NoError(s.keeper.CreateMarket(s.ctx, btcusdt))
+
+		s.ctx = s.ctx.WithBlockHeight(12)
+		s.Require().NoError(s.keeper.EnableMarket(s.ctx, btcusdt.Ticker.String()))
+
+		s.ctx = s.ctx.WithBlockHeight(14)
+		s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+
+		history, err := s.keeper.GetMarketHistory(s.ctx, btcusdt.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().Len(history, 2)
+
+		// the creation at height 10 is not needed to reconstruct the market map at or after height 10
+		s.ctx = s.ctx.WithBlockHeight(15)
+		s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+
+		history, err = s.keeper.GetMarketHistory(s.ctx, btcusdt.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().Len(history, 1)
+		s.Require().Equal(types.MARKET_CHANGE_TYPE_ENABLE, history[0].Type)
+
+		startHeight, err := s.keeper.GetMarketHistoryStartHeight(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(10), startHeight)
+
+		_, err = s.keeper.GetMarketMapAtHeight(s.ctx, 9)
+		s.Require().Error(err)
+
+		mm, err := s.keeper.GetMarketMapAtHeight(s.ctx, 11)
+		s.Require().NoError(err)
+		s.Require().Equal(map[string]types.Market{btcusdt.Ticker.String(): btcusdt}, mm.Markets)
+
+		s.ctx = s.ctx.WithBlockHeight(17)
+		s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+
+		startHeight, err = s.keeper.GetMarketHistoryStartHeight(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(12), startHeight)
+
+		_, err = s.keeper.GetMarketMapAtHeight(s.ctx, 11)
+		s.Require().Error(err)
+	})
+
+	s.Run("history is kept forever without retention", func() {
+		s.SetupTest()
+
+		s.Require().NoError(s.keeper.CreateMarket(s.ctx, btcusdt))
+
+		s.ctx = s.ctx.WithBlockHeight(1000)
+		s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+
+		history, err := s.keeper.GetMarketHistory(s.ctx, btcusdt.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().Len(history, 1)
+	})
+}
@@ -4,15 +4,43 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
 	"cosmossdk.io/core/store"
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
 	"github.com/skip-mev/connect/v2/x/marketmap/types"
 )
 
+// marketChangeIndices are the indexes of the market history.
+type marketChangeIndices struct {
+	// ticker is a multi-index on the ticker strings of the changed markets, i.e. ticker -> change ID -> MarketChange
+	ticker *indexes.Multi[string, uint64, types.MarketChange]
+}
+
+func (m *marketChangeIndices) IndexesList() []collections.Index[uint64, types.MarketChange] {
+	return []collections.Index[uint64, types.MarketChange]{
+		m.ticker,
+	}
+}
+
+func newMarketChangeIndices(sb *collections.SchemaBuilder) *marketChangeIndices {
+	return &marketChangeIndices{
+		ticker: indexes.NewMulti[string, uint64, types.MarketChange](
+			sb, types.MarketChangesByTickerPrefix, "market_changes_by_ticker_idx", collections.StringKey, collections.Uint64Key,
+			func(_ uint64, change types.MarketChange) (string, error) {
+				return change.TickerString(), nil
+			},
+		),
+	}
+}
+
 // Keeper is the module's keeper implementation.
 type Keeper struct {
 	cdc codec.BinaryCodec
@@ -33,8 +61,43 @@ type Keeper struct {
 	// params is the module's parameters.
 	params collections.Item[types.Params]
 
+	// scheduledChanges is keyed by ID and contains the pending scheduled market changes.
+	scheduledChanges collections.Map[uint64, types.ScheduledMarketChange]
+
+	// scheduledChangesByHeight is keyed by target height and ID and contains the scheduled market changes with
+	// a target height.
+	scheduledChangesByHeight collections.KeySet[collections.Pair[uint64, uint64]]
+
+	// scheduledChangesByTime is keyed by target time and ID and contains the scheduled market changes with a
+	// target time.
+	scheduledChangesByTime collections.KeySet[collections.Pair[time.Time, uint64]]
+
+	// nextScheduledChangeID is the ID assigned to the next scheduled market change.
+	nextScheduledChangeID collections.Sequence
+
+	// scopes is keyed by the address of a scoped market authority and contains its scope.
+	scopes collections.Map[string, types.MarketAuthorityScope]
+
+	// marketChanges is keyed by ID and contains the market history, indexed by ticker string.
+	marketChanges *collections.IndexedMap[uint64, types.MarketChange, *marketChangeIndices]
+
+	// nextMarketChangeID is the ID assigned to the next change recorded in the market history.
+	nextMarketChangeID collections.Sequence
+
+	// historyStartHeight is the earliest height from which the market history is complete.
+	historyStartHeight collections.Item[uint64]
+
+	// providers is keyed by provider name and contains the provider registry.
+	providers collections.Map[string, types.ProviderInfo]
+
 	// deleteValidationHooks are called by the keeper before any deletion call is performed.
 	deleteMarketValidationHooks types.MarketValidationHooks
+
+	// createMarketValidationHooks are called by the keeper on every created market.
+	createMarketValidationHooks types.MarketValidationHooks
+
+	// updateMarketValidationHooks are called by the keeper on every updated market.
+	updateMarketValidationHooks types.MarketValidationHooks
 }
 
 // NewKeeper initializes the keeper and its backing stores.
@@ -49,14 +112,76 @@ func NewKeeper(ss store.KVStoreService, cdc codec.BinaryCodec, authority sdk.Acc
 		codec.CollValue[types.Params](cdc),
 	)
 
+	// Create the collections map that will track the pending scheduled market changes.
+	scheduledChanges := collections.NewMap(
+		sb,
+		types.ScheduledMarketChangesPrefix,
+		"scheduled_market_changes",
+		collections.Uint64Key,
+		codec.CollValue[types.ScheduledMarketChange](cdc),
+	)
+
+	// Create the collections key sets that will queue the scheduled market changes by their target.
+	scheduledChangesByHeight := collections.NewKeySet(
+		sb,
+		types.ScheduledMarketChangesByHeightPrefix,
+		"scheduled_market_changes_by_height",
+		collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key),
+	)
+	scheduledChangesByTime := collections.NewKeySet(
+		sb,
+		types.ScheduledMarketChangesByTimePrefix,
+		"scheduled_market_changes_by_time",
+		collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key),
+	)
+
+	// Create the collections map that will track the scopes of the scoped market authorities.
+	scopes := collections.NewMap(
+		sb,
+		types.MarketAuthorityScopesPrefix,
+		"market_authority_scopes",
+		collections.StringKey,
+		codec.CollValue[types.MarketAuthorityScope](cdc),
+	)
+
+	// Create the collections indexed map that will track the market history.
+	marketChanges := collections.NewIndexedMap(
+		sb,
+		types.MarketChangesPrefix,
+		"market_changes",
+		collections.Uint64Key,
+		codec.CollValue[types.MarketChange](cdc),
+		newMarketChangeIndices(sb),
+	)
+
+	// Create the collections map that will track the provider registry.
+	providers := collections.NewMap(
+		sb,
+		types.ProvidersPrefix,
+		"providers",
+		collections.StringKey,
+		codec.CollValue[types.ProviderInfo](cdc),
+	)
+
 	k := &Keeper{
 		cdc:                         cdc,
 		authority:                   authority,
 		markets:                     collections.NewMap(sb, types.MarketsPrefix, "markets", types.TickersCodec, codec.CollValue[types.Market](cdc)),
 		lastUpdated:                 collections.NewItem[uint64](sb, types.LastUpdatedPrefix, "last_updated", types.LastUpdatedCodec),
 		params:                      params,
+		scheduledChanges:            scheduledChanges,
+		scheduledChangesByHeight:    scheduledChangesByHeight,
+		scheduledChangesByTime:      scheduledChangesByTime,
+		scopes:                      scopes,
+		nextScheduledChangeID:       collections.NewSequence(sb, types.NextScheduledMarketChangeIDPrefix, "next_scheduled_market_change_id"),
+		marketChanges:               marketChanges,
+		nextMarketChangeID:          collections.NewSequence(sb, types.NextMarketChangeIDPrefix, "next_market_change_id"),
+		historyStartHeight:          collections.NewItem[uint64](sb, types.MarketHistoryStartHeightPrefix, "market_history_start_height", types.LastUpdatedCodec),
+		providers:                   providers,
 		hooks:                       &types.NoopMarketMapHooks{},
 		deleteMarketValidationHooks: types.DefaultDeleteMarketValidationHooks(),
+		createMarketValidationHooks: types.MarketValidationHooks{},
+		updateMarketValidationHooks: types.MarketValidationHooks{},
 	}
 
 	// apply options to default initialized keeper
@@ -72,6 +197,16 @@ func (k *Keeper) SetDeleteMarketValidationHooks(hooks types.MarketValidationHook
 	k.deleteMarketValidationHooks = hooks
 }
 
+// SetCreateMarketValidationHooks sets the MarketValidationHooks for creation in the keeper.
+func (k *Keeper) SetCreateMarketValidationHooks(hooks types.MarketValidationHooks) {
+	k.createMarketValidationHooks = hooks
+}
+
+// SetUpdateMarketValidationHooks sets the MarketValidationHooks for updates in the keeper.
+func (k *Keeper) SetUpdateMarketValidationHooks(hooks types.MarketValidationHooks) {
+	k.updateMarketValidationHooks = hooks
+}
+
 // SetLastUpdated sets the lastUpdated field to the current block height.
 func (k *Keeper) SetLastUpdated(ctx context.Context, height uint64) error {
 	return k.lastUpdated.Set(ctx, height)
@@ -92,28 +227,48 @@ func (k *Keeper) setMarket(ctx context.Context, market types.Market) error {
 	return k.markets.Set(ctx, types.TickerString(market.Ticker.String()), market)
 }
 
-// EnableMarket sets the Enabled field of a Market Ticker to true.
+// EnableMarket sets the Enabled field of a Market Ticker to true. All UpdateMarketValidationHooks are called on the
+// enabled market before the update.
 func (k *Keeper) EnableMarket(ctx context.Context, tickerStr string) error {
 	market, err := k.GetMarket(ctx, tickerStr)
 	if err != nil {
 		return err
 	}
 
+	before := market
 	market.Ticker.Enabled = true
 
-	return k.setMarket(ctx, market)
+	if err := k.updateMarketValidationHooks.ValidateMarket(ctx, market); err != nil {
+		return err
+	}
+
+	if err := k.setMarket(ctx, market); err != nil {
+		return err
+	}
+
+	return k.recordMarketChange(ctx, &before, &market)
 }
 
-// DisableMarket sets the Enabled field of a Market Ticker to false.
+// DisableMarket sets the Enabled field of a Market Ticker to false. All UpdateMarketValidationHooks are called on
+// the disabled market before the update.
 func (k *Keeper) DisableMarket(ctx context.Context, tickerStr string) error {
 	market, err := k.GetMarket(ctx, tickerStr)
 	if err != nil {
 		return err
 	}
 
+	before := market
 	market.Ticker.Enabled = false
 
-	return k.setMarket(ctx, market)
+	if err := k.updateMarketValidationHooks.ValidateMarket(ctx, market); err != nil {
+		return err
+	}
+
+	if err := k.setMarket(ctx, market); err != nil {
+		return err
+	}
+
+	return k.recordMarketChange(ctx, &before, &market)
 }
 
 // GetAllMarkets returns the set of Market objects currently stored in state
@@ -155,9 +310,75 @@ func (k *Keeper) GetAllMarketsList(ctx context.Context) ([]types.Market, error)
 	return markets, nil
 }
 
-// CreateMarket initializes a new Market.
+// GetFilteredMarkets returns the Market objects currently stored in state that match the given filter, ordered by
+// ticker string. The markets are paginated if a page request is given, in which case the page response is returned.
+func (k *Keeper) GetFilteredMarkets(
+	ctx context.Context,
+	filter types.MarketFilter,
+	pageReq *query.PageRequest,
+) ([]types.Market, *query.PageResponse, error) {
+	if pageReq == nil {
+		markets, err := k.GetAllMarketsList(ctx)
+		if err != nil || filter.IsEmpty() {
+			return markets, nil, err
+		}
+
+		filtered := make([]types.Market, 0, len(markets))
+		for _, market := range markets {
+			if filter.Matches(market) {
+				filtered = append(filtered, market)
+			}
+		}
+
+		return filtered, nil, nil
+	}
+
+	return query.CollectionFilteredPaginate(
+		ctx,
+		k.markets,
+		pageReq,
+		func(_ types.TickerString, market types.Market) (bool, error) {
+			return filter.Matches(market), nil
+		},
+		func(_ types.TickerString, market types.Market) (types.Market, error) {
+			return market, nil
+		},
+	)
+}
+
+// CreateMarket initializes a new Market and records its creation in the market history.
 // The Ticker.String corresponds to a market, and must be unique.
+// The providers of the market are checked against the provider registry, whose default metadata is applied, the
+// metadata of the market is checked against its schemas, and all CreateMarketValidationHooks are called on the market
+// before creation.
 func (k *Keeper) CreateMarket(ctx context.Context, market types.Market) error {
+	return k.createMarketWithHooks(ctx, market, k.createMarketValidationHooks)
+}
+
+// createMarketWithHooks is CreateMarket, calling the given validation hooks instead of the CreateMarketValidationHooks.
+func (k *Keeper) createMarketWithHooks(ctx context.Context, market types.Market, hooks types.MarketValidationHooks) error {
+	market, err := k.applyProviderRegistry(ctx, nil, market)
+	if err != nil {
+		return err
+	}
+
+	if err := market.ValidateMetadata(); err != nil {
+		return err
+	}
+
+	if err := hooks.ValidateMarket(ctx, market); err != nil {
+		return err
+	}
+
+	if err := k.createMarket(ctx, market); err != nil {
+		return err
+	}
+
+	return k.recordMarketChange(ctx, nil, &market)
+}
+
+// createMarket initializes a new Market without recording it in the market history.
+func (k *Keeper) createMarket(ctx context.Context, market types.Market) error {
 	// Check if Ticker already exists for the provider
 	alreadyExists, err := k.markets.Has(ctx, types.TickerString(market.Ticker.String()))
 	if err != nil {
@@ -172,17 +393,42 @@ func (k *Keeper) CreateMarket(ctx context.Context, market types.Market) error {
 
 // UpdateMarket updates a Market.
 // The Ticker.String corresponds to a market, and exists uniquely.
+// The providers of the market are checked against the provider registry, whose default metadata is applied, the
+// metadata of the market is checked against its schemas, and all UpdateMarketValidationHooks are called on the updated
+// market before the update.
 func (k *Keeper) UpdateMarket(ctx context.Context, market types.Market) error {
+	return k.updateMarketWithHooks(ctx, market, k.updateMarketValidationHooks)
+}
+
+// updateMarketWithHooks is UpdateMarket, calling the given validation hooks instead of the UpdateMarketValidationHooks.
+func (k *Keeper) updateMarketWithHooks(ctx context.Context, market types.Market, hooks types.MarketValidationHooks) error {
 	// Check if Ticker already exists for the provider
-	alreadyExists, err := k.markets.Has(ctx, types.TickerString(market.Ticker.String()))
+	existing, err := k.markets.Get(ctx, types.TickerString(market.Ticker.String()))
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		return types.NewMarketDoesNotExistsError(types.TickerString(market.Ticker.String()))
+	case err != nil:
+		return err
+	}
+
+	market, err = k.applyProviderRegistry(ctx, &existing, market)
 	if err != nil {
 		return err
 	}
-	if !alreadyExists {
-		return types.NewMarketDoesNotExistsError(types.TickerString(market.Ticker.String()))
+
+	if err := market.ValidateMetadata(); err != nil {
+		return err
+	}
+
+	if err := hooks.ValidateMarket(ctx, market); err != nil {
+		return err
 	}
 	// Create the config
-	return k.setMarket(ctx, market)
+	if err := k.setMarket(ctx, market); err != nil {
+		return err
+	}
+
+	return k.recordMarketChange(ctx, &existing, &market)
 }
 
 // DeleteMarket removes a Market.  If the market does not exist, this is a no-op and nil is returned.
@@ -206,6 +452,10 @@ func (k *Keeper) DeleteMarket(ctx context.Context, tickerStr string) (bool, erro
 		return false, err
 	}
 
+	if err := k.recordMarketChange(ctx, &market, nil); err != nil {
+		return false, err
+	}
+
 	return true, nil
 }
 
@@ -214,6 +464,26 @@ func (k *Keeper) HasMarket(ctx context.Context, tickerStr string) (bool, error)
 	return k.markets.Has(ctx, types.TickerString(tickerStr))
 }
 
+// GetPowerThreshold returns the per-market voting power threshold configured on the Ticker of the
+// market for the given currency pair. The boolean is false if the market does not exist or if it
+// does not define a threshold, in which case the caller should fall back to its global threshold.
+// Any other error reading the market is returned.
+func (k *Keeper) GetPowerThreshold(ctx context.Context, cp connecttypes.CurrencyPair) (math.LegacyDec, bool, error) {
+	market, err := k.GetMarket(ctx, cp.String())
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.LegacyDec{}, false, nil
+	}
+	if err != nil {
+		return math.LegacyDec{}, false, err
+	}
+
+	if market.Ticker.PowerThreshold == nil {
+		return math.LegacyDec{}, false, nil
+	}
+
+	return *market.Ticker.PowerThreshold, true, nil
+}
+
 // SetParams sets the x/marketmap module's parameters.
 func (k *Keeper) SetParams(ctx context.Context, params types.Params) error {
 	return k.params.Set(ctx, params)
@@ -224,6 +494,33 @@ func (k *Keeper) GetParams(ctx context.Context) (types.Params, error) {
 	return k.params.Get(ctx)
 }
 
+// validateMarketChanges calls all CreateMarketValidationHooks on the created markets and all
+// UpdateMarketValidationHooks on the updated markets with the given tickers, as the markets are in state. It is called
+// once all the changes of a message have been made, so that hooks reading the market map validate the resulting state
+// regardless of the order of the changes.
+func (k *Keeper) validateMarketChanges(ctx context.Context, created, updated []string) error {
+	for _, changes := range []struct {
+		tickers []string
+		hooks   types.MarketValidationHooks
+	}{
+		{created, k.createMarketValidationHooks},
+		{updated, k.updateMarketValidationHooks},
+	} {
+		for _, tickerStr := range changes.tickers {
+			market, err := k.GetMarket(ctx, tickerStr)
+			if err != nil {
+				return fmt.Errorf("failed to get market for ticker %s: %w", tickerStr, err)
+			}
+
+			if err := changes.hooks.ValidateMarket(ctx, market); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 // ValidateState is called after keeper modifications have been made to the market map to verify that
 // the aggregate of all updates has led to a valid state.
 func (k *Keeper) ValidateState(ctx sdk.Context, updates []types.Market) error {
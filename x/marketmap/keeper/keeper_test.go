@@ -8,6 +8,7 @@ import (
 	oraclekeeper "github.com/skip-mev/connect/v2/x/oracle/keeper"
 	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
 
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
@@ -301,6 +302,21 @@ func (s *KeeperTestSuite) TestValidUpdate() {
 	s.Require().NoError(s.keeper.ValidateState(s.ctx, []types.Market{validMarket}))
 }
 
+func (s *KeeperTestSuite) TestInvalidMetadata() {
+	// market whose ticker metadata does not conform to any ticker metadata schema
+	invalidMarket := btcusdt
+	invalidMarket.Ticker.Metadata_JSON = `{"aggregate_ids":[{"venue":"coingecko"}]}`
+
+	s.Require().Error(s.keeper.CreateMarket(s.ctx, invalidMarket))
+
+	s.Require().NoError(s.keeper.CreateMarket(s.ctx, btcusdt))
+	s.Require().Error(s.keeper.UpdateMarket(s.ctx, invalidMarket))
+
+	validMarket := btcusdt
+	validMarket.Ticker.Metadata_JSON = `{"aggregate_ids":[{"venue":"coingecko","ID":"bitcoin"}]}`
+	s.Require().NoError(s.keeper.UpdateMarket(s.ctx, validMarket))
+}
+
 func (s *KeeperTestSuite) TestInvalidUpdateDisabledNormalizeBy() {
 	marketBTCUSDT := btcusdt
 	marketETHUSDT := ethusdt
@@ -395,3 +411,109 @@ func (s *KeeperTestSuite) TestEnableDisableMarket() {
 	s.Require().NoError(err)
 	s.Require().False(market.Ticker.Enabled)
 }
+
+func (s *KeeperTestSuite) TestCreateUpdateValidationHooks() {
+	btcViaUSDT := btcusdt
+	btcViaUSDT.ProviderConfigs = []types.ProviderConfig{
+		{
+			Name:            "kucoin",
+			OffChainTicker:  "btc-usdt",
+			NormalizeByPair: &usdtusd.Ticker.CurrencyPair,
+		},
+	}
+
+	s.Run("create validation hooks are called on creation", func() {
+		s.SetupTest()
+		s.keeper.SetCreateMarketValidationHooks(types.MarketValidationHooks{
+			types.DecimalsRangeValidationHook(1, 6),
+		})
+
+		s.Require().Error(s.keeper.CreateMarket(s.ctx, btcusdt))
+
+		_, err := s.keeper.GetMarket(s.ctx, btcusdt.Ticker.String())
+		s.Require().Error(err)
+	})
+
+	s.Run("update validation hooks are called on updates", func() {
+		s.SetupTest()
+		s.keeper.SetUpdateMarketValidationHooks(types.MarketValidationHooks{
+			types.NormalizeByPairsEnabledValidationHook(s.keeper),
+		})
+
+		s.Require().NoError(s.keeper.CreateMarket(s.ctx, usdtusd))
+		s.Require().NoError(s.keeper.CreateMarket(s.ctx, btcusdt))
+
+		// the normalize-by market of an enabled market is disabled
+		enabledBTCViaUSDT := btcViaUSDT
+		enabledBTCViaUSDT.Ticker.Enabled = true
+		s.Require().Error(s.keeper.UpdateMarket(s.ctx, enabledBTCViaUSDT))
+
+		// a disabled market may normalize by a disabled market
+		s.Require().NoError(s.keeper.UpdateMarket(s.ctx, btcViaUSDT))
+		s.Require().Error(s.keeper.EnableMarket(s.ctx, btcusdt.Ticker.String()))
+
+		s.Require().NoError(s.keeper.EnableMarket(s.ctx, usdtusd.Ticker.String()))
+		s.Require().NoError(s.keeper.EnableMarket(s.ctx, btcusdt.Ticker.String()))
+
+		// the normalize-by market cannot be disabled while an enabled market normalizes by it
+		s.Require().Error(s.keeper.DisableMarket(s.ctx, usdtusd.Ticker.String()))
+
+		disabledUSDT := usdtusd
+		disabledUSDT.Ticker.Enabled = false
+		s.Require().Error(s.keeper.UpdateMarket(s.ctx, disabledUSDT))
+
+		s.Require().NoError(s.keeper.DisableMarket(s.ctx, btcusdt.Ticker.String()))
+		s.Require().NoError(s.keeper.DisableMarket(s.ctx, usdtusd.Ticker.String()))
+	})
+
+	s.Run("hooks can be set with keeper options", func() {
+		s.SetupTest()
+
+		key := storetypes.NewKVStoreKey(types.StoreKey)
+		ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_mm"))
+
+		k := keeper.NewKeeper(
+			runtime.NewKVStoreService(key),
+			moduletestutil.MakeTestEncodingConfig().Codec,
+			s.authority,
+			keeper.WithCreateValidationHooks(types.MarketValidationHooks{types.MinProviderVenuesValidationHook(2)}),
+			keeper.WithUpdateValidationHooks(types.MarketValidationHooks{types.MinProviderCountValidationHook()}),
+		)
+
+		s.Require().Error(k.CreateMarket(ctx, btcusdt))
+	})
+}
+
+func (s *KeeperTestSuite) TestGetPowerThreshold() {
+	// missing markets have no threshold
+	_, ok, err := s.keeper.GetPowerThreshold(s.ctx, btcusdt.Ticker.CurrencyPair)
+	s.Require().NoError(err)
+	s.Require().False(ok)
+
+	// markets without a threshold have no threshold
+	s.Require().NoError(s.keeper.CreateMarket(s.ctx, btcusdt))
+	_, ok, err = s.keeper.GetPowerThreshold(s.ctx, btcusdt.Ticker.CurrencyPair)
+	s.Require().NoError(err)
+	s.Require().False(ok)
+
+	// markets with a threshold return it
+	threshold := math.LegacyNewDecWithPrec(5, 1)
+	btcCopy := btcusdt
+	btcCopy.Ticker.PowerThreshold = &threshold
+	s.Require().NoError(s.keeper.UpdateMarket(s.ctx, btcCopy))
+
+	got, ok, err := s.keeper.GetPowerThreshold(s.ctx, btcusdt.Ticker.CurrencyPair)
+	s.Require().NoError(err)
+	s.Require().True(ok)
+	s.Require().True(threshold.Equal(got))
+
+	// errors other than a missing market are returned
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_mm"))
+	k := keeper.NewKeeper(runtime.NewKVStoreService(key), moduletestutil.MakeTestEncodingConfig().Codec, s.authority)
+	ctx.KVStore(key).Set(append(types.MarketsPrefix.Bytes(), btcusdt.Ticker.String()...), []byte("invalid"))
+
+	_, ok, err = k.GetPowerThreshold(ctx, btcusdt.Ticker.CurrencyPair)
+	s.Require().Error(err)
+	s.Require().False(ok)
+}
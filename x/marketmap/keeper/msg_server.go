@@ -2,10 +2,12 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"slices"
 	"strconv"
 
+	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/skip-mev/connect/v2/x/marketmap/types"
@@ -30,25 +32,32 @@ func (ms msgServer) UpsertMarkets(goCtx context.Context, msg *types.MsgUpsertMar
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	// perform basic msg validity checks
-	if err := ms.verifyMarketAuthorities(ctx, msg); err != nil {
+	scope, err := ms.verifyMarketAuthorities(ctx, msg)
+	if err != nil {
 		return nil, fmt.Errorf("unable to verify market authorities: %w", err)
 	}
 
 	// iterate over all markets and either create them (if no market exists), or update them
+	var created, updated []string
 	for _, market := range msg.Markets {
 		// check if market exists
-		exists, err := ms.k.HasMarket(ctx, market.Ticker.String())
+		existing, err := ms.getExistingMarket(ctx, market.Ticker.String())
 		if err != nil {
 			return nil, err
 		}
 
+		if err := authorizeMarketChange(scope, existing, market); err != nil {
+			return nil, err
+		}
+
 		var eventType string
 		// if market does not exist, create it
-		if !exists {
-			err = ms.k.CreateMarket(ctx, market)
+		if existing == nil {
+			err = ms.k.createMarketWithHooks(ctx, market, nil)
 			if err != nil {
 				return nil, err
 			}
+			created = append(created, market.Ticker.String())
 
 			// run hooks
 			if err = ms.k.hooks.AfterMarketCreated(ctx, market); err != nil {
@@ -57,10 +66,11 @@ func (ms msgServer) UpsertMarkets(goCtx context.Context, msg *types.MsgUpsertMar
 
 			eventType = types.EventTypeCreateMarket
 		} else {
-			err = ms.k.UpdateMarket(ctx, market)
+			err = ms.k.updateMarketWithHooks(ctx, market, nil)
 			if err != nil {
 				return nil, err
 			}
+			updated = append(updated, market.Ticker.String())
 
 			// run hooks
 			if err = ms.k.hooks.AfterMarketUpdated(ctx, market); err != nil {
@@ -81,6 +91,10 @@ func (ms msgServer) UpsertMarkets(goCtx context.Context, msg *types.MsgUpsertMar
 	}
 
 	// validate that the new state of the marketmap is valid
+	if err := ms.k.validateMarketChanges(ctx, created, updated); err != nil {
+		return nil, err
+	}
+
 	if err := ms.k.ValidateState(ctx, msg.Markets); err != nil {
 		return nil, err
 	}
@@ -94,16 +108,23 @@ func (ms msgServer) CreateMarkets(goCtx context.Context, msg *types.MsgCreateMar
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	// perform basic msg validity checks
-	if err := ms.verifyMarketAuthorities(ctx, msg); err != nil {
+	scope, err := ms.verifyMarketAuthorities(ctx, msg)
+	if err != nil {
 		return nil, fmt.Errorf("unable to verify market authorities: %w", err)
 	}
 
 	// create markets
+	created := make([]string, 0, len(msg.CreateMarkets))
 	for _, market := range msg.CreateMarkets {
-		err := ms.k.CreateMarket(ctx, market)
+		if err := authorizeMarketChange(scope, nil, market); err != nil {
+			return nil, err
+		}
+
+		err := ms.k.createMarketWithHooks(ctx, market, nil)
 		if err != nil {
 			return nil, err
 		}
+		created = append(created, market.Ticker.String())
 
 		err = ms.k.hooks.AfterMarketCreated(ctx, market)
 		if err != nil {
@@ -121,7 +142,11 @@ func (ms msgServer) CreateMarkets(goCtx context.Context, msg *types.MsgCreateMar
 	}
 
 	// validate that the new state of the marketmap is valid
-	err := ms.k.ValidateState(ctx, msg.CreateMarkets)
+	if err := ms.k.validateMarketChanges(ctx, created, nil); err != nil {
+		return nil, err
+	}
+
+	err = ms.k.ValidateState(ctx, msg.CreateMarkets)
 	if err != nil {
 		return nil, fmt.Errorf("invalid state resulting from update: %w", err)
 	}
@@ -135,15 +160,27 @@ func (ms msgServer) UpdateMarkets(goCtx context.Context, msg *types.MsgUpdateMar
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	// perform basic msg validity checks
-	if err := ms.verifyMarketAuthorities(ctx, msg); err != nil {
+	scope, err := ms.verifyMarketAuthorities(ctx, msg)
+	if err != nil {
 		return nil, fmt.Errorf("unable to verify market authorities: %w", err)
 	}
 
+	updated := make([]string, 0, len(msg.UpdateMarkets))
 	for _, market := range msg.UpdateMarkets {
-		err := ms.k.UpdateMarket(ctx, market)
+		existing, err := ms.getExistingMarket(ctx, market.Ticker.String())
+		if err != nil {
+			return nil, err
+		}
+
+		if err := authorizeMarketChange(scope, existing, market); err != nil {
+			return nil, err
+		}
+
+		err = ms.k.updateMarketWithHooks(ctx, market, nil)
 		if err != nil {
 			return nil, fmt.Errorf("unable to update market: %w", err)
 		}
+		updated = append(updated, market.Ticker.String())
 
 		err = ms.k.hooks.AfterMarketUpdated(ctx, market)
 		if err != nil {
@@ -162,6 +199,10 @@ func (ms msgServer) UpdateMarkets(goCtx context.Context, msg *types.MsgUpdateMar
 	}
 
 	// validate that the new state of the marketmap is valid
+	if err := ms.k.validateMarketChanges(ctx, nil, updated); err != nil {
+		return nil, fmt.Errorf("invalid state resulting from update: %w", err)
+	}
+
 	if err := ms.k.ValidateState(ctx, msg.UpdateMarkets); err != nil {
 		return nil, fmt.Errorf("invalid state resulting from update: %w", err)
 	}
@@ -169,28 +210,31 @@ func (ms msgServer) UpdateMarkets(goCtx context.Context, msg *types.MsgUpdateMar
 	return &types.MsgUpdateMarketsResponse{}, ms.k.SetLastUpdated(ctx, uint64(ctx.BlockHeight())) //nolint:gosec
 }
 
-// verifyMarketAuthorities verifies that the msg-submitter is a market-authority
-// and returns the context for the msg, this method returns an error if the submitter is not a market
-// authority.
+// verifyMarketAuthorities verifies that the msg-submitter is a market-authority or a scoped market-authority,
+// this method returns an error if the submitter is neither. The scope of a scoped market-authority is returned,
+// while a nil scope is returned for market-authorities, which may change every market.
 func (ms msgServer) verifyMarketAuthorities(ctx sdk.Context, msg interface {
 	GetAuthority() string
 },
-) error {
+) (*types.MarketAuthorityScope, error) {
 	if msg == nil {
-		return fmt.Errorf("unable to process nil msg")
+		return nil, fmt.Errorf("unable to process nil msg")
 	}
 
-	params, err := ms.k.GetParams(ctx)
-	if err != nil {
-		return fmt.Errorf("unable to get marketmap params: %w", err)
-	}
+	return ms.k.getMarketAuthorityScope(ctx, msg.GetAuthority())
+}
 
-	found := checkMarketAuthority(msg.GetAuthority(), params)
-	if !found {
-		return fmt.Errorf("request signer %s does not match module market authorities", msg.GetAuthority())
+// getExistingMarket returns the market for the given ticker, or nil if the market does not exist.
+func (ms msgServer) getExistingMarket(ctx sdk.Context, tickerStr string) (*types.Market, error) {
+	market, err := ms.k.GetMarket(ctx, tickerStr)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to get market for ticker %s: %w", tickerStr, err)
 	}
 
-	return nil
+	return &market, nil
 }
 
 // UpdateParams updates the x/marketmap module's Params.
@@ -264,12 +308,26 @@ func (ms msgServer) RemoveMarkets(
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	// perform basic msg validity checks
-	if err := ms.verifyMarketAuthorities(ctx, msg); err != nil {
+	scope, err := ms.verifyMarketAuthorities(ctx, msg)
+	if err != nil {
 		return nil, fmt.Errorf("unable to verify market authorities: %w", err)
 	}
 
 	deletedMarkets := make([]string, 0, len(msg.Markets))
 	for _, market := range msg.Markets {
+		if scope != nil {
+			existing, err := ms.getExistingMarket(ctx, market)
+			if err != nil {
+				return nil, err
+			}
+
+			if existing != nil {
+				if err := authorizeMarketRemoval(scope, *existing); err != nil {
+					return nil, err
+				}
+			}
+		}
+
 		deleted, err := ms.k.DeleteMarket(ctx, market)
 		if err != nil {
 			return nil, fmt.Errorf("unable to delete market: %w", err)
@@ -296,11 +354,281 @@ func (ms msgServer) RemoveMarkets(
 		return nil, fmt.Errorf("invalid state resulting from removals: %w", err)
 	}
 
+	if len(deletedMarkets) > 0 {
+		if err := ms.k.SetLastUpdated(ctx, uint64(ctx.BlockHeight())); err != nil { //nolint:gosec
+			return nil, err
+		}
+	}
+
 	return &types.MsgRemoveMarketsResponse{
 		DeletedMarkets: deletedMarkets,
 	}, nil
 }
 
+// ScheduleMarketChange schedules a change to an existing market that is executed at the beginning of the first
+// block that reaches the target height or time of the change. The target must be in the future. The response
+// contains the ID assigned to the change.
+func (ms msgServer) ScheduleMarketChange(
+	goCtx context.Context,
+	msg *types.MsgScheduleMarketChange,
+) (*types.MsgScheduleMarketChangeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// perform basic msg validity checks
+	scope, err := ms.verifyMarketAuthorities(ctx, msg)
+	if err != nil {
+		return nil, fmt.Errorf("unable to verify market authorities: %w", err)
+	}
+
+	change := msg.Change
+	if change.IsDue(uint64(ctx.BlockHeight()), ctx.BlockTime()) { //nolint:gosec
+		return nil, fmt.Errorf("scheduled change of market %s must target a future height or time", change.CurrencyPair.String())
+	}
+
+	existing, err := ms.getExistingMarket(ctx, change.CurrencyPair.String())
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, types.NewMarketDoesNotExistsError(types.TickerString(change.CurrencyPair.String()))
+	}
+
+	if err := authorizeMarketChange(scope, existing, scheduledMarket(*existing, change)); err != nil {
+		return nil, err
+	}
+
+	// the authority is re-authorized against the market when the change is executed
+	change.Authority = msg.Authority
+
+	id, err := ms.k.ScheduleMarketChange(ctx, change)
+	if err != nil {
+		return nil, fmt.Errorf("unable to schedule market change: %w", err)
+	}
+
+	return &types.MsgScheduleMarketChangeResponse{Id: id}, nil
+}
+
+// CancelScheduledMarketChange removes a pending scheduled market change.
+func (ms msgServer) CancelScheduledMarketChange(
+	goCtx context.Context,
+	msg *types.MsgCancelScheduledMarketChange,
+) (*types.MsgCancelScheduledMarketChangeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// perform basic msg validity checks
+	scope, err := ms.verifyMarketAuthorities(ctx, msg)
+	if err != nil {
+		return nil, fmt.Errorf("unable to verify market authorities: %w", err)
+	}
+
+	if scope != nil {
+		if err := ms.authorizeScheduledChangeCancellation(ctx, scope, msg.Id); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := ms.k.CancelScheduledMarketChange(ctx, msg.Id); err != nil {
+		return nil, fmt.Errorf("unable to cancel scheduled market change: %w", err)
+	}
+
+	return &types.MsgCancelScheduledMarketChangeResponse{}, nil
+}
+
+// GrantMarketAuthorityScope grants a scope to a market authority, replacing any scope previously granted to the
+// same authority. The signer must be the admin.
+func (ms msgServer) GrantMarketAuthorityScope(
+	goCtx context.Context,
+	msg *types.MsgGrantMarketAuthorityScope,
+) (*types.MsgGrantMarketAuthorityScopeResponse, error) {
+	if msg == nil {
+		return nil, fmt.Errorf("unable to process nil msg")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Admin != params.Admin {
+		return nil, fmt.Errorf("request admin %s does not match module admin %s", msg.Admin, params.Admin)
+	}
+
+	if err := ms.k.SetMarketAuthorityScope(ctx, msg.Scope); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgGrantMarketAuthorityScopeResponse{}, nil
+}
+
+// RevokeMarketAuthorityScope revokes the scope of a scoped market authority. The signer must be the admin.
+func (ms msgServer) RevokeMarketAuthorityScope(
+	goCtx context.Context,
+	msg *types.MsgRevokeMarketAuthorityScope,
+) (*types.MsgRevokeMarketAuthorityScopeResponse, error) {
+	if msg == nil {
+		return nil, fmt.Errorf("unable to process nil msg")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Admin != params.Admin {
+		return nil, fmt.Errorf("request admin %s does not match module admin %s", msg.Admin, params.Admin)
+	}
+
+	if err := ms.k.RemoveMarketAuthorityScope(ctx, msg.Authority); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevokeMarketAuthorityScopeResponse{}, nil
+}
+
+// UpsertProviders registers the given providers in the provider registry, replacing any provider already registered
+// under the same name. The signer must be a market authority; scoped market authorities may not manage providers.
+func (ms msgServer) UpsertProviders(
+	goCtx context.Context,
+	msg *types.MsgUpsertProviders,
+) (*types.MsgUpsertProvidersResponse, error) {
+	if msg == nil {
+		return nil, fmt.Errorf("unable to process nil msg")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get marketmap params: %w", err)
+	}
+
+	if !checkMarketAuthority(msg.Authority, params) {
+		return nil, fmt.Errorf("request signer %s does not match module market authorities", msg.Authority)
+	}
+
+	for _, provider := range msg.Providers {
+		if err := ms.k.SetProvider(ctx, provider); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgUpsertProvidersResponse{}, nil
+}
+
+// RemoveProviders removes the given providers from the provider registry. The signer must be a market authority, and
+// none of the providers may be used by any market.
+func (ms msgServer) RemoveProviders(
+	goCtx context.Context,
+	msg *types.MsgRemoveProviders,
+) (*types.MsgRemoveProvidersResponse, error) {
+	if msg == nil {
+		return nil, fmt.Errorf("unable to process nil msg")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get marketmap params: %w", err)
+	}
+
+	if !checkMarketAuthority(msg.Authority, params) {
+		return nil, fmt.Errorf("request signer %s does not match module market authorities", msg.Authority)
+	}
+
+	for _, name := range msg.Names {
+		if err := ms.k.RemoveProvider(ctx, name); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgRemoveProvidersResponse{}, nil
+}
+
+// authorizeScheduledChangeCancellation returns an error if the given scope does not permit scheduling the scheduled
+// market change with the given ID, in which case the scoped market-authority may not cancel it either.
+func (ms msgServer) authorizeScheduledChangeCancellation(ctx sdk.Context, scope *types.MarketAuthorityScope, id uint64) error {
+	change, err := ms.k.GetScheduledMarketChange(ctx, id)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		return fmt.Errorf("scheduled market change %d does not exist", id)
+	case err != nil:
+		return err
+	}
+
+	existing, err := ms.getExistingMarket(ctx, change.CurrencyPair.String())
+	if err != nil {
+		return err
+	}
+
+	// the market may have been removed since the change was scheduled, in which case only its ticker is matched
+	if existing == nil {
+		existing = &types.Market{Ticker: types.Ticker{CurrencyPair: change.CurrencyPair}}
+	}
+
+	return authorizeMarketChange(scope, existing, scheduledMarket(*existing, change))
+}
+
+// authorizeMarketChange returns an error if the given scope does not permit changing the existing market into the
+// updated market, where a nil existing market denotes the creation of the updated market. Both markets must match
+// the scope. A nil scope permits every change.
+func authorizeMarketChange(scope *types.MarketAuthorityScope, existing *types.Market, updated types.Market) error {
+	if scope == nil {
+		return nil
+	}
+
+	if existing != nil && !scope.Matches(*existing) {
+		return fmt.Errorf("market %s is not in the scope of market authority %s", existing.Ticker.String(), scope.Authority)
+	}
+
+	if !scope.Matches(updated) {
+		return fmt.Errorf("market %s is not in the scope of market authority %s", updated.Ticker.String(), scope.Authority)
+	}
+
+	for _, permission := range types.RequiredPermissions(existing, updated) {
+		if !scope.HasPermission(permission) {
+			return fmt.Errorf("market authority %s is missing permission %s for market %s",
+				scope.Authority, permission, updated.Ticker.String())
+		}
+	}
+
+	return nil
+}
+
+// authorizeMarketRemoval returns an error if the given scope does not permit removing the given market.
+func authorizeMarketRemoval(scope *types.MarketAuthorityScope, market types.Market) error {
+	if !scope.Matches(market) {
+		return fmt.Errorf("market %s is not in the scope of market authority %s", market.Ticker.String(), scope.Authority)
+	}
+
+	if !scope.HasPermission(types.MARKET_PERMISSION_ALL) {
+		return fmt.Errorf("market authority %s is missing permission %s for market %s",
+			scope.Authority, types.MARKET_PERMISSION_ALL, market.Ticker.String())
+	}
+
+	return nil
+}
+
+// scheduledMarket returns the market that results from executing the given scheduled change on the existing market.
+func scheduledMarket(existing types.Market, change types.ScheduledMarketChange) types.Market {
+	switch change.Action {
+	case types.SCHEDULED_MARKET_ACTION_ENABLE:
+		existing.Ticker.Enabled = true
+	case types.SCHEDULED_MARKET_ACTION_DISABLE:
+		existing.Ticker.Enabled = false
+	case types.SCHEDULED_MARKET_ACTION_UPDATE:
+		if change.Market != nil {
+			return *change.Market
+		}
+	}
+
+	return existing
+}
+
 // checkMarketAuthority checks if the given authority is the x/marketmap's list of MarketAuthorities.
 func checkMarketAuthority(authority string, params types.Params) bool {
 	if len(params.MarketAuthorities) == 0 {
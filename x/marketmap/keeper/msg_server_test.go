@@ -213,6 +213,64 @@ func (s *KeeperTestSuite) TestMsgServerUpdateMarkets() {
 	})
 }
 
+func (s *KeeperTestSuite) TestMsgServerValidationHooksValidateEndState() {
+	enabled := func(market types.Market) types.Market {
+		market.Ticker.Enabled = true
+		return market
+	}
+
+	btcusdtViaUSDT := btcusdt
+	btcusdtViaUSDT.ProviderConfigs = []types.ProviderConfig{
+		{
+			Name:            "kucoin",
+			OffChainTicker:  "btc-usdt",
+			NormalizeByPair: &usdtusd.Ticker.CurrencyPair,
+		},
+	}
+
+	setupHooks := func() types.MsgServer {
+		s.SetupTest()
+		hooks := types.MarketValidationHooks{types.NormalizeByPairsEnabledValidationHook(s.keeper)}
+		s.keeper.SetCreateMarketValidationHooks(hooks)
+		s.keeper.SetUpdateMarketValidationHooks(hooks)
+		return keeper.NewMsgServer(s.keeper)
+	}
+
+	s.Run("create a normalize market after the market that depends on it", func() {
+		msgServer := setupHooks()
+
+		_, err := msgServer.CreateMarkets(s.ctx, &types.MsgCreateMarkets{
+			Authority:     s.marketAuthorities[0],
+			CreateMarkets: []types.Market{enabled(btcusdtViaUSDT), enabled(usdtusd)},
+		})
+		s.Require().NoError(err)
+	})
+
+	s.Run("disable a normalize market before the market that depends on it", func() {
+		msgServer := setupHooks()
+		s.Require().NoError(s.keeper.CreateMarket(s.ctx, enabled(usdtusd)))
+		s.Require().NoError(s.keeper.CreateMarket(s.ctx, enabled(btcusdtViaUSDT)))
+
+		_, err := msgServer.UpdateMarkets(s.ctx, &types.MsgUpdateMarkets{
+			Authority:     s.marketAuthorities[0],
+			UpdateMarkets: []types.Market{usdtusd, btcusdtViaUSDT},
+		})
+		s.Require().NoError(err)
+	})
+
+	s.Run("disable only a normalize market", func() {
+		msgServer := setupHooks()
+		s.Require().NoError(s.keeper.CreateMarket(s.ctx, enabled(usdtusd)))
+		s.Require().NoError(s.keeper.CreateMarket(s.ctx, enabled(btcusdtViaUSDT)))
+
+		_, err := msgServer.UpsertMarkets(s.ctx, &types.MsgUpsertMarkets{
+			Authority: s.marketAuthorities[0],
+			Markets:   []types.Market{usdtusd},
+		})
+		s.Require().Error(err)
+	})
+}
+
 func (s *KeeperTestSuite) TestMsgServerParams() {
 	msgServer := keeper.NewMsgServer(s.keeper)
 
@@ -570,6 +628,7 @@ func (s *KeeperTestSuite) TestMsgServerRemoveMarkets() {
 		err := s.keeper.CreateMarket(s.ctx, copyBTC)
 		s.Require().NoError(err)
 
+		s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1)
 		resp, err := msgServer.RemoveMarkets(s.ctx, msg)
 		s.Require().NoError(err)
 		s.Require().Equal([]string{copyBTC.Ticker.String()}, resp.DeletedMarkets)
@@ -577,6 +636,11 @@ func (s *KeeperTestSuite) TestMsgServerRemoveMarkets() {
 		// market should not exist
 		_, err = s.keeper.GetMarket(s.ctx, copyBTC.Ticker.String())
 		s.Require().Error(err)
+
+		// the removal updates the market map
+		lastUpdated, err := s.keeper.GetLastUpdated(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(s.ctx.BlockHeight()), lastUpdated) //nolint:gosec
 	})
 
 	s.Run("do not remove enabled market", func() {
@@ -730,3 +794,451 @@ func (s *KeeperTestSuite) TestMsgServerRemoveMarkets() {
 		s.Require().Error(err)
 	})
 }
+
+func (s *KeeperTestSuite) TestMsgServerScheduleMarketChange() {
+	msgServer := keeper.NewMsgServer(s.keeper)
+	s.Require().NoError(s.keeper.CreateMarket(s.ctx, btcusdt))
+
+	validChange := types.ScheduledMarketChange{
+		Action:       types.SCHEDULED_MARKET_ACTION_ENABLE,
+		CurrencyPair: btcusdt.Ticker.CurrencyPair,
+		Height:       uint64(s.ctx.BlockHeight()) + 1, //nolint:gosec
+	}
+
+	s.Run("unable to process for invalid authority", func() {
+		msg := &types.MsgScheduleMarketChange{
+			Authority: sdk.AccAddress("invalid").String(),
+			Change:    validChange,
+		}
+		resp, err := msgServer.ScheduleMarketChange(s.ctx, msg)
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+
+	s.Run("unable to schedule a change in the past", func() {
+		change := validChange
+		change.Height = uint64(s.ctx.BlockHeight()) //nolint:gosec
+
+		msg := &types.MsgScheduleMarketChange{
+			Authority: s.marketAuthorities[0],
+			Change:    change,
+		}
+		resp, err := msgServer.ScheduleMarketChange(s.ctx, msg)
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+
+	s.Run("unable to schedule a change of a market that does not exist", func() {
+		change := validChange
+		change.CurrencyPair = ethusdt.Ticker.CurrencyPair
+
+		msg := &types.MsgScheduleMarketChange{
+			Authority: s.marketAuthorities[0],
+			Change:    change,
+		}
+		resp, err := msgServer.ScheduleMarketChange(s.ctx, msg)
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+
+	s.Run("schedule changes", func() {
+		for i := range 2 {
+			msg := &types.MsgScheduleMarketChange{
+				Authority: s.marketAuthorities[0],
+				Change:    validChange,
+			}
+			resp, err := msgServer.ScheduleMarketChange(s.ctx, msg)
+			s.Require().NoError(err)
+			s.Require().Equal(uint64(i+1), resp.Id) //nolint:gosec
+
+			change, err := s.keeper.GetScheduledMarketChange(s.ctx, resp.Id)
+			s.Require().NoError(err)
+			s.Require().Equal(resp.Id, change.Id)
+			s.Require().Equal(validChange.CurrencyPair, change.CurrencyPair)
+			s.Require().Equal(s.marketAuthorities[0], change.Authority)
+		}
+	})
+}
+
+func (s *KeeperTestSuite) TestMsgServerCancelScheduledMarketChange() {
+	msgServer := keeper.NewMsgServer(s.keeper)
+	s.Require().NoError(s.keeper.CreateMarket(s.ctx, btcusdt))
+
+	id, err := s.keeper.ScheduleMarketChange(s.ctx, types.ScheduledMarketChange{
+		Action:       types.SCHEDULED_MARKET_ACTION_ENABLE,
+		CurrencyPair: btcusdt.Ticker.CurrencyPair,
+		Height:       uint64(s.ctx.BlockHeight()) + 1, //nolint:gosec
+	})
+	s.Require().NoError(err)
+
+	s.Run("unable to process for invalid authority", func() {
+		msg := &types.MsgCancelScheduledMarketChange{
+			Authority: sdk.AccAddress("invalid").String(),
+			Id:        id,
+		}
+		resp, err := msgServer.CancelScheduledMarketChange(s.ctx, msg)
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+
+	s.Run("unable to cancel a change that does not exist", func() {
+		msg := &types.MsgCancelScheduledMarketChange{
+			Authority: s.marketAuthorities[0],
+			Id:        id + 1,
+		}
+		resp, err := msgServer.CancelScheduledMarketChange(s.ctx, msg)
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+
+	s.Run("cancel a scheduled change", func() {
+		msg := &types.MsgCancelScheduledMarketChange{
+			Authority: s.marketAuthorities[0],
+			Id:        id,
+		}
+		resp, err := msgServer.CancelScheduledMarketChange(s.ctx, msg)
+		s.Require().NoError(err)
+		s.Require().NotNil(resp)
+
+		changes, err := s.keeper.GetAllScheduledMarketChanges(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Empty(changes)
+	})
+}
+
+func (s *KeeperTestSuite) TestMsgServerGrantRevokeMarketAuthorityScope() {
+	msgServer := keeper.NewMsgServer(s.keeper)
+
+	scope := types.MarketAuthorityScope{
+		Authority:     sample.Address(r),
+		CurrencyPairs: []types.CurrencyPairMatcher{{Base: "BITCOIN"}},
+		Permissions:   []types.MarketPermission{types.MARKET_PERMISSION_ENABLE},
+	}
+
+	s.Run("unable to grant if the signer is not the admin", func() {
+		resp, err := msgServer.GrantMarketAuthorityScope(s.ctx, &types.MsgGrantMarketAuthorityScope{
+			Admin: s.marketAuthorities[0],
+			Scope: scope,
+		})
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+
+	s.Run("grant a scope", func() {
+		resp, err := msgServer.GrantMarketAuthorityScope(s.ctx, &types.MsgGrantMarketAuthorityScope{
+			Admin: s.admin,
+			Scope: scope,
+		})
+		s.Require().NoError(err)
+		s.Require().NotNil(resp)
+
+		got, err := s.keeper.GetMarketAuthorityScope(s.ctx, scope.Authority)
+		s.Require().NoError(err)
+		s.Require().Equal(scope, got)
+	})
+
+	s.Run("granting again replaces the scope", func() {
+		replaced := scope
+		replaced.Permissions = []types.MarketPermission{types.MARKET_PERMISSION_ALL}
+
+		_, err := msgServer.GrantMarketAuthorityScope(s.ctx, &types.MsgGrantMarketAuthorityScope{
+			Admin: s.admin,
+			Scope: replaced,
+		})
+		s.Require().NoError(err)
+
+		scopes, err := s.keeper.GetAllMarketAuthorityScopes(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal([]types.MarketAuthorityScope{replaced}, scopes)
+	})
+
+	s.Run("unable to revoke if the signer is not the admin", func() {
+		resp, err := msgServer.RevokeMarketAuthorityScope(s.ctx, &types.MsgRevokeMarketAuthorityScope{
+			Admin:     s.marketAuthorities[0],
+			Authority: scope.Authority,
+		})
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+
+	s.Run("revoke a scope", func() {
+		resp, err := msgServer.RevokeMarketAuthorityScope(s.ctx, &types.MsgRevokeMarketAuthorityScope{
+			Admin:     s.admin,
+			Authority: scope.Authority,
+		})
+		s.Require().NoError(err)
+		s.Require().NotNil(resp)
+
+		found, err := s.keeper.HasMarketAuthorityScope(s.ctx, scope.Authority)
+		s.Require().NoError(err)
+		s.Require().False(found)
+	})
+
+	s.Run("unable to revoke a scope that does not exist", func() {
+		resp, err := msgServer.RevokeMarketAuthorityScope(s.ctx, &types.MsgRevokeMarketAuthorityScope{
+			Admin:     s.admin,
+			Authority: scope.Authority,
+		})
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+}
+
+func (s *KeeperTestSuite) TestMsgServerUpsertRemoveProviders() {
+	msgServer := keeper.NewMsgServer(s.keeper)
+
+	provider := types.ProviderInfo{
+		Name:   "kucoin",
+		Type:   types.PROVIDER_TYPE_CEX,
+		Status: types.PROVIDER_STATUS_ACTIVE,
+	}
+
+	s.Run("unable to upsert if the signer is not a market authority", func() {
+		resp, err := msgServer.UpsertProviders(s.ctx, &types.MsgUpsertProviders{
+			Authority: s.admin,
+			Providers: []types.ProviderInfo{provider},
+		})
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+
+	s.Run("unable to upsert as a scoped market authority", func() {
+		scope := types.MarketAuthorityScope{
+			Authority:     sample.Address(r),
+			CurrencyPairs: []types.CurrencyPairMatcher{{Base: "BITCOIN"}},
+			Permissions:   []types.MarketPermission{types.MARKET_PERMISSION_ALL},
+		}
+		s.Require().NoError(s.keeper.SetMarketAuthorityScope(s.ctx, scope))
+
+		resp, err := msgServer.UpsertProviders(s.ctx, &types.MsgUpsertProviders{
+			Authority: scope.Authority,
+			Providers: []types.ProviderInfo{provider},
+		})
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+
+	s.Run("upsert a provider", func() {
+		resp, err := msgServer.UpsertProviders(s.ctx, &types.MsgUpsertProviders{
+			Authority: s.marketAuthorities[0],
+			Providers: []types.ProviderInfo{provider},
+		})
+		s.Require().NoError(err)
+		s.Require().NotNil(resp)
+
+		got, err := s.keeper.GetProvider(s.ctx, provider.Name)
+		s.Require().NoError(err)
+		s.Require().Equal(provider, got)
+	})
+
+	s.Run("unable to remove a provider used by a market", func() {
+		s.Require().NoError(s.keeper.CreateMarket(s.ctx, btcusdt))
+
+		resp, err := msgServer.RemoveProviders(s.ctx, &types.MsgRemoveProviders{
+			Authority: s.marketAuthorities[0],
+			Names:     []string{provider.Name},
+		})
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+
+		_, err = s.keeper.DeleteMarket(s.ctx, btcusdt.Ticker.String())
+		s.Require().NoError(err)
+	})
+
+	s.Run("unable to remove if the signer is not a market authority", func() {
+		resp, err := msgServer.RemoveProviders(s.ctx, &types.MsgRemoveProviders{
+			Authority: s.admin,
+			Names:     []string{provider.Name},
+		})
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+
+	s.Run("remove a provider", func() {
+		resp, err := msgServer.RemoveProviders(s.ctx, &types.MsgRemoveProviders{
+			Authority: s.marketAuthorities[0],
+			Names:     []string{provider.Name},
+		})
+		s.Require().NoError(err)
+		s.Require().NotNil(resp)
+
+		found, err := s.keeper.HasProvider(s.ctx, provider.Name)
+		s.Require().NoError(err)
+		s.Require().False(found)
+	})
+}
+
+func (s *KeeperTestSuite) TestMsgServerScopedMarketAuthority() {
+	msgServer := keeper.NewMsgServer(s.keeper)
+
+	tagged := ethusdt
+	tagged.Ticker.Metadata_JSON = `{"tags": ["l1"]}`
+
+	s.Require().NoError(s.keeper.CreateMarket(s.ctx, btcusdt))
+	s.Require().NoError(s.keeper.CreateMarket(s.ctx, usdtusd))
+	s.Require().NoError(s.keeper.CreateMarket(s.ctx, tagged))
+
+	grant := func(permissions ...types.MarketPermission) string {
+		authority := sample.Address(r)
+		s.Require().NoError(s.keeper.SetMarketAuthorityScope(s.ctx, types.MarketAuthorityScope{
+			Authority:     authority,
+			CurrencyPairs: []types.CurrencyPairMatcher{{Base: "BITCOIN"}},
+			MetadataTags:  []string{"l1"},
+			Permissions:   permissions,
+		}))
+		return authority
+	}
+
+	enabler := grant(types.MARKET_PERMISSION_ENABLE)
+	configurer := grant(types.MARKET_PERMISSION_PROVIDER_CONFIGS)
+	admin := grant(types.MARKET_PERMISSION_ALL)
+
+	enabled := btcusdt
+	enabled.Ticker.Enabled = true
+
+	newProviders := btcusdt
+	newProviders.ProviderConfigs = []types.ProviderConfig{
+		{
+			Name:           "okx",
+			OffChainTicker: "BTC-USDT",
+		},
+	}
+
+	s.Run("unscoped authority cannot update markets", func() {
+		_, err := msgServer.UpdateMarkets(s.ctx, &types.MsgUpdateMarkets{
+			Authority:     sample.Address(r),
+			UpdateMarkets: []types.Market{enabled},
+		})
+		s.Require().Error(err)
+	})
+
+	s.Run("scoped authority cannot update markets out of scope", func() {
+		outOfScope := usdtusd
+		outOfScope.Ticker.Enabled = true
+
+		_, err := msgServer.UpdateMarkets(s.ctx, &types.MsgUpdateMarkets{
+			Authority:     admin,
+			UpdateMarkets: []types.Market{outOfScope},
+		})
+		s.Require().Error(err)
+	})
+
+	s.Run("scoped authority cannot move markets out of scope", func() {
+		untagged := tagged
+		untagged.Ticker.Metadata_JSON = ""
+
+		_, err := msgServer.UpdateMarkets(s.ctx, &types.MsgUpdateMarkets{
+			Authority:     admin,
+			UpdateMarkets: []types.Market{untagged},
+		})
+		s.Require().Error(err)
+	})
+
+	s.Run("scoped authority without permission cannot enable markets", func() {
+		_, err := msgServer.UpdateMarkets(s.ctx, &types.MsgUpdateMarkets{
+			Authority:     configurer,
+			UpdateMarkets: []types.Market{enabled},
+		})
+		s.Require().Error(err)
+	})
+
+	s.Run("scoped authority without permission cannot change provider configs", func() {
+		_, err := msgServer.UpsertMarkets(s.ctx, &types.MsgUpsertMarkets{
+			Authority: enabler,
+			Markets:   []types.Market{newProviders},
+		})
+		s.Require().Error(err)
+	})
+
+	s.Run("scoped authority without permission cannot create markets", func() {
+		created := btcusdt
+		created.Ticker.CurrencyPair = connecttypes.NewCurrencyPair("BITCOIN", "USDC")
+
+		_, err := msgServer.CreateMarkets(s.ctx, &types.MsgCreateMarkets{
+			Authority:     enabler,
+			CreateMarkets: []types.Market{created},
+		})
+		s.Require().Error(err)
+	})
+
+	s.Run("scoped authority without permission cannot remove markets", func() {
+		_, err := msgServer.RemoveMarkets(s.ctx, &types.MsgRemoveMarkets{
+			Authority: enabler,
+			Markets:   []string{btcusdt.Ticker.String()},
+		})
+		s.Require().Error(err)
+	})
+
+	s.Run("scoped authority can change provider configs", func() {
+		_, err := msgServer.UpsertMarkets(s.ctx, &types.MsgUpsertMarkets{
+			Authority: configurer,
+			Markets:   []types.Market{newProviders},
+		})
+		s.Require().NoError(err)
+
+		got, err := s.keeper.GetMarket(s.ctx, btcusdt.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().Equal(newProviders, got)
+	})
+
+	s.Run("scoped authority can enable markets matching a metadata tag", func() {
+		enabledTagged := tagged
+		enabledTagged.Ticker.Enabled = true
+
+		_, err := msgServer.UpdateMarkets(s.ctx, &types.MsgUpdateMarkets{
+			Authority:     enabler,
+			UpdateMarkets: []types.Market{enabledTagged},
+		})
+		s.Require().NoError(err)
+	})
+
+	s.Run("scoped authority can schedule and cancel enabling markets", func() {
+		change := types.ScheduledMarketChange{
+			Action:       types.SCHEDULED_MARKET_ACTION_ENABLE,
+			CurrencyPair: btcusdt.Ticker.CurrencyPair,
+			Height:       uint64(s.ctx.BlockHeight()) + 1, //nolint:gosec
+		}
+
+		_, err := msgServer.ScheduleMarketChange(s.ctx, &types.MsgScheduleMarketChange{
+			Authority: configurer,
+			Change:    change,
+		})
+		s.Require().Error(err)
+
+		resp, err := msgServer.ScheduleMarketChange(s.ctx, &types.MsgScheduleMarketChange{
+			Authority: enabler,
+			Change:    change,
+		})
+		s.Require().NoError(err)
+
+		_, err = msgServer.CancelScheduledMarketChange(s.ctx, &types.MsgCancelScheduledMarketChange{
+			Authority: configurer,
+			Id:        resp.Id,
+		})
+		s.Require().Error(err)
+
+		_, err = msgServer.CancelScheduledMarketChange(s.ctx, &types.MsgCancelScheduledMarketChange{
+			Authority: enabler,
+			Id:        resp.Id,
+		})
+		s.Require().NoError(err)
+	})
+
+	s.Run("scoped authority with all permissions can create and remove markets", func() {
+		created := btcusdt
+		created.Ticker.CurrencyPair = connecttypes.NewCurrencyPair("BITCOIN", "USDC")
+
+		_, err := msgServer.CreateMarkets(s.ctx, &types.MsgCreateMarkets{
+			Authority:     admin,
+			CreateMarkets: []types.Market{created},
+		})
+		s.Require().NoError(err)
+
+		resp, err := msgServer.RemoveMarkets(s.ctx, &types.MsgRemoveMarkets{
+			Authority: admin,
+			Markets:   []string{created.Ticker.String()},
+		})
+		s.Require().NoError(err)
+		s.Require().Equal([]string{created.Ticker.String()}, resp.DeletedMarkets)
+	})
+}
@@ -19,3 +19,17 @@ func WithDeleteValidationHooks(hooks []types.MarketValidationHook) Option {
 		k.deleteMarketValidationHooks = hooks
 	}
 }
+
+// WithCreateValidationHooks sets the keeper createMarketValidationHooks to the given hooks.
+func WithCreateValidationHooks(hooks []types.MarketValidationHook) Option {
+	return func(k *Keeper) {
+		k.createMarketValidationHooks = hooks
+	}
+}
+
+// WithUpdateValidationHooks sets the keeper updateMarketValidationHooks to the given hooks.
+func WithUpdateValidationHooks(hooks []types.MarketValidationHook) Option {
+	return func(k *Keeper) {
+		k.updateMarketValidationHooks = hooks
+	}
+}
@@ -18,7 +18,8 @@ func NewQueryServer(k *Keeper) types.QueryServer {
 	return &queryServerImpl{k}
 }
 
-// MarketMap returns the full MarketMap and associated information stored in the x/marketmap module.
+// MarketMap returns the MarketMap and associated information stored in the x/marketmap module. The markets are
+// filtered by the filter of the request, and paginated if the request has a page request.
 //
 // NOTE: the map type returned by this query is NOT SAFE. Use Markets instead for a safe value.
 func (q queryServerImpl) MarketMap(goCtx context.Context, req *types.MarketMapRequest) (*types.MarketMapResponse, error) {
@@ -29,35 +30,42 @@ func (q queryServerImpl) MarketMap(goCtx context.Context, req *types.MarketMapRe
 	// unwrap the context
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	markets, err := q.k.GetAllMarkets(ctx)
+	markets, pageRes, err := q.k.GetFilteredMarkets(ctx, req.Filter, req.Pagination)
 	if err != nil {
 		return nil, err
 	}
 
+	marketsMap := make(map[string]types.Market, len(markets))
+	for _, market := range markets {
+		marketsMap[market.Ticker.String()] = market
+	}
+
 	lastUpdated, err := q.k.GetLastUpdated(ctx)
 	return &types.MarketMapResponse{
 			MarketMap: types.MarketMap{
-				Markets: markets,
+				Markets: marketsMap,
 			},
 
 			LastUpdated: lastUpdated,
 			ChainId:     ctx.ChainID(),
+			Pagination:  pageRes,
 		},
 		err
 }
 
-// Markets returns all markets stored in the x/marketmap module.
+// Markets returns the markets stored in the x/marketmap module. The markets are filtered by the filter of the
+// request, and paginated if the request has a page request.
 func (q queryServerImpl) Markets(ctx context.Context, req *types.MarketsRequest) (*types.MarketsResponse, error) {
 	if req == nil {
 		return nil, fmt.Errorf("request cannot be nil")
 	}
 
-	markets, err := q.k.GetAllMarketsList(ctx)
+	markets, pageRes, err := q.k.GetFilteredMarkets(ctx, req.Filter, req.Pagination)
 	if err != nil {
 		return nil, err
 	}
 
-	return &types.MarketsResponse{Markets: markets}, nil
+	return &types.MarketsResponse{Markets: markets, Pagination: pageRes}, nil
 }
 
 // Market returns the requested market stored in the x/marketmap module.
@@ -107,3 +115,128 @@ func (q queryServerImpl) Params(ctx context.Context, req *types.ParamsRequest) (
 
 	return &types.ParamsResponse{Params: params}, nil
 }
+
+// ScheduledMarketChanges returns the pending scheduled market changes stored in the x/marketmap module.
+func (q queryServerImpl) ScheduledMarketChanges(
+	ctx context.Context,
+	req *types.ScheduledMarketChangesRequest,
+) (*types.ScheduledMarketChangesResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	changes, err := q.k.GetAllScheduledMarketChanges(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ScheduledMarketChangesResponse{Changes: changes}, nil
+}
+
+// MarketAuthorityScopes returns the scopes of the scoped market authorities stored in the x/marketmap module.
+func (q queryServerImpl) MarketAuthorityScopes(
+	ctx context.Context,
+	req *types.MarketAuthorityScopesRequest,
+) (*types.MarketAuthorityScopesResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	scopes, err := q.k.GetAllMarketAuthorityScopes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MarketAuthorityScopesResponse{Scopes: scopes}, nil
+}
+
+// MarketHistory returns the changes recorded in the market history for the requested market.
+func (q queryServerImpl) MarketHistory(
+	ctx context.Context,
+	req *types.MarketHistoryRequest,
+) (*types.MarketHistoryResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	if err := req.CurrencyPair.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	changes, err := q.k.GetMarketHistory(ctx, req.CurrencyPair.String())
+	if err != nil {
+		return nil, err
+	}
+
+	startHeight, err := q.k.GetMarketHistoryStartHeight(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MarketHistoryResponse{Changes: changes, EarliestHeight: startHeight}, nil
+}
+
+// MarketMapAtHeight returns the market map as it was at the end of the requested height, reconstructed from the
+// market history.
+func (q queryServerImpl) MarketMapAtHeight(
+	ctx context.Context,
+	req *types.MarketMapAtHeightRequest,
+) (*types.MarketMapAtHeightResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	marketMap, err := q.k.GetMarketMapAtHeight(ctx, req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MarketMapAtHeightResponse{MarketMap: marketMap, Height: req.Height}, nil
+}
+
+// MarketsSince returns the markets that were changed after the requested height, reconstructed from the market
+// history.
+func (q queryServerImpl) MarketsSince(
+	goCtx context.Context,
+	req *types.MarketsSinceRequest,
+) (*types.MarketsSinceResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	markets, removed, err := q.k.GetMarketsChangedSince(ctx, req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	lastUpdated, err := q.k.GetLastUpdated(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MarketsSinceResponse{
+		Markets:        markets,
+		RemovedMarkets: removed,
+		LastUpdated:    lastUpdated,
+		ChainId:        ctx.ChainID(),
+	}, nil
+}
+
+// Providers returns the providers of the provider registry stored in the x/marketmap module.
+func (q queryServerImpl) Providers(
+	ctx context.Context,
+	req *types.ProvidersRequest,
+) (*types.ProvidersResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	providers, err := q.k.GetAllProviders(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ProvidersResponse{Providers: providers}, nil
+}
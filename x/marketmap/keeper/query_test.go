@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"github.com/cosmos/cosmos-sdk/types/query"
+
 	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
 	"github.com/skip-mev/connect/v2/x/marketmap/keeper"
 	"github.com/skip-mev/connect/v2/x/marketmap/types"
@@ -93,6 +95,48 @@ func (s *KeeperTestSuite) TestMarkets() {
 		s.Require().Equal(expected, resp)
 		s.Require().NotEqual(nonSortedResp, resp)
 	})
+
+	s.Run("run filtered query", func() {
+		resp, err := qs.Markets(s.ctx, &types.MarketsRequest{
+			Filter: types.MarketFilter{Quote: "USDT"},
+		})
+		s.Require().NoError(err)
+		s.Require().Equal([]types.Market{btcusdt, ethusdt}, resp.Markets)
+		s.Require().Nil(resp.Pagination)
+
+		resp, err = qs.Markets(s.ctx, &types.MarketsRequest{
+			Filter: types.MarketFilter{Enabled: types.MARKET_ENABLED_FILTER_ENABLED},
+		})
+		s.Require().NoError(err)
+		s.Require().Empty(resp.Markets)
+	})
+
+	s.Run("run paginated query", func() {
+		resp, err := qs.Markets(s.ctx, &types.MarketsRequest{
+			Pagination: &query.PageRequest{Limit: 3},
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(marketsKeySorted[:3], resp.Markets)
+		s.Require().NotNil(resp.Pagination.NextKey)
+
+		resp, err = qs.Markets(s.ctx, &types.MarketsRequest{
+			Pagination: &query.PageRequest{Key: resp.Pagination.NextKey, Limit: 3},
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(marketsKeySorted[3:], resp.Markets)
+		s.Require().Nil(resp.Pagination.NextKey)
+	})
+
+	s.Run("run paginated and filtered market map query", func() {
+		resp, err := qs.MarketMap(s.ctx, &types.MarketMapRequest{
+			Pagination: &query.PageRequest{Limit: 1},
+			Filter:     types.MarketFilter{Quote: "USD"},
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(map[string]types.Market{usdcusd.Ticker.String(): usdcusd}, resp.MarketMap.Markets)
+		s.Require().NotNil(resp.Pagination.NextKey)
+		s.Require().Equal("test-chain", resp.ChainId)
+	})
 }
 
 func (s *KeeperTestSuite) TestMarket() {
@@ -180,3 +224,177 @@ func (s *KeeperTestSuite) TestLastUpdated() {
 		s.Require().Error(err)
 	})
 }
+
+func (s *KeeperTestSuite) TestScheduledMarketChanges() {
+	qs := keeper.NewQueryServer(s.keeper)
+
+	s.Run("run valid request with no changes", func() {
+		resp, err := qs.ScheduledMarketChanges(s.ctx, &types.ScheduledMarketChangesRequest{})
+		s.Require().NoError(err)
+		s.Require().Empty(resp.Changes)
+	})
+
+	s.Run("run valid request", func() {
+		change := types.ScheduledMarketChange{
+			Action:       types.SCHEDULED_MARKET_ACTION_ENABLE,
+			CurrencyPair: btcusdt.Ticker.CurrencyPair,
+			Height:       100,
+		}
+		id, err := s.keeper.ScheduleMarketChange(s.ctx, change)
+		s.Require().NoError(err)
+		change.Id = id
+
+		resp, err := qs.ScheduledMarketChanges(s.ctx, &types.ScheduledMarketChangesRequest{})
+		s.Require().NoError(err)
+		s.Require().Equal([]types.ScheduledMarketChange{change}, resp.Changes)
+	})
+
+	s.Run("run invalid nil request", func() {
+		_, err := qs.ScheduledMarketChanges(s.ctx, nil)
+		s.Require().Error(err)
+	})
+}
+
+func (s *KeeperTestSuite) TestMarketAuthorityScopes() {
+	qs := keeper.NewQueryServer(s.keeper)
+
+	s.Run("run valid request with no scopes", func() {
+		resp, err := qs.MarketAuthorityScopes(s.ctx, &types.MarketAuthorityScopesRequest{})
+		s.Require().NoError(err)
+		s.Require().Empty(resp.Scopes)
+	})
+
+	s.Run("run valid request", func() {
+		scope := types.MarketAuthorityScope{
+			Authority:     s.marketAuthorities[0],
+			CurrencyPairs: []types.CurrencyPairMatcher{{Base: "BITCOIN"}},
+			Permissions:   []types.MarketPermission{types.MARKET_PERMISSION_ENABLE},
+		}
+		s.Require().NoError(s.keeper.SetMarketAuthorityScope(s.ctx, scope))
+
+		resp, err := qs.MarketAuthorityScopes(s.ctx, &types.MarketAuthorityScopesRequest{})
+		s.Require().NoError(err)
+		s.Require().Equal([]types.MarketAuthorityScope{scope}, resp.Scopes)
+	})
+
+	s.Run("run invalid nil request", func() {
+		_, err := qs.MarketAuthorityScopes(s.ctx, nil)
+		s.Require().Error(err)
+	})
+}
+
+func (s *KeeperTestSuite) TestProviders() {
+	qs := keeper.NewQueryServer(s.keeper)
+
+	s.Run("run valid request with no providers", func() {
+		resp, err := qs.Providers(s.ctx, &types.ProvidersRequest{})
+		s.Require().NoError(err)
+		s.Require().Empty(resp.Providers)
+	})
+
+	s.Run("run valid request", func() {
+		providers := []types.ProviderInfo{
+			{
+				Name:   "kucoin",
+				Type:   types.PROVIDER_TYPE_CEX,
+				Status: types.PROVIDER_STATUS_ACTIVE,
+			},
+			{
+				Name:   "uniswapv3_api-ethereum",
+				Type:   types.PROVIDER_TYPE_DEX,
+				Status: types.PROVIDER_STATUS_DEPRECATED,
+			},
+		}
+		for _, provider := range providers {
+			s.Require().NoError(s.keeper.SetProvider(s.ctx, provider))
+		}
+
+		resp, err := qs.Providers(s.ctx, &types.ProvidersRequest{})
+		s.Require().NoError(err)
+		s.Require().Equal(providers, resp.Providers)
+	})
+
+	s.Run("run invalid nil request", func() {
+		_, err := qs.Providers(s.ctx, nil)
+		s.Require().Error(err)
+	})
+}
+
+func (s *KeeperTestSuite) TestMarketHistoryQueries() {
+	qs := keeper.NewQueryServer(s.keeper)
+
+	s.Run("run valid market history request", func() {
+		s.Require().NoError(s.keeper.CreateMarket(s.ctx, btcusdt))
+
+		resp, err := qs.MarketHistory(s.ctx, &types.MarketHistoryRequest{CurrencyPair: btcusdt.Ticker.CurrencyPair})
+		s.Require().NoError(err)
+		s.Require().Len(resp.Changes, 1)
+		s.Require().Equal(types.MARKET_CHANGE_TYPE_CREATE, resp.Changes[0].Type)
+		s.Require().Equal(uint64(s.ctx.BlockHeight()), resp.EarliestHeight) //nolint:gosec
+	})
+
+	s.Run("run valid market map at height request", func() {
+		resp, err := qs.MarketMapAtHeight(s.ctx, &types.MarketMapAtHeightRequest{Height: uint64(s.ctx.BlockHeight())}) //nolint:gosec
+		s.Require().NoError(err)
+		s.Require().Equal(map[string]types.Market{btcusdt.Ticker.String(): btcusdt}, resp.MarketMap.Markets)
+	})
+
+	s.Run("run invalid market history request", func() {
+		_, err := qs.MarketHistory(s.ctx, &types.MarketHistoryRequest{})
+		s.Require().Error(err)
+	})
+
+	s.Run("run invalid nil requests", func() {
+		_, err := qs.MarketHistory(s.ctx, nil)
+		s.Require().Error(err)
+
+		_, err = qs.MarketMapAtHeight(s.ctx, nil)
+		s.Require().Error(err)
+	})
+}
+
+func (s *KeeperTestSuite) TestMarketsSince() {
+	qs := keeper.NewQueryServer(s.keeper)
+	s.ctx = s.ctx.WithChainID("test-chain")
+
+	updated := btcusdt
+	updated.Ticker.Enabled = true
+
+	s.Require().NoError(s.keeper.CreateMarket(s.ctx, btcusdt))
+	s.Require().NoError(s.keeper.CreateMarket(s.ctx, usdtusd))
+
+	s.ctx = s.ctx.WithBlockHeight(11)
+	s.Require().NoError(s.keeper.CreateMarket(s.ctx, ethusdt))
+	s.Require().NoError(s.keeper.UpdateMarket(s.ctx, updated))
+	_, err := s.keeper.DeleteMarket(s.ctx, usdtusd.Ticker.String())
+	s.Require().NoError(err)
+	s.Require().NoError(s.keeper.SetLastUpdated(s.ctx, 11))
+
+	s.Run("run valid request", func() {
+		resp, err := qs.MarketsSince(s.ctx, &types.MarketsSinceRequest{Height: 10})
+		s.Require().NoError(err)
+		s.Require().Equal(&types.MarketsSinceResponse{
+			Markets:        []types.Market{updated, ethusdt},
+			RemovedMarkets: []string{usdtusd.Ticker.String()},
+			LastUpdated:    11,
+			ChainId:        "test-chain",
+		}, resp)
+	})
+
+	s.Run("run request without changes", func() {
+		resp, err := qs.MarketsSince(s.ctx, &types.MarketsSinceRequest{Height: 11})
+		s.Require().NoError(err)
+		s.Require().Empty(resp.Markets)
+		s.Require().Empty(resp.RemovedMarkets)
+	})
+
+	s.Run("run request before the market history", func() {
+		_, err := qs.MarketsSince(s.ctx, &types.MarketsSinceRequest{Height: 9})
+		s.Require().Error(err)
+	})
+
+	s.Run("run invalid nil request", func() {
+		_, err := qs.MarketsSince(s.ctx, nil)
+		s.Require().Error(err)
+	})
+}
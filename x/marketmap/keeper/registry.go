@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+// SetProvider registers the given provider in the provider registry, replacing any provider already registered under
+// the same name.
+func (k *Keeper) SetProvider(ctx context.Context, provider types.ProviderInfo) error {
+	return k.providers.Set(ctx, provider.Name, provider)
+}
+
+// GetProvider returns the provider registered under the given name.
+func (k *Keeper) GetProvider(ctx context.Context, name string) (types.ProviderInfo, error) {
+	return k.providers.Get(ctx, name)
+}
+
+// HasProvider checks if a provider is registered under the given name.
+func (k *Keeper) HasProvider(ctx context.Context, name string) (bool, error) {
+	return k.providers.Has(ctx, name)
+}
+
+// RemoveProvider removes the provider registered under the given name from the provider registry. An error is
+// returned if no such provider is registered, or if the provider is used by any market.
+func (k *Keeper) RemoveProvider(ctx context.Context, name string) error {
+	found, err := k.providers.Has(ctx, name)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return fmt.Errorf("provider %s is not registered", name)
+	}
+
+	iter, err := k.markets.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		market, err := iter.Value()
+		if err != nil {
+			return err
+		}
+
+		if marketUsesProvider(market, name) {
+			return fmt.Errorf("provider %s is used by market %s", name, market.Ticker.String())
+		}
+	}
+
+	return k.providers.Remove(ctx, name)
+}
+
+// GetAllProviders returns all providers of the provider registry, ordered by name.
+func (k *Keeper) GetAllProviders(ctx context.Context) ([]types.ProviderInfo, error) {
+	iter, err := k.providers.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return iter.Values()
+}
+
+// applyProviderRegistry checks the provider configs of the given market against the provider registry and returns
+// the market with the default metadata of the registered providers applied to the provider configs without metadata.
+// Only the providers added to the existing market, if any, are checked: they must be registered and not deprecated.
+// Providers that the existing market already uses may be kept even if they are not registered, so that markets
+// predating the registry remain updatable. The registry is only enforced once it is non-empty, so that chains can
+// populate it before markets are validated against it.
+func (k *Keeper) applyProviderRegistry(ctx context.Context, existing *types.Market, market types.Market) (types.Market, error) {
+	iter, err := k.providers.Iterate(ctx, nil)
+	if err != nil {
+		return types.Market{}, err
+	}
+	enforced := iter.Valid()
+	iter.Close()
+
+	if !enforced || len(market.ProviderConfigs) == 0 {
+		return market, nil
+	}
+
+	configs := make([]types.ProviderConfig, len(market.ProviderConfigs))
+	for i, providerConfig := range market.ProviderConfigs {
+		added := existing == nil || !marketUsesProvider(*existing, providerConfig.Name)
+
+		provider, err := k.providers.Get(ctx, providerConfig.Name)
+		switch {
+		case errors.Is(err, collections.ErrNotFound) && !added:
+			configs[i] = providerConfig
+			continue
+		case err != nil:
+			return types.Market{}, fmt.Errorf("provider %s of market %s is not registered: %w",
+				providerConfig.Name, market.Ticker.String(), err)
+		}
+
+		if provider.Status == types.PROVIDER_STATUS_DEPRECATED && added {
+			return types.Market{}, fmt.Errorf("deprecated provider %s cannot be added to market %s",
+				provider.Name, market.Ticker.String())
+		}
+
+		if providerConfig.Metadata_JSON == "" {
+			providerConfig.Metadata_JSON = provider.DefaultMetadata_JSON
+		}
+
+		configs[i] = providerConfig
+	}
+
+	market.ProviderConfigs = configs
+	return market, nil
+}
+
+// marketUsesProvider returns true if any provider config of the given market has the given provider name.
+func marketUsesProvider(market types.Market, name string) bool {
+	for _, providerConfig := range market.ProviderConfigs {
+		if providerConfig.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,127 @@
+package keeper_test
+
+import (
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+func (s *KeeperTestSuite) TestProviderRegistry() {
+	kucoin := types.ProviderInfo{
+		Name:                 "kucoin",
+		Type:                 types.PROVIDER_TYPE_CEX,
+		Status:               types.PROVIDER_STATUS_ACTIVE,
+		DefaultMetadata_JSON: `{"key":"value"}`,
+	}
+
+	okx := types.ProviderInfo{
+		Name:   "okx",
+		Type:   types.PROVIDER_TYPE_CEX,
+		Status: types.PROVIDER_STATUS_DEPRECATED,
+	}
+
+	withOkx := btcusdt
+	withOkx.ProviderConfigs = append([]types.ProviderConfig{}, btcusdt.ProviderConfigs...)
+	withOkx.ProviderConfigs = append(withOkx.ProviderConfigs, types.ProviderConfig{
+		Name:           "okx",
+		OffChainTicker: "BTC-USDT",
+	})
+
+	s.Run("markets are not validated against an empty registry", func() {
+		s.SetupTest()
+
+		s.Require().NoError(s.keeper.CreateMarket(s.ctx, btcusdt))
+
+		got, err := s.keeper.GetMarket(s.ctx, btcusdt.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().Equal(btcusdt, got)
+	})
+
+	s.Run("unregistered providers are rejected", func() {
+		s.SetupTest()
+
+		s.Require().NoError(s.keeper.SetProvider(s.ctx, okx))
+
+		s.Require().Error(s.keeper.CreateMarket(s.ctx, btcusdt))
+	})
+
+	s.Run("unregistered providers of existing markets can be kept", func() {
+		s.SetupTest()
+
+		// the market predates the registry
+		s.Require().NoError(s.keeper.CreateMarket(s.ctx, btcusdt))
+		s.Require().NoError(s.keeper.SetProvider(s.ctx, okx))
+
+		s.Require().NoError(s.keeper.DisableMarket(s.ctx, btcusdt.Ticker.String()))
+		s.Require().NoError(s.keeper.EnableMarket(s.ctx, btcusdt.Ticker.String()))
+
+		updated := btcusdt
+		updated.Ticker.Decimals = 10
+		s.Require().NoError(s.keeper.UpdateMarket(s.ctx, updated))
+
+		got, err := s.keeper.GetMarket(s.ctx, btcusdt.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().Equal(updated, got)
+
+		// unregistered providers cannot be added
+		withBinance := updated
+		withBinance.ProviderConfigs = append([]types.ProviderConfig{}, updated.ProviderConfigs...)
+		withBinance.ProviderConfigs = append(withBinance.ProviderConfigs, types.ProviderConfig{
+			Name:           "binance",
+			OffChainTicker: "BTCUSDT",
+		})
+		s.Require().Error(s.keeper.UpdateMarket(s.ctx, withBinance))
+	})
+
+	s.Run("default metadata is applied to provider configs without metadata", func() {
+		s.SetupTest()
+
+		s.Require().NoError(s.keeper.SetProvider(s.ctx, kucoin))
+		s.Require().NoError(s.keeper.CreateMarket(s.ctx, btcusdt))
+
+		got, err := s.keeper.GetMarket(s.ctx, btcusdt.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().Equal(kucoin.DefaultMetadata_JSON, got.ProviderConfigs[0].Metadata_JSON)
+
+		// the given market is left untouched
+		s.Require().Empty(btcusdt.ProviderConfigs[0].Metadata_JSON)
+	})
+
+	s.Run("deprecated providers cannot be added but can be kept", func() {
+		s.SetupTest()
+
+		s.Require().NoError(s.keeper.SetProvider(s.ctx, kucoin))
+		s.Require().NoError(s.keeper.SetProvider(s.ctx, okx))
+
+		s.Require().Error(s.keeper.CreateMarket(s.ctx, withOkx))
+
+		s.Require().NoError(s.keeper.CreateMarket(s.ctx, btcusdt))
+		s.Require().Error(s.keeper.UpdateMarket(s.ctx, withOkx))
+
+		// a market that already uses the provider may keep it
+		okx.Status = types.PROVIDER_STATUS_ACTIVE
+		s.Require().NoError(s.keeper.SetProvider(s.ctx, okx))
+		s.Require().NoError(s.keeper.UpdateMarket(s.ctx, withOkx))
+
+		okx.Status = types.PROVIDER_STATUS_DEPRECATED
+		s.Require().NoError(s.keeper.SetProvider(s.ctx, okx))
+
+		updated := withOkx
+		updated.Ticker.Decimals = 10
+		s.Require().NoError(s.keeper.UpdateMarket(s.ctx, updated))
+	})
+
+	s.Run("providers used by markets cannot be removed", func() {
+		s.SetupTest()
+
+		s.Require().NoError(s.keeper.SetProvider(s.ctx, kucoin))
+		s.Require().NoError(s.keeper.SetProvider(s.ctx, okx))
+		s.Require().NoError(s.keeper.CreateMarket(s.ctx, btcusdt))
+
+		s.Require().Error(s.keeper.RemoveProvider(s.ctx, kucoin.Name))
+		s.Require().NoError(s.keeper.RemoveProvider(s.ctx, okx.Name))
+		s.Require().Error(s.keeper.RemoveProvider(s.ctx, okx.Name))
+
+		providers, err := s.keeper.GetAllProviders(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal([]types.ProviderInfo{kucoin}, providers)
+	})
+}
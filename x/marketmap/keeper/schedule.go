@@ -0,0 +1,209 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+// ScheduleMarketChange adds the given change to the queue of scheduled market changes and returns the ID
+// assigned to it. Any ID set on the given change is overwritten.
+func (k *Keeper) ScheduleMarketChange(ctx context.Context, change types.ScheduledMarketChange) (uint64, error) {
+	id, err := k.nextScheduledChangeID.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	// IDs start at 1 so that the zero ID denotes an unassigned ID.
+	if id == 0 {
+		if id, err = k.nextScheduledChangeID.Next(ctx); err != nil {
+			return 0, err
+		}
+	}
+
+	change.Id = id
+	return id, k.setScheduledMarketChange(ctx, change)
+}
+
+// GetScheduledMarketChange returns the scheduled market change with the given ID.
+func (k *Keeper) GetScheduledMarketChange(ctx context.Context, id uint64) (types.ScheduledMarketChange, error) {
+	return k.scheduledChanges.Get(ctx, id)
+}
+
+// CancelScheduledMarketChange removes the scheduled market change with the given ID from the queue. An error
+// is returned if no such change is scheduled.
+func (k *Keeper) CancelScheduledMarketChange(ctx context.Context, id uint64) error {
+	change, err := k.scheduledChanges.Get(ctx, id)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		return fmt.Errorf("scheduled market change %d does not exist", id)
+	case err != nil:
+		return err
+	}
+
+	return k.removeScheduledMarketChange(ctx, change)
+}
+
+// GetAllScheduledMarketChanges returns all pending scheduled market changes, ordered by ID.
+func (k *Keeper) GetAllScheduledMarketChanges(ctx context.Context) ([]types.ScheduledMarketChange, error) {
+	iter, err := k.scheduledChanges.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return iter.Values()
+}
+
+// GetDueScheduledMarketChanges returns the pending scheduled market changes that must be executed in a block
+// with the given height and time, ordered by ID. Only the due part of the queue is read.
+func (k *Keeper) GetDueScheduledMarketChanges(ctx context.Context, height uint64, blockTime time.Time) ([]types.ScheduledMarketChange, error) {
+	heightIter, err := k.scheduledChangesByHeight.Iterate(ctx, collections.NewPrefixUntilPairRange[uint64, uint64](height))
+	if err != nil {
+		return nil, err
+	}
+
+	byHeight, err := heightIter.Keys()
+	if err != nil {
+		return nil, err
+	}
+
+	timeIter, err := k.scheduledChangesByTime.Iterate(ctx, collections.NewPrefixUntilPairRange[time.Time, uint64](blockTime))
+	if err != nil {
+		return nil, err
+	}
+
+	byTime, err := timeIter.Keys()
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uint64, 0, len(byHeight)+len(byTime))
+	for _, key := range byHeight {
+		ids = append(ids, key.K2())
+	}
+	for _, key := range byTime {
+		ids = append(ids, key.K2())
+	}
+	slices.Sort(ids)
+
+	changes := make([]types.ScheduledMarketChange, 0, len(ids))
+	for _, id := range ids {
+		change, err := k.scheduledChanges.Get(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get scheduled market change %d: %w", id, err)
+		}
+
+		changes = append(changes, change)
+	}
+
+	return changes, nil
+}
+
+// GetNextScheduledMarketChangeID returns the ID that is assigned to the next scheduled market change.
+func (k *Keeper) GetNextScheduledMarketChangeID(ctx context.Context) (uint64, error) {
+	return k.nextScheduledChangeID.Peek(ctx)
+}
+
+// SetNextScheduledMarketChangeID sets the ID that is assigned to the next scheduled market change.
+func (k *Keeper) SetNextScheduledMarketChangeID(ctx context.Context, id uint64) error {
+	return k.nextScheduledChangeID.Set(ctx, id)
+}
+
+// setScheduledMarketChange stores the given change and queues it by its target height or time.
+func (k *Keeper) setScheduledMarketChange(ctx context.Context, change types.ScheduledMarketChange) error {
+	if err := k.scheduledChanges.Set(ctx, change.Id, change); err != nil {
+		return err
+	}
+
+	if change.Time != nil {
+		return k.scheduledChangesByTime.Set(ctx, collections.Join(*change.Time, change.Id))
+	}
+
+	return k.scheduledChangesByHeight.Set(ctx, collections.Join(change.Height, change.Id))
+}
+
+// removeScheduledMarketChange removes the given change and its entry in the queue.
+func (k *Keeper) removeScheduledMarketChange(ctx context.Context, change types.ScheduledMarketChange) error {
+	if err := k.scheduledChanges.Remove(ctx, change.Id); err != nil {
+		return err
+	}
+
+	if change.Time != nil {
+		return k.scheduledChangesByTime.Remove(ctx, collections.Join(*change.Time, change.Id))
+	}
+
+	return k.scheduledChangesByHeight.Remove(ctx, collections.Join(change.Height, change.Id))
+}
+
+// executeScheduledMarketChange applies the given change to its market, runs the update hooks and validates
+// the resulting market map. The authority that scheduled the change, if any, must be permitted to change the
+// market as it is at execution. It returns the updated market. The caller is responsible for discarding the
+// state changes if an error is returned.
+func (k *Keeper) executeScheduledMarketChange(ctx sdk.Context, change types.ScheduledMarketChange) (types.Market, error) {
+	tickerStr := change.CurrencyPair.String()
+
+	market, err := k.GetMarket(ctx, tickerStr)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		return types.Market{}, types.NewMarketDoesNotExistsError(types.TickerString(tickerStr))
+	case err != nil:
+		return types.Market{}, fmt.Errorf("failed to get market for ticker %s: %w", tickerStr, err)
+	}
+
+	switch change.Action {
+	case types.SCHEDULED_MARKET_ACTION_ENABLE, types.SCHEDULED_MARKET_ACTION_DISABLE:
+	case types.SCHEDULED_MARKET_ACTION_UPDATE:
+		if change.Market == nil {
+			return types.Market{}, fmt.Errorf("market must be set for scheduled update of market %s", tickerStr)
+		}
+	default:
+		return types.Market{}, fmt.Errorf("invalid action %d for scheduled change of market %s", change.Action, tickerStr)
+	}
+
+	existing := market
+	market = scheduledMarket(existing, change)
+
+	// the authority that scheduled the change must still be permitted to change the market as it is now
+	if change.Authority != "" {
+		scope, err := k.getMarketAuthorityScope(ctx, change.Authority)
+		if err != nil {
+			return types.Market{}, fmt.Errorf("unable to verify market authority: %w", err)
+		}
+
+		if err := authorizeMarketChange(scope, &existing, market); err != nil {
+			return types.Market{}, err
+		}
+	}
+
+	if err := k.UpdateMarket(ctx, market); err != nil {
+		return types.Market{}, fmt.Errorf("unable to update market: %w", err)
+	}
+
+	if err := k.hooks.AfterMarketUpdated(ctx, market); err != nil {
+		return types.Market{}, fmt.Errorf("unable to run update market hook: %w", err)
+	}
+
+	if err := k.ValidateState(ctx, []types.Market{market}); err != nil {
+		return types.Market{}, fmt.Errorf("invalid state resulting from update: %w", err)
+	}
+
+	// disabling or updating a market may invalidate the markets that normalize by it
+	allMarkets, err := k.GetAllMarkets(ctx)
+	if err != nil {
+		return types.Market{}, err
+	}
+
+	mm := types.MarketMap{Markets: allMarkets}
+	if err := mm.ValidateBasic(); err != nil {
+		return types.Market{}, fmt.Errorf("invalid state resulting from update: %w", err)
+	}
+
+	return market, nil
+}
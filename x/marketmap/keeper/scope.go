@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+// SetMarketAuthorityScope sets the scope of a scoped market authority, replacing any scope previously set for the
+// same authority.
+func (k *Keeper) SetMarketAuthorityScope(ctx context.Context, scope types.MarketAuthorityScope) error {
+	return k.scopes.Set(ctx, scope.Authority, scope)
+}
+
+// GetMarketAuthorityScope returns the scope of the given scoped market authority.
+func (k *Keeper) GetMarketAuthorityScope(ctx context.Context, authority string) (types.MarketAuthorityScope, error) {
+	return k.scopes.Get(ctx, authority)
+}
+
+// HasMarketAuthorityScope checks if a scope is set for the given authority.
+func (k *Keeper) HasMarketAuthorityScope(ctx context.Context, authority string) (bool, error) {
+	return k.scopes.Has(ctx, authority)
+}
+
+// RemoveMarketAuthorityScope removes the scope of the given scoped market authority. An error is returned if no
+// scope is set for the authority.
+func (k *Keeper) RemoveMarketAuthorityScope(ctx context.Context, authority string) error {
+	found, err := k.scopes.Has(ctx, authority)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return fmt.Errorf("no scope set for market authority %s", authority)
+	}
+
+	return k.scopes.Remove(ctx, authority)
+}
+
+// GetAllMarketAuthorityScopes returns the scopes of all scoped market authorities, ordered by authority.
+func (k *Keeper) GetAllMarketAuthorityScopes(ctx context.Context) ([]types.MarketAuthorityScope, error) {
+	iter, err := k.scopes.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return iter.Values()
+}
+
+// getMarketAuthorityScope returns the scope of the given authority, or nil if it is a market authority and thus
+// permitted every change. An error is returned if the authority is neither a market authority nor a scoped market
+// authority.
+func (k *Keeper) getMarketAuthorityScope(ctx context.Context, authority string) (*types.MarketAuthorityScope, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get marketmap params: %w", err)
+	}
+
+	if checkMarketAuthority(authority, params) {
+		return nil, nil
+	}
+
+	scope, err := k.GetMarketAuthorityScope(ctx, authority)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		return nil, fmt.Errorf("request signer %s does not match module market authorities", authority)
+	case err != nil:
+		return nil, fmt.Errorf("unable to get market authority scope: %w", err)
+	}
+
+	return &scope, nil
+}
@@ -17,6 +17,12 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgParams{}, "connect/x/marketmap/MsgParams")
 	legacy.RegisterAminoMsg(cdc, &MsgUpsertMarkets{}, "connect/x/marketmap/MsgUpsertMarkets")
 	legacy.RegisterAminoMsg(cdc, &MsgRemoveMarkets{}, "connect/x/marketmap/MsgRemoveMarkets")
+	legacy.RegisterAminoMsg(cdc, &MsgScheduleMarketChange{}, "connect/x/marketmap/MsgScheduleChange")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelScheduledMarketChange{}, "connect/x/marketmap/MsgCancelChange")
+	legacy.RegisterAminoMsg(cdc, &MsgGrantMarketAuthorityScope{}, "connect/x/marketmap/MsgGrantScope")
+	legacy.RegisterAminoMsg(cdc, &MsgRevokeMarketAuthorityScope{}, "connect/x/marketmap/MsgRevokeScope")
+	legacy.RegisterAminoMsg(cdc, &MsgUpsertProviders{}, "connect/x/marketmap/MsgUpsertProviders")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveProviders{}, "connect/x/marketmap/MsgRemoveProviders")
 }
 
 // RegisterInterfaces registers the x/marketmap messages + message service w/ the InterfaceRegistry (registry).
@@ -29,6 +35,12 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgUpsertMarkets{},
 		&MsgRemoveMarkets{},
 		&MsgRemoveMarketAuthorities{},
+		&MsgScheduleMarketChange{},
+		&MsgCancelScheduledMarketChange{},
+		&MsgGrantMarketAuthorityScope{},
+		&MsgRevokeMarketAuthorityScope{},
+		&MsgUpsertProviders{},
+		&MsgRemoveProviders{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -6,8 +6,12 @@ const (
 	EventTypeCreateMarket = "create_market"
 	EventTypeUpdateMarket = "update_market"
 
+	EventTypeScheduledMarketChangeFailed = "scheduled_market_change_failed"
+
 	AttributeKeyCurrencyPair     = "currency_pair"
 	AttributeKeyDecimals         = "decimals"
 	AttributeKeyMinProviderCount = "min_provider_count"
 	AttributeKeyMetadata         = "metadata"
+	AttributeKeyScheduledID      = "scheduled_change_id"
+	AttributeKeyError            = "error"
 )
@@ -0,0 +1,40 @@
+package types
+
+// IsEmpty returns true if the filter matches all markets.
+func (f *MarketFilter) IsEmpty() bool {
+	return f.Enabled == MARKET_ENABLED_FILTER_UNSPECIFIED && f.Provider == "" && f.Base == "" && f.Quote == ""
+}
+
+// Matches returns true if the given market matches every set field of the filter.
+func (f *MarketFilter) Matches(market Market) bool {
+	switch f.Enabled {
+	case MARKET_ENABLED_FILTER_ENABLED:
+		if !market.Ticker.Enabled {
+			return false
+		}
+	case MARKET_ENABLED_FILTER_DISABLED:
+		if market.Ticker.Enabled {
+			return false
+		}
+	}
+
+	if f.Base != "" && market.Ticker.CurrencyPair.Base != f.Base {
+		return false
+	}
+
+	if f.Quote != "" && market.Ticker.CurrencyPair.Quote != f.Quote {
+		return false
+	}
+
+	if f.Provider == "" {
+		return true
+	}
+
+	for _, providerConfig := range market.ProviderConfigs {
+		if providerConfig.Name == f.Provider {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,56 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+func TestMarketFilterMatches(t *testing.T) {
+	market := types.Market{
+		Ticker: types.Ticker{
+			CurrencyPair:     connecttypes.NewCurrencyPair("BTC", "USD"),
+			Decimals:         8,
+			MinProviderCount: 1,
+			Enabled:          true,
+		},
+		ProviderConfigs: []types.ProviderConfig{
+			{
+				Name:           "kucoin",
+				OffChainTicker: "BTC-USD",
+			},
+			{
+				Name:           "okx",
+				OffChainTicker: "BTC-USD",
+			},
+		},
+	}
+
+	tcs := []struct {
+		name   string
+		filter types.MarketFilter
+		match  bool
+	}{
+		{"empty filter", types.MarketFilter{}, true},
+		{"enabled", types.MarketFilter{Enabled: types.MARKET_ENABLED_FILTER_ENABLED}, true},
+		{"disabled", types.MarketFilter{Enabled: types.MARKET_ENABLED_FILTER_DISABLED}, false},
+		{"provider", types.MarketFilter{Provider: "okx"}, true},
+		{"other provider", types.MarketFilter{Provider: "coinbase"}, false},
+		{"base", types.MarketFilter{Base: "BTC"}, true},
+		{"other base", types.MarketFilter{Base: "ETH"}, false},
+		{"quote", types.MarketFilter{Quote: "USD"}, true},
+		{"other quote", types.MarketFilter{Quote: "USDT"}, false},
+		{"all fields", types.MarketFilter{Enabled: types.MARKET_ENABLED_FILTER_ENABLED, Provider: "kucoin", Base: "BTC", Quote: "USD"}, true},
+		{"one field mismatch", types.MarketFilter{Provider: "kucoin", Base: "BTC", Quote: "USDT"}, false},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.match, tc.filter.Matches(market))
+			require.Equal(t, tc.name == "empty filter", tc.filter.IsEmpty())
+		})
+	}
+}
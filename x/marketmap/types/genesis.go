@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // NewGenesisState returns an instance of GenesisState.
 func NewGenesisState(
 	marketMap MarketMap,
@@ -19,6 +21,41 @@ func (gs *GenesisState) ValidateBasic() error {
 		return err
 	}
 
+	seenIDs := make(map[uint64]struct{}, len(gs.ScheduledMarketChanges))
+	for _, change := range gs.ScheduledMarketChanges {
+		if change.Id == 0 || change.Id >= gs.NextScheduledMarketChangeId {
+			return fmt.Errorf("scheduled change id %d must be positive and less than the next id %d",
+				change.Id, gs.NextScheduledMarketChangeId)
+		}
+
+		if _, seen := seenIDs[change.Id]; seen {
+			return fmt.Errorf("duplicate scheduled change id %d", change.Id)
+		}
+
+		if err := change.ValidateBasic(); err != nil {
+			return err
+		}
+
+		seenIDs[change.Id] = struct{}{}
+	}
+
+	seenAuthorities := make(map[string]struct{}, len(gs.MarketAuthorityScopes))
+	for _, scope := range gs.MarketAuthorityScopes {
+		if _, seen := seenAuthorities[scope.Authority]; seen {
+			return fmt.Errorf("duplicate scope for market authority %s", scope.Authority)
+		}
+
+		if err := scope.ValidateBasic(); err != nil {
+			return err
+		}
+
+		seenAuthorities[scope.Authority] = struct{}{}
+	}
+
+	if err := ValidateProviders(gs.Providers); err != nil {
+		return err
+	}
+
 	return gs.Params.ValidateBasic()
 }
 
@@ -28,7 +65,8 @@ func DefaultGenesisState() *GenesisState {
 		MarketMap: MarketMap{
 			Markets: make(map[string]Market),
 		},
-		LastUpdated: 0,
-		Params:      DefaultParams(),
+		LastUpdated:                 0,
+		Params:                      DefaultParams(),
+		NextScheduledMarketChangeId: 1,
 	}
 }
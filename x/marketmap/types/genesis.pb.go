@@ -34,6 +34,15 @@ type GenesisState struct {
 	LastUpdated uint64 `protobuf:"varint,2,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
 	// Params are the parameters for the x/marketmap module.
 	Params Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	// ScheduledMarketChanges are the pending scheduled market changes.
+	ScheduledMarketChanges []ScheduledMarketChange `protobuf:"bytes,4,rep,name=scheduled_market_changes,json=scheduledMarketChanges,proto3" json:"scheduled_market_changes"`
+	// NextScheduledMarketChangeID is the ID assigned to the next scheduled market
+	// change.
+	NextScheduledMarketChangeId uint64 `protobuf:"varint,5,opt,name=next_scheduled_market_change_id,json=nextScheduledMarketChangeId,proto3" json:"next_scheduled_market_change_id,omitempty"`
+	// MarketAuthorityScopes are the scopes of the scoped market authorities.
+	MarketAuthorityScopes []MarketAuthorityScope `protobuf:"bytes,6,rep,name=market_authority_scopes,json=marketAuthorityScopes,proto3" json:"market_authority_scopes"`
+	// Providers are the providers registered in the provider registry.
+	Providers []ProviderInfo `protobuf:"bytes,7,rep,name=providers,proto3" json:"providers"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -90,6 +99,34 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetScheduledMarketChanges() []ScheduledMarketChange {
+	if m != nil {
+		return m.ScheduledMarketChanges
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextScheduledMarketChangeId() uint64 {
+	if m != nil {
+		return m.NextScheduledMarketChangeId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetMarketAuthorityScopes() []MarketAuthorityScope {
+	if m != nil {
+		return m.MarketAuthorityScopes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProviders() []ProviderInfo {
+	if m != nil {
+		return m.Providers
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "connect.marketmap.v2.GenesisState")
 }
@@ -99,24 +136,34 @@ func init() {
 }
 
 var fileDescriptor_02569cd74fc8ae65 = []byte{
-	// 269 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4a, 0xce, 0xcf, 0xcb,
-	0x4b, 0x4d, 0x2e, 0xd1, 0xcf, 0x4d, 0x2c, 0xca, 0x4e, 0x2d, 0xc9, 0x4d, 0x2c, 0xd0, 0x2f, 0x33,
-	0xd2, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
-	0x81, 0xaa, 0xd1, 0x83, 0xab, 0xd1, 0x2b, 0x33, 0x92, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b,
-	0xd0, 0x07, 0xb1, 0x20, 0x6a, 0xa5, 0x14, 0xb1, 0x9a, 0x07, 0xe1, 0xe0, 0x55, 0x52, 0x90, 0x58,
-	0x94, 0x98, 0x0b, 0xb5, 0x51, 0x69, 0x33, 0x23, 0x17, 0x8f, 0x3b, 0xc4, 0x0d, 0xc1, 0x25, 0x89,
-	0x25, 0xa9, 0x42, 0x2e, 0x5c, 0x5c, 0x10, 0xd5, 0xf1, 0xb9, 0x89, 0x05, 0x12, 0x8c, 0x0a, 0x8c,
-	0x1a, 0xdc, 0x46, 0xf2, 0x7a, 0xd8, 0xdc, 0xa5, 0xe7, 0x0b, 0xe6, 0xf8, 0x26, 0x16, 0x38, 0xb1,
-	0x9c, 0xb8, 0x27, 0xcf, 0x10, 0xc4, 0x99, 0x0b, 0x13, 0x10, 0x52, 0xe4, 0xe2, 0xc9, 0x49, 0x2c,
-	0x2e, 0x89, 0x2f, 0x2d, 0x48, 0x49, 0x2c, 0x49, 0x4d, 0x91, 0x60, 0x52, 0x60, 0xd4, 0x60, 0x09,
-	0xe2, 0x06, 0x89, 0x85, 0x42, 0x84, 0x84, 0xac, 0xb8, 0xd8, 0x20, 0x2e, 0x91, 0x60, 0x06, 0x5b,
-	0x22, 0x83, 0xdd, 0x92, 0x00, 0xb0, 0x1a, 0xa8, 0x0d, 0x50, 0x1d, 0x4e, 0x5e, 0x27, 0x1e, 0xc9,
-	0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e,
-	0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65, 0x90, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97,
-	0x9c, 0x9f, 0xab, 0x5f, 0x9c, 0x9d, 0x59, 0xa0, 0x9b, 0x9b, 0x5a, 0xa6, 0x0f, 0x0b, 0x86, 0x32,
-	0x23, 0xfd, 0x0a, 0xa4, 0xb0, 0x28, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x07, 0x84, 0x31,
-	0x20, 0x00, 0x00, 0xff, 0xff, 0x3f, 0x2d, 0x44, 0xf7, 0xa0, 0x01, 0x00, 0x00,
+	// 421 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x31, 0x8f, 0xd3, 0x30,
+	0x14, 0xc7, 0x13, 0x2e, 0x14, 0x9d, 0x7b, 0x93, 0x75, 0x80, 0x55, 0x50, 0x9a, 0x2b, 0x4b, 0x05,
+	0x22, 0x41, 0x61, 0x63, 0xe3, 0x38, 0x81, 0x0e, 0xe9, 0x24, 0x74, 0x15, 0x0b, 0x4b, 0xe4, 0x26,
+	0x26, 0x89, 0x5a, 0xc7, 0x96, 0xed, 0x44, 0xed, 0xb7, 0x60, 0xe4, 0x23, 0x75, 0xec, 0xc8, 0x84,
+	0x50, 0xfb, 0x45, 0x50, 0x1c, 0xa7, 0xba, 0xc1, 0xed, 0x66, 0x3f, 0xff, 0xfe, 0xef, 0xff, 0xde,
+	0xf3, 0x03, 0x93, 0x94, 0x55, 0x15, 0x49, 0x55, 0x44, 0xb1, 0x58, 0x10, 0x45, 0x31, 0x8f, 0x9a,
+	0x38, 0xca, 0x49, 0x45, 0x64, 0x29, 0x43, 0x2e, 0x98, 0x62, 0xf0, 0xd2, 0x30, 0xe1, 0x81, 0x09,
+	0x9b, 0x78, 0x74, 0x99, 0xb3, 0x9c, 0x69, 0x20, 0x6a, 0x4f, 0x1d, 0x3b, 0xba, 0xb2, 0xe6, 0xeb,
+	0x2e, 0x27, 0x11, 0x8e, 0x05, 0xa6, 0xc6, 0x71, 0xf4, 0xca, 0x8a, 0x08, 0x92, 0x97, 0x52, 0x89,
+	0xf5, 0x49, 0x48, 0xa6, 0x05, 0xc9, 0xea, 0x25, 0x31, 0x50, 0x70, 0x04, 0x62, 0xdc, 0x10, 0x93,
+	0xdf, 0x1e, 0xb8, 0xf8, 0xd2, 0xf5, 0x3b, 0x53, 0x58, 0x11, 0x78, 0x03, 0x40, 0x07, 0x27, 0x14,
+	0x73, 0xe4, 0x06, 0xee, 0x74, 0x18, 0x8f, 0x43, 0xdb, 0x0c, 0xc2, 0x3b, 0x7d, 0xb9, 0xc3, 0xfc,
+	0xda, 0xdb, 0xfc, 0x1d, 0x3b, 0xf7, 0xe7, 0xb4, 0x0f, 0xc0, 0x2b, 0x70, 0xb1, 0xc4, 0x52, 0x25,
+	0x35, 0xcf, 0xb0, 0x22, 0x19, 0x7a, 0x14, 0xb8, 0x53, 0xef, 0x7e, 0xd8, 0xc6, 0xbe, 0x77, 0x21,
+	0xf8, 0x01, 0x0c, 0xba, 0xae, 0xd1, 0x99, 0x36, 0x79, 0x69, 0x37, 0xf9, 0xa6, 0x19, 0xe3, 0x60,
+	0x14, 0x70, 0x01, 0x50, 0xdf, 0x69, 0x96, 0x98, 0x72, 0xd3, 0x02, 0x57, 0x39, 0x91, 0xc8, 0x0b,
+	0xce, 0xa6, 0xc3, 0xf8, 0x8d, 0x3d, 0xdb, 0xac, 0x57, 0x75, 0xb5, 0x7f, 0xd2, 0x1a, 0x93, 0xfc,
+	0x99, 0xb4, 0x3d, 0x4a, 0x78, 0x03, 0xc6, 0x15, 0x59, 0xa9, 0xe4, 0x88, 0x63, 0x52, 0x66, 0xe8,
+	0xb1, 0x6e, 0xef, 0x45, 0x8b, 0x59, 0x1d, 0x6e, 0x33, 0x58, 0x80, 0xe7, 0x46, 0x86, 0x6b, 0x55,
+	0x30, 0x51, 0xaa, 0x75, 0xa2, 0x3f, 0x42, 0xa2, 0x81, 0xae, 0xf8, 0xf5, 0xa9, 0x21, 0x7f, 0xec,
+	0x35, 0xb3, 0x56, 0x62, 0x0a, 0x7e, 0x4a, 0x2d, 0x6f, 0x12, 0x7e, 0x06, 0xe7, 0x5c, 0xb0, 0xa6,
+	0xcc, 0x88, 0x90, 0xe8, 0x89, 0xce, 0x3d, 0x39, 0x32, 0x5b, 0x83, 0xdd, 0x56, 0x3f, 0x59, 0xff,
+	0x87, 0x07, 0xe9, 0xf5, 0xd7, 0xcd, 0xce, 0x77, 0xb7, 0x3b, 0xdf, 0xfd, 0xb7, 0xf3, 0xdd, 0x5f,
+	0x7b, 0xdf, 0xd9, 0xee, 0x7d, 0xe7, 0xcf, 0xde, 0x77, 0x7e, 0xbc, 0xcb, 0x4b, 0x55, 0xd4, 0xf3,
+	0x30, 0x65, 0x34, 0x92, 0x8b, 0x92, 0xbf, 0xa5, 0xa4, 0x89, 0xfa, 0x55, 0x6b, 0xe2, 0x68, 0xf5,
+	0x60, 0xdf, 0xd4, 0x9a, 0x13, 0x39, 0x1f, 0xe8, 0x6d, 0x7b, 0xff, 0x7f, 0x00, 0xd9, 0x83, 0x61,
+	0x6b, 0x71, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -139,6 +186,53 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Providers) > 0 {
+		for iNdEx := len(m.Providers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Providers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.MarketAuthorityScopes) > 0 {
+		for iNdEx := len(m.MarketAuthorityScopes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MarketAuthorityScopes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.NextScheduledMarketChangeId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextScheduledMarketChangeId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ScheduledMarketChanges) > 0 {
+		for iNdEx := len(m.ScheduledMarketChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledMarketChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -191,6 +285,27 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ScheduledMarketChanges) > 0 {
+		for _, e := range m.ScheduledMarketChanges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextScheduledMarketChangeId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextScheduledMarketChangeId))
+	}
+	if len(m.MarketAuthorityScopes) > 0 {
+		for _, e := range m.MarketAuthorityScopes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Providers) > 0 {
+		for _, e := range m.Providers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -314,6 +429,127 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledMarketChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledMarketChanges = append(m.ScheduledMarketChanges, ScheduledMarketChange{})
+			if err := m.ScheduledMarketChanges[len(m.ScheduledMarketChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextScheduledMarketChangeId", wireType)
+			}
+			m.NextScheduledMarketChangeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextScheduledMarketChangeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketAuthorityScopes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketAuthorityScopes = append(m.MarketAuthorityScopes, MarketAuthorityScope{})
+			if err := m.MarketAuthorityScopes[len(m.MarketAuthorityScopes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Providers = append(m.Providers, ProviderInfo{})
+			if err := m.Providers[len(m.Providers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
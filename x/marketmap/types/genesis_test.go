@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	"github.com/skip-mev/chaintestutil/sample"
 	"github.com/stretchr/testify/require"
 
 	"github.com/skip-mev/connect/v2/x/marketmap/types"
@@ -30,4 +31,89 @@ func TestGenesisState(t *testing.T) {
 		}
 		require.NoError(t, gs.ValidateBasic())
 	})
+	t.Run("good genesis state with scheduled changes", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+		gs.ScheduledMarketChanges = []types.ScheduledMarketChange{
+			{Id: 1, Action: types.SCHEDULED_MARKET_ACTION_ENABLE, CurrencyPair: btcusdtCP, Height: 10},
+			{Id: 2, Action: types.SCHEDULED_MARKET_ACTION_DISABLE, CurrencyPair: btcusdtCP, Height: 20},
+		}
+		gs.NextScheduledMarketChangeId = 3
+		require.NoError(t, gs.ValidateBasic())
+	})
+
+	t.Run("scheduled change id not less than next id - fail", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+		gs.ScheduledMarketChanges = []types.ScheduledMarketChange{
+			{Id: 1, Action: types.SCHEDULED_MARKET_ACTION_ENABLE, CurrencyPair: btcusdtCP, Height: 10},
+		}
+		require.Error(t, gs.ValidateBasic())
+	})
+
+	t.Run("duplicate scheduled change ids - fail", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+		gs.ScheduledMarketChanges = []types.ScheduledMarketChange{
+			{Id: 1, Action: types.SCHEDULED_MARKET_ACTION_ENABLE, CurrencyPair: btcusdtCP, Height: 10},
+			{Id: 1, Action: types.SCHEDULED_MARKET_ACTION_DISABLE, CurrencyPair: btcusdtCP, Height: 20},
+		}
+		gs.NextScheduledMarketChangeId = 2
+		require.Error(t, gs.ValidateBasic())
+	})
+
+	t.Run("invalid scheduled change - fail", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+		gs.ScheduledMarketChanges = []types.ScheduledMarketChange{
+			{Id: 1, Action: types.SCHEDULED_MARKET_ACTION_ENABLE, CurrencyPair: btcusdtCP},
+		}
+		gs.NextScheduledMarketChangeId = 2
+		require.Error(t, gs.ValidateBasic())
+	})
+	t.Run("good genesis state with market authority scopes", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+		gs.MarketAuthorityScopes = []types.MarketAuthorityScope{
+			{
+				Authority:     sample.Address(sample.Rand()),
+				CurrencyPairs: []types.CurrencyPairMatcher{{Base: "BTC"}},
+				Permissions:   []types.MarketPermission{types.MARKET_PERMISSION_ENABLE},
+			},
+		}
+		require.NoError(t, gs.ValidateBasic())
+	})
+
+	t.Run("duplicate market authority scopes - fail", func(t *testing.T) {
+		scope := types.MarketAuthorityScope{
+			Authority:     sample.Address(sample.Rand()),
+			CurrencyPairs: []types.CurrencyPairMatcher{{Base: "BTC"}},
+			Permissions:   []types.MarketPermission{types.MARKET_PERMISSION_ENABLE},
+		}
+
+		gs := types.DefaultGenesisState()
+		gs.MarketAuthorityScopes = []types.MarketAuthorityScope{scope, scope}
+		require.Error(t, gs.ValidateBasic())
+	})
+
+	t.Run("invalid market authority scope - fail", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+		gs.MarketAuthorityScopes = []types.MarketAuthorityScope{
+			{
+				Authority:     sample.Address(sample.Rand()),
+				CurrencyPairs: []types.CurrencyPairMatcher{{Base: "BTC"}},
+			},
+		}
+		require.Error(t, gs.ValidateBasic())
+	})
+
+	t.Run("duplicate providers - fail", func(t *testing.T) {
+		provider := types.ProviderInfo{
+			Name:   "binance_api",
+			Type:   types.PROVIDER_TYPE_CEX,
+			Status: types.PROVIDER_STATUS_ACTIVE,
+		}
+
+		gs := types.DefaultGenesisState()
+		gs.Providers = []types.ProviderInfo{provider}
+		require.NoError(t, gs.ValidateBasic())
+
+		gs.Providers = append(gs.Providers, provider)
+		require.Error(t, gs.ValidateBasic())
+	})
 }
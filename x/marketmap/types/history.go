@@ -0,0 +1,60 @@
+package types
+
+// NewMarketChange returns the MarketChange recording the change of the before market into the after market at the
+// given height. A nil before market denotes the creation of the after market, and a nil after market denotes the
+// removal of the before market. An update that only changes the Enabled field of the ticker is recorded as enabling
+// or disabling the market.
+func NewMarketChange(height uint64, before, after *Market) MarketChange {
+	change := MarketChange{
+		Height: height,
+		Before: before,
+		After:  after,
+	}
+
+	switch {
+	case before == nil:
+		change.Type = MARKET_CHANGE_TYPE_CREATE
+	case after == nil:
+		change.Type = MARKET_CHANGE_TYPE_REMOVE
+	case before.Ticker.Enabled != after.Ticker.Enabled && onlyEnabledChanged(*before, *after):
+		if after.Ticker.Enabled {
+			change.Type = MARKET_CHANGE_TYPE_ENABLE
+		} else {
+			change.Type = MARKET_CHANGE_TYPE_DISABLE
+		}
+	default:
+		change.Type = MARKET_CHANGE_TYPE_UPDATE
+	}
+
+	return change
+}
+
+// TickerString returns the ticker string of the changed market.
+func (c *MarketChange) TickerString() string {
+	if c.After != nil {
+		return c.After.Ticker.String()
+	}
+
+	if c.Before != nil {
+		return c.Before.Ticker.String()
+	}
+
+	return ""
+}
+
+// Revert undoes the change on the given markets, keyed by ticker string, restoring the market as it was before the
+// change.
+func (c *MarketChange) Revert(markets map[string]Market) {
+	if c.After != nil {
+		delete(markets, c.After.Ticker.String())
+	}
+
+	if c.Before != nil {
+		markets[c.Before.Ticker.String()] = *c.Before
+	}
+}
+
+func onlyEnabledChanged(before, after Market) bool {
+	after.Ticker.Enabled = before.Ticker.Enabled
+	return before.Equal(after)
+}
@@ -0,0 +1,559 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: connect/marketmap/v2/history.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MarketChangeType is the kind of change recorded by a MarketChange.
+type MarketChangeType int32
+
+const (
+	// MARKET_CHANGE_TYPE_UNSPECIFIED is an invalid change type.
+	MARKET_CHANGE_TYPE_UNSPECIFIED MarketChangeType = 0
+	// MARKET_CHANGE_TYPE_CREATE is the creation of a market.
+	MARKET_CHANGE_TYPE_CREATE MarketChangeType = 1
+	// MARKET_CHANGE_TYPE_UPDATE is an update of a market other than only
+	// enabling or disabling it.
+	MARKET_CHANGE_TYPE_UPDATE MarketChangeType = 2
+	// MARKET_CHANGE_TYPE_REMOVE is the removal of a market.
+	MARKET_CHANGE_TYPE_REMOVE MarketChangeType = 3
+	// MARKET_CHANGE_TYPE_ENABLE is the enabling of a market.
+	MARKET_CHANGE_TYPE_ENABLE MarketChangeType = 4
+	// MARKET_CHANGE_TYPE_DISABLE is the disabling of a market.
+	MARKET_CHANGE_TYPE_DISABLE MarketChangeType = 5
+)
+
+var MarketChangeType_name = map[int32]string{
+	0: "MARKET_CHANGE_TYPE_UNSPECIFIED",
+	1: "MARKET_CHANGE_TYPE_CREATE",
+	2: "MARKET_CHANGE_TYPE_UPDATE",
+	3: "MARKET_CHANGE_TYPE_REMOVE",
+	4: "MARKET_CHANGE_TYPE_ENABLE",
+	5: "MARKET_CHANGE_TYPE_DISABLE",
+}
+
+var MarketChangeType_value = map[string]int32{
+	"MARKET_CHANGE_TYPE_UNSPECIFIED": 0,
+	"MARKET_CHANGE_TYPE_CREATE":      1,
+	"MARKET_CHANGE_TYPE_UPDATE":      2,
+	"MARKET_CHANGE_TYPE_REMOVE":      3,
+	"MARKET_CHANGE_TYPE_ENABLE":      4,
+	"MARKET_CHANGE_TYPE_DISABLE":     5,
+}
+
+func (x MarketChangeType) String() string {
+	return proto.EnumName(MarketChangeType_name, int32(x))
+}
+
+func (MarketChangeType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e8831f20b8738de4, []int{0}
+}
+
+// MarketChange is an entry of the market history. It records a change made to
+// a market at a given block height, along with the market before and after
+// the change.
+type MarketChange struct {
+	// ID is the unique, increasing identifier of the change.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Height is the block height at which the change was made.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// Type is the kind of change.
+	Type MarketChangeType `protobuf:"varint,3,opt,name=type,proto3,enum=connect.marketmap.v2.MarketChangeType" json:"type,omitempty"`
+	// Before is the market before the change. It is unset for a creation.
+	Before *Market `protobuf:"bytes,4,opt,name=before,proto3" json:"before,omitempty"`
+	// After is the market after the change. It is unset for a removal.
+	After *Market `protobuf:"bytes,5,opt,name=after,proto3" json:"after,omitempty"`
+}
+
+func (m *MarketChange) Reset()         { *m = MarketChange{} }
+func (m *MarketChange) String() string { return proto.CompactTextString(m) }
+func (*MarketChange) ProtoMessage()    {}
+func (*MarketChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8831f20b8738de4, []int{0}
+}
+func (m *MarketChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketChange.Merge(m, src)
+}
+func (m *MarketChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketChange proto.InternalMessageInfo
+
+func (m *MarketChange) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MarketChange) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *MarketChange) GetType() MarketChangeType {
+	if m != nil {
+		return m.Type
+	}
+	return MARKET_CHANGE_TYPE_UNSPECIFIED
+}
+
+func (m *MarketChange) GetBefore() *Market {
+	if m != nil {
+		return m.Before
+	}
+	return nil
+}
+
+func (m *MarketChange) GetAfter() *Market {
+	if m != nil {
+		return m.After
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("connect.marketmap.v2.MarketChangeType", MarketChangeType_name, MarketChangeType_value)
+	proto.RegisterType((*MarketChange)(nil), "connect.marketmap.v2.MarketChange")
+}
+
+func init() {
+	proto.RegisterFile("connect/marketmap/v2/history.proto", fileDescriptor_e8831f20b8738de4)
+}
+
+var fileDescriptor_e8831f20b8738de4 = []byte{
+	// 377 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x6b, 0xe2, 0x40,
+	0x14, 0xc7, 0x33, 0x31, 0x7a, 0x98, 0x5d, 0x24, 0x0c, 0xb2, 0x64, 0xc3, 0xee, 0xe0, 0x7a, 0x58,
+	0x64, 0x61, 0x93, 0x25, 0xbb, 0xa7, 0xbd, 0xc5, 0x38, 0xbb, 0xb5, 0xad, 0x56, 0x62, 0x2c, 0xb4,
+	0x17, 0x89, 0x3a, 0x26, 0x41, 0xe2, 0x84, 0x38, 0x0d, 0xf5, 0x1b, 0xf4, 0xd8, 0xef, 0xd0, 0x2f,
+	0xd3, 0xa3, 0x47, 0x0f, 0x3d, 0x14, 0xfd, 0x22, 0xc5, 0xc4, 0x16, 0x29, 0xb1, 0xf4, 0x36, 0xef,
+	0xfd, 0x7e, 0xef, 0xf1, 0x60, 0xfe, 0xb0, 0x36, 0x62, 0xb3, 0x19, 0x1d, 0x71, 0x3d, 0x74, 0xe3,
+	0x29, 0xe5, 0xa1, 0x1b, 0xe9, 0x89, 0xa1, 0xfb, 0xc1, 0x9c, 0xb3, 0x78, 0xa1, 0x45, 0x31, 0xe3,
+	0x0c, 0x55, 0x76, 0x8e, 0xf6, 0xe2, 0x68, 0x89, 0xa1, 0x56, 0x3c, 0xe6, 0xb1, 0x54, 0xd0, 0xb7,
+	0xaf, 0xcc, 0x55, 0xbf, 0xe5, 0xee, 0xcb, 0x8a, 0x4c, 0xa9, 0x3d, 0x00, 0xf8, 0xb1, 0x9d, 0x36,
+	0x2c, 0xdf, 0x9d, 0x79, 0x14, 0x95, 0xa1, 0x18, 0x8c, 0x15, 0x50, 0x05, 0x75, 0xc9, 0x16, 0x83,
+	0x31, 0xfa, 0x04, 0x4b, 0x3e, 0x0d, 0x3c, 0x9f, 0x2b, 0x62, 0xda, 0xdb, 0x55, 0xe8, 0x2f, 0x94,
+	0xf8, 0x22, 0xa2, 0x4a, 0xa1, 0x0a, 0xea, 0x65, 0xe3, 0xbb, 0x96, 0x77, 0x96, 0xb6, 0xbf, 0xd9,
+	0x59, 0x44, 0xd4, 0x4e, 0x67, 0xd0, 0x1f, 0x58, 0x1a, 0xd2, 0x09, 0x8b, 0xa9, 0x22, 0x55, 0x41,
+	0xfd, 0x83, 0xf1, 0xe5, 0xad, 0x69, 0x7b, 0xe7, 0x22, 0x03, 0x16, 0xdd, 0x09, 0xa7, 0xb1, 0x52,
+	0x7c, 0xc7, 0x50, 0xa6, 0xfe, 0x58, 0x01, 0x28, 0xbf, 0x3e, 0x02, 0xd5, 0x20, 0x6e, 0x9b, 0xf6,
+	0x09, 0x71, 0x06, 0xd6, 0x91, 0xd9, 0xf9, 0x4f, 0x06, 0xce, 0x45, 0x97, 0x0c, 0xfa, 0x9d, 0x5e,
+	0x97, 0x58, 0xad, 0x7f, 0x2d, 0xd2, 0x94, 0x05, 0xf4, 0x15, 0x7e, 0xce, 0x71, 0x2c, 0x9b, 0x98,
+	0x0e, 0x91, 0xc1, 0x01, 0xdc, 0xef, 0x36, 0xb7, 0x58, 0x3c, 0x80, 0x6d, 0xd2, 0x3e, 0x3b, 0x27,
+	0x72, 0xe1, 0x00, 0x26, 0x1d, 0xb3, 0x71, 0x4a, 0x64, 0x09, 0x61, 0xa8, 0xe6, 0xe0, 0x66, 0xab,
+	0x97, 0xf2, 0xa2, 0x2a, 0xdd, 0xdc, 0x61, 0xa1, 0x71, 0x7c, 0xbf, 0xc6, 0x60, 0xb9, 0xc6, 0xe0,
+	0x71, 0x8d, 0xc1, 0xed, 0x06, 0x0b, 0xcb, 0x0d, 0x16, 0x56, 0x1b, 0x2c, 0x5c, 0xfe, 0xf2, 0x02,
+	0xee, 0x5f, 0x0d, 0xb5, 0x11, 0x0b, 0xf5, 0xf9, 0x34, 0x88, 0x7e, 0x86, 0x34, 0xd1, 0x9f, 0xa3,
+	0x90, 0x18, 0xfa, 0xf5, 0x5e, 0x1e, 0xb6, 0xff, 0x31, 0x1f, 0x96, 0xd2, 0x30, 0xfc, 0x7e, 0x1a,
+	0x00, 0x9e, 0xd1, 0x2a, 0xc0, 0x81, 0x02, 0x00, 0x00,
+}
+
+func (m *MarketChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.After != nil {
+		{
+			size, err := m.After.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Before != nil {
+		{
+			size, err := m.Before.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Type != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintHistory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovHistory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MarketChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovHistory(uint64(m.Id))
+	}
+	if m.Height != 0 {
+		n += 1 + sovHistory(uint64(m.Height))
+	}
+	if m.Type != 0 {
+		n += 1 + sovHistory(uint64(m.Type))
+	}
+	if m.Before != nil {
+		l = m.Before.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.After != nil {
+		l = m.After.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+
+func sovHistory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozHistory(x uint64) (n int) {
+	return sovHistory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MarketChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= MarketChangeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Before", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Before == nil {
+				m.Before = &Market{}
+			}
+			if err := m.Before.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field After", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.After == nil {
+				m.After = &Market{}
+			}
+			if err := m.After.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipHistory(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthHistory
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupHistory
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthHistory
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthHistory        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowHistory          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupHistory = fmt.Errorf("proto: unexpected end of group")
+)
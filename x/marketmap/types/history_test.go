@@ -0,0 +1,85 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+func TestNewMarketChange(t *testing.T) {
+	market := types.Market{
+		Ticker: types.Ticker{
+			CurrencyPair:     connecttypes.NewCurrencyPair("BTC", "USD"),
+			Decimals:         8,
+			MinProviderCount: 1,
+		},
+		ProviderConfigs: []types.ProviderConfig{
+			{
+				Name:           "kucoin",
+				OffChainTicker: "BTC-USD",
+			},
+		},
+	}
+
+	enabled := market
+	enabled.Ticker.Enabled = true
+
+	updated := market
+	updated.Ticker.Decimals = 6
+
+	enabledAndUpdated := updated
+	enabledAndUpdated.Ticker.Enabled = true
+
+	tcs := []struct {
+		name     string
+		before   *types.Market
+		after    *types.Market
+		expected types.MarketChangeType
+	}{
+		{"create", nil, &market, types.MARKET_CHANGE_TYPE_CREATE},
+		{"remove", &market, nil, types.MARKET_CHANGE_TYPE_REMOVE},
+		{"enable", &market, &enabled, types.MARKET_CHANGE_TYPE_ENABLE},
+		{"disable", &enabled, &market, types.MARKET_CHANGE_TYPE_DISABLE},
+		{"update", &market, &updated, types.MARKET_CHANGE_TYPE_UPDATE},
+		{"enable with update", &market, &enabledAndUpdated, types.MARKET_CHANGE_TYPE_UPDATE},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			change := types.NewMarketChange(10, tc.before, tc.after)
+			require.Equal(t, tc.expected, change.Type)
+			require.Equal(t, uint64(10), change.Height)
+			require.Equal(t, market.Ticker.String(), change.TickerString())
+		})
+	}
+}
+
+func TestMarketChangeRevert(t *testing.T) {
+	market := types.Market{
+		Ticker: types.Ticker{
+			CurrencyPair:     connecttypes.NewCurrencyPair("BTC", "USD"),
+			Decimals:         8,
+			MinProviderCount: 1,
+		},
+	}
+
+	updated := market
+	updated.Ticker.Enabled = true
+
+	markets := map[string]types.Market{market.Ticker.String(): updated}
+
+	update := types.NewMarketChange(10, &market, &updated)
+	update.Revert(markets)
+	require.Equal(t, map[string]types.Market{market.Ticker.String(): market}, markets)
+
+	create := types.NewMarketChange(9, nil, &market)
+	create.Revert(markets)
+	require.Empty(t, markets)
+
+	remove := types.NewMarketChange(11, &market, nil)
+	remove.Revert(markets)
+	require.Equal(t, map[string]types.Market{market.Ticker.String(): market}, markets)
+}
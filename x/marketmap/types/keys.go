@@ -22,6 +22,36 @@ var (
 	// ParamsPrefix is the key prefix of the module Params.
 	ParamsPrefix = collections.NewPrefix(3)
 
+	// ScheduledMarketChangesPrefix is the key prefix for the scheduled market changes.
+	ScheduledMarketChangesPrefix = collections.NewPrefix(4)
+
+	// NextScheduledMarketChangeIDPrefix is the key prefix for the ID of the next scheduled market change.
+	NextScheduledMarketChangeIDPrefix = collections.NewPrefix(5)
+
+	// MarketAuthorityScopesPrefix is the key prefix for the scopes of the scoped market authorities.
+	MarketAuthorityScopesPrefix = collections.NewPrefix(6)
+
+	// MarketChangesPrefix is the key prefix for the changes recorded in the market history.
+	MarketChangesPrefix = collections.NewPrefix(7)
+
+	// MarketChangesByTickerPrefix is the key prefix for the index of the market history by ticker.
+	MarketChangesByTickerPrefix = collections.NewPrefix(8)
+
+	// NextMarketChangeIDPrefix is the key prefix for the ID of the next change recorded in the market history.
+	NextMarketChangeIDPrefix = collections.NewPrefix(9)
+
+	// MarketHistoryStartHeightPrefix is the key prefix for the earliest height covered by the market history.
+	MarketHistoryStartHeightPrefix = collections.NewPrefix(10)
+
+	// ProvidersPrefix is the key prefix for the providers of the provider registry.
+	ProvidersPrefix = collections.NewPrefix(11)
+
+	// ScheduledMarketChangesByHeightPrefix is the key prefix for the queue of scheduled market changes by target height.
+	ScheduledMarketChangesByHeightPrefix = collections.NewPrefix(12)
+
+	// ScheduledMarketChangesByTimePrefix is the key prefix for the queue of scheduled market changes by target time.
+	ScheduledMarketChangesByTimePrefix = collections.NewPrefix(13)
+
 	// TickersCodec is the collections.KeyCodec value used for the markets map.
 	TickersCodec = codec.NewStringKeyCodec[TickerString]()
 
@@ -62,6 +62,11 @@ func (mm *MarketMap) GetValidSubset() (MarketMap, error) {
 					continue
 				}
 			}
+
+			// drop provider configs whose metadata does not conform to the schema of their provider
+			if err := DefaultMetadataSchemaRegistry.ValidateProviderMetadata(providerConfig.Name, providerConfig.Metadata_JSON); err != nil {
+				continue
+			}
 			validProviderConfigs = append(validProviderConfigs, providerConfig)
 		}
 		market.ProviderConfigs = validProviderConfigs
@@ -127,6 +132,22 @@ func (m *Market) ValidateBasic() error {
 	return nil
 }
 
+// ValidateMetadata checks that the metadata of the Ticker and of each of the ProviderConfigs of the Market conform
+// to their schemas in the DefaultMetadataSchemaRegistry.
+func (m *Market) ValidateMetadata() error {
+	if err := DefaultMetadataSchemaRegistry.ValidateTickerMetadata(m.Ticker.Metadata_JSON); err != nil {
+		return fmt.Errorf("invalid metadata of ticker %s: %w", m.Ticker.String(), err)
+	}
+
+	for _, providerConfig := range m.ProviderConfigs {
+		if err := DefaultMetadataSchemaRegistry.ValidateProviderMetadata(providerConfig.Name, providerConfig.Metadata_JSON); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // String returns the string representation of the market.
 func (m *Market) String() string {
 	return fmt.Sprintf(
@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	types "github.com/skip-mev/connect/v2/pkg/types"
@@ -92,6 +94,19 @@ type Ticker struct {
 	// MinProviderCount is the minimum number of providers required to consider
 	// the ticker valid.
 	MinProviderCount uint64 `protobuf:"varint,3,opt,name=min_provider_count,json=minProviderCount,proto3" json:"min_provider_count,omitempty"`
+	// PowerThreshold is an optional per-market override of the voting power %
+	// that must submit a price for this ticker in order for the price to be
+	// included in the final oracle price. If unset, the application's global
+	// threshold is used.
+	PowerThreshold *cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=power_threshold,json=powerThreshold,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"power_threshold,omitempty"`
+	// MaxPriceAgeBlocks is an optional maximum number of blocks since the last
+	// price update after which the on-chain price for this ticker is considered
+	// stale. If zero, the price never becomes stale by block count.
+	MaxPriceAgeBlocks uint64 `protobuf:"varint,5,opt,name=max_price_age_blocks,json=maxPriceAgeBlocks,proto3" json:"max_price_age_blocks,omitempty"`
+	// MaxPriceAgeSeconds is an optional maximum number of seconds since the last
+	// price update after which the on-chain price for this ticker is considered
+	// stale. If zero, the price never becomes stale by elapsed time.
+	MaxPriceAgeSeconds uint64 `protobuf:"varint,6,opt,name=max_price_age_seconds,json=maxPriceAgeSeconds,proto3" json:"max_price_age_seconds,omitempty"`
 	// Enabled is the flag that denotes if the Ticker is enabled for price
 	// fetching by an oracle.
 	Enabled bool `protobuf:"varint,14,opt,name=enabled,proto3" json:"enabled,omitempty"`
@@ -153,6 +168,20 @@ func (m *Ticker) GetMinProviderCount() uint64 {
 	return 0
 }
 
+func (m *Ticker) GetMaxPriceAgeBlocks() uint64 {
+	if m != nil {
+		return m.MaxPriceAgeBlocks
+	}
+	return 0
+}
+
+func (m *Ticker) GetMaxPriceAgeSeconds() uint64 {
+	if m != nil {
+		return m.MaxPriceAgeSeconds
+	}
+	return 0
+}
+
 func (m *Ticker) GetEnabled() bool {
 	if m != nil {
 		return m.Enabled
@@ -313,42 +342,50 @@ func init() {
 func init() { proto.RegisterFile("connect/marketmap/v2/market.proto", fileDescriptor_54627e801f077fe4) }
 
 var fileDescriptor_54627e801f077fe4 = []byte{
-	// 549 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0x8e, 0xd7, 0xd2, 0xad, 0x5e, 0xd7, 0x16, 0x6b, 0x42, 0x51, 0x85, 0xb2, 0x52, 0x76, 0xc8,
-	0x61, 0x24, 0x28, 0x5c, 0x50, 0x8f, 0xad, 0x38, 0x50, 0x31, 0x98, 0x02, 0x48, 0x88, 0x4b, 0xe4,
-	0xa6, 0x6e, 0x67, 0xb5, 0xb1, 0x23, 0xc7, 0x8d, 0x28, 0x27, 0x7e, 0x02, 0x47, 0x8e, 0x5c, 0xf8,
-	0x19, 0xdc, 0x77, 0xdc, 0x0d, 0x0e, 0x08, 0xa1, 0x56, 0xe2, 0x77, 0xa0, 0x38, 0x4e, 0xd7, 0x4a,
-	0x45, 0xda, 0xed, 0xbd, 0xe7, 0xcf, 0xdf, 0xf3, 0xf7, 0xf9, 0x3d, 0xf8, 0x20, 0xe4, 0x8c, 0x91,
-	0x50, 0xba, 0x11, 0x16, 0x53, 0x22, 0x23, 0x1c, 0xbb, 0xa9, 0xa7, 0x13, 0x27, 0x16, 0x5c, 0x72,
-	0x74, 0xac, 0x21, 0xce, 0x1a, 0xe2, 0xa4, 0x5e, 0xeb, 0x78, 0xc2, 0x27, 0x5c, 0x01, 0xdc, 0x2c,
-	0xca, 0xb1, 0xad, 0xd3, 0x82, 0x4e, 0x2e, 0x62, 0x92, 0x64, 0x54, 0xe1, 0x5c, 0x08, 0xc2, 0xc2,
-	0x45, 0x10, 0x63, 0x2a, 0x72, 0x54, 0xe7, 0x1b, 0x80, 0x95, 0x73, 0x45, 0x86, 0xba, 0xb0, 0x22,
-	0x69, 0x38, 0x25, 0xc2, 0x04, 0x6d, 0x60, 0x1f, 0x7a, 0xf7, 0x9d, 0x5d, 0xdd, 0x9c, 0x37, 0x0a,
-	0xd3, 0x2b, 0x5f, 0xfd, 0x3e, 0x31, 0x7c, 0x7d, 0x03, 0xbd, 0x85, 0xcd, 0x58, 0xf0, 0x94, 0x8e,
-	0x88, 0x08, 0x42, 0xce, 0xc6, 0x74, 0x92, 0x98, 0x7b, 0xed, 0x92, 0x7d, 0xe8, 0x9d, 0xee, 0x66,
-	0xb9, 0xd0, 0xe8, 0xbe, 0x02, 0x6b, 0xb6, 0x46, 0xbc, 0x55, 0x4d, 0xba, 0x07, 0x5f, 0xbe, 0x9e,
-	0x18, 0x9f, 0x7e, 0xb5, 0x8d, 0xce, 0x5f, 0x00, 0x2b, 0x79, 0x67, 0xf4, 0x1c, 0x1e, 0x6d, 0x29,
-	0xd1, 0xcf, 0xb5, 0xd6, 0x8d, 0x94, 0xe0, 0xac, 0x49, 0x5f, 0xc3, 0x2e, 0x30, 0x2d, 0x1e, 0x5c,
-	0x0b, 0x37, 0x6a, 0xa8, 0x05, 0x0f, 0x46, 0x24, 0xa4, 0x11, 0x9e, 0x65, 0xcf, 0x05, 0x76, 0xd9,
-	0x5f, 0xe7, 0xe8, 0x0c, 0xa2, 0x88, 0xb2, 0x60, 0x43, 0xd6, 0x9c, 0x49, 0xb3, 0xa4, 0x50, 0xcd,
-	0x88, 0xb2, 0x1b, 0x05, 0x73, 0x26, 0x91, 0x09, 0xf7, 0x09, 0xc3, 0xc3, 0x19, 0x19, 0x99, 0xf5,
-	0x36, 0xb0, 0x0f, 0xfc, 0x22, 0x45, 0x0f, 0xe1, 0x51, 0x44, 0x24, 0x1e, 0x61, 0x89, 0x83, 0xc1,
-	0xeb, 0x57, 0x2f, 0xcd, 0x46, 0x1b, 0xd8, 0x55, 0xbf, 0x56, 0x14, 0xb3, 0xda, 0x86, 0xd0, 0x1f,
-	0x00, 0xd6, 0xb7, 0xcd, 0x41, 0x08, 0x96, 0x19, 0x8e, 0x88, 0xd2, 0x59, 0xf5, 0x55, 0x8c, 0x6c,
-	0xd8, 0xe4, 0xe3, 0x71, 0x10, 0x5e, 0x62, 0xca, 0x02, 0xfd, 0x6d, 0x7b, 0xea, 0xbc, 0xce, 0xc7,
-	0xe3, 0x7e, 0x56, 0xd6, 0x76, 0x0d, 0xe0, 0x5d, 0xc6, 0x45, 0x84, 0x67, 0xf4, 0x23, 0x09, 0x86,
-	0xda, 0xb2, 0xd2, 0x6d, 0x2c, 0xf3, 0x1b, 0xeb, 0x8b, 0xbd, 0xdc, 0xaf, 0x7b, 0xb0, 0x42, 0x59,
-	0x4a, 0x84, 0x34, 0xcb, 0x4a, 0xa4, 0xce, 0x6e, 0xa5, 0xb1, 0xf3, 0x1d, 0xc0, 0x6a, 0x3e, 0x6a,
-	0xe7, 0x38, 0x46, 0x2f, 0xe0, 0x7e, 0x3e, 0x10, 0x89, 0x09, 0xd4, 0xa0, 0x9c, 0xed, 0x1e, 0x94,
-	0xf5, 0x0d, 0x1d, 0x25, 0xcf, 0x98, 0x14, 0x0b, 0xfd, 0x9b, 0x05, 0x45, 0xeb, 0x1d, 0xac, 0x6d,
-	0x1e, 0xa3, 0x26, 0x2c, 0x4d, 0xc9, 0x42, 0x3b, 0x96, 0x85, 0xc8, 0x83, 0x77, 0x52, 0x3c, 0x9b,
-	0x13, 0xe5, 0xd2, 0x7f, 0x87, 0x3b, 0x27, 0xf1, 0x73, 0x68, 0x77, 0xef, 0x29, 0xb8, 0xf9, 0x99,
-	0xde, 0xe0, 0x6a, 0x69, 0x81, 0xeb, 0xa5, 0x05, 0xfe, 0x2c, 0x2d, 0xf0, 0x79, 0x65, 0x19, 0xd7,
-	0x2b, 0xcb, 0xf8, 0xb9, 0xb2, 0x8c, 0xf7, 0x8f, 0x27, 0x54, 0x5e, 0xce, 0x87, 0x4e, 0xc8, 0x23,
-	0x37, 0x99, 0xd2, 0xf8, 0x51, 0x44, 0x52, 0xb7, 0x58, 0xbf, 0xd4, 0x73, 0x3f, 0x6c, 0xac, 0xb4,
-	0x72, 0x7a, 0x58, 0x51, 0xdb, 0xf7, 0xe4, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x11, 0xa9, 0x16,
-	0x4f, 0xf4, 0x03, 0x00, 0x00,
+	// 676 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xbd, 0x4e, 0x1b, 0x4b,
+	0x14, 0xf6, 0xd8, 0xc6, 0x98, 0x01, 0x6c, 0x33, 0xe2, 0x5e, 0xed, 0xf5, 0xbd, 0xb2, 0x7d, 0x09,
+	0x85, 0x0b, 0xd8, 0x0d, 0x4e, 0x13, 0xd1, 0xc5, 0x90, 0x22, 0x08, 0x12, 0xb4, 0x10, 0x29, 0xa2,
+	0x59, 0x8d, 0xc7, 0x63, 0x7b, 0x64, 0xcf, 0xcc, 0x6a, 0x76, 0xec, 0xe0, 0x54, 0x79, 0x84, 0x94,
+	0x29, 0xd3, 0x24, 0x4f, 0x90, 0x36, 0x3d, 0x25, 0x4a, 0x93, 0x28, 0x8a, 0x50, 0x04, 0x2f, 0x12,
+	0xed, 0xec, 0xd8, 0xd8, 0x12, 0x8a, 0xe8, 0xce, 0x39, 0xdf, 0x77, 0xfe, 0xf6, 0x3b, 0x3b, 0xf0,
+	0x7f, 0x22, 0x85, 0xa0, 0x44, 0x7b, 0x1c, 0xab, 0x3e, 0xd5, 0x1c, 0x87, 0xde, 0xa8, 0x61, 0x1d,
+	0x37, 0x54, 0x52, 0x4b, 0xb4, 0x6e, 0x29, 0xee, 0x94, 0xe2, 0x8e, 0x1a, 0xe5, 0xf5, 0xae, 0xec,
+	0x4a, 0x43, 0xf0, 0x62, 0x2b, 0xe1, 0x96, 0xff, 0x21, 0x32, 0xe2, 0x32, 0x0a, 0x12, 0x20, 0x71,
+	0x2c, 0xb4, 0x39, 0xe9, 0xa4, 0xc7, 0x21, 0x8d, 0xe2, 0x2e, 0x64, 0xa8, 0x14, 0x15, 0x64, 0x1c,
+	0x84, 0x98, 0xa9, 0x84, 0xb5, 0xf1, 0x11, 0xc0, 0xdc, 0x91, 0xe9, 0x83, 0x76, 0x61, 0x4e, 0x33,
+	0xd2, 0xa7, 0xca, 0x01, 0x35, 0x50, 0x5f, 0x6e, 0xfc, 0xe7, 0xde, 0x35, 0x88, 0x7b, 0x6a, 0x38,
+	0xcd, 0xec, 0xc5, 0x55, 0x35, 0xe5, 0xdb, 0x0c, 0xf4, 0x12, 0x96, 0x42, 0x25, 0x47, 0xac, 0x4d,
+	0x55, 0x40, 0xa4, 0xe8, 0xb0, 0x6e, 0xe4, 0xa4, 0x6b, 0x99, 0xfa, 0x72, 0x63, 0xf3, 0xee, 0x2a,
+	0xc7, 0x96, 0xbd, 0x67, 0xc8, 0xb6, 0x5a, 0x31, 0x9c, 0x8b, 0x46, 0xbb, 0xf9, 0xf7, 0x1f, 0xaa,
+	0xa9, 0xb7, 0x3f, 0x6b, 0xa9, 0x8d, 0x4f, 0x19, 0x98, 0x4b, 0x3a, 0xa3, 0x67, 0x70, 0x75, 0x6e,
+	0x13, 0x3b, 0x6e, 0x65, 0xda, 0xc8, 0x2c, 0x1c, 0x37, 0xd9, 0xb3, 0xb4, 0x63, 0xcc, 0x26, 0x03,
+	0xaf, 0x90, 0x99, 0x18, 0x2a, 0xc3, 0x7c, 0x9b, 0x12, 0xc6, 0xf1, 0x20, 0x1e, 0x17, 0xd4, 0xb3,
+	0xfe, 0xd4, 0x47, 0x5b, 0x10, 0x71, 0x26, 0x82, 0x99, 0xb5, 0x86, 0x42, 0x3b, 0x19, 0xc3, 0x2a,
+	0x71, 0x26, 0x6e, 0x37, 0x18, 0x0a, 0x8d, 0xce, 0x60, 0x31, 0x94, 0xaf, 0xa9, 0x0a, 0x74, 0x4f,
+	0xd1, 0xa8, 0x27, 0x07, 0x6d, 0x27, 0x5b, 0x03, 0xf5, 0xa5, 0xe6, 0xce, 0xc5, 0x55, 0x15, 0xfc,
+	0xb8, 0xaa, 0xfe, 0x9b, 0x88, 0x13, 0xb5, 0xfb, 0x2e, 0x93, 0x1e, 0xc7, 0xba, 0xe7, 0x1e, 0xd2,
+	0x2e, 0x26, 0xe3, 0x7d, 0x4a, 0xbe, 0x7e, 0xde, 0x86, 0x56, 0xbb, 0x7d, 0x4a, 0xfc, 0x82, 0xa9,
+	0x74, 0x3a, 0x29, 0x84, 0x3c, 0xb8, 0xce, 0xf1, 0x79, 0x10, 0x2a, 0x46, 0x68, 0x80, 0xbb, 0x34,
+	0x68, 0x0d, 0x24, 0xe9, 0x47, 0xce, 0x82, 0x99, 0x65, 0x8d, 0xe3, 0xf3, 0xe3, 0x18, 0x7a, 0xd2,
+	0xa5, 0x4d, 0x03, 0xa0, 0x1d, 0xf8, 0xd7, 0x7c, 0x42, 0x44, 0x89, 0x14, 0xed, 0xc8, 0xc9, 0x99,
+	0x0c, 0x34, 0x93, 0x71, 0x92, 0x20, 0xc8, 0x81, 0x8b, 0x54, 0xe0, 0xd6, 0x80, 0xb6, 0x9d, 0x42,
+	0x0d, 0xd4, 0xf3, 0xfe, 0xc4, 0x45, 0x0f, 0xe0, 0x2a, 0xa7, 0x1a, 0xb7, 0xb1, 0xc6, 0xc1, 0xc1,
+	0xc9, 0x8b, 0xe7, 0x4e, 0x31, 0xde, 0xcb, 0x5f, 0x99, 0x04, 0xe3, 0xd8, 0x8c, 0x50, 0xdf, 0x00,
+	0x2c, 0xcc, 0x8b, 0x8b, 0x10, 0xcc, 0x0a, 0xcc, 0xa9, 0xd1, 0x69, 0xc9, 0x37, 0x36, 0xaa, 0xc3,
+	0x92, 0xec, 0x74, 0x02, 0xd2, 0xc3, 0x4c, 0x04, 0xf6, 0xec, 0xd2, 0x06, 0x2f, 0xc8, 0x4e, 0x67,
+	0x2f, 0x0e, 0x5b, 0xb9, 0x0f, 0xe0, 0x9a, 0x90, 0x8a, 0xe3, 0x01, 0x7b, 0x43, 0x83, 0x96, 0x95,
+	0x3c, 0x73, 0x1f, 0xc9, 0xfd, 0xe2, 0x34, 0xb1, 0x99, 0xe8, 0xfd, 0x37, 0xcc, 0x31, 0x31, 0xa2,
+	0x4a, 0x1b, 0x71, 0xf2, 0xbe, 0xf5, 0xee, 0xb5, 0xe3, 0xc6, 0x17, 0x00, 0x97, 0x92, 0x5f, 0xe5,
+	0x08, 0x87, 0xe8, 0x10, 0x2e, 0x26, 0x07, 0x1d, 0x39, 0xc0, 0x1c, 0xfa, 0xd6, 0xdd, 0x87, 0x3e,
+	0xcd, 0xb0, 0x56, 0xf4, 0x54, 0x68, 0x35, 0xb6, 0xd7, 0x38, 0x29, 0x51, 0x7e, 0x05, 0x57, 0x66,
+	0x61, 0x54, 0x82, 0x99, 0x3e, 0x1d, 0xdb, 0x2f, 0x16, 0x9b, 0xa8, 0x01, 0x17, 0x46, 0x78, 0x30,
+	0xa4, 0x4e, 0xfa, 0x4f, 0x3f, 0x67, 0x52, 0xc4, 0x4f, 0xa8, 0xbb, 0xe9, 0xc7, 0xe0, 0x56, 0x99,
+	0xe6, 0xc1, 0xc5, 0x75, 0x05, 0x5c, 0x5e, 0x57, 0xc0, 0xaf, 0xeb, 0x0a, 0x78, 0x77, 0x53, 0x49,
+	0x5d, 0xde, 0x54, 0x52, 0xdf, 0x6f, 0x2a, 0xa9, 0xb3, 0x87, 0x5d, 0xa6, 0x7b, 0xc3, 0x96, 0x4b,
+	0x24, 0xf7, 0xa2, 0x3e, 0x0b, 0xb7, 0x39, 0x1d, 0x79, 0x93, 0xe7, 0x63, 0xd4, 0xf0, 0xce, 0x67,
+	0x5e, 0x2b, 0xf3, 0xa5, 0x5b, 0x39, 0xf3, 0x7a, 0x3c, 0xfa, 0x3d, 0x00, 0x88, 0xb5, 0x60, 0xf3,
+	0xcf, 0x04, 0x00, 0x00,
 }
 
 func (m *Market) Marshal() (dAtA []byte, err error) {
@@ -435,6 +472,28 @@ func (m *Ticker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x70
 	}
+	if m.MaxPriceAgeSeconds != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.MaxPriceAgeSeconds))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxPriceAgeBlocks != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.MaxPriceAgeBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PowerThreshold != nil {
+		{
+			size := m.PowerThreshold.Size()
+			i -= size
+			if _, err := m.PowerThreshold.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintMarket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.MinProviderCount != 0 {
 		i = encodeVarintMarket(dAtA, i, uint64(m.MinProviderCount))
 		i--
@@ -613,6 +672,16 @@ func (m *Ticker) Size() (n int) {
 	if m.MinProviderCount != 0 {
 		n += 1 + sovMarket(uint64(m.MinProviderCount))
 	}
+	if m.PowerThreshold != nil {
+		l = m.PowerThreshold.Size()
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	if m.MaxPriceAgeBlocks != 0 {
+		n += 1 + sovMarket(uint64(m.MaxPriceAgeBlocks))
+	}
+	if m.MaxPriceAgeSeconds != 0 {
+		n += 1 + sovMarket(uint64(m.MaxPriceAgeSeconds))
+	}
 	if m.Enabled {
 		n += 2
 	}
@@ -892,6 +961,80 @@ func (m *Ticker) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.PowerThreshold = &v
+			if err := m.PowerThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceAgeBlocks", wireType)
+			}
+			m.MaxPriceAgeBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPriceAgeBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceAgeSeconds", wireType)
+			}
+			m.MaxPriceAgeSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPriceAgeSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 14:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
@@ -0,0 +1,199 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/skip-mev/connect/v2/x/marketmap/types/providermetadata"
+	"github.com/skip-mev/connect/v2/x/marketmap/types/tickermetadata"
+)
+
+const (
+	// CoreTickerMetadataSchema is the name of the ticker metadata schema of tickermetadata.CoreMetadata.
+	CoreTickerMetadataSchema = "core"
+
+	// DyDxTickerMetadataSchema is the name of the ticker metadata schema of tickermetadata.DyDx.
+	DyDxTickerMetadataSchema = "dydx"
+)
+
+// DefaultMetadataSchemaRegistry is the MetadataSchemaRegistry used to validate the Metadata_JSON of Tickers and
+// ProviderConfigs in ValidateBasic. It is initialized with the schemas of the known ticker metadata types and of the
+// metadata of the built-in providers, so that the validation does not depend on the packages linked into a binary.
+var DefaultMetadataSchemaRegistry = NewMetadataSchemaRegistry()
+
+func init() {
+	RegisterTickerMetadataSchema(CoreTickerMetadataSchema, NewMetadataSchema(tickermetadata.CoreMetadata.ValidateBasic))
+	RegisterTickerMetadataSchema(DyDxTickerMetadataSchema, NewMetadataSchema(tickermetadata.DyDx.ValidateBasic))
+
+	RegisterProviderMetadataSchema(providermetadata.OsmosisProvider, NewMetadataSchema(providermetadata.Osmosis.ValidateBasic))
+	RegisterProviderMetadataSchema(providermetadata.RaydiumProvider, NewMetadataSchema(providermetadata.Raydium.ValidateBasic))
+	for _, provider := range []string{providermetadata.UniswapV3EthereumProvider, providermetadata.UniswapV3BaseProvider} {
+		RegisterProviderMetadataSchema(provider, NewMetadataSchema(func(m providermetadata.UniswapV3) error {
+			return m.ValidateBasic()
+		}))
+	}
+}
+
+// MetadataSchema validates a Metadata_JSON string against the Go type it is expected to encode.
+type MetadataSchema interface {
+	// Validate returns an error if the given metadata does not conform to the schema.
+	Validate(metadataJSON string) error
+}
+
+// metadataSchema is the MetadataSchema of a Go type T, validated by an optional validator.
+type metadataSchema[T any] struct {
+	validate func(T) error
+}
+
+// NewMetadataSchema returns a MetadataSchema which requires the metadata to be decodable into T without unknown fields
+// and, if the given validator is non-nil, the decoded T to pass it.
+func NewMetadataSchema[T any](validate func(T) error) MetadataSchema {
+	return metadataSchema[T]{
+		validate: validate,
+	}
+}
+
+// Validate decodes the given metadata into T, rejecting unknown fields and trailing data, and validates it.
+func (s metadataSchema[T]) Validate(metadataJSON string) error {
+	dec := json.NewDecoder(strings.NewReader(metadataJSON))
+	dec.DisallowUnknownFields()
+
+	var elem T
+	if err := dec.Decode(&elem); err != nil {
+		return err
+	}
+
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return fmt.Errorf("unexpected data after metadata")
+	}
+
+	if s.validate == nil {
+		return nil
+	}
+
+	return s.validate(elem)
+}
+
+// MetadataSchemaRegistry contains the MetadataSchemas of the known ticker metadata types, by name, and of the
+// provider metadata, by provider name. It is safe for concurrent use.
+type MetadataSchemaRegistry struct {
+	mtx sync.RWMutex
+
+	// tickerSchemas is a map from ticker metadata schema name to schema.
+	tickerSchemas map[string]MetadataSchema
+
+	// providerSchemas is a map from provider name to schema.
+	providerSchemas map[string]MetadataSchema
+}
+
+// NewMetadataSchemaRegistry returns a new, empty MetadataSchemaRegistry.
+func NewMetadataSchemaRegistry() *MetadataSchemaRegistry {
+	return &MetadataSchemaRegistry{
+		tickerSchemas:   make(map[string]MetadataSchema),
+		providerSchemas: make(map[string]MetadataSchema),
+	}
+}
+
+// RegisterTickerMetadataSchema registers the schema of a ticker metadata type under the given name. It panics if a
+// schema is already registered under the name.
+func (r *MetadataSchemaRegistry) RegisterTickerMetadataSchema(name string, schema MetadataSchema) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if _, ok := r.tickerSchemas[name]; ok {
+		panic(fmt.Sprintf("ticker metadata schema %s is already registered", name))
+	}
+
+	r.tickerSchemas[name] = schema
+}
+
+// RegisterProviderMetadataSchema registers the schema of the metadata of the given provider. It panics if a schema is
+// already registered for the provider.
+func (r *MetadataSchemaRegistry) RegisterProviderMetadataSchema(provider string, schema MetadataSchema) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if _, ok := r.providerSchemas[provider]; ok {
+		panic(fmt.Sprintf("metadata schema of provider %s is already registered", provider))
+	}
+
+	r.providerSchemas[provider] = schema
+}
+
+// ProviderMetadataSchema returns the schema of the metadata of the given provider, and false if none is registered.
+func (r *MetadataSchemaRegistry) ProviderMetadataSchema(provider string) (MetadataSchema, bool) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	schema, ok := r.providerSchemas[provider]
+	return schema, ok
+}
+
+// ValidateTickerMetadata checks that the given ticker metadata conforms to at least one of the registered ticker
+// metadata schemas. Empty metadata, and any metadata if no ticker metadata schema is registered, is valid.
+func (r *MetadataSchemaRegistry) ValidateTickerMetadata(metadataJSON string) error {
+	if len(metadataJSON) == 0 {
+		return nil
+	}
+
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	if len(r.tickerSchemas) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(r.tickerSchemas))
+	for name := range r.tickerSchemas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	errs := make([]error, 0, len(names))
+	for _, name := range names {
+		err := r.tickerSchemas[name].Validate(metadataJSON)
+		if err == nil {
+			return nil
+		}
+
+		errs = append(errs, fmt.Errorf("%s: %w", name, err))
+	}
+
+	return fmt.Errorf("ticker metadata does not conform to any ticker metadata schema: %w", errors.Join(errs...))
+}
+
+// ValidateProviderMetadata checks that the given metadata conforms to the schema of the given provider. Empty
+// metadata, and any metadata of a provider without a registered schema, is valid.
+func (r *MetadataSchemaRegistry) ValidateProviderMetadata(provider, metadataJSON string) error {
+	if len(metadataJSON) == 0 {
+		return nil
+	}
+
+	schema, ok := r.ProviderMetadataSchema(provider)
+	if !ok {
+		return nil
+	}
+
+	if err := schema.Validate(metadataJSON); err != nil {
+		return fmt.Errorf("metadata of provider %s does not conform to its schema: %w", provider, err)
+	}
+
+	return nil
+}
+
+// RegisterTickerMetadataSchema registers the schema of a ticker metadata type under the given name in the
+// DefaultMetadataSchemaRegistry. It panics if a schema is already registered under the name.
+func RegisterTickerMetadataSchema(name string, schema MetadataSchema) {
+	DefaultMetadataSchemaRegistry.RegisterTickerMetadataSchema(name, schema)
+}
+
+// RegisterProviderMetadataSchema registers the schema of the metadata of the given provider in the
+// DefaultMetadataSchemaRegistry. It panics if a schema is already registered for the provider.
+func RegisterProviderMetadataSchema(provider string, schema MetadataSchema) {
+	DefaultMetadataSchemaRegistry.RegisterProviderMetadataSchema(provider, schema)
+}
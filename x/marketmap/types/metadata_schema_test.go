@@ -0,0 +1,211 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+	"github.com/skip-mev/connect/v2/x/marketmap/types/providermetadata"
+)
+
+// schemaTestProvider is the name of the provider whose metadata schema is registered in the
+// DefaultMetadataSchemaRegistry by these tests.
+const schemaTestProvider = "schema_test_provider"
+
+type schemaTestMetadata struct {
+	Pool string `json:"pool"`
+}
+
+func init() {
+	types.RegisterProviderMetadataSchema(schemaTestProvider, types.NewMetadataSchema(func(m schemaTestMetadata) error {
+		if len(m.Pool) == 0 {
+			return fmt.Errorf("pool must not be empty")
+		}
+
+		return nil
+	}))
+}
+
+func TestMetadataSchemaRegistry(t *testing.T) {
+	t.Run("metadata of providers without a schema is not validated", func(t *testing.T) {
+		r := types.NewMetadataSchemaRegistry()
+
+		_, ok := r.ProviderMetadataSchema("binance_api")
+		require.False(t, ok)
+		require.NoError(t, r.ValidateProviderMetadata("binance_api", `{"pool":1}`))
+	})
+
+	t.Run("metadata of providers with a schema is validated", func(t *testing.T) {
+		r := types.NewMetadataSchemaRegistry()
+		r.RegisterProviderMetadataSchema("test_api", types.NewMetadataSchema(func(m schemaTestMetadata) error {
+			if len(m.Pool) == 0 {
+				return fmt.Errorf("pool must not be empty")
+			}
+
+			return nil
+		}))
+
+		require.NoError(t, r.ValidateProviderMetadata("test_api", `{"pool":"0x"}`))
+		require.NoError(t, r.ValidateProviderMetadata("test_api", ""))
+		require.Error(t, r.ValidateProviderMetadata("test_api", `{"pool":""}`))
+		require.Error(t, r.ValidateProviderMetadata("test_api", `{"pool":1}`))
+		require.Error(t, r.ValidateProviderMetadata("test_api", `[]`))
+	})
+
+	t.Run("schemas without a validator only check that the metadata can be decoded", func(t *testing.T) {
+		r := types.NewMetadataSchemaRegistry()
+		r.RegisterProviderMetadataSchema("test_api", types.NewMetadataSchema[schemaTestMetadata](nil))
+
+		require.NoError(t, r.ValidateProviderMetadata("test_api", `{"pool":""}`))
+		require.Error(t, r.ValidateProviderMetadata("test_api", `{"pool":1}`))
+	})
+
+	t.Run("ticker metadata must conform to any of the ticker schemas", func(t *testing.T) {
+		r := types.NewMetadataSchemaRegistry()
+		require.NoError(t, r.ValidateTickerMetadata(`{"pool":1}`))
+
+		r.RegisterTickerMetadataSchema("a", types.NewMetadataSchema[schemaTestMetadata](nil))
+		r.RegisterTickerMetadataSchema("b", types.NewMetadataSchema[map[string]int](nil))
+
+		require.NoError(t, r.ValidateTickerMetadata(""))
+		require.NoError(t, r.ValidateTickerMetadata(`{"pool":"0x"}`))
+		require.NoError(t, r.ValidateTickerMetadata(`{"pool":1}`))
+		require.Error(t, r.ValidateTickerMetadata(`{"pool":true}`))
+	})
+
+	t.Run("metadata with unknown fields or trailing data does not conform to the schema", func(t *testing.T) {
+		r := types.NewMetadataSchemaRegistry()
+		r.RegisterProviderMetadataSchema("test_api", types.NewMetadataSchema[schemaTestMetadata](nil))
+
+		require.NoError(t, r.ValidateProviderMetadata("test_api", ` {"pool":"0x"} `))
+		require.Error(t, r.ValidateProviderMetadata("test_api", `{"pool":"0x","pol":"0x"}`))
+		require.Error(t, r.ValidateProviderMetadata("test_api", `{"pool":"0x"}{}`))
+	})
+
+	t.Run("schemas cannot be registered twice", func(t *testing.T) {
+		r := types.NewMetadataSchemaRegistry()
+		r.RegisterTickerMetadataSchema("a", types.NewMetadataSchema[schemaTestMetadata](nil))
+		r.RegisterProviderMetadataSchema("test_api", types.NewMetadataSchema[schemaTestMetadata](nil))
+
+		require.Panics(t, func() {
+			r.RegisterTickerMetadataSchema("a", types.NewMetadataSchema[schemaTestMetadata](nil))
+		})
+		require.Panics(t, func() {
+			r.RegisterProviderMetadataSchema("test_api", types.NewMetadataSchema[schemaTestMetadata](nil))
+		})
+	})
+
+	t.Run("the default registry validates the known ticker metadata types", func(t *testing.T) {
+		require.NoError(t, types.DefaultMetadataSchemaRegistry.ValidateTickerMetadata(
+			`{"aggregate_ids":[{"venue":"coingecko","ID":"bitcoin"}]}`,
+		))
+		require.NoError(t, types.DefaultMetadataSchemaRegistry.ValidateTickerMetadata(
+			`{"reference_price":100,"liquidity":10,"aggregate_ids":[]}`,
+		))
+		require.Error(t, types.DefaultMetadataSchemaRegistry.ValidateTickerMetadata(
+			`{"aggregate_ids":[{"venue":"coingecko"}]}`,
+		))
+		require.Error(t, types.DefaultMetadataSchemaRegistry.ValidateTickerMetadata(`{"aggregate_ids":"bitcoin"}`))
+		require.NoError(t, types.DefaultMetadataSchemaRegistry.ValidateTickerMetadata(`{"tags":["defi"]}`))
+		require.Error(t, types.DefaultMetadataSchemaRegistry.ValidateTickerMetadata(`{"aggregate_ids":[],"tag":["defi"]}`))
+	})
+
+	t.Run("the default registry validates the metadata of the built-in providers", func(t *testing.T) {
+		for _, provider := range []string{
+			providermetadata.OsmosisProvider,
+			providermetadata.RaydiumProvider,
+			providermetadata.UniswapV3EthereumProvider,
+			providermetadata.UniswapV3BaseProvider,
+		} {
+			_, ok := types.DefaultMetadataSchemaRegistry.ProviderMetadataSchema(provider)
+			require.True(t, ok, provider)
+		}
+
+		require.NoError(t, types.DefaultMetadataSchemaRegistry.ValidateProviderMetadata(
+			providermetadata.UniswapV3EthereumProvider,
+			`{"address":"0x88e6A0c2dDD26FEEb64F039a2c41296FcB3f5640","base_decimals":18,"quote_decimals":6,"invert":true}`,
+		))
+		require.Error(t, types.DefaultMetadataSchemaRegistry.ValidateProviderMetadata(
+			providermetadata.UniswapV3BaseProvider,
+			`{"address":"0x88e6A0c2dDD26FEEb64F039a2c41296FcB3f5640","base_decimals":18,"quote_decimal":6}`,
+		))
+		require.Error(t, types.DefaultMetadataSchemaRegistry.ValidateProviderMetadata(providermetadata.UniswapV3BaseProvider, "null"))
+		require.Error(t, types.DefaultMetadataSchemaRegistry.ValidateProviderMetadata(
+			providermetadata.OsmosisProvider,
+			`{"pool_id":1,"base_token_denom":"uosmo"}`,
+		))
+	})
+}
+
+func TestMarketValidateMetadata(t *testing.T) {
+	market := types.Market{
+		Ticker: types.Ticker{
+			CurrencyPair:     btcusdt.Ticker.CurrencyPair,
+			Decimals:         8,
+			MinProviderCount: 1,
+			Metadata_JSON:    `{"aggregate_ids":[{"venue":"coingecko","ID":"bitcoin"}]}`,
+		},
+		ProviderConfigs: []types.ProviderConfig{
+			{
+				Name:           schemaTestProvider,
+				OffChainTicker: "BTC-USDT",
+				Metadata_JSON:  `{"pool":"0x"}`,
+			},
+		},
+	}
+
+	t.Run("valid metadata", func(t *testing.T) {
+		require.NoError(t, market.ValidateMetadata())
+		require.NoError(t, market.ValidateBasic())
+	})
+
+	t.Run("invalid ticker metadata", func(t *testing.T) {
+		invalid := market
+		invalid.Ticker.Metadata_JSON = `{"aggregate_ids":[{"ID":"bitcoin"}]}`
+
+		require.Error(t, invalid.ValidateMetadata())
+		require.Error(t, invalid.ValidateBasic())
+	})
+
+	t.Run("invalid provider metadata", func(t *testing.T) {
+		invalid := market
+		invalid.ProviderConfigs = []types.ProviderConfig{
+			{
+				Name:           schemaTestProvider,
+				OffChainTicker: "BTC-USDT",
+				Metadata_JSON:  `{"pool":""}`,
+			},
+		}
+
+		require.Error(t, invalid.ValidateMetadata())
+		require.Error(t, invalid.ValidateBasic())
+	})
+
+	t.Run("provider configs with invalid metadata are dropped from the valid subset", func(t *testing.T) {
+		withInvalid := market
+		withInvalid.ProviderConfigs = append([]types.ProviderConfig{
+			{
+				Name:           schemaTestProvider,
+				OffChainTicker: "BTC-USD",
+				Metadata_JSON:  `{"pool":""}`,
+			},
+		}, market.ProviderConfigs...)
+
+		mm := types.MarketMap{
+			Markets: map[string]types.Market{
+				market.Ticker.String(): withInvalid,
+			},
+		}
+		require.Error(t, mm.ValidateBasic())
+
+		validSubset, err := mm.GetValidSubset()
+		require.NoError(t, err)
+		require.Equal(t, types.MarketMap{
+			Markets: map[string]types.Market{
+				market.Ticker.String(): market,
+			},
+		}, validSubset)
+	})
+}
@@ -173,6 +173,154 @@ func (_c *QueryClient_Market_Call) RunAndReturn(run func(context.Context, *types
 	return _c
 }
 
+// MarketAuthorityScopes provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) MarketAuthorityScopes(ctx context.Context, in *types.MarketAuthorityScopesRequest, opts ...grpc.CallOption) (*types.MarketAuthorityScopesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarketAuthorityScopes")
+	}
+
+	var r0 *types.MarketAuthorityScopesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.MarketAuthorityScopesRequest, ...grpc.CallOption) (*types.MarketAuthorityScopesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.MarketAuthorityScopesRequest, ...grpc.CallOption) *types.MarketAuthorityScopesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.MarketAuthorityScopesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.MarketAuthorityScopesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// QueryClient_MarketAuthorityScopes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarketAuthorityScopes'
+type QueryClient_MarketAuthorityScopes_Call struct {
+	*mock.Call
+}
+
+// MarketAuthorityScopes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.MarketAuthorityScopesRequest
+//   - opts ...grpc.CallOption
+func (_e *QueryClient_Expecter) MarketAuthorityScopes(ctx interface{}, in interface{}, opts ...interface{}) *QueryClient_MarketAuthorityScopes_Call {
+	return &QueryClient_MarketAuthorityScopes_Call{Call: _e.mock.On("MarketAuthorityScopes",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *QueryClient_MarketAuthorityScopes_Call) Run(run func(ctx context.Context, in *types.MarketAuthorityScopesRequest, opts ...grpc.CallOption)) *QueryClient_MarketAuthorityScopes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.MarketAuthorityScopesRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *QueryClient_MarketAuthorityScopes_Call) Return(_a0 *types.MarketAuthorityScopesResponse, _a1 error) *QueryClient_MarketAuthorityScopes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *QueryClient_MarketAuthorityScopes_Call) RunAndReturn(run func(context.Context, *types.MarketAuthorityScopesRequest, ...grpc.CallOption) (*types.MarketAuthorityScopesResponse, error)) *QueryClient_MarketAuthorityScopes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarketHistory provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) MarketHistory(ctx context.Context, in *types.MarketHistoryRequest, opts ...grpc.CallOption) (*types.MarketHistoryResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarketHistory")
+	}
+
+	var r0 *types.MarketHistoryResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.MarketHistoryRequest, ...grpc.CallOption) (*types.MarketHistoryResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.MarketHistoryRequest, ...grpc.CallOption) *types.MarketHistoryResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.MarketHistoryResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.MarketHistoryRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// QueryClient_MarketHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarketHistory'
+type QueryClient_MarketHistory_Call struct {
+	*mock.Call
+}
+
+// MarketHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.MarketHistoryRequest
+//   - opts ...grpc.CallOption
+func (_e *QueryClient_Expecter) MarketHistory(ctx interface{}, in interface{}, opts ...interface{}) *QueryClient_MarketHistory_Call {
+	return &QueryClient_MarketHistory_Call{Call: _e.mock.On("MarketHistory",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *QueryClient_MarketHistory_Call) Run(run func(ctx context.Context, in *types.MarketHistoryRequest, opts ...grpc.CallOption)) *QueryClient_MarketHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.MarketHistoryRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *QueryClient_MarketHistory_Call) Return(_a0 *types.MarketHistoryResponse, _a1 error) *QueryClient_MarketHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *QueryClient_MarketHistory_Call) RunAndReturn(run func(context.Context, *types.MarketHistoryRequest, ...grpc.CallOption) (*types.MarketHistoryResponse, error)) *QueryClient_MarketHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // MarketMap provides a mock function with given fields: ctx, in, opts
 func (_m *QueryClient) MarketMap(ctx context.Context, in *types.MarketMapRequest, opts ...grpc.CallOption) (*types.MarketMapResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -247,6 +395,80 @@ func (_c *QueryClient_MarketMap_Call) RunAndReturn(run func(context.Context, *ty
 	return _c
 }
 
+// MarketMapAtHeight provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) MarketMapAtHeight(ctx context.Context, in *types.MarketMapAtHeightRequest, opts ...grpc.CallOption) (*types.MarketMapAtHeightResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarketMapAtHeight")
+	}
+
+	var r0 *types.MarketMapAtHeightResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.MarketMapAtHeightRequest, ...grpc.CallOption) (*types.MarketMapAtHeightResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.MarketMapAtHeightRequest, ...grpc.CallOption) *types.MarketMapAtHeightResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.MarketMapAtHeightResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.MarketMapAtHeightRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// QueryClient_MarketMapAtHeight_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarketMapAtHeight'
+type QueryClient_MarketMapAtHeight_Call struct {
+	*mock.Call
+}
+
+// MarketMapAtHeight is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.MarketMapAtHeightRequest
+//   - opts ...grpc.CallOption
+func (_e *QueryClient_Expecter) MarketMapAtHeight(ctx interface{}, in interface{}, opts ...interface{}) *QueryClient_MarketMapAtHeight_Call {
+	return &QueryClient_MarketMapAtHeight_Call{Call: _e.mock.On("MarketMapAtHeight",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *QueryClient_MarketMapAtHeight_Call) Run(run func(ctx context.Context, in *types.MarketMapAtHeightRequest, opts ...grpc.CallOption)) *QueryClient_MarketMapAtHeight_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.MarketMapAtHeightRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *QueryClient_MarketMapAtHeight_Call) Return(_a0 *types.MarketMapAtHeightResponse, _a1 error) *QueryClient_MarketMapAtHeight_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *QueryClient_MarketMapAtHeight_Call) RunAndReturn(run func(context.Context, *types.MarketMapAtHeightRequest, ...grpc.CallOption) (*types.MarketMapAtHeightResponse, error)) *QueryClient_MarketMapAtHeight_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Markets provides a mock function with given fields: ctx, in, opts
 func (_m *QueryClient) Markets(ctx context.Context, in *types.MarketsRequest, opts ...grpc.CallOption) (*types.MarketsResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -321,6 +543,80 @@ func (_c *QueryClient_Markets_Call) RunAndReturn(run func(context.Context, *type
 	return _c
 }
 
+// MarketsSince provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) MarketsSince(ctx context.Context, in *types.MarketsSinceRequest, opts ...grpc.CallOption) (*types.MarketsSinceResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarketsSince")
+	}
+
+	var r0 *types.MarketsSinceResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.MarketsSinceRequest, ...grpc.CallOption) (*types.MarketsSinceResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.MarketsSinceRequest, ...grpc.CallOption) *types.MarketsSinceResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.MarketsSinceResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.MarketsSinceRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// QueryClient_MarketsSince_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarketsSince'
+type QueryClient_MarketsSince_Call struct {
+	*mock.Call
+}
+
+// MarketsSince is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.MarketsSinceRequest
+//   - opts ...grpc.CallOption
+func (_e *QueryClient_Expecter) MarketsSince(ctx interface{}, in interface{}, opts ...interface{}) *QueryClient_MarketsSince_Call {
+	return &QueryClient_MarketsSince_Call{Call: _e.mock.On("MarketsSince",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *QueryClient_MarketsSince_Call) Run(run func(ctx context.Context, in *types.MarketsSinceRequest, opts ...grpc.CallOption)) *QueryClient_MarketsSince_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.MarketsSinceRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *QueryClient_MarketsSince_Call) Return(_a0 *types.MarketsSinceResponse, _a1 error) *QueryClient_MarketsSince_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *QueryClient_MarketsSince_Call) RunAndReturn(run func(context.Context, *types.MarketsSinceRequest, ...grpc.CallOption) (*types.MarketsSinceResponse, error)) *QueryClient_MarketsSince_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Params provides a mock function with given fields: ctx, in, opts
 func (_m *QueryClient) Params(ctx context.Context, in *types.ParamsRequest, opts ...grpc.CallOption) (*types.ParamsResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -395,6 +691,154 @@ func (_c *QueryClient_Params_Call) RunAndReturn(run func(context.Context, *types
 	return _c
 }
 
+// Providers provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) Providers(ctx context.Context, in *types.ProvidersRequest, opts ...grpc.CallOption) (*types.ProvidersResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Providers")
+	}
+
+	var r0 *types.ProvidersResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.ProvidersRequest, ...grpc.CallOption) (*types.ProvidersResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.ProvidersRequest, ...grpc.CallOption) *types.ProvidersResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ProvidersResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.ProvidersRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// QueryClient_Providers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Providers'
+type QueryClient_Providers_Call struct {
+	*mock.Call
+}
+
+// Providers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.ProvidersRequest
+//   - opts ...grpc.CallOption
+func (_e *QueryClient_Expecter) Providers(ctx interface{}, in interface{}, opts ...interface{}) *QueryClient_Providers_Call {
+	return &QueryClient_Providers_Call{Call: _e.mock.On("Providers",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *QueryClient_Providers_Call) Run(run func(ctx context.Context, in *types.ProvidersRequest, opts ...grpc.CallOption)) *QueryClient_Providers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.ProvidersRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *QueryClient_Providers_Call) Return(_a0 *types.ProvidersResponse, _a1 error) *QueryClient_Providers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *QueryClient_Providers_Call) RunAndReturn(run func(context.Context, *types.ProvidersRequest, ...grpc.CallOption) (*types.ProvidersResponse, error)) *QueryClient_Providers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ScheduledMarketChanges provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) ScheduledMarketChanges(ctx context.Context, in *types.ScheduledMarketChangesRequest, opts ...grpc.CallOption) (*types.ScheduledMarketChangesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScheduledMarketChanges")
+	}
+
+	var r0 *types.ScheduledMarketChangesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.ScheduledMarketChangesRequest, ...grpc.CallOption) (*types.ScheduledMarketChangesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.ScheduledMarketChangesRequest, ...grpc.CallOption) *types.ScheduledMarketChangesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ScheduledMarketChangesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.ScheduledMarketChangesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// QueryClient_ScheduledMarketChanges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ScheduledMarketChanges'
+type QueryClient_ScheduledMarketChanges_Call struct {
+	*mock.Call
+}
+
+// ScheduledMarketChanges is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.ScheduledMarketChangesRequest
+//   - opts ...grpc.CallOption
+func (_e *QueryClient_Expecter) ScheduledMarketChanges(ctx interface{}, in interface{}, opts ...interface{}) *QueryClient_ScheduledMarketChanges_Call {
+	return &QueryClient_ScheduledMarketChanges_Call{Call: _e.mock.On("ScheduledMarketChanges",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *QueryClient_ScheduledMarketChanges_Call) Run(run func(ctx context.Context, in *types.ScheduledMarketChangesRequest, opts ...grpc.CallOption)) *QueryClient_ScheduledMarketChanges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.ScheduledMarketChangesRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *QueryClient_ScheduledMarketChanges_Call) Return(_a0 *types.ScheduledMarketChangesResponse, _a1 error) *QueryClient_ScheduledMarketChanges_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *QueryClient_ScheduledMarketChanges_Call) RunAndReturn(run func(context.Context, *types.ScheduledMarketChangesRequest, ...grpc.CallOption) (*types.ScheduledMarketChangesResponse, error)) *QueryClient_ScheduledMarketChanges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewQueryClient creates a new instance of QueryClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewQueryClient(t interface {
@@ -15,6 +15,12 @@ var (
 	_ sdk.Msg = &MsgRemoveMarketAuthorities{}
 	_ sdk.Msg = &MsgUpsertMarkets{}
 	_ sdk.Msg = &MsgRemoveMarkets{}
+	_ sdk.Msg = &MsgScheduleMarketChange{}
+	_ sdk.Msg = &MsgCancelScheduledMarketChange{}
+	_ sdk.Msg = &MsgGrantMarketAuthorityScope{}
+	_ sdk.Msg = &MsgRevokeMarketAuthorityScope{}
+	_ sdk.Msg = &MsgUpsertProviders{}
+	_ sdk.Msg = &MsgRemoveProviders{}
 )
 
 // ValidateBasic asserts that the authority address in the upsert-markets message is formatted correctly.
@@ -155,3 +161,90 @@ func (m *MsgRemoveMarkets) ValidateBasic() error {
 
 	return nil
 }
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the signer is a valid acc-address and the scheduled change is valid. The ID of the change is
+// assigned by the module and must not be set.
+func (m *MsgScheduleMarketChange) ValidateBasic() error {
+	// validate signer address
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return err
+	}
+
+	if m.Change.Id != 0 {
+		return fmt.Errorf("scheduled change id must not be set")
+	}
+
+	return m.Change.ValidateBasic()
+}
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the signer is a valid acc-address.
+func (m *MsgCancelScheduledMarketChange) ValidateBasic() error {
+	// validate signer address
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return err
+	}
+
+	if m.Id == 0 {
+		return fmt.Errorf("scheduled change id must be set")
+	}
+
+	return nil
+}
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the signer is a valid acc-address and the granted scope is valid.
+func (m *MsgGrantMarketAuthorityScope) ValidateBasic() error {
+	// validate signer address
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return err
+	}
+
+	return m.Scope.ValidateBasic()
+}
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the signer and the scoped authority are valid acc-addresses.
+func (m *MsgRevokeMarketAuthorityScope) ValidateBasic() error {
+	// validate signer address
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return fmt.Errorf("invalid scoped market authority: %w", err)
+	}
+
+	return nil
+}
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the signer is a valid acc-address and the providers are valid and unique.
+func (m *MsgUpsertProviders) ValidateBasic() error {
+	// validate signer address
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return err
+	}
+
+	if len(m.Providers) == 0 {
+		return fmt.Errorf("no providers to upsert")
+	}
+
+	return ValidateProviders(m.Providers)
+}
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the signer is a valid acc-address and the provider names are non-empty and unique.
+func (m *MsgRemoveProviders) ValidateBasic() error {
+	// validate signer address
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return err
+	}
+
+	if len(m.Names) == 0 {
+		return fmt.Errorf("providers to remove cannot be nil")
+	}
+
+	return validateUniqueStrings("provider name", m.Names)
+}
@@ -577,3 +577,213 @@ func TestValidateBasicMsgRemoveMarkets(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateBasicMsgScheduleMarketChange(t *testing.T) {
+	rng := sample.Rand()
+
+	tcs := []struct {
+		name       string
+		msg        types.MsgScheduleMarketChange
+		expectPass bool
+	}{
+		{
+			"if the authority is not an acc-address - fail",
+			types.MsgScheduleMarketChange{
+				Authority: "invalid",
+			},
+			false,
+		},
+		{
+			"if the id is set - fail",
+			types.MsgScheduleMarketChange{
+				Authority: sample.Address(rng),
+				Change: types.ScheduledMarketChange{
+					Id:           1,
+					Action:       types.SCHEDULED_MARKET_ACTION_ENABLE,
+					CurrencyPair: btcusdtCP,
+					Height:       10,
+				},
+			},
+			false,
+		},
+		{
+			"if the change is invalid - fail",
+			types.MsgScheduleMarketChange{
+				Authority: sample.Address(rng),
+				Change: types.ScheduledMarketChange{
+					Action:       types.SCHEDULED_MARKET_ACTION_ENABLE,
+					CurrencyPair: btcusdtCP,
+				},
+			},
+			false,
+		},
+		{
+			"valid message",
+			types.MsgScheduleMarketChange{
+				Authority: sample.Address(rng),
+				Change: types.ScheduledMarketChange{
+					Action:       types.SCHEDULED_MARKET_ACTION_ENABLE,
+					CurrencyPair: btcusdtCP,
+					Height:       10,
+				},
+			},
+			true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if !tc.expectPass {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateBasicMsgCancelScheduledMarketChange(t *testing.T) {
+	rng := sample.Rand()
+
+	tcs := []struct {
+		name       string
+		msg        types.MsgCancelScheduledMarketChange
+		expectPass bool
+	}{
+		{
+			"if the authority is not an acc-address - fail",
+			types.MsgCancelScheduledMarketChange{
+				Authority: "invalid",
+				Id:        1,
+			},
+			false,
+		},
+		{
+			"if the id is not set - fail",
+			types.MsgCancelScheduledMarketChange{
+				Authority: sample.Address(rng),
+			},
+			false,
+		},
+		{
+			"valid message",
+			types.MsgCancelScheduledMarketChange{
+				Authority: sample.Address(rng),
+				Id:        1,
+			},
+			true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if !tc.expectPass {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateBasicMsgGrantMarketAuthorityScope(t *testing.T) {
+	rng := sample.Rand()
+
+	validScope := types.MarketAuthorityScope{
+		Authority:     sample.Address(rng),
+		CurrencyPairs: []types.CurrencyPairMatcher{{Base: "BTC"}},
+		Permissions:   []types.MarketPermission{types.MARKET_PERMISSION_ENABLE},
+	}
+
+	tcs := []struct {
+		name       string
+		msg        types.MsgGrantMarketAuthorityScope
+		expectPass bool
+	}{
+		{
+			"if the admin is not an acc-address - fail",
+			types.MsgGrantMarketAuthorityScope{
+				Admin: "invalid",
+				Scope: validScope,
+			},
+			false,
+		},
+		{
+			"if the scope is invalid - fail",
+			types.MsgGrantMarketAuthorityScope{
+				Admin: sample.Address(rng),
+				Scope: types.MarketAuthorityScope{
+					Authority: sample.Address(rng),
+				},
+			},
+			false,
+		},
+		{
+			"valid message",
+			types.MsgGrantMarketAuthorityScope{
+				Admin: sample.Address(rng),
+				Scope: validScope,
+			},
+			true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if !tc.expectPass {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateBasicMsgRevokeMarketAuthorityScope(t *testing.T) {
+	rng := sample.Rand()
+
+	tcs := []struct {
+		name       string
+		msg        types.MsgRevokeMarketAuthorityScope
+		expectPass bool
+	}{
+		{
+			"if the admin is not an acc-address - fail",
+			types.MsgRevokeMarketAuthorityScope{
+				Admin:     "invalid",
+				Authority: sample.Address(rng),
+			},
+			false,
+		},
+		{
+			"if the authority is not an acc-address - fail",
+			types.MsgRevokeMarketAuthorityScope{
+				Admin:     sample.Address(rng),
+				Authority: "invalid",
+			},
+			false,
+		},
+		{
+			"valid message",
+			types.MsgRevokeMarketAuthorityScope{
+				Admin:     sample.Address(rng),
+				Authority: sample.Address(rng),
+			},
+			true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if !tc.expectPass {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
@@ -30,6 +30,11 @@ type Params struct {
 	// Admin is an address that can remove addresses from the MarketAuthorities
 	// list. Only governance can add to the MarketAuthorities or change the Admin.
 	Admin string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty"`
+	// MarketHistoryRetentionBlocks is the number of blocks for which the changes
+	// made to markets are kept in the market history. Changes older than the
+	// retention period are pruned at the beginning of every block. A value of
+	// zero keeps the market history forever.
+	MarketHistoryRetentionBlocks uint64 `protobuf:"varint,3,opt,name=market_history_retention_blocks,json=marketHistoryRetentionBlocks,proto3" json:"market_history_retention_blocks,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -79,6 +84,13 @@ func (m *Params) GetAdmin() string {
 	return ""
 }
 
+func (m *Params) GetMarketHistoryRetentionBlocks() uint64 {
+	if m != nil {
+		return m.MarketHistoryRetentionBlocks
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "connect.marketmap.v2.Params")
 }
@@ -86,20 +98,22 @@ func init() {
 func init() { proto.RegisterFile("connect/marketmap/v2/params.proto", fileDescriptor_40e3a88492006139) }
 
 var fileDescriptor_40e3a88492006139 = []byte{
-	// 193 bytes of a gzipped FileDescriptorProto
+	// 240 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0xce, 0xcf, 0xcb,
 	0x4b, 0x4d, 0x2e, 0xd1, 0xcf, 0x4d, 0x2c, 0xca, 0x4e, 0x2d, 0xc9, 0x4d, 0x2c, 0xd0, 0x2f, 0x33,
 	0xd2, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x81,
-	0x2a, 0xd1, 0x83, 0x2b, 0xd1, 0x2b, 0x33, 0x52, 0xf2, 0xe5, 0x62, 0x0b, 0x00, 0xab, 0x12, 0xd2,
-	0xe5, 0x12, 0x82, 0xc8, 0xc4, 0x27, 0x96, 0x96, 0x64, 0xe4, 0x17, 0x65, 0x96, 0x64, 0xa6, 0x16,
-	0x4b, 0x30, 0x2a, 0x30, 0x6b, 0x70, 0x06, 0x09, 0x42, 0x64, 0x1c, 0x11, 0x12, 0x42, 0x22, 0x5c,
-	0xac, 0x89, 0x29, 0xb9, 0x99, 0x79, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0x10, 0x8e, 0x93,
-	0xd7, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1,
-	0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x19, 0xa4, 0x67, 0x96, 0x64,
-	0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x17, 0x67, 0x67, 0x16, 0xe8, 0xe6, 0xa6, 0x96, 0xe9,
-	0xc3, 0x5c, 0x5d, 0x66, 0xa4, 0x5f, 0x81, 0xe4, 0xf4, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36,
-	0xb0, 0xbb, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x37, 0x9c, 0xf0, 0x12, 0xdc, 0x00, 0x00,
-	0x00,
+	0x2a, 0xd1, 0x83, 0x2b, 0xd1, 0x2b, 0x33, 0x52, 0x9a, 0xc2, 0xc8, 0xc5, 0x16, 0x00, 0x56, 0x26,
+	0xa4, 0xcb, 0x25, 0x04, 0x91, 0x8a, 0x4f, 0x2c, 0x2d, 0xc9, 0xc8, 0x2f, 0xca, 0x2c, 0xc9, 0x4c,
+	0x2d, 0x96, 0x60, 0x54, 0x60, 0xd6, 0xe0, 0x0c, 0x12, 0x84, 0xc8, 0x38, 0x22, 0x24, 0x84, 0x44,
+	0xb8, 0x58, 0x13, 0x53, 0x72, 0x33, 0xf3, 0x24, 0x98, 0x14, 0x18, 0x35, 0x38, 0x83, 0x20, 0x1c,
+	0x21, 0x57, 0x2e, 0x79, 0xa8, 0x21, 0x19, 0x99, 0xc5, 0x25, 0xf9, 0x45, 0x95, 0xf1, 0x45, 0xa9,
+	0x25, 0xa9, 0x79, 0x25, 0x99, 0xf9, 0x79, 0xf1, 0x49, 0x39, 0xf9, 0xc9, 0xd9, 0xc5, 0x12, 0xcc,
+	0x0a, 0x8c, 0x1a, 0x2c, 0x41, 0x32, 0x10, 0x65, 0x1e, 0x10, 0x55, 0x41, 0x30, 0x45, 0x4e, 0x60,
+	0x35, 0x4e, 0x5e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3,
+	0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65, 0x90, 0x9e,
+	0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x5f, 0x9c, 0x9d, 0x59, 0xa0, 0x9b, 0x9b,
+	0x5a, 0xa6, 0x0f, 0xf3, 0x7d, 0x99, 0x91, 0x7e, 0x05, 0x52, 0x10, 0x94, 0x54, 0x16, 0xa4, 0x16,
+	0x27, 0xb1, 0x81, 0xfd, 0x6f, 0x0c, 0x18, 0x00, 0xf8, 0x01, 0xf1, 0xb5, 0x24, 0x01, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -122,6 +136,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MarketHistoryRetentionBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MarketHistoryRetentionBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Admin) > 0 {
 		i -= len(m.Admin)
 		copy(dAtA[i:], m.Admin)
@@ -168,6 +187,9 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if m.MarketHistoryRetentionBlocks != 0 {
+		n += 1 + sovParams(uint64(m.MarketHistoryRetentionBlocks))
+	}
 	return n
 }
 
@@ -270,6 +292,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketHistoryRetentionBlocks", wireType)
+			}
+			m.MarketHistoryRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketHistoryRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
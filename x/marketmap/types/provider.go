@@ -31,7 +31,7 @@ func (pc *ProviderConfig) ValidateBasic() error {
 		return fmt.Errorf("invalid provider config metadata json: %w", err)
 	}
 
-	return nil
+	return DefaultMetadataSchemaRegistry.ValidateProviderMetadata(pc.Name, pc.Metadata_JSON)
 }
 
 // Equal returns true iff the ProviderConfig is equal to the given ProviderConfig.
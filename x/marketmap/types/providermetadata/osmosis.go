@@ -0,0 +1,28 @@
+package providermetadata
+
+import "fmt"
+
+// OsmosisProvider is the name of the provider whose ProviderConfig.Metadata_JSON is an Osmosis.
+const OsmosisProvider = "osmosis_api"
+
+// Osmosis is the ProviderConfig.Metadata_JSON of the markets of the osmosis provider, identifying the osmosis pool
+// the price is derived from.
+type Osmosis struct {
+	// PoolID is the unique uint ID of the osmosis pool.
+	PoolID uint64 `json:"pool_id"`
+
+	// BaseTokenDenom is the identifier (on osmosis) of the base token.
+	BaseTokenDenom string `json:"base_token_denom"`
+
+	// QuoteTokenDenom is the identifier (on osmosis) of the quote token.
+	QuoteTokenDenom string `json:"quote_token_denom"`
+}
+
+// ValidateBasic checks that the token denoms of the Osmosis are non-empty.
+func (m Osmosis) ValidateBasic() error {
+	if m.BaseTokenDenom == "" || m.QuoteTokenDenom == "" {
+		return fmt.Errorf("base token denom or quote token denom cannot be empty")
+	}
+
+	return nil
+}
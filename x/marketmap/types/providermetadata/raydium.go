@@ -0,0 +1,72 @@
+package providermetadata
+
+import (
+	"fmt"
+
+	"github.com/mr-tron/base58"
+)
+
+const (
+	// RaydiumProvider is the name of the provider whose ProviderConfig.Metadata_JSON is a Raydium.
+	RaydiumProvider = "raydium_api"
+
+	// solanaAddressLength is the number of bytes of a solana public key.
+	solanaAddressLength = 32
+)
+
+// Raydium is the ProviderConfig.Metadata_JSON of the markets of the raydium provider, identifying the accounts of the
+// raydium pool the price is derived from.
+type Raydium struct {
+	// BaseTokenVault is the metadata associated with the base token's token vault
+	BaseTokenVault RaydiumTokenVault `json:"base_token_vault"`
+
+	// QuoteTokenVault is the metadata associated with the quote token's token vault
+	QuoteTokenVault RaydiumTokenVault `json:"quote_token_vault"`
+
+	// AMMInfoAddress is the address of the AMMInfo account for this raydium pool
+	AMMInfoAddress string `json:"amm_info_address"`
+
+	// OpenOrdersAddress is the address of the open orders account for this raydium pool
+	OpenOrdersAddress string `json:"open_orders_address"`
+}
+
+// RaydiumTokenVault represents the metadata associated with a raydium AMM pool's token vault. Specifically, we
+// require the token vault address and the token decimals for the token that the vault is associated with.
+type RaydiumTokenVault struct {
+	// TokenVaultAddress is the base58 encoded address of the token vault.
+	TokenVaultAddress string `json:"token_vault_address"`
+
+	// TokenDecimals is the number of decimals used for the token, we use this for
+	// normalizing the balance of tokens at the designated vault address
+	TokenDecimals uint64 `json:"token_decimals"`
+}
+
+// ValidateBasic checks that the solana addresses of the Raydium are valid.
+func (m Raydium) ValidateBasic() error {
+	for _, address := range []string{
+		m.BaseTokenVault.TokenVaultAddress,
+		m.QuoteTokenVault.TokenVaultAddress,
+		m.AMMInfoAddress,
+		m.OpenOrdersAddress,
+	} {
+		if err := validateSolanaAddress(address); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateSolanaAddress checks that the given address is a base58 encoded 32 byte solana public key.
+func validateSolanaAddress(address string) error {
+	b, err := base58.Decode(address)
+	if err != nil {
+		return fmt.Errorf("invalid solana address %s: %w", address, err)
+	}
+
+	if len(b) != solanaAddressLength {
+		return fmt.Errorf("invalid solana address %s: expected %d bytes, got %d", address, solanaAddressLength, len(b))
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package providermetadata_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/x/marketmap/types/providermetadata"
+)
+
+func TestRaydiumValidateBasic(t *testing.T) {
+	valid := providermetadata.Raydium{
+		BaseTokenVault: providermetadata.RaydiumTokenVault{
+			TokenVaultAddress: "DQyrAcCrDXQ7NeoqGgDCZwBvWDcYmFCjSb9JtteuvPpz",
+			TokenDecimals:     9,
+		},
+		QuoteTokenVault: providermetadata.RaydiumTokenVault{
+			TokenVaultAddress: "HLmqeL62xR1QoZ1HKKbXRrdN1p3phKpxRMb2VVopvBBz",
+			TokenDecimals:     6,
+		},
+		AMMInfoAddress:    "58oQChx4yWmvKdwLLZzBi4ChoCc2fqCUWBkwMihLYQo2",
+		OpenOrdersAddress: "HmiHHzq4Fym9e1D4qzLS6LDDM3tNsCTBPDWHTLZ763jY",
+	}
+	require.NoError(t, valid.ValidateBasic())
+
+	invalidBase58 := valid
+	invalidBase58.AMMInfoAddress = "0OIl"
+	require.Error(t, invalidBase58.ValidateBasic())
+
+	invalidLength := valid
+	invalidLength.OpenOrdersAddress = "HmiHHzq4Fym9e1D4qzLS6LDDM3tNsCTBPDWHTLZ763"
+	require.Error(t, invalidLength.ValidateBasic())
+
+	missing := valid
+	missing.BaseTokenVault.TokenVaultAddress = ""
+	require.Error(t, missing.ValidateBasic())
+}
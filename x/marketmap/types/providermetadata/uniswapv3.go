@@ -0,0 +1,75 @@
+package providermetadata
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	// UniswapV3EthereumProvider is the name of the provider whose ProviderConfig.Metadata_JSON is a UniswapV3 of a
+	// pool on Ethereum.
+	UniswapV3EthereumProvider = "uniswapv3_api-ethereum"
+
+	// UniswapV3BaseProvider is the name of the provider whose ProviderConfig.Metadata_JSON is a UniswapV3 of a pool on
+	// Base.
+	UniswapV3BaseProvider = "uniswapv3_api-base"
+)
+
+// UniswapV3 is the ProviderConfig.Metadata_JSON of the markets of the uniswapv3 providers, configuring the Uniswap
+// V3 pool the price is derived from. This is specific to each pair of tokens.
+type UniswapV3 struct {
+	// Address is the Uniswap V3 pool address.
+	Address string `json:"address"`
+	// BaseDecimals is the number of decimals for the base token. This should be derived from the
+	// token contract.
+	BaseDecimals int64 `json:"base_decimals"`
+	// QuoteDecimals is the number of decimals for the quote token. This should be derived from the
+	// token contract.
+	QuoteDecimals int64 `json:"quote_decimals"`
+	// Invert is utilized to invert the price of a pool's reserves. This may be required for certain
+	// pools as the price is derived based on the sorted order of the ERC20 addresses of the tokens
+	// in the pool.
+	Invert bool `json:"invert"`
+}
+
+// ValidateBasic validates the pool configuration.
+func (m *UniswapV3) ValidateBasic() error {
+	if !isHexAddress(m.Address) {
+		return fmt.Errorf("pool address is not a valid ethereum address")
+	}
+
+	if m.BaseDecimals < 0 {
+		return fmt.Errorf("base decimals must be non-negative")
+	}
+
+	if m.QuoteDecimals < 0 {
+		return fmt.Errorf("quote decimals must be non-negative")
+	}
+
+	return nil
+}
+
+// MustToJSON converts the pool configuration to JSON.
+func (m *UniswapV3) MustToJSON() string {
+	b, err := json.Marshal(m)
+	if err != nil {
+		panic(err)
+	}
+	return string(b)
+}
+
+// isHexAddress returns true if the given string is a hex encoded 20 byte ethereum address, with an optional 0x prefix.
+func isHexAddress(address string) bool {
+	if strings.HasPrefix(address, "0x") || strings.HasPrefix(address, "0X") {
+		address = address[2:]
+	}
+
+	if len(address) != 40 {
+		return false
+	}
+
+	_, err := hex.DecodeString(address)
+	return err == nil
+}
@@ -0,0 +1,34 @@
+package providermetadata_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/x/marketmap/types/providermetadata"
+)
+
+func TestUniswapV3ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name    string
+		pool    providermetadata.UniswapV3
+		wantErr bool
+	}{
+		{"valid", providermetadata.UniswapV3{Address: "0x8ad599c3A0ff1De082011EFDDc58f1908eb6e6D8", BaseDecimals: 18, QuoteDecimals: 6}, false},
+		{"valid without prefix", providermetadata.UniswapV3{Address: "8ad599c3A0ff1De082011EFDDc58f1908eb6e6D8"}, false},
+		{"invalid hex", providermetadata.UniswapV3{Address: "0x8ad599c3A0ff1De082011EFDDc58f1908eb6e6DZ"}, true},
+		{"invalid length", providermetadata.UniswapV3{Address: "0x8ad599c3A0ff1De082011EFDDc58f1908eb6e6"}, true},
+		{"negative base decimals", providermetadata.UniswapV3{Address: "0x8ad599c3A0ff1De082011EFDDc58f1908eb6e6D8", BaseDecimals: -1}, true},
+		{"negative quote decimals", providermetadata.UniswapV3{Address: "0x8ad599c3A0ff1De082011EFDDc58f1908eb6e6D8", QuoteDecimals: -1}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.pool.ValidateBasic()
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -30,16 +31,127 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// MarketEnabledFilter filters markets by the enabled state of their ticker.
+type MarketEnabledFilter int32
+
+const (
+	// MARKET_ENABLED_FILTER_UNSPECIFIED matches all markets.
+	MARKET_ENABLED_FILTER_UNSPECIFIED MarketEnabledFilter = 0
+	// MARKET_ENABLED_FILTER_ENABLED matches enabled markets.
+	MARKET_ENABLED_FILTER_ENABLED MarketEnabledFilter = 1
+	// MARKET_ENABLED_FILTER_DISABLED matches disabled markets.
+	MARKET_ENABLED_FILTER_DISABLED MarketEnabledFilter = 2
+)
+
+var MarketEnabledFilter_name = map[int32]string{
+	0: "MARKET_ENABLED_FILTER_UNSPECIFIED",
+	1: "MARKET_ENABLED_FILTER_ENABLED",
+	2: "MARKET_ENABLED_FILTER_DISABLED",
+}
+
+var MarketEnabledFilter_value = map[string]int32{
+	"MARKET_ENABLED_FILTER_UNSPECIFIED": 0,
+	"MARKET_ENABLED_FILTER_ENABLED":     1,
+	"MARKET_ENABLED_FILTER_DISABLED":    2,
+}
+
+func (x MarketEnabledFilter) String() string {
+	return proto.EnumName(MarketEnabledFilter_name, int32(x))
+}
+
+func (MarketEnabledFilter) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fc65f1e15c5a0bef, []int{0}
+}
+
+// MarketFilter filters the markets returned by the MarketMap and Markets
+// queries. A market matches the filter if it matches every set field.
+type MarketFilter struct {
+	// Enabled filters markets by the enabled state of their ticker.
+	Enabled MarketEnabledFilter `protobuf:"varint,1,opt,name=enabled,proto3,enum=connect.marketmap.v2.MarketEnabledFilter" json:"enabled,omitempty"`
+	// Provider matches markets with a provider config of the given provider
+	// name.
+	Provider string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	// Base matches markets with the given base asset.
+	Base string `protobuf:"bytes,3,opt,name=base,proto3" json:"base,omitempty"`
+	// Quote matches markets with the given quote asset.
+	Quote string `protobuf:"bytes,4,opt,name=quote,proto3" json:"quote,omitempty"`
+}
+
+func (m *MarketFilter) Reset()         { *m = MarketFilter{} }
+func (m *MarketFilter) String() string { return proto.CompactTextString(m) }
+func (*MarketFilter) ProtoMessage()    {}
+func (*MarketFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc65f1e15c5a0bef, []int{0}
+}
+func (m *MarketFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketFilter.Merge(m, src)
+}
+func (m *MarketFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketFilter proto.InternalMessageInfo
+
+func (m *MarketFilter) GetEnabled() MarketEnabledFilter {
+	if m != nil {
+		return m.Enabled
+	}
+	return MARKET_ENABLED_FILTER_UNSPECIFIED
+}
+
+func (m *MarketFilter) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *MarketFilter) GetBase() string {
+	if m != nil {
+		return m.Base
+	}
+	return ""
+}
+
+func (m *MarketFilter) GetQuote() string {
+	if m != nil {
+		return m.Quote
+	}
+	return ""
+}
+
 // MarketMapRequest is the query request for the MarketMap query.
-// It takes no arguments.
 type MarketMapRequest struct {
+	// Pagination paginates the markets, ordered by ticker string. All markets
+	// are returned if it is unset.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// Filter filters the returned markets.
+	Filter MarketFilter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter"`
 }
 
 func (m *MarketMapRequest) Reset()         { *m = MarketMapRequest{} }
 func (m *MarketMapRequest) String() string { return proto.CompactTextString(m) }
 func (*MarketMapRequest) ProtoMessage()    {}
 func (*MarketMapRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc65f1e15c5a0bef, []int{0}
+	return fileDescriptor_fc65f1e15c5a0bef, []int{1}
 }
 func (m *MarketMapRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -68,6 +180,20 @@ func (m *MarketMapRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MarketMapRequest proto.InternalMessageInfo
 
+func (m *MarketMapRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *MarketMapRequest) GetFilter() MarketFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return MarketFilter{}
+}
+
 // MarketMapResponse is the query response for the MarketMap query.
 type MarketMapResponse struct {
 	// MarketMap defines the global set of market configurations for all providers
@@ -79,13 +205,16 @@ type MarketMapResponse struct {
 	LastUpdated uint64 `protobuf:"varint,2,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
 	// ChainId is the chain identifier for the market map.
 	ChainId string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Pagination is the pagination of the markets. It is only set for paginated
+	// requests.
+	Pagination *query.PageResponse `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *MarketMapResponse) Reset()         { *m = MarketMapResponse{} }
 func (m *MarketMapResponse) String() string { return proto.CompactTextString(m) }
 func (*MarketMapResponse) ProtoMessage()    {}
 func (*MarketMapResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc65f1e15c5a0bef, []int{1}
+	return fileDescriptor_fc65f1e15c5a0bef, []int{2}
 }
 func (m *MarketMapResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -135,15 +264,27 @@ func (m *MarketMapResponse) GetChainId() string {
 	return ""
 }
 
+func (m *MarketMapResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // MarketsRequest is the query request for the Market query.
 type MarketsRequest struct {
+	// Pagination paginates the markets, ordered by ticker string. All markets
+	// are returned if it is unset.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// Filter filters the returned markets.
+	Filter MarketFilter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter"`
 }
 
 func (m *MarketsRequest) Reset()         { *m = MarketsRequest{} }
 func (m *MarketsRequest) String() string { return proto.CompactTextString(m) }
 func (*MarketsRequest) ProtoMessage()    {}
 func (*MarketsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc65f1e15c5a0bef, []int{2}
+	return fileDescriptor_fc65f1e15c5a0bef, []int{3}
 }
 func (m *MarketsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -172,17 +313,34 @@ func (m *MarketsRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MarketsRequest proto.InternalMessageInfo
 
+func (m *MarketsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *MarketsRequest) GetFilter() MarketFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return MarketFilter{}
+}
+
 // MarketsResponse is the query response for the Markets query.
 type MarketsResponse struct {
 	// Markets is a sorted list of all markets in the module.
 	Markets []Market `protobuf:"bytes,1,rep,name=markets,proto3" json:"markets"`
+	// Pagination is the pagination of the markets. It is only set for paginated
+	// requests.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *MarketsResponse) Reset()         { *m = MarketsResponse{} }
 func (m *MarketsResponse) String() string { return proto.CompactTextString(m) }
 func (*MarketsResponse) ProtoMessage()    {}
 func (*MarketsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc65f1e15c5a0bef, []int{3}
+	return fileDescriptor_fc65f1e15c5a0bef, []int{4}
 }
 func (m *MarketsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -218,6 +376,13 @@ func (m *MarketsResponse) GetMarkets() []Market {
 	return nil
 }
 
+func (m *MarketsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // MarketRequest is the query request for the Market query.
 // It takes the currency pair of the market as an argument.
 type MarketRequest struct {
@@ -230,7 +395,7 @@ func (m *MarketRequest) Reset()         { *m = MarketRequest{} }
 func (m *MarketRequest) String() string { return proto.CompactTextString(m) }
 func (*MarketRequest) ProtoMessage()    {}
 func (*MarketRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc65f1e15c5a0bef, []int{4}
+	return fileDescriptor_fc65f1e15c5a0bef, []int{5}
 }
 func (m *MarketRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -276,7 +441,7 @@ func (m *MarketResponse) Reset()         { *m = MarketResponse{} }
 func (m *MarketResponse) String() string { return proto.CompactTextString(m) }
 func (*MarketResponse) ProtoMessage()    {}
 func (*MarketResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc65f1e15c5a0bef, []int{5}
+	return fileDescriptor_fc65f1e15c5a0bef, []int{6}
 }
 func (m *MarketResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -320,7 +485,7 @@ func (m *ParamsRequest) Reset()         { *m = ParamsRequest{} }
 func (m *ParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*ParamsRequest) ProtoMessage()    {}
 func (*ParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc65f1e15c5a0bef, []int{6}
+	return fileDescriptor_fc65f1e15c5a0bef, []int{7}
 }
 func (m *ParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -358,7 +523,7 @@ func (m *ParamsResponse) Reset()         { *m = ParamsResponse{} }
 func (m *ParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*ParamsResponse) ProtoMessage()    {}
 func (*ParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc65f1e15c5a0bef, []int{7}
+	return fileDescriptor_fc65f1e15c5a0bef, []int{8}
 }
 func (m *ParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -403,7 +568,7 @@ func (m *LastUpdatedRequest) Reset()         { *m = LastUpdatedRequest{} }
 func (m *LastUpdatedRequest) String() string { return proto.CompactTextString(m) }
 func (*LastUpdatedRequest) ProtoMessage()    {}
 func (*LastUpdatedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc65f1e15c5a0bef, []int{8}
+	return fileDescriptor_fc65f1e15c5a0bef, []int{9}
 }
 func (m *LastUpdatedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -442,7 +607,7 @@ func (m *LastUpdatedResponse) Reset()         { *m = LastUpdatedResponse{} }
 func (m *LastUpdatedResponse) String() string { return proto.CompactTextString(m) }
 func (*LastUpdatedResponse) ProtoMessage()    {}
 func (*LastUpdatedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc65f1e15c5a0bef, []int{9}
+	return fileDescriptor_fc65f1e15c5a0bef, []int{10}
 }
 func (m *LastUpdatedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -478,739 +643,3470 @@ func (m *LastUpdatedResponse) GetLastUpdated() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*MarketMapRequest)(nil), "connect.marketmap.v2.MarketMapRequest")
-	proto.RegisterType((*MarketMapResponse)(nil), "connect.marketmap.v2.MarketMapResponse")
-	proto.RegisterType((*MarketsRequest)(nil), "connect.marketmap.v2.MarketsRequest")
-	proto.RegisterType((*MarketsResponse)(nil), "connect.marketmap.v2.MarketsResponse")
-	proto.RegisterType((*MarketRequest)(nil), "connect.marketmap.v2.MarketRequest")
-	proto.RegisterType((*MarketResponse)(nil), "connect.marketmap.v2.MarketResponse")
-	proto.RegisterType((*ParamsRequest)(nil), "connect.marketmap.v2.ParamsRequest")
-	proto.RegisterType((*ParamsResponse)(nil), "connect.marketmap.v2.ParamsResponse")
-	proto.RegisterType((*LastUpdatedRequest)(nil), "connect.marketmap.v2.LastUpdatedRequest")
-	proto.RegisterType((*LastUpdatedResponse)(nil), "connect.marketmap.v2.LastUpdatedResponse")
+// ScheduledMarketChangesRequest is the request type for the
+// Query/ScheduledMarketChanges RPC method.
+type ScheduledMarketChangesRequest struct {
 }
 
-func init() { proto.RegisterFile("connect/marketmap/v2/query.proto", fileDescriptor_fc65f1e15c5a0bef) }
-
-var fileDescriptor_fc65f1e15c5a0bef = []byte{
-	// 603 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4f, 0x6f, 0xd3, 0x4e,
-	0x10, 0xcd, 0xf6, 0x4f, 0xf2, 0xcb, 0xa4, 0x69, 0xfb, 0x5b, 0x72, 0x08, 0x51, 0x70, 0x12, 0x93,
-	0xd2, 0x80, 0x84, 0x8d, 0xcc, 0x05, 0x21, 0x4e, 0x85, 0x4b, 0x51, 0x2a, 0x4a, 0x24, 0x2e, 0xbd,
-	0x44, 0x5b, 0x67, 0x95, 0x5a, 0xad, 0xbd, 0x5b, 0xdb, 0x89, 0xc8, 0x81, 0x0b, 0x12, 0x57, 0x84,
-	0x84, 0x10, 0x5f, 0xa9, 0xc7, 0x4a, 0x5c, 0x38, 0x21, 0x94, 0xf0, 0x41, 0x90, 0xbd, 0xbb, 0xae,
-	0x43, 0x53, 0xa7, 0x37, 0x7b, 0xf6, 0xcd, 0x7b, 0xcf, 0x33, 0xcf, 0x0b, 0x4d, 0x9b, 0x79, 0x1e,
-	0xb5, 0x43, 0xd3, 0x25, 0xfe, 0x29, 0x0d, 0x5d, 0xc2, 0xcd, 0xb1, 0x65, 0x9e, 0x8f, 0xa8, 0x3f,
-	0x31, 0xb8, 0xcf, 0x42, 0x86, 0x2b, 0x12, 0x61, 0x24, 0x08, 0x63, 0x6c, 0xd5, 0x2a, 0x43, 0x36,
-	0x64, 0x31, 0xc0, 0x8c, 0x9e, 0x04, 0xb6, 0x56, 0x1f, 0x32, 0x36, 0x3c, 0xa3, 0x26, 0xe1, 0x8e,
-	0x49, 0x3c, 0x8f, 0x85, 0x24, 0x74, 0x98, 0x17, 0xc8, 0xd3, 0xb6, 0xd2, 0x0a, 0x27, 0x9c, 0x06,
-	0x91, 0x8e, 0x3d, 0xf2, 0x7d, 0xea, 0xd9, 0x93, 0x3e, 0x27, 0x8e, 0x2f, 0x51, 0xad, 0x85, 0x8e,
-	0xc4, 0x4b, 0x26, 0x84, 0x13, 0x9f, 0xb8, 0x52, 0x4b, 0xc7, 0xb0, 0x7d, 0x10, 0x1f, 0x1e, 0x10,
-	0xde, 0xa3, 0xe7, 0x23, 0x1a, 0x84, 0xfa, 0x77, 0x04, 0xff, 0xa7, 0x8a, 0x01, 0x67, 0x5e, 0x40,
-	0xf1, 0x2b, 0x00, 0x41, 0xd3, 0x77, 0x09, 0xaf, 0xa2, 0x26, 0xea, 0x94, 0xac, 0x86, 0xb1, 0xe8,
-	0xa3, 0x8d, 0xa4, 0x79, 0x6f, 0xed, 0xe2, 0x57, 0x23, 0xd7, 0x2b, 0xba, 0xaa, 0x80, 0x5b, 0xb0,
-	0x71, 0x46, 0x82, 0xb0, 0x3f, 0xe2, 0x03, 0x12, 0xd2, 0x41, 0x75, 0xa5, 0x89, 0x3a, 0x6b, 0xbd,
-	0x52, 0x54, 0x7b, 0x27, 0x4a, 0xf8, 0x2e, 0xfc, 0x67, 0x9f, 0x10, 0xc7, 0xeb, 0x3b, 0x83, 0xea,
-	0x6a, 0x13, 0x75, 0x8a, 0xbd, 0x42, 0xfc, 0xbe, 0x3f, 0xd0, 0xb7, 0x61, 0x53, 0x70, 0x07, 0xca,
-	0xeb, 0x1b, 0xd8, 0x4a, 0x2a, 0xd2, 0xe8, 0x0b, 0x28, 0x08, 0xbd, 0xa0, 0x8a, 0x9a, 0xab, 0x9d,
-	0x92, 0x55, 0xcf, 0x72, 0x29, 0x2d, 0xaa, 0x16, 0xfd, 0x08, 0xca, 0xe2, 0x40, 0x2a, 0xe0, 0x7d,
-	0x28, 0xcf, 0x8d, 0x5f, 0x7e, 0xba, 0x96, 0x90, 0xc6, 0x5b, 0x8a, 0x08, 0x5f, 0x4a, 0xd8, 0x21,
-	0x71, 0x7c, 0x49, 0xbb, 0x61, 0xa7, 0x6a, 0x7a, 0x57, 0xd9, 0x4f, 0xbc, 0x3e, 0x87, 0xbc, 0x10,
-	0x96, 0xac, 0xb7, 0xb1, 0x2a, 0x3b, 0xf4, 0x2d, 0x28, 0x1f, 0xc6, 0xab, 0x54, 0xb3, 0xe8, 0xc2,
-	0xa6, 0x2a, 0x5c, 0xd1, 0x8b, 0x6d, 0x67, 0xd3, 0x8b, 0x2e, 0x45, 0x2f, 0x3a, 0xf4, 0x0a, 0xe0,
-	0xee, 0xd5, 0x56, 0x94, 0xc6, 0x33, 0xb8, 0x33, 0x57, 0x95, 0x42, 0xff, 0xae, 0x15, 0x5d, 0x5b,
-	0xab, 0xf5, 0x6d, 0x1d, 0xd6, 0xdf, 0x46, 0xff, 0x0b, 0xfe, 0x84, 0xa0, 0x98, 0x44, 0x04, 0x3f,
-	0x58, 0x92, 0x21, 0xa9, 0x5c, 0xdb, 0x5d, 0x8a, 0x13, 0x5e, 0xf4, 0xdd, 0x8f, 0x3f, 0xfe, 0x7c,
-	0x5d, 0x69, 0xe1, 0x86, 0x99, 0xf1, 0x87, 0xb8, 0x84, 0xe3, 0x0f, 0x50, 0x90, 0xd9, 0xc1, 0xed,
-	0x2c, 0x72, 0x35, 0xe0, 0xda, 0xce, 0x12, 0x94, 0x34, 0xb0, 0x13, 0x1b, 0x68, 0xe0, 0x7b, 0x59,
-	0x06, 0x02, 0x3c, 0x81, 0xbc, 0xe8, 0xc4, 0xf7, 0xb3, 0x78, 0x95, 0x78, 0x3b, 0x1b, 0x24, 0xb5,
-	0xdb, 0xb1, 0xb6, 0x86, 0xeb, 0x59, 0xda, 0xf8, 0x33, 0x82, 0x52, 0x6a, 0x8d, 0xb8, 0xb3, 0x98,
-	0xfb, 0xfa, 0xfe, 0x6b, 0x0f, 0x6f, 0x81, 0x94, 0x56, 0x1e, 0xc5, 0x56, 0xda, 0x58, 0x5f, 0x6c,
-	0x25, 0x9d, 0x97, 0x68, 0x16, 0x22, 0x84, 0x37, 0xcd, 0x62, 0x2e, 0xe9, 0x37, 0xcd, 0x62, 0x3e,
-	0xfd, 0xcb, 0x66, 0x21, 0x72, 0xbe, 0xf7, 0xfa, 0x62, 0xaa, 0xa1, 0xcb, 0xa9, 0x86, 0x7e, 0x4f,
-	0x35, 0xf4, 0x65, 0xa6, 0xe5, 0x2e, 0x67, 0x5a, 0xee, 0xe7, 0x4c, 0xcb, 0x1d, 0x3d, 0x19, 0x3a,
-	0xe1, 0xc9, 0xe8, 0xd8, 0xb0, 0x99, 0x6b, 0x06, 0xa7, 0x0e, 0x7f, 0xec, 0xd2, 0x71, 0x42, 0x35,
-	0xb6, 0xcc, 0xf7, 0x29, 0xbe, 0xf8, 0x12, 0x38, 0xce, 0xc7, 0x97, 0xea, 0xd3, 0xbf, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x10, 0x69, 0xe0, 0x2c, 0x2e, 0x06, 0x00, 0x00,
+func (m *ScheduledMarketChangesRequest) Reset()         { *m = ScheduledMarketChangesRequest{} }
+func (m *ScheduledMarketChangesRequest) String() string { return proto.CompactTextString(m) }
+func (*ScheduledMarketChangesRequest) ProtoMessage()    {}
+func (*ScheduledMarketChangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc65f1e15c5a0bef, []int{11}
+}
+func (m *ScheduledMarketChangesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledMarketChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledMarketChangesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledMarketChangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledMarketChangesRequest.Merge(m, src)
+}
+func (m *ScheduledMarketChangesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledMarketChangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledMarketChangesRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_ScheduledMarketChangesRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// MarketMap returns the full market map stored in the x/marketmap
-	// module.  NOTE: the value returned by this query is not safe for on-chain
-	// code.
-	MarketMap(ctx context.Context, in *MarketMapRequest, opts ...grpc.CallOption) (*MarketMapResponse, error)
-	// Market returns all stored in the x/marketmap
-	// module as a sorted list.
-	Markets(ctx context.Context, in *MarketsRequest, opts ...grpc.CallOption) (*MarketsResponse, error)
-	// Market returns a market stored in the x/marketmap
-	// module.
-	Market(ctx context.Context, in *MarketRequest, opts ...grpc.CallOption) (*MarketResponse, error)
-	// LastUpdated returns the last height the market map was updated at.
-	LastUpdated(ctx context.Context, in *LastUpdatedRequest, opts ...grpc.CallOption) (*LastUpdatedResponse, error)
-	// Params returns the current x/marketmap module parameters.
-	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+// ScheduledMarketChangesResponse is the response type for the
+// Query/ScheduledMarketChanges RPC method.
+type ScheduledMarketChangesResponse struct {
+	Changes []ScheduledMarketChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *ScheduledMarketChangesResponse) Reset()         { *m = ScheduledMarketChangesResponse{} }
+func (m *ScheduledMarketChangesResponse) String() string { return proto.CompactTextString(m) }
+func (*ScheduledMarketChangesResponse) ProtoMessage()    {}
+func (*ScheduledMarketChangesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc65f1e15c5a0bef, []int{12}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *ScheduledMarketChangesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) MarketMap(ctx context.Context, in *MarketMapRequest, opts ...grpc.CallOption) (*MarketMapResponse, error) {
-	out := new(MarketMapResponse)
-	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Query/MarketMap", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ScheduledMarketChangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledMarketChangesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) Markets(ctx context.Context, in *MarketsRequest, opts ...grpc.CallOption) (*MarketsResponse, error) {
-	out := new(MarketsResponse)
-	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Query/Markets", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ScheduledMarketChangesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledMarketChangesResponse.Merge(m, src)
 }
-
-func (c *queryClient) Market(ctx context.Context, in *MarketRequest, opts ...grpc.CallOption) (*MarketResponse, error) {
-	out := new(MarketResponse)
-	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Query/Market", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ScheduledMarketChangesResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) LastUpdated(ctx context.Context, in *LastUpdatedRequest, opts ...grpc.CallOption) (*LastUpdatedResponse, error) {
-	out := new(LastUpdatedResponse)
-	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Query/LastUpdated", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ScheduledMarketChangesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledMarketChangesResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
-	out := new(ParamsResponse)
-	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ScheduledMarketChangesResponse proto.InternalMessageInfo
+
+func (m *ScheduledMarketChangesResponse) GetChanges() []ScheduledMarketChange {
+	if m != nil {
+		return m.Changes
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// MarketMap returns the full market map stored in the x/marketmap
-	// module.  NOTE: the value returned by this query is not safe for on-chain
-	// code.
-	MarketMap(context.Context, *MarketMapRequest) (*MarketMapResponse, error)
-	// Market returns all stored in the x/marketmap
-	// module as a sorted list.
-	Markets(context.Context, *MarketsRequest) (*MarketsResponse, error)
-	// Market returns a market stored in the x/marketmap
-	// module.
-	Market(context.Context, *MarketRequest) (*MarketResponse, error)
-	// LastUpdated returns the last height the market map was updated at.
-	LastUpdated(context.Context, *LastUpdatedRequest) (*LastUpdatedResponse, error)
-	// Params returns the current x/marketmap module parameters.
-	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+// MarketAuthorityScopesRequest is the request type for the
+// Query/MarketAuthorityScopes RPC method.
+type MarketAuthorityScopesRequest struct {
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *MarketAuthorityScopesRequest) Reset()         { *m = MarketAuthorityScopesRequest{} }
+func (m *MarketAuthorityScopesRequest) String() string { return proto.CompactTextString(m) }
+func (*MarketAuthorityScopesRequest) ProtoMessage()    {}
+func (*MarketAuthorityScopesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc65f1e15c5a0bef, []int{13}
 }
-
-func (*UnimplementedQueryServer) MarketMap(ctx context.Context, req *MarketMapRequest) (*MarketMapResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MarketMap not implemented")
+func (m *MarketAuthorityScopesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Markets(ctx context.Context, req *MarketsRequest) (*MarketsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Markets not implemented")
+func (m *MarketAuthorityScopesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketAuthorityScopesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Market(ctx context.Context, req *MarketRequest) (*MarketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Market not implemented")
+func (m *MarketAuthorityScopesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketAuthorityScopesRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) LastUpdated(ctx context.Context, req *LastUpdatedRequest) (*LastUpdatedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LastUpdated not implemented")
+func (m *MarketAuthorityScopesRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *MarketAuthorityScopesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketAuthorityScopesRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_MarketAuthorityScopesRequest proto.InternalMessageInfo
+
+// MarketAuthorityScopesResponse is the response type for the
+// Query/MarketAuthorityScopes RPC method.
+type MarketAuthorityScopesResponse struct {
+	Scopes []MarketAuthorityScope `protobuf:"bytes,1,rep,name=scopes,proto3" json:"scopes"`
 }
 
-func _Query_MarketMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MarketMapRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).MarketMap(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/connect.marketmap.v2.Query/MarketMap",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MarketMap(ctx, req.(*MarketMapRequest))
+func (m *MarketAuthorityScopesResponse) Reset()         { *m = MarketAuthorityScopesResponse{} }
+func (m *MarketAuthorityScopesResponse) String() string { return proto.CompactTextString(m) }
+func (*MarketAuthorityScopesResponse) ProtoMessage()    {}
+func (*MarketAuthorityScopesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc65f1e15c5a0bef, []int{14}
+}
+func (m *MarketAuthorityScopesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketAuthorityScopesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketAuthorityScopesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MarketAuthorityScopesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketAuthorityScopesResponse.Merge(m, src)
+}
+func (m *MarketAuthorityScopesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketAuthorityScopesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketAuthorityScopesResponse.DiscardUnknown(m)
 }
 
-func _Query_Markets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MarketsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Markets(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/connect.marketmap.v2.Query/Markets",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Markets(ctx, req.(*MarketsRequest))
+var xxx_messageInfo_MarketAuthorityScopesResponse proto.InternalMessageInfo
+
+func (m *MarketAuthorityScopesResponse) GetScopes() []MarketAuthorityScope {
+	if m != nil {
+		return m.Scopes
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Market_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MarketRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Market(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/connect.marketmap.v2.Query/Market",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Market(ctx, req.(*MarketRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// MarketHistoryRequest is the request type for the Query/MarketHistory RPC
+// method.
+type MarketHistoryRequest struct {
+	// CurrencyPair is the currency pair of the market whose history is
+	// requested.
+	CurrencyPair types.CurrencyPair `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair"`
 }
 
-func _Query_LastUpdated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LastUpdatedRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LastUpdated(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/connect.marketmap.v2.Query/LastUpdated",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LastUpdated(ctx, req.(*LastUpdatedRequest))
+func (m *MarketHistoryRequest) Reset()         { *m = MarketHistoryRequest{} }
+func (m *MarketHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*MarketHistoryRequest) ProtoMessage()    {}
+func (*MarketHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc65f1e15c5a0bef, []int{15}
+}
+func (m *MarketHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MarketHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketHistoryRequest.Merge(m, src)
+}
+func (m *MarketHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketHistoryRequest.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/connect.marketmap.v2.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*ParamsRequest))
+var xxx_messageInfo_MarketHistoryRequest proto.InternalMessageInfo
+
+func (m *MarketHistoryRequest) GetCurrencyPair() types.CurrencyPair {
+	if m != nil {
+		return m.CurrencyPair
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.CurrencyPair{}
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "connect.marketmap.v2.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "MarketMap",
-			Handler:    _Query_MarketMap_Handler,
-		},
-		{
-			MethodName: "Markets",
-			Handler:    _Query_Markets_Handler,
-		},
-		{
-			MethodName: "Market",
-			Handler:    _Query_Market_Handler,
-		},
-		{
-			MethodName: "LastUpdated",
-			Handler:    _Query_LastUpdated_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "connect/marketmap/v2/query.proto",
+// MarketHistoryResponse is the response type for the Query/MarketHistory RPC
+// method.
+type MarketHistoryResponse struct {
+	Changes []MarketChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes"`
+	// EarliestHeight is the earliest height from which the market history is
+	// complete.
+	EarliestHeight uint64 `protobuf:"varint,2,opt,name=earliest_height,json=earliestHeight,proto3" json:"earliest_height,omitempty"`
 }
 
-func (m *MarketMapRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MarketHistoryResponse) Reset()         { *m = MarketHistoryResponse{} }
+func (m *MarketHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*MarketHistoryResponse) ProtoMessage()    {}
+func (*MarketHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc65f1e15c5a0bef, []int{16}
+}
+func (m *MarketHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MarketMapRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MarketHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketHistoryResponse.Merge(m, src)
+}
+func (m *MarketHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketHistoryResponse.DiscardUnknown(m)
 }
 
-func (m *MarketMapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MarketHistoryResponse proto.InternalMessageInfo
+
+func (m *MarketHistoryResponse) GetChanges() []MarketChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
 }
 
-func (m *MarketMapResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MarketHistoryResponse) GetEarliestHeight() uint64 {
+	if m != nil {
+		return m.EarliestHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MarketMapResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MarketMapAtHeightRequest is the request type for the Query/MarketMapAtHeight
+// RPC method.
+type MarketMapAtHeightRequest struct {
+	// Height is the block height at the end of which the market map is
+	// requested.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (m *MarketMapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.LastUpdated != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.LastUpdated))
-		i--
-		dAtA[i] = 0x10
-	}
-	{
-		size, err := m.MarketMap.MarshalToSizedBuffer(dAtA[:i])
+func (m *MarketMapAtHeightRequest) Reset()         { *m = MarketMapAtHeightRequest{} }
+func (m *MarketMapAtHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*MarketMapAtHeightRequest) ProtoMessage()    {}
+func (*MarketMapAtHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc65f1e15c5a0bef, []int{17}
+}
+func (m *MarketMapAtHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketMapAtHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketMapAtHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *MarketsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MarketMapAtHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketMapAtHeightRequest.Merge(m, src)
 }
-
-func (m *MarketsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MarketMapAtHeightRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MarketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MarketMapAtHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketMapAtHeightRequest.DiscardUnknown(m)
 }
 
-func (m *MarketsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MarketMapAtHeightRequest proto.InternalMessageInfo
+
+func (m *MarketMapAtHeightRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MarketsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MarketMapAtHeightResponse is the response type for the
+// Query/MarketMapAtHeight RPC method.
+type MarketMapAtHeightResponse struct {
+	// MarketMap is the market map at the end of the requested height.
+	MarketMap MarketMap `protobuf:"bytes,1,opt,name=market_map,json=marketMap,proto3" json:"market_map"`
+	// Height is the requested block height.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (m *MarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Markets) > 0 {
-		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *MarketMapAtHeightResponse) Reset()         { *m = MarketMapAtHeightResponse{} }
+func (m *MarketMapAtHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*MarketMapAtHeightResponse) ProtoMessage()    {}
+func (*MarketMapAtHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc65f1e15c5a0bef, []int{18}
+}
+func (m *MarketMapAtHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketMapAtHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketMapAtHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MarketMapAtHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketMapAtHeightResponse.Merge(m, src)
+}
+func (m *MarketMapAtHeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketMapAtHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketMapAtHeightResponse.DiscardUnknown(m)
 }
 
-func (m *MarketRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MarketMapAtHeightResponse proto.InternalMessageInfo
+
+func (m *MarketMapAtHeightResponse) GetMarketMap() MarketMap {
+	if m != nil {
+		return m.MarketMap
 	}
-	return dAtA[:n], nil
+	return MarketMap{}
 }
 
-func (m *MarketRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MarketMapAtHeightResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-func (m *MarketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.CurrencyPair.MarshalToSizedBuffer(dAtA[:i])
+// MarketsSinceRequest is the request type for the Query/MarketsSince RPC
+// method.
+type MarketsSinceRequest struct {
+	// Height is the block height after which the changed markets are returned.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *MarketsSinceRequest) Reset()         { *m = MarketsSinceRequest{} }
+func (m *MarketsSinceRequest) String() string { return proto.CompactTextString(m) }
+func (*MarketsSinceRequest) ProtoMessage()    {}
+func (*MarketsSinceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc65f1e15c5a0bef, []int{19}
+}
+func (m *MarketsSinceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketsSinceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketsSinceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *MarketsSinceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketsSinceRequest.Merge(m, src)
+}
+func (m *MarketsSinceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketsSinceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketsSinceRequest.DiscardUnknown(m)
 }
 
-func (m *MarketResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MarketsSinceRequest proto.InternalMessageInfo
+
+func (m *MarketsSinceRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MarketResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MarketsSinceResponse is the response type for the Query/MarketsSince RPC
+// method.
+type MarketsSinceResponse struct {
+	// Markets are the markets that were created or updated after the requested
+	// height, as they currently are, sorted by ticker string.
+	Markets []Market `protobuf:"bytes,1,rep,name=markets,proto3" json:"markets"`
+	// RemovedMarkets are the ticker strings of the markets that were removed
+	// after the requested height, sorted.
+	RemovedMarkets []string `protobuf:"bytes,2,rep,name=removed_markets,json=removedMarkets,proto3" json:"removed_markets,omitempty"`
+	// LastUpdated is the last block height that the market map was updated.
+	LastUpdated uint64 `protobuf:"varint,3,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	// ChainId is the chain identifier for the market map.
+	ChainId string `protobuf:"bytes,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *MarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
+func (m *MarketsSinceResponse) Reset()         { *m = MarketsSinceResponse{} }
+func (m *MarketsSinceResponse) String() string { return proto.CompactTextString(m) }
+func (*MarketsSinceResponse) ProtoMessage()    {}
+func (*MarketsSinceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc65f1e15c5a0bef, []int{20}
+}
+func (m *MarketsSinceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketsSinceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketsSinceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *MarketsSinceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketsSinceResponse.Merge(m, src)
+}
+func (m *MarketsSinceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketsSinceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketsSinceResponse.DiscardUnknown(m)
 }
 
-func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MarketsSinceResponse proto.InternalMessageInfo
+
+func (m *MarketsSinceResponse) GetMarkets() []Market {
+	if m != nil {
+		return m.Markets
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MarketsSinceResponse) GetRemovedMarkets() []string {
+	if m != nil {
+		return m.RemovedMarkets
+	}
+	return nil
 }
 
-func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MarketsSinceResponse) GetLastUpdated() uint64 {
+	if m != nil {
+		return m.LastUpdated
+	}
+	return 0
 }
 
-func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MarketsSinceResponse) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ProvidersRequest is the request type for the Query/Providers RPC method.
+type ProvidersRequest struct {
 }
 
-func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *ProvidersRequest) Reset()         { *m = ProvidersRequest{} }
+func (m *ProvidersRequest) String() string { return proto.CompactTextString(m) }
+func (*ProvidersRequest) ProtoMessage()    {}
+func (*ProvidersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc65f1e15c5a0bef, []int{21}
+}
+func (m *ProvidersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProvidersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProvidersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *LastUpdatedRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ProvidersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProvidersRequest.Merge(m, src)
 }
-
-func (m *LastUpdatedRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ProvidersRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *LastUpdatedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *ProvidersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProvidersRequest.DiscardUnknown(m)
 }
 
-func (m *LastUpdatedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_ProvidersRequest proto.InternalMessageInfo
 
-func (m *LastUpdatedResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ProvidersResponse is the response type for the Query/Providers RPC method.
+type ProvidersResponse struct {
+	Providers []ProviderInfo `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers"`
 }
 
-func (m *LastUpdatedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LastUpdated != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.LastUpdated))
-		i--
-		dAtA[i] = 0x8
+func (m *ProvidersResponse) Reset()         { *m = ProvidersResponse{} }
+func (m *ProvidersResponse) String() string { return proto.CompactTextString(m) }
+func (*ProvidersResponse) ProtoMessage()    {}
+func (*ProvidersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc65f1e15c5a0bef, []int{22}
+}
+func (m *ProvidersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProvidersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProvidersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ProvidersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProvidersResponse.Merge(m, src)
+}
+func (m *ProvidersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProvidersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProvidersResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_ProvidersResponse proto.InternalMessageInfo
+
+func (m *ProvidersResponse) GetProviders() []ProviderInfo {
+	if m != nil {
+		return m.Providers
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *MarketMapRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func init() {
+	proto.RegisterEnum("connect.marketmap.v2.MarketEnabledFilter", MarketEnabledFilter_name, MarketEnabledFilter_value)
+	proto.RegisterType((*MarketFilter)(nil), "connect.marketmap.v2.MarketFilter")
+	proto.RegisterType((*MarketMapRequest)(nil), "connect.marketmap.v2.MarketMapRequest")
+	proto.RegisterType((*MarketMapResponse)(nil), "connect.marketmap.v2.MarketMapResponse")
+	proto.RegisterType((*MarketsRequest)(nil), "connect.marketmap.v2.MarketsRequest")
+	proto.RegisterType((*MarketsResponse)(nil), "connect.marketmap.v2.MarketsResponse")
+	proto.RegisterType((*MarketRequest)(nil), "connect.marketmap.v2.MarketRequest")
+	proto.RegisterType((*MarketResponse)(nil), "connect.marketmap.v2.MarketResponse")
+	proto.RegisterType((*ParamsRequest)(nil), "connect.marketmap.v2.ParamsRequest")
+	proto.RegisterType((*ParamsResponse)(nil), "connect.marketmap.v2.ParamsResponse")
+	proto.RegisterType((*LastUpdatedRequest)(nil), "connect.marketmap.v2.LastUpdatedRequest")
+	proto.RegisterType((*LastUpdatedResponse)(nil), "connect.marketmap.v2.LastUpdatedResponse")
+	proto.RegisterType((*ScheduledMarketChangesRequest)(nil), "connect.marketmap.v2.ScheduledMarketChangesRequest")
+	proto.RegisterType((*ScheduledMarketChangesResponse)(nil), "connect.marketmap.v2.ScheduledMarketChangesResponse")
+	proto.RegisterType((*MarketAuthorityScopesRequest)(nil), "connect.marketmap.v2.MarketAuthorityScopesRequest")
+	proto.RegisterType((*MarketAuthorityScopesResponse)(nil), "connect.marketmap.v2.MarketAuthorityScopesResponse")
+	proto.RegisterType((*MarketHistoryRequest)(nil), "connect.marketmap.v2.MarketHistoryRequest")
+	proto.RegisterType((*MarketHistoryResponse)(nil), "connect.marketmap.v2.MarketHistoryResponse")
+	proto.RegisterType((*MarketMapAtHeightRequest)(nil), "connect.marketmap.v2.MarketMapAtHeightRequest")
+	proto.RegisterType((*MarketMapAtHeightResponse)(nil), "connect.marketmap.v2.MarketMapAtHeightResponse")
+	proto.RegisterType((*MarketsSinceRequest)(nil), "connect.marketmap.v2.MarketsSinceRequest")
+	proto.RegisterType((*MarketsSinceResponse)(nil), "connect.marketmap.v2.MarketsSinceResponse")
+	proto.RegisterType((*ProvidersRequest)(nil), "connect.marketmap.v2.ProvidersRequest")
+	proto.RegisterType((*ProvidersResponse)(nil), "connect.marketmap.v2.ProvidersResponse")
+}
+
+func init() { proto.RegisterFile("connect/marketmap/v2/query.proto", fileDescriptor_fc65f1e15c5a0bef) }
+
+var fileDescriptor_fc65f1e15c5a0bef = []byte{
+	// 1309 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4d, 0x6f, 0x1b, 0x65,
+	0x10, 0xf6, 0x3a, 0xae, 0xd3, 0x4c, 0x3e, 0xfb, 0xd6, 0x54, 0xee, 0x2a, 0xd9, 0x38, 0x5b, 0xa7,
+	0x4e, 0xdd, 0x76, 0xb7, 0x75, 0x01, 0x21, 0xc4, 0x81, 0x7c, 0x38, 0xd4, 0x34, 0xa9, 0x82, 0xd3,
+	0x5e, 0xca, 0x61, 0xf5, 0xc6, 0x7e, 0x6b, 0xaf, 0xea, 0xfd, 0xc8, 0xee, 0xda, 0xc2, 0x07, 0x2e,
+	0x08, 0x24, 0xb8, 0x20, 0x04, 0x12, 0x02, 0xc4, 0x8d, 0x0b, 0xff, 0x80, 0x43, 0xff, 0x40, 0x8f,
+	0x95, 0xb8, 0x70, 0x42, 0x55, 0xc2, 0x99, 0xdf, 0x80, 0xfc, 0x7e, 0x6c, 0x6c, 0x67, 0xb3, 0x76,
+	0x50, 0x91, 0xb8, 0x79, 0x67, 0x9f, 0x99, 0x79, 0x66, 0xde, 0xd9, 0x79, 0x76, 0x0d, 0xb9, 0x9a,
+	0x63, 0xdb, 0xa4, 0x16, 0xe8, 0x16, 0xf6, 0x9e, 0x91, 0xc0, 0xc2, 0xae, 0xde, 0x29, 0xe9, 0x87,
+	0x6d, 0xe2, 0x75, 0x35, 0xd7, 0x73, 0x02, 0x07, 0x65, 0x38, 0x42, 0x0b, 0x11, 0x5a, 0xa7, 0x24,
+	0x67, 0x1a, 0x4e, 0xc3, 0xa1, 0x00, 0xbd, 0xf7, 0x8b, 0x61, 0xe5, 0xc5, 0x86, 0xe3, 0x34, 0x5a,
+	0x44, 0xc7, 0xae, 0xa9, 0x63, 0xdb, 0x76, 0x02, 0x1c, 0x98, 0x8e, 0xed, 0xf3, 0xbb, 0xc5, 0x9a,
+	0xe3, 0x5b, 0x8e, 0xaf, 0x1f, 0x60, 0x9f, 0xb0, 0x14, 0x7a, 0xe7, 0xee, 0x01, 0x09, 0xf0, 0x5d,
+	0xdd, 0xc5, 0x0d, 0xd3, 0xa6, 0x60, 0x8e, 0xcd, 0x0b, 0x5e, 0x41, 0xd7, 0x25, 0x7e, 0x8f, 0x53,
+	0xad, 0xed, 0x79, 0xc4, 0xae, 0x75, 0x0d, 0x17, 0x9b, 0x1e, 0x47, 0xad, 0x44, 0xb2, 0x67, 0x17,
+	0xb1, 0x10, 0x17, 0x7b, 0xd8, 0x12, 0xbc, 0xae, 0x45, 0x42, 0xfc, 0x5a, 0x93, 0xd4, 0xdb, 0x2d,
+	0xc2, 0x41, 0xb9, 0x33, 0x40, 0x8e, 0x2b, 0x10, 0x6a, 0x24, 0xa2, 0x69, 0xfa, 0x81, 0xe3, 0x75,
+	0x63, 0x53, 0x79, 0xa4, 0x61, 0xfa, 0x81, 0x00, 0xa9, 0x3f, 0x4a, 0x30, 0xb3, 0x4b, 0xef, 0x6f,
+	0x9b, 0xad, 0x80, 0x78, 0x68, 0x13, 0x26, 0x89, 0x8d, 0x0f, 0x5a, 0xa4, 0x9e, 0x95, 0x72, 0xd2,
+	0xda, 0x5c, 0xe9, 0x86, 0x16, 0x75, 0x28, 0x1a, 0x73, 0x2a, 0x33, 0x28, 0xf3, 0xad, 0x0a, 0x4f,
+	0x24, 0xc3, 0x45, 0xd7, 0x73, 0x3a, 0x66, 0x9d, 0x78, 0xd9, 0x64, 0x4e, 0x5a, 0x9b, 0xaa, 0x86,
+	0xd7, 0x08, 0x41, 0xaa, 0x77, 0x28, 0xd9, 0x09, 0x6a, 0xa7, 0xbf, 0x51, 0x06, 0x2e, 0x1c, 0xb6,
+	0x9d, 0x80, 0x64, 0x53, 0xd4, 0xc8, 0x2e, 0xd4, 0x9f, 0x25, 0x58, 0x60, 0x69, 0x76, 0xb1, 0x5b,
+	0x25, 0x87, 0x6d, 0xe2, 0x07, 0x68, 0x1b, 0xe0, 0xe4, 0x00, 0x29, 0xc5, 0xe9, 0xd2, 0x75, 0x8d,
+	0x9d, 0xb6, 0xd6, 0x0b, 0xa6, 0xb1, 0x81, 0xe2, 0xa7, 0xad, 0xed, 0xe1, 0x06, 0xe1, 0xbe, 0xd5,
+	0x3e, 0x4f, 0xf4, 0x3e, 0xa4, 0x9f, 0x52, 0xd6, 0x94, 0xe0, 0x74, 0x49, 0x8d, 0x2b, 0x93, 0xd5,
+	0xb7, 0x91, 0x7a, 0xf1, 0xe7, 0x72, 0xa2, 0xca, 0xfd, 0xd4, 0x57, 0x12, 0x5c, 0xea, 0xa3, 0xe7,
+	0xbb, 0x8e, 0xed, 0x13, 0xb4, 0x05, 0xc0, 0x02, 0x18, 0x16, 0x76, 0x39, 0xbf, 0xe5, 0xb8, 0xd8,
+	0xbb, 0xd8, 0xe5, 0x81, 0xa7, 0x2c, 0x61, 0x40, 0x2b, 0x30, 0xd3, 0xc2, 0x7e, 0x60, 0xb4, 0xdd,
+	0x3a, 0x0e, 0x48, 0x9d, 0x72, 0x4c, 0x55, 0xa7, 0x7b, 0xb6, 0xc7, 0xcc, 0x84, 0xae, 0xc2, 0xc5,
+	0x5a, 0x13, 0x9b, 0xb6, 0x61, 0xd6, 0x79, 0x2f, 0x27, 0xe9, 0x75, 0xa5, 0x8e, 0x3e, 0x18, 0xe8,
+	0x51, 0x8a, 0x72, 0x28, 0x8c, 0xec, 0x11, 0x2b, 0xa0, 0xbf, 0x49, 0xea, 0x4f, 0x12, 0xcc, 0x31,
+	0x96, 0xfe, 0xff, 0xaf, 0xff, 0x3f, 0x48, 0x30, 0x1f, 0x92, 0xe3, 0xdd, 0x7f, 0x0f, 0x26, 0x99,
+	0xbb, 0x9f, 0x95, 0x72, 0x13, 0x6b, 0xd3, 0xa5, 0xc5, 0xb8, 0xb0, 0x3c, 0xa0, 0x70, 0x19, 0xea,
+	0x5b, 0xf2, 0xdf, 0xf7, 0xed, 0x09, 0xcc, 0xb2, 0x0c, 0xa2, 0x6b, 0x15, 0x98, 0x1d, 0xd8, 0x29,
+	0xbc, 0x71, 0x4a, 0xc8, 0x8e, 0xae, 0x9e, 0x1e, 0xb3, 0x4d, 0x0e, 0xdb, 0xc3, 0xa6, 0x28, 0x78,
+	0xa6, 0xd6, 0x67, 0x53, 0x77, 0xc4, 0x91, 0x84, 0x45, 0xbf, 0x0b, 0x69, 0x56, 0x01, 0x8f, 0x3a,
+	0x4e, 0xcd, 0xdc, 0x43, 0x9d, 0x87, 0xd9, 0x3d, 0xba, 0x9f, 0x38, 0xd3, 0x5e, 0x78, 0x61, 0x38,
+	0x09, 0xcf, 0x56, 0x58, 0x7c, 0x78, 0xe6, 0x25, 0xc2, 0x33, 0x0f, 0x35, 0x03, 0x68, 0xe7, 0x64,
+	0x66, 0x45, 0x8e, 0x77, 0xe0, 0xf2, 0x80, 0x95, 0x27, 0x1a, 0x1e, 0x7a, 0xe9, 0xd4, 0xd0, 0xab,
+	0xcb, 0xb0, 0xb4, 0xcf, 0x77, 0x65, 0x9d, 0xd5, 0xb3, 0xd9, 0xc4, 0x76, 0x83, 0x84, 0xf4, 0x2d,
+	0x50, 0xce, 0x02, 0xf0, 0x2c, 0x0f, 0x60, 0xb2, 0xc6, 0x4c, 0x7c, 0x44, 0x6e, 0x46, 0xd7, 0x13,
+	0x19, 0x46, 0x4c, 0x0c, 0x8f, 0xa0, 0x2a, 0xb0, 0xc8, 0x6e, 0xaf, 0xb7, 0x83, 0xa6, 0xe3, 0x99,
+	0x41, 0x77, 0xbf, 0xb7, 0xa5, 0x43, 0x3a, 0x26, 0x2c, 0x9d, 0x71, 0x9f, 0xb3, 0xb9, 0x0f, 0x69,
+	0xba, 0xd7, 0x05, 0x99, 0x62, 0xdc, 0xd9, 0x0d, 0x06, 0x11, 0xad, 0x66, 0xfe, 0x2a, 0x86, 0x0c,
+	0x43, 0xdd, 0x67, 0x2a, 0xf0, 0x1f, 0x8c, 0xde, 0xe7, 0x12, 0xbc, 0x31, 0x94, 0x83, 0x97, 0xb1,
+	0x31, 0xdc, 0xd4, 0xd8, 0xc7, 0x39, 0xb2, 0x97, 0xa8, 0x00, 0xf3, 0x04, 0x7b, 0x2d, 0x93, 0xf8,
+	0x81, 0xd1, 0x24, 0x66, 0xa3, 0x19, 0xf0, 0xb5, 0x37, 0x27, 0xcc, 0xf7, 0xa9, 0x55, 0x2d, 0x41,
+	0x36, 0x5c, 0x9d, 0xeb, 0xdc, 0x28, 0xaa, 0xbd, 0x02, 0x69, 0xee, 0xcb, 0xa6, 0x87, 0x5f, 0xa9,
+	0x5d, 0xb8, 0x1a, 0xe1, 0xf3, 0x5a, 0x77, 0xf6, 0x49, 0xea, 0xe4, 0x40, 0xea, 0xdb, 0x70, 0x99,
+	0x79, 0xf9, 0xfb, 0xa6, 0x5d, 0x23, 0xa3, 0x98, 0x3e, 0x97, 0x20, 0x33, 0x88, 0x7f, 0x2d, 0xbb,
+	0xad, 0x00, 0xf3, 0x1e, 0xb1, 0x9c, 0x0e, 0xa9, 0x1b, 0x22, 0x4a, 0x32, 0x37, 0xb1, 0x36, 0x55,
+	0x9d, 0xe3, 0x66, 0x9e, 0xf3, 0xd4, 0x53, 0x38, 0x11, 0x2f, 0x3d, 0xa9, 0x01, 0xe9, 0x51, 0x11,
+	0x2c, 0xec, 0x71, 0xa5, 0x0f, 0x1f, 0x82, 0x8f, 0xe1, 0x52, 0x9f, 0x8d, 0x57, 0xb3, 0x0d, 0x53,
+	0xe2, 0x95, 0x60, 0xc4, 0xcc, 0x08, 0xdf, 0x8a, 0xfd, 0xd4, 0x11, 0x5d, 0x0f, 0x5d, 0x8b, 0x5f,
+	0x49, 0x70, 0x39, 0xe2, 0x5d, 0x04, 0xad, 0xc2, 0xca, 0xee, 0x7a, 0xf5, 0x41, 0xf9, 0x91, 0x51,
+	0x7e, 0xb8, 0xbe, 0xb1, 0x53, 0xde, 0x32, 0xb6, 0x2b, 0x3b, 0x8f, 0xca, 0x55, 0xe3, 0xf1, 0xc3,
+	0xfd, 0xbd, 0xf2, 0x66, 0x65, 0xbb, 0x52, 0xde, 0x5a, 0x48, 0xa0, 0x15, 0x58, 0x8a, 0x86, 0xf1,
+	0xcb, 0x05, 0x09, 0xa9, 0xa0, 0x44, 0x43, 0xb6, 0x2a, 0xfb, 0x0c, 0x93, 0x94, 0x53, 0x5f, 0xfe,
+	0xa2, 0x24, 0x4a, 0x7f, 0xcf, 0xc0, 0x85, 0x8f, 0x7a, 0x0a, 0x81, 0xbe, 0x90, 0x60, 0x2a, 0x1c,
+	0x15, 0x74, 0x7d, 0xc4, 0x2c, 0xf1, 0x46, 0xc9, 0x85, 0x91, 0x38, 0xd6, 0x3c, 0xb5, 0xf0, 0xd9,
+	0xef, 0x7f, 0x7d, 0x97, 0x5c, 0x41, 0xcb, 0x7a, 0xcc, 0x4b, 0xa9, 0x85, 0x5d, 0xf4, 0x29, 0x4c,
+	0x8a, 0x73, 0xcd, 0xc7, 0x05, 0x17, 0x67, 0x25, 0xaf, 0x8e, 0x40, 0x71, 0x02, 0xab, 0x94, 0xc0,
+	0x32, 0x5a, 0x8a, 0x23, 0xe0, 0xa3, 0x2e, 0xa4, 0x99, 0x27, 0xba, 0x16, 0x17, 0x57, 0x24, 0xcf,
+	0xc7, 0x83, 0x78, 0xee, 0x3c, 0xcd, 0xad, 0xa0, 0xc5, 0xb8, 0xdc, 0xe8, 0x6b, 0x09, 0xa6, 0xfb,
+	0x44, 0x06, 0xad, 0x45, 0xc7, 0x3e, 0xad, 0x4e, 0xf2, 0x8d, 0x31, 0x90, 0x9c, 0x4a, 0x91, 0x52,
+	0xc9, 0x23, 0x35, 0x9a, 0x4a, 0xff, 0x73, 0xd4, 0xeb, 0x05, 0x93, 0xc8, 0xb3, 0x7a, 0x31, 0xa0,
+	0xc3, 0x72, 0x3e, 0x1e, 0x34, 0x5e, 0x2f, 0x98, 0x0a, 0xa3, 0xe7, 0x12, 0x5c, 0x89, 0x56, 0x45,
+	0x74, 0xef, 0x1c, 0xe2, 0x17, 0x72, 0x7b, 0xf3, 0x7c, 0x4e, 0x9c, 0xeb, 0xdb, 0x94, 0xeb, 0x1d,
+	0xa4, 0xe9, 0xb1, 0xdf, 0x40, 0x62, 0x3f, 0x19, 0x42, 0x17, 0x7e, 0x0b, 0x55, 0x67, 0x48, 0x44,
+	0x51, 0x69, 0x7c, 0xb1, 0x0c, 0xb9, 0xdf, 0x3b, 0x97, 0x0f, 0xa7, 0xfe, 0x16, 0xa5, 0xae, 0xa3,
+	0xdb, 0x71, 0x23, 0x67, 0x60, 0xe1, 0x6d, 0x30, 0x49, 0x46, 0xdf, 0x4b, 0x30, 0x3b, 0xa0, 0x97,
+	0x28, 0x56, 0xde, 0x07, 0x85, 0x5b, 0xbe, 0x39, 0x16, 0x96, 0x33, 0xbc, 0x45, 0x19, 0x5e, 0x47,
+	0xf9, 0x58, 0x86, 0xfc, 0x03, 0x11, 0xfd, 0xda, 0xff, 0xe9, 0x22, 0xe4, 0x10, 0x69, 0x23, 0xd6,
+	0xcf, 0x90, 0xd6, 0xca, 0xfa, 0xd8, 0x78, 0x4e, 0xb2, 0x44, 0x49, 0xde, 0x42, 0xc5, 0x58, 0x92,
+	0x16, 0x76, 0x0d, 0x2c, 0x5e, 0x01, 0xd0, 0xb7, 0xe1, 0x07, 0x2a, 0x93, 0x43, 0x14, 0xfb, 0x3d,
+	0x3a, 0x20, 0xb1, 0x72, 0x71, 0x1c, 0x28, 0xe7, 0x76, 0x93, 0x72, 0x5b, 0x45, 0xd7, 0x62, 0x37,
+	0x9a, 0xe1, 0x53, 0x0e, 0xbd, 0xf5, 0x1e, 0x4a, 0xda, 0x59, 0xeb, 0x7d, 0x58, 0x07, 0xe5, 0xc2,
+	0x48, 0xdc, 0x78, 0xeb, 0x3d, 0x14, 0xbf, 0x8d, 0x0f, 0x5f, 0x1c, 0x29, 0xd2, 0xcb, 0x23, 0x45,
+	0x7a, 0x75, 0xa4, 0x48, 0xdf, 0x1c, 0x2b, 0x89, 0x97, 0xc7, 0x4a, 0xe2, 0x8f, 0x63, 0x25, 0xf1,
+	0xe4, 0x4e, 0xc3, 0x0c, 0x9a, 0xed, 0x03, 0xad, 0xe6, 0x58, 0xba, 0xff, 0xcc, 0x74, 0x6f, 0x5b,
+	0xa4, 0x13, 0x46, 0xeb, 0x94, 0xf4, 0x4f, 0xfa, 0x42, 0xd2, 0x17, 0xc0, 0x83, 0x34, 0xfd, 0x43,
+	0xe0, 0xde, 0x3f, 0x03, 0x00, 0xf4, 0x0d, 0x01, 0xa0, 0xa6, 0x11, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// MarketMap returns the full market map stored in the x/marketmap
+	// module.  NOTE: the value returned by this query is not safe for on-chain
+	// code.
+	MarketMap(ctx context.Context, in *MarketMapRequest, opts ...grpc.CallOption) (*MarketMapResponse, error)
+	// Market returns all stored in the x/marketmap
+	// module as a sorted list.
+	Markets(ctx context.Context, in *MarketsRequest, opts ...grpc.CallOption) (*MarketsResponse, error)
+	// Market returns a market stored in the x/marketmap
+	// module.
+	Market(ctx context.Context, in *MarketRequest, opts ...grpc.CallOption) (*MarketResponse, error)
+	// LastUpdated returns the last height the market map was updated at.
+	LastUpdated(ctx context.Context, in *LastUpdatedRequest, opts ...grpc.CallOption) (*LastUpdatedResponse, error)
+	// Params returns the current x/marketmap module parameters.
+	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+	// ScheduledMarketChanges returns the pending scheduled market changes,
+	// ordered by ID.
+	ScheduledMarketChanges(ctx context.Context, in *ScheduledMarketChangesRequest, opts ...grpc.CallOption) (*ScheduledMarketChangesResponse, error)
+	// MarketAuthorityScopes returns the scopes of the scoped market authorities,
+	// ordered by authority.
+	MarketAuthorityScopes(ctx context.Context, in *MarketAuthorityScopesRequest, opts ...grpc.CallOption) (*MarketAuthorityScopesResponse, error)
+	// MarketHistory returns the recorded changes made to the market of the given
+	// currency pair, ordered by the height at which they were made.
+	MarketHistory(ctx context.Context, in *MarketHistoryRequest, opts ...grpc.CallOption) (*MarketHistoryResponse, error)
+	// MarketMapAtHeight returns the market map as it was at the end of the given
+	// block height. Heights before the earliest height covered by the market
+	// history cannot be queried.
+	MarketMapAtHeight(ctx context.Context, in *MarketMapAtHeightRequest, opts ...grpc.CallOption) (*MarketMapAtHeightResponse, error)
+	// MarketsSince returns the markets that were created or updated, and the
+	// tickers of the markets that were removed, after the given block height.
+	// Heights before the earliest height covered by the market history cannot be
+	// queried.
+	MarketsSince(ctx context.Context, in *MarketsSinceRequest, opts ...grpc.CallOption) (*MarketsSinceResponse, error)
+	// Providers returns the providers registered in the provider registry,
+	// ordered by name.
+	Providers(ctx context.Context, in *ProvidersRequest, opts ...grpc.CallOption) (*ProvidersResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) MarketMap(ctx context.Context, in *MarketMapRequest, opts ...grpc.CallOption) (*MarketMapResponse, error) {
+	out := new(MarketMapResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Query/MarketMap", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MarketMapResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) Markets(ctx context.Context, in *MarketsRequest, opts ...grpc.CallOption) (*MarketsResponse, error) {
+	out := new(MarketsResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Query/Markets", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.MarketMap.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.LastUpdated != 0 {
-		n += 1 + sovQuery(uint64(m.LastUpdated))
+	return out, nil
+}
+
+func (c *queryClient) Market(ctx context.Context, in *MarketRequest, opts ...grpc.CallOption) (*MarketResponse, error) {
+	out := new(MarketResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Query/Market", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) LastUpdated(ctx context.Context, in *LastUpdatedRequest, opts ...grpc.CallOption) (*LastUpdatedResponse, error) {
+	out := new(LastUpdatedResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Query/LastUpdated", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MarketsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
+	out := new(ParamsResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MarketsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) ScheduledMarketChanges(ctx context.Context, in *ScheduledMarketChangesRequest, opts ...grpc.CallOption) (*ScheduledMarketChangesResponse, error) {
+	out := new(ScheduledMarketChangesResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Query/ScheduledMarketChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Markets) > 0 {
-		for _, e := range m.Markets {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *queryClient) MarketAuthorityScopes(ctx context.Context, in *MarketAuthorityScopesRequest, opts ...grpc.CallOption) (*MarketAuthorityScopesResponse, error) {
+	out := new(MarketAuthorityScopesResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Query/MarketAuthorityScopes", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MarketRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) MarketHistory(ctx context.Context, in *MarketHistoryRequest, opts ...grpc.CallOption) (*MarketHistoryResponse, error) {
+	out := new(MarketHistoryResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Query/MarketHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.CurrencyPair.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *MarketResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) MarketMapAtHeight(ctx context.Context, in *MarketMapAtHeightRequest, opts ...grpc.CallOption) (*MarketMapAtHeightResponse, error) {
+	out := new(MarketMapAtHeightResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Query/MarketMapAtHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Market.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *ParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) MarketsSince(ctx context.Context, in *MarketsSinceRequest, opts ...grpc.CallOption) (*MarketsSinceResponse, error) {
+	out := new(MarketsSinceResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Query/MarketsSince", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *ParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) Providers(ctx context.Context, in *ProvidersRequest, opts ...grpc.CallOption) (*ProvidersResponse, error) {
+	out := new(ProvidersResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Query/Providers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// MarketMap returns the full market map stored in the x/marketmap
+	// module.  NOTE: the value returned by this query is not safe for on-chain
+	// code.
+	MarketMap(context.Context, *MarketMapRequest) (*MarketMapResponse, error)
+	// Market returns all stored in the x/marketmap
+	// module as a sorted list.
+	Markets(context.Context, *MarketsRequest) (*MarketsResponse, error)
+	// Market returns a market stored in the x/marketmap
+	// module.
+	Market(context.Context, *MarketRequest) (*MarketResponse, error)
+	// LastUpdated returns the last height the market map was updated at.
+	LastUpdated(context.Context, *LastUpdatedRequest) (*LastUpdatedResponse, error)
+	// Params returns the current x/marketmap module parameters.
+	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	// ScheduledMarketChanges returns the pending scheduled market changes,
+	// ordered by ID.
+	ScheduledMarketChanges(context.Context, *ScheduledMarketChangesRequest) (*ScheduledMarketChangesResponse, error)
+	// MarketAuthorityScopes returns the scopes of the scoped market authorities,
+	// ordered by authority.
+	MarketAuthorityScopes(context.Context, *MarketAuthorityScopesRequest) (*MarketAuthorityScopesResponse, error)
+	// MarketHistory returns the recorded changes made to the market of the given
+	// currency pair, ordered by the height at which they were made.
+	MarketHistory(context.Context, *MarketHistoryRequest) (*MarketHistoryResponse, error)
+	// MarketMapAtHeight returns the market map as it was at the end of the given
+	// block height. Heights before the earliest height covered by the market
+	// history cannot be queried.
+	MarketMapAtHeight(context.Context, *MarketMapAtHeightRequest) (*MarketMapAtHeightResponse, error)
+	// MarketsSince returns the markets that were created or updated, and the
+	// tickers of the markets that were removed, after the given block height.
+	// Heights before the earliest height covered by the market history cannot be
+	// queried.
+	MarketsSince(context.Context, *MarketsSinceRequest) (*MarketsSinceResponse, error)
+	// Providers returns the providers registered in the provider registry,
+	// ordered by name.
+	Providers(context.Context, *ProvidersRequest) (*ProvidersResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) MarketMap(ctx context.Context, req *MarketMapRequest) (*MarketMapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketMap not implemented")
+}
+func (*UnimplementedQueryServer) Markets(ctx context.Context, req *MarketsRequest) (*MarketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Markets not implemented")
+}
+func (*UnimplementedQueryServer) Market(ctx context.Context, req *MarketRequest) (*MarketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Market not implemented")
+}
+func (*UnimplementedQueryServer) LastUpdated(ctx context.Context, req *LastUpdatedRequest) (*LastUpdatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastUpdated not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ScheduledMarketChanges(ctx context.Context, req *ScheduledMarketChangesRequest) (*ScheduledMarketChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledMarketChanges not implemented")
+}
+func (*UnimplementedQueryServer) MarketAuthorityScopes(ctx context.Context, req *MarketAuthorityScopesRequest) (*MarketAuthorityScopesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketAuthorityScopes not implemented")
+}
+func (*UnimplementedQueryServer) MarketHistory(ctx context.Context, req *MarketHistoryRequest) (*MarketHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketHistory not implemented")
+}
+func (*UnimplementedQueryServer) MarketMapAtHeight(ctx context.Context, req *MarketMapAtHeightRequest) (*MarketMapAtHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketMapAtHeight not implemented")
+}
+func (*UnimplementedQueryServer) MarketsSince(ctx context.Context, req *MarketsSinceRequest) (*MarketsSinceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketsSince not implemented")
+}
+func (*UnimplementedQueryServer) Providers(ctx context.Context, req *ProvidersRequest) (*ProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Providers not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_MarketMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketMapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketMap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Query/MarketMap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketMap(ctx, req.(*MarketMapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Markets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Markets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Query/Markets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Markets(ctx, req.(*MarketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Market_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Market(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Query/Market",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Market(ctx, req.(*MarketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LastUpdated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LastUpdatedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LastUpdated(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Query/LastUpdated",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LastUpdated(ctx, req.(*LastUpdatedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*ParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledMarketChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduledMarketChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledMarketChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Query/ScheduledMarketChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledMarketChanges(ctx, req.(*ScheduledMarketChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MarketAuthorityScopes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketAuthorityScopesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketAuthorityScopes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Query/MarketAuthorityScopes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketAuthorityScopes(ctx, req.(*MarketAuthorityScopesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MarketHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Query/MarketHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketHistory(ctx, req.(*MarketHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MarketMapAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketMapAtHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketMapAtHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Query/MarketMapAtHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketMapAtHeight(ctx, req.(*MarketMapAtHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MarketsSince_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketsSinceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketsSince(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Query/MarketsSince",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketsSince(ctx, req.(*MarketsSinceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Providers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Providers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Query/Providers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Providers(ctx, req.(*ProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "connect.marketmap.v2.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "MarketMap",
+			Handler:    _Query_MarketMap_Handler,
+		},
+		{
+			MethodName: "Markets",
+			Handler:    _Query_Markets_Handler,
+		},
+		{
+			MethodName: "Market",
+			Handler:    _Query_Market_Handler,
+		},
+		{
+			MethodName: "LastUpdated",
+			Handler:    _Query_LastUpdated_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ScheduledMarketChanges",
+			Handler:    _Query_ScheduledMarketChanges_Handler,
+		},
+		{
+			MethodName: "MarketAuthorityScopes",
+			Handler:    _Query_MarketAuthorityScopes_Handler,
+		},
+		{
+			MethodName: "MarketHistory",
+			Handler:    _Query_MarketHistory_Handler,
+		},
+		{
+			MethodName: "MarketMapAtHeight",
+			Handler:    _Query_MarketMapAtHeight_Handler,
+		},
+		{
+			MethodName: "MarketsSince",
+			Handler:    _Query_MarketsSince_Handler,
+		},
+		{
+			MethodName: "Providers",
+			Handler:    _Query_Providers_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "connect/marketmap/v2/query.proto",
+}
+
+func (m *MarketFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Quote)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Base) > 0 {
+		i -= len(m.Base)
+		copy(dAtA[i:], m.Base)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Base)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Enabled != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Enabled))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketMapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketMapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketMapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketMapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketMapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketMapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.LastUpdated != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastUpdated))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.MarketMap.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Markets) > 0 {
+		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CurrencyPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *LastUpdatedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LastUpdatedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LastUpdatedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *LastUpdatedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LastUpdatedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LastUpdatedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastUpdated != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastUpdated))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledMarketChangesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledMarketChangesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledMarketChangesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledMarketChangesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledMarketChangesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledMarketChangesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketAuthorityScopesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketAuthorityScopesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketAuthorityScopesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketAuthorityScopesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketAuthorityScopesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketAuthorityScopesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Scopes) > 0 {
+		for iNdEx := len(m.Scopes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Scopes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CurrencyPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EarliestHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EarliestHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketMapAtHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketMapAtHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketMapAtHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketMapAtHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketMapAtHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketMapAtHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.MarketMap.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketsSinceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketsSinceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketsSinceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketsSinceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketsSinceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketsSinceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.LastUpdated != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastUpdated))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RemovedMarkets) > 0 {
+		for iNdEx := len(m.RemovedMarkets) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemovedMarkets[iNdEx])
+			copy(dAtA[i:], m.RemovedMarkets[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.RemovedMarkets[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Markets) > 0 {
+		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProvidersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProvidersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProvidersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ProvidersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProvidersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProvidersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Providers) > 0 {
+		for iNdEx := len(m.Providers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Providers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MarketFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled != 0 {
+		n += 1 + sovQuery(uint64(m.Enabled))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Base)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *MarketMapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Filter.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *MarketMapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MarketMap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.LastUpdated != 0 {
+		n += 1 + sovQuery(uint64(m.LastUpdated))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *MarketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Filter.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *MarketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for _, e := range m.Markets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *MarketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrencyPair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *MarketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Market.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *LastUpdatedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *LastUpdatedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastUpdated != 0 {
+		n += 1 + sovQuery(uint64(m.LastUpdated))
+	}
+	return n
+}
+
+func (m *ScheduledMarketChangesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ScheduledMarketChangesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MarketAuthorityScopesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MarketAuthorityScopesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Scopes) > 0 {
+		for _, e := range m.Scopes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MarketHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrencyPair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *MarketHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.EarliestHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EarliestHeight))
+	}
+	return n
+}
+
+func (m *MarketMapAtHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *MarketMapAtHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MarketMap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *MarketsSinceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *MarketsSinceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for _, e := range m.Markets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.RemovedMarkets) > 0 {
+		for _, s := range m.RemovedMarkets {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.LastUpdated != 0 {
+		n += 1 + sovQuery(uint64(m.LastUpdated))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ProvidersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ProvidersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Providers) > 0 {
+		for _, e := range m.Providers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MarketFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			m.Enabled = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Enabled |= MarketEnabledFilter(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Base = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketMapRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketMapRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketMapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketMapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketMapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketMapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketMap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MarketMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdated", wireType)
+			}
+			m.LastUpdated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdated |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Markets = append(m.Markets, Market{})
+			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Market.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
 
-func (m *LastUpdatedRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *LastUpdatedResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *LastUpdatedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LastUpdatedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LastUpdatedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	if m.LastUpdated != 0 {
-		n += 1 + sovQuery(uint64(m.LastUpdated))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *LastUpdatedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LastUpdatedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LastUpdatedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdated", wireType)
+			}
+			m.LastUpdated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdated |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MarketMapRequest) Unmarshal(dAtA []byte) error {
+func (m *ScheduledMarketChangesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1233,10 +4129,10 @@ func (m *MarketMapRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MarketMapRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScheduledMarketChangesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarketMapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScheduledMarketChangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1260,7 +4156,7 @@ func (m *MarketMapRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MarketMapResponse) Unmarshal(dAtA []byte) error {
+func (m *ScheduledMarketChangesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1283,15 +4179,15 @@ func (m *MarketMapResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MarketMapResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScheduledMarketChangesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarketMapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScheduledMarketChangesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketMap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1318,34 +4214,116 @@ func (m *MarketMapResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MarketMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Changes = append(m.Changes, ScheduledMarketChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdated", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.LastUpdated = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastUpdated |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
 			}
-		case 3:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketAuthorityScopesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketAuthorityScopesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketAuthorityScopesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketAuthorityScopesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketAuthorityScopesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketAuthorityScopesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1355,23 +4333,25 @@ func (m *MarketMapResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.Scopes = append(m.Scopes, MarketAuthorityScope{})
+			if err := m.Scopes[len(m.Scopes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1394,7 +4374,7 @@ func (m *MarketMapResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MarketsRequest) Unmarshal(dAtA []byte) error {
+func (m *MarketHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1417,12 +4397,45 @@ func (m *MarketsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MarketsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MarketHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MarketHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1444,7 +4457,7 @@ func (m *MarketsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MarketsResponse) Unmarshal(dAtA []byte) error {
+func (m *MarketHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1467,15 +4480,15 @@ func (m *MarketsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MarketsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MarketHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MarketHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1502,11 +4515,30 @@ func (m *MarketsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Markets = append(m.Markets, Market{})
-			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Changes = append(m.Changes, MarketChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarliestHeight", wireType)
+			}
+			m.EarliestHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EarliestHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1528,7 +4560,7 @@ func (m *MarketsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MarketRequest) Unmarshal(dAtA []byte) error {
+func (m *MarketMapAtHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1551,17 +4583,17 @@ func (m *MarketRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MarketRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MarketMapAtHeightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MarketMapAtHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1571,25 +4603,11 @@ func (m *MarketRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1611,7 +4629,7 @@ func (m *MarketRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MarketResponse) Unmarshal(dAtA []byte) error {
+func (m *MarketMapAtHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1634,15 +4652,15 @@ func (m *MarketResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MarketResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MarketMapAtHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MarketMapAtHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketMap", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1669,10 +4687,29 @@ func (m *MarketResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Market.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MarketMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1694,7 +4731,7 @@ func (m *MarketResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *MarketsSinceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1717,12 +4754,31 @@ func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MarketsSinceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MarketsSinceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1744,7 +4800,7 @@ func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MarketsSinceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1767,15 +4823,15 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MarketsSinceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MarketsSinceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1802,10 +4858,94 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Markets = append(m.Markets, Market{})
+			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedMarkets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemovedMarkets = append(m.RemovedMarkets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdated", wireType)
+			}
+			m.LastUpdated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdated |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1827,7 +4967,7 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LastUpdatedRequest) Unmarshal(dAtA []byte) error {
+func (m *ProvidersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1850,10 +4990,10 @@ func (m *LastUpdatedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LastUpdatedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProvidersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LastUpdatedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProvidersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1877,7 +5017,7 @@ func (m *LastUpdatedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LastUpdatedResponse) Unmarshal(dAtA []byte) error {
+func (m *ProvidersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1900,17 +5040,17 @@ func (m *LastUpdatedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LastUpdatedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProvidersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LastUpdatedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdated", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
 			}
-			m.LastUpdated = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1920,11 +5060,26 @@ func (m *LastUpdatedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastUpdated |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Providers = append(m.Providers, ProviderInfo{})
+			if err := m.Providers[len(m.Providers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
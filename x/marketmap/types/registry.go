@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/skip-mev/connect/v2/pkg/json"
+)
+
+// ValidateBasic performs stateless validation of a ProviderInfo. It checks that the name is non-empty, that the
+// type and status are valid, and that the default metadata is valid JSON within the maximum metadata length which
+// conforms to the metadata schema of the provider, if any.
+func (p *ProviderInfo) ValidateBasic() error {
+	if len(p.Name) == 0 {
+		return fmt.Errorf("provider name must not be empty")
+	}
+
+	if _, ok := ProviderType_name[int32(p.Type)]; !ok || p.Type == PROVIDER_TYPE_UNSPECIFIED {
+		return fmt.Errorf("invalid type %d of provider %s", p.Type, p.Name)
+	}
+
+	if _, ok := ProviderStatus_name[int32(p.Status)]; !ok || p.Status == PROVIDER_STATUS_UNSPECIFIED {
+		return fmt.Errorf("invalid status %d of provider %s", p.Status, p.Name)
+	}
+
+	if len(p.DefaultMetadata_JSON) > MaxMetadataJSONFieldLength {
+		return fmt.Errorf("default metadata json field is longer than maximum length of %d", MaxMetadataJSONFieldLength)
+	}
+
+	if err := json.IsValid([]byte(p.DefaultMetadata_JSON)); err != nil {
+		return fmt.Errorf("invalid default metadata json of provider %s: %w", p.Name, err)
+	}
+
+	return DefaultMetadataSchemaRegistry.ValidateProviderMetadata(p.Name, p.DefaultMetadata_JSON)
+}
+
+// ValidateProviders performs stateless validation of a set of providers, checking that each provider is valid and
+// that no two providers share a name.
+func ValidateProviders(providers []ProviderInfo) error {
+	seen := make(map[string]struct{}, len(providers))
+	for _, provider := range providers {
+		if _, ok := seen[provider.Name]; ok {
+			return fmt.Errorf("duplicate provider %s", provider.Name)
+		}
+
+		if err := provider.ValidateBasic(); err != nil {
+			return err
+		}
+
+		seen[provider.Name] = struct{}{}
+	}
+
+	return nil
+}
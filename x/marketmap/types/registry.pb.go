@@ -0,0 +1,535 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: connect/marketmap/v2/registry.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ProviderType is the kind of source a registered provider fetches prices
+// from.
+type ProviderType int32
+
+const (
+	// PROVIDER_TYPE_UNSPECIFIED is an invalid provider type.
+	PROVIDER_TYPE_UNSPECIFIED ProviderType = 0
+	// PROVIDER_TYPE_CEX is a centralized exchange.
+	PROVIDER_TYPE_CEX ProviderType = 1
+	// PROVIDER_TYPE_DEX is a decentralized exchange.
+	PROVIDER_TYPE_DEX ProviderType = 2
+	// PROVIDER_TYPE_AGGREGATOR is a price aggregator.
+	PROVIDER_TYPE_AGGREGATOR ProviderType = 3
+)
+
+var ProviderType_name = map[int32]string{
+	0: "PROVIDER_TYPE_UNSPECIFIED",
+	1: "PROVIDER_TYPE_CEX",
+	2: "PROVIDER_TYPE_DEX",
+	3: "PROVIDER_TYPE_AGGREGATOR",
+}
+
+var ProviderType_value = map[string]int32{
+	"PROVIDER_TYPE_UNSPECIFIED": 0,
+	"PROVIDER_TYPE_CEX":         1,
+	"PROVIDER_TYPE_DEX":         2,
+	"PROVIDER_TYPE_AGGREGATOR":  3,
+}
+
+func (x ProviderType) String() string {
+	return proto.EnumName(ProviderType_name, int32(x))
+}
+
+func (ProviderType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3b3690afb7abea44, []int{0}
+}
+
+// ProviderStatus is the status of a registered provider.
+type ProviderStatus int32
+
+const (
+	// PROVIDER_STATUS_UNSPECIFIED is an invalid provider status.
+	PROVIDER_STATUS_UNSPECIFIED ProviderStatus = 0
+	// PROVIDER_STATUS_ACTIVE is a provider that may be used by markets.
+	PROVIDER_STATUS_ACTIVE ProviderStatus = 1
+	// PROVIDER_STATUS_DEPRECATED is a provider that is still used by existing
+	// markets, but may not be added to any market.
+	PROVIDER_STATUS_DEPRECATED ProviderStatus = 2
+)
+
+var ProviderStatus_name = map[int32]string{
+	0: "PROVIDER_STATUS_UNSPECIFIED",
+	1: "PROVIDER_STATUS_ACTIVE",
+	2: "PROVIDER_STATUS_DEPRECATED",
+}
+
+var ProviderStatus_value = map[string]int32{
+	"PROVIDER_STATUS_UNSPECIFIED": 0,
+	"PROVIDER_STATUS_ACTIVE":      1,
+	"PROVIDER_STATUS_DEPRECATED":  2,
+}
+
+func (x ProviderStatus) String() string {
+	return proto.EnumName(ProviderStatus_name, int32(x))
+}
+
+func (ProviderStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3b3690afb7abea44, []int{1}
+}
+
+// ProviderInfo is a provider registered in the provider registry. Once the
+// registry is non-empty, the name of every provider config of a created or
+// updated market must be registered.
+type ProviderInfo struct {
+	// Name is the name of the provider, which must match the name of the
+	// provider in the oracle configuration of the sidecar.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Type is the kind of source the provider fetches prices from.
+	Type ProviderType `protobuf:"varint,2,opt,name=type,proto3,enum=connect.marketmap.v2.ProviderType" json:"type,omitempty"`
+	// Status is the status of the provider.
+	Status ProviderStatus `protobuf:"varint,3,opt,name=status,proto3,enum=connect.marketmap.v2.ProviderStatus" json:"status,omitempty"`
+	// DefaultMetadata_JSON is the metadata JSON given to the provider configs of
+	// this provider that are created or updated without metadata.
+	DefaultMetadata_JSON string `protobuf:"bytes,4,opt,name=default_metadata_JSON,json=defaultMetadataJSON,proto3" json:"default_metadata_JSON,omitempty"`
+}
+
+func (m *ProviderInfo) Reset()         { *m = ProviderInfo{} }
+func (m *ProviderInfo) String() string { return proto.CompactTextString(m) }
+func (*ProviderInfo) ProtoMessage()    {}
+func (*ProviderInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b3690afb7abea44, []int{0}
+}
+func (m *ProviderInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProviderInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderInfo.Merge(m, src)
+}
+func (m *ProviderInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderInfo proto.InternalMessageInfo
+
+func (m *ProviderInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ProviderInfo) GetType() ProviderType {
+	if m != nil {
+		return m.Type
+	}
+	return PROVIDER_TYPE_UNSPECIFIED
+}
+
+func (m *ProviderInfo) GetStatus() ProviderStatus {
+	if m != nil {
+		return m.Status
+	}
+	return PROVIDER_STATUS_UNSPECIFIED
+}
+
+func (m *ProviderInfo) GetDefaultMetadata_JSON() string {
+	if m != nil {
+		return m.DefaultMetadata_JSON
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("connect.marketmap.v2.ProviderType", ProviderType_name, ProviderType_value)
+	proto.RegisterEnum("connect.marketmap.v2.ProviderStatus", ProviderStatus_name, ProviderStatus_value)
+	proto.RegisterType((*ProviderInfo)(nil), "connect.marketmap.v2.ProviderInfo")
+}
+
+func init() {
+	proto.RegisterFile("connect/marketmap/v2/registry.proto", fileDescriptor_3b3690afb7abea44)
+}
+
+var fileDescriptor_3b3690afb7abea44 = []byte{
+	// 405 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x41, 0x8b, 0xd3, 0x40,
+	0x1c, 0xc5, 0x33, 0xdd, 0xb0, 0xe0, 0xb0, 0x2c, 0x71, 0xdc, 0x95, 0x18, 0x75, 0x5c, 0x56, 0x0f,
+	0xcb, 0x82, 0x89, 0x44, 0xf0, 0xe4, 0x25, 0x26, 0x63, 0xc9, 0x82, 0xdb, 0x30, 0xc9, 0x2e, 0xab,
+	0x97, 0x30, 0xdb, 0x4e, 0x63, 0xa8, 0xc9, 0x84, 0x64, 0x1a, 0xec, 0xc9, 0xab, 0x47, 0xbf, 0x83,
+	0x5f, 0xc6, 0x83, 0x87, 0x1e, 0x3d, 0x4a, 0xfb, 0x45, 0xa4, 0x69, 0x5a, 0xda, 0x2a, 0xde, 0xfe,
+	0xfc, 0xdf, 0xef, 0xfd, 0xf3, 0xc2, 0x3c, 0xf8, 0xb4, 0x2f, 0xf2, 0x9c, 0xf7, 0xa5, 0x95, 0xb1,
+	0x72, 0xc4, 0x65, 0xc6, 0x0a, 0xab, 0xb6, 0xad, 0x92, 0x27, 0x69, 0x25, 0xcb, 0x89, 0x59, 0x94,
+	0x42, 0x0a, 0x74, 0xd4, 0x42, 0xe6, 0x1a, 0x32, 0x6b, 0xdb, 0x38, 0x4a, 0x44, 0x22, 0x1a, 0xc0,
+	0x5a, 0x4c, 0x4b, 0xf6, 0xf4, 0x27, 0x80, 0x07, 0x41, 0x29, 0xea, 0x74, 0xc0, 0x4b, 0x3f, 0x1f,
+	0x0a, 0x84, 0xa0, 0x9a, 0xb3, 0x8c, 0xeb, 0xe0, 0x04, 0x9c, 0xdd, 0xa1, 0xcd, 0x8c, 0x5e, 0x41,
+	0x55, 0x4e, 0x0a, 0xae, 0x77, 0x4e, 0xc0, 0xd9, 0xa1, 0x7d, 0x6a, 0xfe, 0xeb, 0xbe, 0xb9, 0xba,
+	0x12, 0x4d, 0x0a, 0x4e, 0x1b, 0x1e, 0xbd, 0x86, 0xfb, 0x95, 0x64, 0x72, 0x5c, 0xe9, 0x7b, 0x8d,
+	0xf3, 0xd9, 0xff, 0x9d, 0x61, 0xc3, 0xd2, 0xd6, 0x83, 0x6c, 0x78, 0x3c, 0xe0, 0x43, 0x36, 0xfe,
+	0x24, 0xe3, 0x8c, 0x4b, 0x36, 0x60, 0x92, 0xc5, 0x17, 0x61, 0xef, 0x52, 0x57, 0x9b, 0x68, 0xf7,
+	0x5a, 0xf1, 0x5d, 0xab, 0x2d, 0xa4, 0xf3, 0x2f, 0xf0, 0x60, 0x33, 0x07, 0x7a, 0x0c, 0x1f, 0x04,
+	0xb4, 0x77, 0xed, 0x7b, 0x84, 0xc6, 0xd1, 0xfb, 0x80, 0xc4, 0x57, 0x97, 0x61, 0x40, 0x5c, 0xff,
+	0xad, 0x4f, 0x3c, 0x4d, 0x41, 0xc7, 0xf0, 0xee, 0xb6, 0xec, 0x92, 0x1b, 0x0d, 0xfc, 0xbd, 0xf6,
+	0xc8, 0x8d, 0xd6, 0x41, 0x8f, 0xa0, 0xbe, 0xbd, 0x76, 0xba, 0x5d, 0x4a, 0xba, 0x4e, 0xd4, 0xa3,
+	0xda, 0x9e, 0xa1, 0x7e, 0xfd, 0x8e, 0x95, 0xf3, 0x0a, 0x1e, 0x6e, 0xff, 0x0e, 0x7a, 0x02, 0x1f,
+	0xae, 0x5d, 0x61, 0xe4, 0x44, 0x57, 0xe1, 0x4e, 0x08, 0x03, 0xde, 0xdf, 0x05, 0x1c, 0x37, 0xf2,
+	0xaf, 0x89, 0x06, 0x10, 0x86, 0xc6, 0xae, 0xe6, 0x91, 0x80, 0x12, 0xd7, 0x89, 0x88, 0xa7, 0x75,
+	0x96, 0x1f, 0x7d, 0x73, 0xf1, 0x63, 0x86, 0xc1, 0x74, 0x86, 0xc1, 0xef, 0x19, 0x06, 0xdf, 0xe6,
+	0x58, 0x99, 0xce, 0xb1, 0xf2, 0x6b, 0x8e, 0x95, 0x0f, 0x2f, 0x92, 0x54, 0x7e, 0x1c, 0xdf, 0x9a,
+	0x7d, 0x91, 0x59, 0xd5, 0x28, 0x2d, 0x9e, 0x67, 0xbc, 0xb6, 0x56, 0x1d, 0xaa, 0x6d, 0xeb, 0xf3,
+	0x46, 0x91, 0x16, 0x4f, 0x56, 0xdd, 0xee, 0x37, 0xbd, 0x78, 0xf9, 0x67, 0x00, 0x8c, 0xa9, 0x02,
+	0xd6, 0x6a, 0x02, 0x00, 0x00,
+}
+
+func (m *ProviderInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DefaultMetadata_JSON) > 0 {
+		i -= len(m.DefaultMetadata_JSON)
+		copy(dAtA[i:], m.DefaultMetadata_JSON)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.DefaultMetadata_JSON)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Status != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Type != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRegistry(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRegistry(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ProviderInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovRegistry(uint64(m.Type))
+	}
+	if m.Status != 0 {
+		n += 1 + sovRegistry(uint64(m.Status))
+	}
+	l = len(m.DefaultMetadata_JSON)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	return n
+}
+
+func sovRegistry(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRegistry(x uint64) (n int) {
+	return sovRegistry(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ProviderInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRegistry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ProviderType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ProviderStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultMetadata_JSON", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefaultMetadata_JSON = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRegistry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRegistry(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRegistry
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRegistry
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRegistry
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRegistry
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRegistry        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRegistry          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRegistry = fmt.Errorf("proto: unexpected end of group")
+)
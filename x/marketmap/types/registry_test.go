@@ -0,0 +1,114 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/testutil"
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+func TestProviderInfoValidateBasic(t *testing.T) {
+	tcs := []struct {
+		name       string
+		provider   types.ProviderInfo
+		expectPass bool
+	}{
+		{
+			"valid provider",
+			types.ProviderInfo{
+				Name:   "binance_api",
+				Type:   types.PROVIDER_TYPE_CEX,
+				Status: types.PROVIDER_STATUS_ACTIVE,
+			},
+			true,
+		},
+		{
+			"valid provider with default metadata",
+			types.ProviderInfo{
+				Name:                 "uniswapv3_api-ethereum",
+				Type:                 types.PROVIDER_TYPE_DEX,
+				Status:               types.PROVIDER_STATUS_DEPRECATED,
+				DefaultMetadata_JSON: `{"address":"0x88e6A0c2dDD26FEEb64F039a2c41296FcB3f5640","base_decimals":18,"quote_decimals":6,"invert":false}`,
+			},
+			true,
+		},
+		{
+			"empty name - fail",
+			types.ProviderInfo{
+				Type:   types.PROVIDER_TYPE_CEX,
+				Status: types.PROVIDER_STATUS_ACTIVE,
+			},
+			false,
+		},
+		{
+			"unspecified type - fail",
+			types.ProviderInfo{
+				Name:   "binance_api",
+				Status: types.PROVIDER_STATUS_ACTIVE,
+			},
+			false,
+		},
+		{
+			"unknown type - fail",
+			types.ProviderInfo{
+				Name:   "binance_api",
+				Type:   types.ProviderType(10),
+				Status: types.PROVIDER_STATUS_ACTIVE,
+			},
+			false,
+		},
+		{
+			"unspecified status - fail",
+			types.ProviderInfo{
+				Name: "binance_api",
+				Type: types.PROVIDER_TYPE_AGGREGATOR,
+			},
+			false,
+		},
+		{
+			"invalid default metadata - fail",
+			types.ProviderInfo{
+				Name:                 "binance_api",
+				Type:                 types.PROVIDER_TYPE_CEX,
+				Status:               types.PROVIDER_STATUS_ACTIVE,
+				DefaultMetadata_JSON: "{",
+			},
+			false,
+		},
+		{
+			"default metadata too long - fail",
+			types.ProviderInfo{
+				Name:                 "binance_api",
+				Type:                 types.PROVIDER_TYPE_CEX,
+				Status:               types.PROVIDER_STATUS_ACTIVE,
+				DefaultMetadata_JSON: testutil.RandomString(types.MaxMetadataJSONFieldLength + 1),
+			},
+			false,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.provider.ValidateBasic()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateProviders(t *testing.T) {
+	provider := types.ProviderInfo{
+		Name:   "binance_api",
+		Type:   types.PROVIDER_TYPE_CEX,
+		Status: types.PROVIDER_STATUS_ACTIVE,
+	}
+
+	require.NoError(t, types.ValidateProviders(nil))
+	require.NoError(t, types.ValidateProviders([]types.ProviderInfo{provider}))
+	require.Error(t, types.ValidateProviders([]types.ProviderInfo{provider, provider}))
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// ValidateBasic performs stateless validation of a ScheduledMarketChange. It checks that the action
+// is specified, that exactly one of the target height and time is set, and that a market is given
+// if and only if the market is updated. The market of an update must be valid and must be keyed by
+// the currency pair of the change.
+func (c *ScheduledMarketChange) ValidateBasic() error {
+	if err := c.CurrencyPair.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if _, ok := ScheduledMarketAction_name[int32(c.Action)]; !ok || c.Action == SCHEDULED_MARKET_ACTION_UNSPECIFIED {
+		return fmt.Errorf("invalid action %d for scheduled change of market %s", c.Action, c.CurrencyPair.String())
+	}
+
+	if (c.Height == 0) == (c.Time == nil) {
+		return fmt.Errorf("exactly one of height and time must be set for scheduled change of market %s", c.CurrencyPair.String())
+	}
+
+	if c.Action != SCHEDULED_MARKET_ACTION_UPDATE {
+		if c.Market != nil {
+			return fmt.Errorf("market can only be set for scheduled updates of market %s", c.CurrencyPair.String())
+		}
+
+		return nil
+	}
+
+	if c.Market == nil {
+		return fmt.Errorf("market must be set for scheduled update of market %s", c.CurrencyPair.String())
+	}
+
+	if err := c.Market.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if c.Market.Ticker.String() != c.CurrencyPair.String() {
+		return fmt.Errorf("market %s does not match currency pair %s of scheduled update",
+			c.Market.Ticker.String(), c.CurrencyPair.String())
+	}
+
+	return nil
+}
+
+// IsDue returns true if the change must be executed in a block with the given height and time.
+func (c *ScheduledMarketChange) IsDue(height uint64, blockTime time.Time) bool {
+	if c.Time != nil {
+		return !c.Time.After(blockTime)
+	}
+
+	return c.Height <= height
+}
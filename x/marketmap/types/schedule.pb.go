@@ -0,0 +1,674 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: connect/marketmap/v2/schedule.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	types "github.com/skip-mev/connect/v2/pkg/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ScheduledMarketAction is the change that a ScheduledMarketChange performs on
+// a market.
+type ScheduledMarketAction int32
+
+const (
+	// SCHEDULED_MARKET_ACTION_UNSPECIFIED is an invalid action.
+	SCHEDULED_MARKET_ACTION_UNSPECIFIED ScheduledMarketAction = 0
+	// SCHEDULED_MARKET_ACTION_ENABLE enables the market.
+	SCHEDULED_MARKET_ACTION_ENABLE ScheduledMarketAction = 1
+	// SCHEDULED_MARKET_ACTION_DISABLE disables the market.
+	SCHEDULED_MARKET_ACTION_DISABLE ScheduledMarketAction = 2
+	// SCHEDULED_MARKET_ACTION_UPDATE replaces the market with the given market.
+	SCHEDULED_MARKET_ACTION_UPDATE ScheduledMarketAction = 3
+)
+
+var ScheduledMarketAction_name = map[int32]string{
+	0: "SCHEDULED_MARKET_ACTION_UNSPECIFIED",
+	1: "SCHEDULED_MARKET_ACTION_ENABLE",
+	2: "SCHEDULED_MARKET_ACTION_DISABLE",
+	3: "SCHEDULED_MARKET_ACTION_UPDATE",
+}
+
+var ScheduledMarketAction_value = map[string]int32{
+	"SCHEDULED_MARKET_ACTION_UNSPECIFIED": 0,
+	"SCHEDULED_MARKET_ACTION_ENABLE":      1,
+	"SCHEDULED_MARKET_ACTION_DISABLE":     2,
+	"SCHEDULED_MARKET_ACTION_UPDATE":      3,
+}
+
+func (x ScheduledMarketAction) String() string {
+	return proto.EnumName(ScheduledMarketAction_name, int32(x))
+}
+
+func (ScheduledMarketAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6d78a482c3729980, []int{0}
+}
+
+// ScheduledMarketChange is a change to an existing market that is executed at
+// the beginning of the first block whose height or time reaches its target
+// height or time. Exactly one of the target height and time is set.
+type ScheduledMarketChange struct {
+	// ID is the unique identifier of the scheduled change. It is assigned when
+	// the change is scheduled.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Action is the change performed on the market.
+	Action ScheduledMarketAction `protobuf:"varint,2,opt,name=action,proto3,enum=connect.marketmap.v2.ScheduledMarketAction" json:"action,omitempty"`
+	// CurrencyPair is the currency pair of the changed market.
+	CurrencyPair types.CurrencyPair `protobuf:"bytes,3,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair"`
+	// Market is the market that replaces the existing market. It is only set
+	// for SCHEDULED_MARKET_ACTION_UPDATE.
+	Market *Market `protobuf:"bytes,4,opt,name=market,proto3" json:"market,omitempty"`
+	// Height is the block height at which the change is executed.
+	Height uint64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	// Time is the block time at which the change is executed.
+	Time *time.Time `protobuf:"bytes,6,opt,name=time,proto3,stdtime" json:"time,omitempty"`
+	// Authority is the market authority that scheduled the change. It is set
+	// when the change is scheduled, and the change is only executed if the
+	// authority is still permitted to perform it at that time.
+	Authority string `protobuf:"bytes,7,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *ScheduledMarketChange) Reset()         { *m = ScheduledMarketChange{} }
+func (m *ScheduledMarketChange) String() string { return proto.CompactTextString(m) }
+func (*ScheduledMarketChange) ProtoMessage()    {}
+func (*ScheduledMarketChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d78a482c3729980, []int{0}
+}
+func (m *ScheduledMarketChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledMarketChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledMarketChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledMarketChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledMarketChange.Merge(m, src)
+}
+func (m *ScheduledMarketChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledMarketChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledMarketChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledMarketChange proto.InternalMessageInfo
+
+func (m *ScheduledMarketChange) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ScheduledMarketChange) GetAction() ScheduledMarketAction {
+	if m != nil {
+		return m.Action
+	}
+	return SCHEDULED_MARKET_ACTION_UNSPECIFIED
+}
+
+func (m *ScheduledMarketChange) GetCurrencyPair() types.CurrencyPair {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return types.CurrencyPair{}
+}
+
+func (m *ScheduledMarketChange) GetMarket() *Market {
+	if m != nil {
+		return m.Market
+	}
+	return nil
+}
+
+func (m *ScheduledMarketChange) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ScheduledMarketChange) GetTime() *time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *ScheduledMarketChange) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("connect.marketmap.v2.ScheduledMarketAction", ScheduledMarketAction_name, ScheduledMarketAction_value)
+	proto.RegisterType((*ScheduledMarketChange)(nil), "connect.marketmap.v2.ScheduledMarketChange")
+}
+
+func init() {
+	proto.RegisterFile("connect/marketmap/v2/schedule.proto", fileDescriptor_6d78a482c3729980)
+}
+
+var fileDescriptor_6d78a482c3729980 = []byte{
+	// 514 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x41, 0x6e, 0xd3, 0x40,
+	0x14, 0x86, 0x3d, 0x69, 0x08, 0x74, 0x80, 0xaa, 0x1a, 0xa5, 0xc8, 0x44, 0xc8, 0x09, 0x0d, 0x12,
+	0x11, 0xa8, 0x36, 0x0a, 0x12, 0x42, 0xec, 0x1c, 0xc7, 0x08, 0x43, 0x1b, 0x22, 0x3b, 0xd9, 0xb0,
+	0xb1, 0x1c, 0x7b, 0xb0, 0x47, 0xad, 0x3d, 0x96, 0x3d, 0x8e, 0xc8, 0x0d, 0x58, 0xf6, 0x0e, 0x1c,
+	0x01, 0x0e, 0xd1, 0x65, 0xc5, 0x8a, 0x15, 0xa0, 0xe4, 0x1c, 0x48, 0xc8, 0xe3, 0x49, 0x29, 0x28,
+	0x65, 0x97, 0x97, 0xf7, 0xbd, 0xdf, 0xff, 0x7b, 0xff, 0xc0, 0xae, 0x4f, 0x93, 0x04, 0xfb, 0x4c,
+	0x8b, 0xbd, 0xec, 0x18, 0xb3, 0xd8, 0x4b, 0xb5, 0x79, 0x5f, 0xcb, 0xfd, 0x08, 0x07, 0xc5, 0x09,
+	0x56, 0xd3, 0x8c, 0x32, 0x8a, 0x9a, 0x02, 0x52, 0x2f, 0x20, 0x75, 0xde, 0x6f, 0x35, 0x43, 0x1a,
+	0x52, 0x0e, 0x68, 0xe5, 0xaf, 0x8a, 0x6d, 0xdd, 0xf5, 0x69, 0x1e, 0xd3, 0xdc, 0xad, 0x1a, 0x55,
+	0x21, 0x5a, 0xed, 0x90, 0xd2, 0xf0, 0x04, 0x6b, 0xbc, 0x9a, 0x15, 0xef, 0x35, 0x46, 0x62, 0x9c,
+	0x33, 0x2f, 0x4e, 0x05, 0xf0, 0x60, 0x6d, 0x86, 0x2d, 0x52, 0x9c, 0x97, 0x46, 0xfc, 0x22, 0xcb,
+	0x70, 0xe2, 0x2f, 0xdc, 0xd4, 0x23, 0x99, 0xa0, 0xee, 0x6f, 0xb4, 0x5c, 0x15, 0x15, 0xb2, 0xff,
+	0xab, 0x06, 0xf7, 0x1c, 0xb1, 0x43, 0x70, 0xc4, 0x3b, 0x46, 0xe4, 0x25, 0x21, 0x46, 0x3b, 0xb0,
+	0x46, 0x02, 0x19, 0x74, 0x40, 0xaf, 0x6e, 0xd7, 0x48, 0x80, 0x0c, 0xd8, 0xf0, 0x7c, 0x46, 0x68,
+	0x22, 0xd7, 0x3a, 0xa0, 0xb7, 0xd3, 0x7f, 0xac, 0x6e, 0xda, 0x55, 0xfd, 0x47, 0x4c, 0xe7, 0x23,
+	0xb6, 0x18, 0x45, 0x16, 0xbc, 0xfd, 0x97, 0x51, 0x79, 0xab, 0x03, 0x7a, 0x37, 0xfb, 0xca, 0x85,
+	0x16, 0xdf, 0xa7, 0xd4, 0x31, 0x04, 0x36, 0xf6, 0x48, 0x36, 0xa8, 0x9f, 0x7d, 0x6f, 0x4b, 0xf6,
+	0x2d, 0xff, 0xd2, 0x7f, 0xe8, 0x05, 0x6c, 0x54, 0x1f, 0x96, 0xeb, 0x5c, 0xe3, 0xde, 0x66, 0x3f,
+	0x95, 0x0d, 0xae, 0x00, 0x6c, 0x31, 0x81, 0xee, 0xc0, 0x46, 0x84, 0x49, 0x18, 0x31, 0xf9, 0x1a,
+	0xdf, 0x4f, 0x54, 0xe8, 0x39, 0xac, 0x97, 0x97, 0x96, 0x1b, 0x5c, 0xb1, 0xa5, 0x56, 0x31, 0xa8,
+	0xeb, 0x18, 0xd4, 0xc9, 0x3a, 0x86, 0xc1, 0x8d, 0x52, 0xef, 0xf4, 0x47, 0x1b, 0xd8, 0x7c, 0x02,
+	0x3d, 0x83, 0xdb, 0x5e, 0xc1, 0x22, 0x9a, 0x11, 0xb6, 0x90, 0xaf, 0x77, 0x40, 0x6f, 0x7b, 0x20,
+	0x7f, 0xfd, 0x72, 0xd0, 0x14, 0xb1, 0xea, 0x41, 0x90, 0xe1, 0x3c, 0x77, 0x58, 0x46, 0x92, 0xd0,
+	0xfe, 0x83, 0x3e, 0xfa, 0x0c, 0xe0, 0xde, 0xc6, 0x93, 0xa1, 0x87, 0xb0, 0xeb, 0x18, 0xaf, 0xcc,
+	0xe1, 0xf4, 0xd0, 0x1c, 0xba, 0x47, 0xba, 0xfd, 0xc6, 0x9c, 0xb8, 0xba, 0x31, 0xb1, 0xde, 0x8e,
+	0xdc, 0xe9, 0xc8, 0x19, 0x9b, 0x86, 0xf5, 0xd2, 0x32, 0x87, 0xbb, 0x12, 0xda, 0x87, 0xca, 0x55,
+	0xa0, 0x39, 0xd2, 0x07, 0x87, 0xe6, 0x2e, 0x40, 0x5d, 0xd8, 0xbe, 0x8a, 0x19, 0x5a, 0x0e, 0x87,
+	0x6a, 0xff, 0x13, 0x9a, 0x8e, 0x87, 0xfa, 0xc4, 0xdc, 0xdd, 0x6a, 0xd5, 0x3f, 0x7e, 0x52, 0xa4,
+	0xc1, 0xeb, 0xb3, 0xa5, 0x02, 0xce, 0x97, 0x0a, 0xf8, 0xb9, 0x54, 0xc0, 0xe9, 0x4a, 0x91, 0xce,
+	0x57, 0x8a, 0xf4, 0x6d, 0xa5, 0x48, 0xef, 0x9e, 0x84, 0x84, 0x45, 0xc5, 0x4c, 0xf5, 0x69, 0xac,
+	0xe5, 0xc7, 0x24, 0x3d, 0x88, 0xf1, 0x5c, 0x5b, 0x3f, 0xc3, 0x79, 0x5f, 0xfb, 0x70, 0xe9, 0x2d,
+	0xf2, 0xac, 0x67, 0x0d, 0x7e, 0xdd, 0xa7, 0xbf, 0x07, 0x00, 0xb5, 0x45, 0x69, 0x7c, 0x60, 0x03,
+	0x00, 0x00,
+}
+
+func (m *ScheduledMarketChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledMarketChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledMarketChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Time != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintSchedule(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Height != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Market != nil {
+		{
+			size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.CurrencyPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSchedule(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Action != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSchedule(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSchedule(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ScheduledMarketChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSchedule(uint64(m.Id))
+	}
+	if m.Action != 0 {
+		n += 1 + sovSchedule(uint64(m.Action))
+	}
+	l = m.CurrencyPair.Size()
+	n += 1 + l + sovSchedule(uint64(l))
+	if m.Market != nil {
+		l = m.Market.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSchedule(uint64(m.Height))
+	}
+	if m.Time != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time)
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	return n
+}
+
+func sovSchedule(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSchedule(x uint64) (n int) {
+	return sovSchedule(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ScheduledMarketChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledMarketChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledMarketChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= ScheduledMarketAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Market == nil {
+				m.Market = &Market{}
+			}
+			if err := m.Market.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Time == nil {
+				m.Time = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSchedule(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSchedule
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSchedule
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSchedule
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSchedule        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSchedule          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSchedule = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,165 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+func TestScheduledMarketChangeValidateBasic(t *testing.T) {
+	now := time.Now()
+
+	tcs := []struct {
+		name       string
+		change     types.ScheduledMarketChange
+		expectPass bool
+	}{
+		{
+			"valid enable at height",
+			types.ScheduledMarketChange{
+				Action:       types.SCHEDULED_MARKET_ACTION_ENABLE,
+				CurrencyPair: btcusdtCP,
+				Height:       10,
+			},
+			true,
+		},
+		{
+			"valid disable at time",
+			types.ScheduledMarketChange{
+				Action:       types.SCHEDULED_MARKET_ACTION_DISABLE,
+				CurrencyPair: btcusdtCP,
+				Time:         &now,
+			},
+			true,
+		},
+		{
+			"valid update",
+			types.ScheduledMarketChange{
+				Action:       types.SCHEDULED_MARKET_ACTION_UPDATE,
+				CurrencyPair: btcusdtCP,
+				Market:       &btcusdt,
+				Height:       10,
+			},
+			true,
+		},
+		{
+			"invalid currency pair - fail",
+			types.ScheduledMarketChange{
+				Action:       types.SCHEDULED_MARKET_ACTION_ENABLE,
+				CurrencyPair: connecttypes.CurrencyPair{Base: "BTC"},
+				Height:       10,
+			},
+			false,
+		},
+		{
+			"unspecified action - fail",
+			types.ScheduledMarketChange{
+				CurrencyPair: btcusdtCP,
+				Height:       10,
+			},
+			false,
+		},
+		{
+			"unknown action - fail",
+			types.ScheduledMarketChange{
+				Action:       types.ScheduledMarketAction(10),
+				CurrencyPair: btcusdtCP,
+				Height:       10,
+			},
+			false,
+		},
+		{
+			"no target - fail",
+			types.ScheduledMarketChange{
+				Action:       types.SCHEDULED_MARKET_ACTION_ENABLE,
+				CurrencyPair: btcusdtCP,
+			},
+			false,
+		},
+		{
+			"both height and time - fail",
+			types.ScheduledMarketChange{
+				Action:       types.SCHEDULED_MARKET_ACTION_ENABLE,
+				CurrencyPair: btcusdtCP,
+				Height:       10,
+				Time:         &now,
+			},
+			false,
+		},
+		{
+			"market set for enable - fail",
+			types.ScheduledMarketChange{
+				Action:       types.SCHEDULED_MARKET_ACTION_ENABLE,
+				CurrencyPair: btcusdtCP,
+				Market:       &btcusdt,
+				Height:       10,
+			},
+			false,
+		},
+		{
+			"update without market - fail",
+			types.ScheduledMarketChange{
+				Action:       types.SCHEDULED_MARKET_ACTION_UPDATE,
+				CurrencyPair: btcusdtCP,
+				Height:       10,
+			},
+			false,
+		},
+		{
+			"update with invalid market - fail",
+			types.ScheduledMarketChange{
+				Action:       types.SCHEDULED_MARKET_ACTION_UPDATE,
+				CurrencyPair: btcusdtCP,
+				Market: &types.Market{
+					Ticker: btcusdt.Ticker,
+				},
+				Height: 10,
+			},
+			false,
+		},
+		{
+			"update with mismatched market - fail",
+			types.ScheduledMarketChange{
+				Action:       types.SCHEDULED_MARKET_ACTION_UPDATE,
+				CurrencyPair: btcusdtCP,
+				Market:       &ethusdt,
+				Height:       10,
+			},
+			false,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.change.ValidateBasic()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestScheduledMarketChangeIsDue(t *testing.T) {
+	now := time.Now()
+	later := now.Add(time.Minute)
+
+	t.Run("height target", func(t *testing.T) {
+		change := types.ScheduledMarketChange{Height: 10}
+		require.False(t, change.IsDue(9, later))
+		require.True(t, change.IsDue(10, now))
+		require.True(t, change.IsDue(11, now))
+	})
+
+	t.Run("time target", func(t *testing.T) {
+		change := types.ScheduledMarketChange{Time: &later}
+		require.False(t, change.IsDue(100, now))
+		require.True(t, change.IsDue(1, later))
+		require.True(t, change.IsDue(1, later.Add(time.Second)))
+	})
+}
@@ -0,0 +1,230 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CurrencyPairMatcherWildcard is the string representation of an empty base or quote of a CurrencyPairMatcher,
+// i.e. one that matches any base or quote.
+const CurrencyPairMatcherWildcard = "*"
+
+// CurrencyPairMatcherFromString parses a CurrencyPairMatcher from its string representation BASE/QUOTE, where
+// either the base or the quote may be the wildcard "*", e.g. "BTC/*" or "*/USDT".
+func CurrencyPairMatcherFromString(s string) (CurrencyPairMatcher, error) {
+	base, quote, found := strings.Cut(s, "/")
+	if !found {
+		return CurrencyPairMatcher{}, fmt.Errorf("invalid currency pair matcher %q: expected BASE/QUOTE", s)
+	}
+
+	if base == CurrencyPairMatcherWildcard {
+		base = ""
+	}
+
+	if quote == CurrencyPairMatcherWildcard {
+		quote = ""
+	}
+
+	m := CurrencyPairMatcher{Base: base, Quote: quote}
+	return m, m.ValidateBasic()
+}
+
+// String returns the string representation of the matcher, i.e. BASE/QUOTE where an empty base or quote is
+// represented by the wildcard "*".
+func (m CurrencyPairMatcher) String() string {
+	base, quote := m.Base, m.Quote
+	if base == "" {
+		base = CurrencyPairMatcherWildcard
+	}
+
+	if quote == "" {
+		quote = CurrencyPairMatcherWildcard
+	}
+
+	return base + "/" + quote
+}
+
+// ValidateBasic checks that at least one of the base and quote is set, and that neither contains a "/" or the
+// wildcard.
+func (m CurrencyPairMatcher) ValidateBasic() error {
+	if m.Base == "" && m.Quote == "" {
+		return fmt.Errorf("currency pair matcher must have a base or a quote")
+	}
+
+	for _, asset := range []string{m.Base, m.Quote} {
+		if strings.ContainsAny(asset, "/"+CurrencyPairMatcherWildcard) {
+			return fmt.Errorf("invalid asset %q in currency pair matcher", asset)
+		}
+	}
+
+	return nil
+}
+
+// Matches returns true if the base and quote of the ticker match the base and quote of the matcher. An empty base
+// or quote matches any base or quote.
+func (m CurrencyPairMatcher) Matches(ticker Ticker) bool {
+	cp := ticker.CurrencyPair
+	return (m.Base == "" || m.Base == cp.Base) && (m.Quote == "" || m.Quote == cp.Quote)
+}
+
+// ValidateBasic performs stateless validation of a MarketAuthorityScope. It checks that the authority is a valid
+// address, that the scope has at least one currency pair matcher or metadata tag, and that at least one permission
+// is granted. Matchers, tags and permissions must be valid and unique.
+func (s *MarketAuthorityScope) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(s.Authority); err != nil {
+		return fmt.Errorf("invalid scoped market authority: %w", err)
+	}
+
+	if len(s.CurrencyPairs) == 0 && len(s.MetadataTags) == 0 {
+		return fmt.Errorf("scope of %s must have at least one currency pair matcher or metadata tag", s.Authority)
+	}
+
+	seenMatchers := make(map[CurrencyPairMatcher]struct{}, len(s.CurrencyPairs))
+	for _, matcher := range s.CurrencyPairs {
+		if err := matcher.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid scope of %s: %w", s.Authority, err)
+		}
+
+		if _, seen := seenMatchers[matcher]; seen {
+			return fmt.Errorf("duplicate currency pair matcher %s in scope of %s", matcher, s.Authority)
+		}
+
+		seenMatchers[matcher] = struct{}{}
+	}
+
+	if err := validateUniqueStrings("metadata tag", s.MetadataTags); err != nil {
+		return err
+	}
+
+	if len(s.Permissions) == 0 {
+		return fmt.Errorf("scope of %s must have at least one permission", s.Authority)
+	}
+
+	seenPermissions := make(map[MarketPermission]struct{}, len(s.Permissions))
+	for _, permission := range s.Permissions {
+		if _, ok := MarketPermission_name[int32(permission)]; !ok || permission == MARKET_PERMISSION_UNSPECIFIED {
+			return fmt.Errorf("invalid permission %d in scope of %s", permission, s.Authority)
+		}
+
+		if _, seen := seenPermissions[permission]; seen {
+			return fmt.Errorf("duplicate permission %s in scope of %s", permission, s.Authority)
+		}
+
+		seenPermissions[permission] = struct{}{}
+	}
+
+	return nil
+}
+
+// Matches returns true if the given market is within the scope, i.e. if its ticker matches one of the currency
+// pair matchers of the scope, or if its metadata contains one of the metadata tags of the scope.
+func (s *MarketAuthorityScope) Matches(market Market) bool {
+	for _, matcher := range s.CurrencyPairs {
+		if matcher.Matches(market.Ticker) {
+			return true
+		}
+	}
+
+	if len(s.MetadataTags) == 0 {
+		return false
+	}
+
+	for _, tag := range MetadataTags(market.Ticker.Metadata_JSON) {
+		for _, scopeTag := range s.MetadataTags {
+			if tag == scopeTag {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// HasPermission returns true if the scope grants the given permission. MARKET_PERMISSION_ALL grants every
+// permission.
+func (s *MarketAuthorityScope) HasPermission(permission MarketPermission) bool {
+	for _, granted := range s.Permissions {
+		if granted == permission || granted == MARKET_PERMISSION_ALL {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RequiredPermissions returns the permissions needed to change the existing market into the updated market. A nil
+// existing market denotes the creation of the updated market, which requires MARKET_PERMISSION_ALL, as does any
+// change to the ticker other than enabling or disabling it.
+func RequiredPermissions(existing *Market, updated Market) []MarketPermission {
+	if existing == nil {
+		return []MarketPermission{MARKET_PERMISSION_ALL}
+	}
+
+	ticker := updated.Ticker
+	ticker.Enabled = existing.Ticker.Enabled
+	if !existing.Ticker.Equal(ticker) {
+		return []MarketPermission{MARKET_PERMISSION_ALL}
+	}
+
+	var permissions []MarketPermission
+	if existing.Ticker.Enabled != updated.Ticker.Enabled {
+		permissions = append(permissions, MARKET_PERMISSION_ENABLE)
+	}
+
+	if !providerConfigsEqual(existing.ProviderConfigs, updated.ProviderConfigs) {
+		permissions = append(permissions, MARKET_PERMISSION_PROVIDER_CONFIGS)
+	}
+
+	return permissions
+}
+
+// MetadataTags returns the tags of a ticker metadata JSON string, i.e. the strings of its top-level "tags" array.
+// No tags are returned if the metadata is not a JSON object or has no such array.
+func MetadataTags(metadataJSON string) []string {
+	if metadataJSON == "" {
+		return nil
+	}
+
+	var metadata struct {
+		Tags []string `json:"tags"`
+	}
+	if err := json.Unmarshal([]byte(metadataJSON), &metadata); err != nil {
+		return nil
+	}
+
+	return metadata.Tags
+}
+
+func providerConfigsEqual(a, b []ProviderConfig) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func validateUniqueStrings(kind string, values []string) error {
+	seen := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		if value == "" {
+			return fmt.Errorf("%s cannot be empty", kind)
+		}
+
+		if _, ok := seen[value]; ok {
+			return fmt.Errorf("duplicate %s %s", kind, value)
+		}
+
+		seen[value] = struct{}{}
+	}
+
+	return nil
+}
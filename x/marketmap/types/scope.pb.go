@@ -0,0 +1,832 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: connect/marketmap/v2/scope.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MarketPermission is a kind of market change that a scoped market authority
+// may be permitted to make.
+type MarketPermission int32
+
+const (
+	// MARKET_PERMISSION_UNSPECIFIED is an invalid permission.
+	MARKET_PERMISSION_UNSPECIFIED MarketPermission = 0
+	// MARKET_PERMISSION_PROVIDER_CONFIGS permits changing the provider configs of
+	// existing markets.
+	MARKET_PERMISSION_PROVIDER_CONFIGS MarketPermission = 1
+	// MARKET_PERMISSION_ENABLE permits enabling and disabling existing markets.
+	MARKET_PERMISSION_ENABLE MarketPermission = 2
+	// MARKET_PERMISSION_ALL permits any change, including creating, removing and
+	// changing the tickers of markets.
+	MARKET_PERMISSION_ALL MarketPermission = 3
+)
+
+var MarketPermission_name = map[int32]string{
+	0: "MARKET_PERMISSION_UNSPECIFIED",
+	1: "MARKET_PERMISSION_PROVIDER_CONFIGS",
+	2: "MARKET_PERMISSION_ENABLE",
+	3: "MARKET_PERMISSION_ALL",
+}
+
+var MarketPermission_value = map[string]int32{
+	"MARKET_PERMISSION_UNSPECIFIED":      0,
+	"MARKET_PERMISSION_PROVIDER_CONFIGS": 1,
+	"MARKET_PERMISSION_ENABLE":           2,
+	"MARKET_PERMISSION_ALL":              3,
+}
+
+func (x MarketPermission) String() string {
+	return proto.EnumName(MarketPermission_name, int32(x))
+}
+
+func (MarketPermission) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1bd01122fef5fc27, []int{0}
+}
+
+// CurrencyPairMatcher matches the markets whose ticker has the given base and
+// quote. An empty base or quote matches any base or quote, e.g. a matcher with
+// only the quote USDT matches every market quoted in USDT. At least one of the
+// base and quote must be set.
+type CurrencyPairMatcher struct {
+	// Base is the base of the matching tickers, or empty to match any base.
+	Base string `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// Quote is the quote of the matching tickers, or empty to match any quote.
+	Quote string `protobuf:"bytes,2,opt,name=quote,proto3" json:"quote,omitempty"`
+}
+
+func (m *CurrencyPairMatcher) Reset()      { *m = CurrencyPairMatcher{} }
+func (*CurrencyPairMatcher) ProtoMessage() {}
+func (*CurrencyPairMatcher) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bd01122fef5fc27, []int{0}
+}
+func (m *CurrencyPairMatcher) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CurrencyPairMatcher) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CurrencyPairMatcher.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CurrencyPairMatcher) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CurrencyPairMatcher.Merge(m, src)
+}
+func (m *CurrencyPairMatcher) XXX_Size() int {
+	return m.Size()
+}
+func (m *CurrencyPairMatcher) XXX_DiscardUnknown() {
+	xxx_messageInfo_CurrencyPairMatcher.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CurrencyPairMatcher proto.InternalMessageInfo
+
+func (m *CurrencyPairMatcher) GetBase() string {
+	if m != nil {
+		return m.Base
+	}
+	return ""
+}
+
+func (m *CurrencyPairMatcher) GetQuote() string {
+	if m != nil {
+		return m.Quote
+	}
+	return ""
+}
+
+// MarketAuthorityScope grants an authority the permission to make a limited
+// set of changes to the markets that match the scope. A market matches the
+// scope if its ticker matches one of the currency pair matchers, or if its
+// metadata contains one of the metadata tags.
+type MarketAuthorityScope struct {
+	// Authority is the address of the scoped market authority.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// CurrencyPairs are the matchers of the tickers of the matching markets,
+	// e.g. a matcher with base BTC for all markets with base BTC.
+	CurrencyPairs []CurrencyPairMatcher `protobuf:"bytes,2,rep,name=currency_pairs,json=currencyPairs,proto3" json:"currency_pairs"`
+	// MetadataTags are the tags of the matching markets. The tags of a market
+	// are the strings of the "tags" array of its ticker metadata JSON.
+	MetadataTags []string `protobuf:"bytes,3,rep,name=metadata_tags,json=metadataTags,proto3" json:"metadata_tags,omitempty"`
+	// Permissions are the kinds of changes the authority may make to the
+	// matching markets.
+	Permissions []MarketPermission `protobuf:"varint,4,rep,packed,name=permissions,proto3,enum=connect.marketmap.v2.MarketPermission" json:"permissions,omitempty"`
+}
+
+func (m *MarketAuthorityScope) Reset()         { *m = MarketAuthorityScope{} }
+func (m *MarketAuthorityScope) String() string { return proto.CompactTextString(m) }
+func (*MarketAuthorityScope) ProtoMessage()    {}
+func (*MarketAuthorityScope) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bd01122fef5fc27, []int{1}
+}
+func (m *MarketAuthorityScope) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketAuthorityScope) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketAuthorityScope.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketAuthorityScope) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketAuthorityScope.Merge(m, src)
+}
+func (m *MarketAuthorityScope) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketAuthorityScope) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketAuthorityScope.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketAuthorityScope proto.InternalMessageInfo
+
+func (m *MarketAuthorityScope) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MarketAuthorityScope) GetCurrencyPairs() []CurrencyPairMatcher {
+	if m != nil {
+		return m.CurrencyPairs
+	}
+	return nil
+}
+
+func (m *MarketAuthorityScope) GetMetadataTags() []string {
+	if m != nil {
+		return m.MetadataTags
+	}
+	return nil
+}
+
+func (m *MarketAuthorityScope) GetPermissions() []MarketPermission {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("connect.marketmap.v2.MarketPermission", MarketPermission_name, MarketPermission_value)
+	proto.RegisterType((*CurrencyPairMatcher)(nil), "connect.marketmap.v2.CurrencyPairMatcher")
+	proto.RegisterType((*MarketAuthorityScope)(nil), "connect.marketmap.v2.MarketAuthorityScope")
+}
+
+func init() { proto.RegisterFile("connect/marketmap/v2/scope.proto", fileDescriptor_1bd01122fef5fc27) }
+
+var fileDescriptor_1bd01122fef5fc27 = []byte{
+	// 473 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x4f, 0x8b, 0xd3, 0x40,
+	0x18, 0xc6, 0x93, 0xa6, 0x8a, 0x9d, 0x75, 0x97, 0x30, 0x46, 0xc8, 0x16, 0xcd, 0xc6, 0x0a, 0x4b,
+	0x15, 0x36, 0x91, 0x08, 0x1e, 0xbc, 0xa5, 0xdd, 0xac, 0x46, 0xfb, 0x27, 0x24, 0xeb, 0x1e, 0xbc,
+	0x84, 0x69, 0x3a, 0xa4, 0x61, 0x49, 0x26, 0xce, 0x4c, 0x8b, 0xbd, 0x79, 0xf4, 0xe8, 0x45, 0xf0,
+	0xe0, 0x41, 0xf0, 0x2b, 0xf8, 0x21, 0xf6, 0xb8, 0x78, 0xf2, 0x20, 0x22, 0xed, 0x17, 0x91, 0x4d,
+	0xda, 0x5a, 0xb6, 0xbd, 0xbd, 0xef, 0xfb, 0xfc, 0x92, 0x79, 0x1e, 0x78, 0x80, 0x1e, 0x91, 0x2c,
+	0xc3, 0x11, 0x37, 0x53, 0x44, 0xcf, 0x31, 0x4f, 0x51, 0x6e, 0x4e, 0x2c, 0x93, 0x45, 0x24, 0xc7,
+	0x46, 0x4e, 0x09, 0x27, 0x50, 0x59, 0x10, 0xc6, 0x8a, 0x30, 0x26, 0x56, 0x5d, 0x89, 0x49, 0x4c,
+	0x0a, 0xc0, 0xbc, 0x9a, 0x4a, 0xb6, 0xbe, 0x1f, 0x11, 0x96, 0x12, 0x16, 0x96, 0x42, 0xb9, 0x94,
+	0x52, 0xc3, 0x05, 0x77, 0xda, 0x63, 0x4a, 0x71, 0x16, 0x4d, 0x3d, 0x94, 0xd0, 0x2e, 0xe2, 0xd1,
+	0x08, 0x53, 0x08, 0x41, 0x75, 0x80, 0x18, 0x56, 0x45, 0x5d, 0x6c, 0xd6, 0xfc, 0x62, 0x86, 0x0a,
+	0xb8, 0xf1, 0x6e, 0x4c, 0x38, 0x56, 0x2b, 0xc5, 0xb1, 0x5c, 0x9e, 0xdf, 0xfa, 0xf2, 0xed, 0x40,
+	0xf8, 0xf0, 0x5b, 0x17, 0x1a, 0x9f, 0x2b, 0x40, 0xe9, 0x16, 0x66, 0xec, 0x31, 0x1f, 0x11, 0x9a,
+	0xf0, 0x69, 0x70, 0x65, 0x18, 0x3e, 0x03, 0x35, 0xb4, 0xbc, 0x94, 0x7f, 0x6c, 0xa9, 0x3f, 0x7f,
+	0x1c, 0x29, 0x0b, 0x23, 0xf6, 0x70, 0x48, 0x31, 0x63, 0x01, 0xa7, 0x49, 0x16, 0xfb, 0xff, 0x51,
+	0x78, 0x06, 0xf6, 0xa2, 0x85, 0xb7, 0x30, 0x47, 0x09, 0x65, 0x6a, 0x45, 0x97, 0x9a, 0x3b, 0xd6,
+	0x23, 0x63, 0x5b, 0x76, 0x63, 0x4b, 0x8e, 0x56, 0xf5, 0xe2, 0xcf, 0x81, 0xe0, 0xef, 0x46, 0x6b,
+	0x12, 0x83, 0x0f, 0xc1, 0x6e, 0x8a, 0x39, 0x1a, 0x22, 0x8e, 0x42, 0x8e, 0x62, 0xa6, 0x4a, 0xba,
+	0xd4, 0xac, 0xf9, 0xb7, 0x97, 0xc7, 0x53, 0x14, 0x33, 0xf8, 0x12, 0xec, 0xe4, 0x98, 0xa6, 0x09,
+	0x63, 0x09, 0xc9, 0x98, 0x5a, 0xd5, 0xa5, 0xe6, 0x9e, 0x75, 0xb8, 0xfd, 0xe5, 0x32, 0xb5, 0xb7,
+	0xc2, 0xfd, 0xf5, 0x4f, 0x1f, 0x7f, 0x15, 0x81, 0x7c, 0x9d, 0x80, 0x0f, 0xc0, 0xfd, 0xae, 0xed,
+	0xbf, 0x76, 0x4e, 0x43, 0xcf, 0xf1, 0xbb, 0x6e, 0x10, 0xb8, 0xfd, 0x5e, 0xf8, 0xa6, 0x17, 0x78,
+	0x4e, 0xdb, 0x3d, 0x71, 0x9d, 0x63, 0x59, 0x80, 0x87, 0xa0, 0xb1, 0x89, 0x78, 0x7e, 0xff, 0xcc,
+	0x3d, 0x76, 0xfc, 0xb0, 0xdd, 0xef, 0x9d, 0xb8, 0x2f, 0x02, 0x59, 0x84, 0xf7, 0x80, 0xba, 0xc9,
+	0x39, 0x3d, 0xbb, 0xd5, 0x71, 0xe4, 0x0a, 0xdc, 0x07, 0x77, 0x37, 0x55, 0xbb, 0xd3, 0x91, 0xa5,
+	0x7a, 0xf5, 0xe3, 0x77, 0x4d, 0x68, 0xbd, 0xba, 0x98, 0x69, 0xe2, 0xe5, 0x4c, 0x13, 0xff, 0xce,
+	0x34, 0xf1, 0xd3, 0x5c, 0x13, 0x2e, 0xe7, 0x9a, 0xf0, 0x6b, 0xae, 0x09, 0x6f, 0x9f, 0xc4, 0x09,
+	0x1f, 0x8d, 0x07, 0x46, 0x44, 0x52, 0x93, 0x9d, 0x27, 0xf9, 0x51, 0x8a, 0x27, 0xe6, 0xb2, 0x98,
+	0x13, 0xcb, 0x7c, 0xbf, 0xd6, 0x4e, 0x3e, 0xcd, 0x31, 0x1b, 0xdc, 0x2c, 0x4a, 0xf5, 0xf4, 0xdf,
+	0x00, 0x41, 0x31, 0xb5, 0x67, 0xbf, 0x02, 0x00, 0x00,
+}
+
+func (m *CurrencyPairMatcher) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CurrencyPairMatcher) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CurrencyPairMatcher) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.Quote)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Base) > 0 {
+		i -= len(m.Base)
+		copy(dAtA[i:], m.Base)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.Base)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketAuthorityScope) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketAuthorityScope) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketAuthorityScope) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Permissions) > 0 {
+		dAtA2 := make([]byte, len(m.Permissions)*10)
+		var j1 int
+		for _, num := range m.Permissions {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintScope(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MetadataTags) > 0 {
+		for iNdEx := len(m.MetadataTags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MetadataTags[iNdEx])
+			copy(dAtA[i:], m.MetadataTags[iNdEx])
+			i = encodeVarintScope(dAtA, i, uint64(len(m.MetadataTags[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.CurrencyPairs) > 0 {
+		for iNdEx := len(m.CurrencyPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CurrencyPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintScope(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintScope(dAtA []byte, offset int, v uint64) int {
+	offset -= sovScope(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CurrencyPairMatcher) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Base)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	return n
+}
+
+func (m *MarketAuthorityScope) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	if len(m.CurrencyPairs) > 0 {
+		for _, e := range m.CurrencyPairs {
+			l = e.Size()
+			n += 1 + l + sovScope(uint64(l))
+		}
+	}
+	if len(m.MetadataTags) > 0 {
+		for _, s := range m.MetadataTags {
+			l = len(s)
+			n += 1 + l + sovScope(uint64(l))
+		}
+	}
+	if len(m.Permissions) > 0 {
+		l = 0
+		for _, e := range m.Permissions {
+			l += sovScope(uint64(e))
+		}
+		n += 1 + sovScope(uint64(l)) + l
+	}
+	return n
+}
+
+func sovScope(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozScope(x uint64) (n int) {
+	return sovScope(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CurrencyPairMatcher) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScope
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CurrencyPairMatcher: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CurrencyPairMatcher: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Base = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScope(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScope
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketAuthorityScope) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScope
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketAuthorityScope: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketAuthorityScope: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPairs = append(m.CurrencyPairs, CurrencyPairMatcher{})
+			if err := m.CurrencyPairs[len(m.CurrencyPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataTags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataTags = append(m.MetadataTags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v MarketPermission
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowScope
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= MarketPermission(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Permissions = append(m.Permissions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowScope
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthScope
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthScope
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Permissions) == 0 {
+					m.Permissions = make([]MarketPermission, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v MarketPermission
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowScope
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= MarketPermission(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Permissions = append(m.Permissions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScope(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScope
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipScope(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowScope
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthScope
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupScope
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthScope
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthScope        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowScope          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupScope = fmt.Errorf("proto: unexpected end of group")
+)
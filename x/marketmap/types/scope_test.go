@@ -0,0 +1,274 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/skip-mev/chaintestutil/sample"
+	"github.com/stretchr/testify/require"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+func TestMarketAuthorityScopeValidateBasic(t *testing.T) {
+	authority := sample.Address(sample.Rand())
+
+	tcs := []struct {
+		name       string
+		scope      types.MarketAuthorityScope
+		expectPass bool
+	}{
+		{
+			"valid scope with currency pair matchers",
+			types.MarketAuthorityScope{
+				Authority:     authority,
+				CurrencyPairs: []types.CurrencyPairMatcher{{Base: "BTC"}, {Quote: "USDT"}, {Base: "ETH", Quote: "USD"}},
+				Permissions:   []types.MarketPermission{types.MARKET_PERMISSION_PROVIDER_CONFIGS},
+			},
+			true,
+		},
+		{
+			"valid scope with metadata tags",
+			types.MarketAuthorityScope{
+				Authority:    authority,
+				MetadataTags: []string{"defi"},
+				Permissions:  []types.MarketPermission{types.MARKET_PERMISSION_ENABLE, types.MARKET_PERMISSION_PROVIDER_CONFIGS},
+			},
+			true,
+		},
+		{
+			"invalid authority - fail",
+			types.MarketAuthorityScope{
+				Authority:     "invalid",
+				CurrencyPairs: []types.CurrencyPairMatcher{{Base: "BTC"}},
+				Permissions:   []types.MarketPermission{types.MARKET_PERMISSION_ALL},
+			},
+			false,
+		},
+		{
+			"no currency pairs or tags - fail",
+			types.MarketAuthorityScope{
+				Authority:   authority,
+				Permissions: []types.MarketPermission{types.MARKET_PERMISSION_ALL},
+			},
+			false,
+		},
+		{
+			"empty currency pair matcher - fail",
+			types.MarketAuthorityScope{
+				Authority:     authority,
+				CurrencyPairs: []types.CurrencyPairMatcher{{}},
+				Permissions:   []types.MarketPermission{types.MARKET_PERMISSION_ALL},
+			},
+			false,
+		},
+		{
+			"wildcard in currency pair matcher - fail",
+			types.MarketAuthorityScope{
+				Authority:     authority,
+				CurrencyPairs: []types.CurrencyPairMatcher{{Base: "*", Quote: "USDT"}},
+				Permissions:   []types.MarketPermission{types.MARKET_PERMISSION_ALL},
+			},
+			false,
+		},
+		{
+			"duplicate currency pair matcher - fail",
+			types.MarketAuthorityScope{
+				Authority:     authority,
+				CurrencyPairs: []types.CurrencyPairMatcher{{Base: "BTC"}, {Base: "BTC"}},
+				Permissions:   []types.MarketPermission{types.MARKET_PERMISSION_ALL},
+			},
+			false,
+		},
+		{
+			"duplicate tag - fail",
+			types.MarketAuthorityScope{
+				Authority:    authority,
+				MetadataTags: []string{"defi", "defi"},
+				Permissions:  []types.MarketPermission{types.MARKET_PERMISSION_ALL},
+			},
+			false,
+		},
+		{
+			"no permissions - fail",
+			types.MarketAuthorityScope{
+				Authority:     authority,
+				CurrencyPairs: []types.CurrencyPairMatcher{{Base: "BTC"}},
+			},
+			false,
+		},
+		{
+			"unspecified permission - fail",
+			types.MarketAuthorityScope{
+				Authority:     authority,
+				CurrencyPairs: []types.CurrencyPairMatcher{{Base: "BTC"}},
+				Permissions:   []types.MarketPermission{types.MARKET_PERMISSION_UNSPECIFIED},
+			},
+			false,
+		},
+		{
+			"unknown permission - fail",
+			types.MarketAuthorityScope{
+				Authority:     authority,
+				CurrencyPairs: []types.CurrencyPairMatcher{{Base: "BTC"}},
+				Permissions:   []types.MarketPermission{types.MarketPermission(10)},
+			},
+			false,
+		},
+		{
+			"duplicate permission - fail",
+			types.MarketAuthorityScope{
+				Authority:     authority,
+				CurrencyPairs: []types.CurrencyPairMatcher{{Base: "BTC"}},
+				Permissions:   []types.MarketPermission{types.MARKET_PERMISSION_ENABLE, types.MARKET_PERMISSION_ENABLE},
+			},
+			false,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.scope.ValidateBasic()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMarketAuthorityScopeMatches(t *testing.T) {
+	tagged := ethusdt
+	tagged.Ticker.Metadata_JSON = `{"tags": ["defi", "l1"]}`
+
+	t.Run("base", func(t *testing.T) {
+		scope := types.MarketAuthorityScope{CurrencyPairs: []types.CurrencyPairMatcher{{Base: "BTC"}}}
+		require.True(t, scope.Matches(btcusdt))
+		require.False(t, scope.Matches(ethusdt))
+
+		// the base must match exactly
+		btcst := btcusdt
+		btcst.Ticker.CurrencyPair = connecttypes.NewCurrencyPair("BTCST", "USD")
+		require.False(t, scope.Matches(btcst))
+	})
+
+	t.Run("quote", func(t *testing.T) {
+		scope := types.MarketAuthorityScope{CurrencyPairs: []types.CurrencyPairMatcher{{Quote: "USDT"}}}
+		require.True(t, scope.Matches(btcusdt))
+		require.True(t, scope.Matches(ethusdt))
+		require.False(t, scope.Matches(usdtusd))
+	})
+
+	t.Run("base and quote", func(t *testing.T) {
+		scope := types.MarketAuthorityScope{CurrencyPairs: []types.CurrencyPairMatcher{{Base: "ETHEREUM", Quote: "USDT"}}}
+		require.True(t, scope.Matches(ethusdt))
+		require.False(t, scope.Matches(btcusdt))
+	})
+
+	t.Run("metadata tag", func(t *testing.T) {
+		scope := types.MarketAuthorityScope{MetadataTags: []string{"l1"}}
+		require.True(t, scope.Matches(tagged))
+		require.False(t, scope.Matches(ethusdt))
+		require.False(t, scope.Matches(btcusdt))
+	})
+
+	t.Run("ticker prefix or metadata tag", func(t *testing.T) {
+		scope := types.MarketAuthorityScope{CurrencyPairs: []types.CurrencyPairMatcher{{Base: "BTC"}}, MetadataTags: []string{"defi"}}
+		require.True(t, scope.Matches(btcusdt))
+		require.True(t, scope.Matches(tagged))
+		require.False(t, scope.Matches(usdtusd))
+	})
+}
+
+func TestCurrencyPairMatcherFromString(t *testing.T) {
+	tcs := []struct {
+		input    string
+		expected types.CurrencyPairMatcher
+		valid    bool
+	}{
+		{"BTC/USD", types.CurrencyPairMatcher{Base: "BTC", Quote: "USD"}, true},
+		{"BTC/*", types.CurrencyPairMatcher{Base: "BTC"}, true},
+		{"*/USDT", types.CurrencyPairMatcher{Quote: "USDT"}, true},
+		{"*/*", types.CurrencyPairMatcher{}, false},
+		{"BTC", types.CurrencyPairMatcher{}, false},
+		{"BTC/USD/T", types.CurrencyPairMatcher{}, false},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.input, func(t *testing.T) {
+			m, err := types.CurrencyPairMatcherFromString(tc.input)
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, m)
+			require.Equal(t, tc.input, m.String())
+		})
+	}
+}
+
+func TestMarketAuthorityScopeHasPermission(t *testing.T) {
+	scope := types.MarketAuthorityScope{Permissions: []types.MarketPermission{types.MARKET_PERMISSION_ENABLE}}
+	require.True(t, scope.HasPermission(types.MARKET_PERMISSION_ENABLE))
+	require.False(t, scope.HasPermission(types.MARKET_PERMISSION_PROVIDER_CONFIGS))
+	require.False(t, scope.HasPermission(types.MARKET_PERMISSION_ALL))
+
+	scope = types.MarketAuthorityScope{Permissions: []types.MarketPermission{types.MARKET_PERMISSION_ALL}}
+	require.True(t, scope.HasPermission(types.MARKET_PERMISSION_ENABLE))
+	require.True(t, scope.HasPermission(types.MARKET_PERMISSION_PROVIDER_CONFIGS))
+	require.True(t, scope.HasPermission(types.MARKET_PERMISSION_ALL))
+}
+
+func TestRequiredPermissions(t *testing.T) {
+	disabled := btcusdt
+	disabled.Ticker.Enabled = false
+
+	newProviders := btcusdt
+	newProviders.ProviderConfigs = []types.ProviderConfig{
+		{
+			Name:           "okx",
+			OffChainTicker: "BTC-USDT",
+		},
+	}
+
+	newDecimals := btcusdt
+	newDecimals.Ticker.Decimals = 10
+
+	both := newProviders
+	both.Ticker.Enabled = false
+
+	tcs := []struct {
+		name     string
+		existing *types.Market
+		updated  types.Market
+		expected []types.MarketPermission
+	}{
+		{"create", nil, btcusdt, []types.MarketPermission{types.MARKET_PERMISSION_ALL}},
+		{"no change", &btcusdt, btcusdt, nil},
+		{"disable", &btcusdt, disabled, []types.MarketPermission{types.MARKET_PERMISSION_ENABLE}},
+		{"provider configs", &btcusdt, newProviders, []types.MarketPermission{types.MARKET_PERMISSION_PROVIDER_CONFIGS}},
+		{"ticker", &btcusdt, newDecimals, []types.MarketPermission{types.MARKET_PERMISSION_ALL}},
+		{
+			"disable and provider configs",
+			&btcusdt,
+			both,
+			[]types.MarketPermission{types.MARKET_PERMISSION_ENABLE, types.MARKET_PERMISSION_PROVIDER_CONFIGS},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, types.RequiredPermissions(tc.existing, tc.updated))
+		})
+	}
+}
+
+func TestMetadataTags(t *testing.T) {
+	require.Nil(t, types.MetadataTags(""))
+	require.Nil(t, types.MetadataTags("not json"))
+	require.Nil(t, types.MetadataTags(`{"aggregate_ids": []}`))
+	require.Equal(t, []string{"defi"}, types.MetadataTags(`{"tags": ["defi"], "aggregate_ids": []}`))
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"strings"
 
+	"cosmossdk.io/math"
+
 	"github.com/skip-mev/connect/v2/pkg/json"
 
 	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
@@ -60,6 +62,16 @@ func (t *Ticker) ValidateBasic() error {
 		return fmt.Errorf("invalid ticker metadata json: %w", err)
 	}
 
+	if err := DefaultMetadataSchemaRegistry.ValidateTickerMetadata(t.Metadata_JSON); err != nil {
+		return fmt.Errorf("invalid metadata of ticker %s: %w", t.CurrencyPair.String(), err)
+	}
+
+	if t.PowerThreshold != nil {
+		if t.PowerThreshold.IsNil() || !t.PowerThreshold.IsPositive() || t.PowerThreshold.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("power threshold must be in (0, 1]; got %s for %s", t.PowerThreshold, t.CurrencyPair.String())
+		}
+	}
+
 	return nil
 }
 
@@ -69,5 +81,17 @@ func (t *Ticker) Equal(other Ticker) bool {
 		t.Decimals == other.Decimals &&
 		t.MinProviderCount == other.MinProviderCount &&
 		t.Metadata_JSON == other.Metadata_JSON &&
-		t.Enabled == other.Enabled
+		t.Enabled == other.Enabled &&
+		t.MaxPriceAgeBlocks == other.MaxPriceAgeBlocks &&
+		t.MaxPriceAgeSeconds == other.MaxPriceAgeSeconds &&
+		powerThresholdsEqual(t.PowerThreshold, other.PowerThreshold)
+}
+
+// powerThresholdsEqual returns true iff both power thresholds are unset or both are set to the same value.
+func powerThresholdsEqual(a, b *math.LegacyDec) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.Equal(*b)
 }
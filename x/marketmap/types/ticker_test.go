@@ -3,6 +3,8 @@ package types_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
+
 	"github.com/skip-mev/connect/v2/testutil"
 
 	"github.com/stretchr/testify/require"
@@ -134,6 +136,71 @@ func TestTicker(t *testing.T) {
 			},
 			expErr: true,
 		},
+		{
+			name: "valid power threshold",
+			ticker: types.Ticker{
+				CurrencyPair: connecttypes.CurrencyPair{
+					Base:  "BITCOIN",
+					Quote: "USDT",
+				},
+				Decimals:         8,
+				MinProviderCount: 1,
+				PowerThreshold:   powerThreshold("0.5"),
+			},
+			expErr: false,
+		},
+		{
+			name: "power threshold of one",
+			ticker: types.Ticker{
+				CurrencyPair: connecttypes.CurrencyPair{
+					Base:  "BITCOIN",
+					Quote: "USDT",
+				},
+				Decimals:         8,
+				MinProviderCount: 1,
+				PowerThreshold:   powerThreshold("1"),
+			},
+			expErr: false,
+		},
+		{
+			name: "zero power threshold",
+			ticker: types.Ticker{
+				CurrencyPair: connecttypes.CurrencyPair{
+					Base:  "BITCOIN",
+					Quote: "USDT",
+				},
+				Decimals:         8,
+				MinProviderCount: 1,
+				PowerThreshold:   powerThreshold("0"),
+			},
+			expErr: true,
+		},
+		{
+			name: "negative power threshold",
+			ticker: types.Ticker{
+				CurrencyPair: connecttypes.CurrencyPair{
+					Base:  "BITCOIN",
+					Quote: "USDT",
+				},
+				Decimals:         8,
+				MinProviderCount: 1,
+				PowerThreshold:   powerThreshold("-0.5"),
+			},
+			expErr: true,
+		},
+		{
+			name: "power threshold greater than one",
+			ticker: types.Ticker{
+				CurrencyPair: connecttypes.CurrencyPair{
+					Base:  "BITCOIN",
+					Quote: "USDT",
+				},
+				Decimals:         8,
+				MinProviderCount: 1,
+				PowerThreshold:   powerThreshold("1.5"),
+			},
+			expErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -302,6 +369,96 @@ func TestTickerEqual(t *testing.T) {
 			},
 			exp: false,
 		},
+		{
+			name: "equal power thresholds",
+			ticker: types.Ticker{
+				CurrencyPair: connecttypes.CurrencyPair{
+					Base:  "BITCOIN",
+					Quote: "USDT",
+				},
+				Decimals:         8,
+				MinProviderCount: 1,
+				PowerThreshold:   powerThreshold("0.5"),
+			},
+			other: types.Ticker{
+				CurrencyPair: connecttypes.CurrencyPair{
+					Base:  "BITCOIN",
+					Quote: "USDT",
+				},
+				Decimals:         8,
+				MinProviderCount: 1,
+				PowerThreshold:   powerThreshold("0.50"),
+			},
+			exp: true,
+		},
+		{
+			name: "different power thresholds",
+			ticker: types.Ticker{
+				CurrencyPair: connecttypes.CurrencyPair{
+					Base:  "BITCOIN",
+					Quote: "USDT",
+				},
+				Decimals:         8,
+				MinProviderCount: 1,
+				PowerThreshold:   powerThreshold("0.5"),
+			},
+			other: types.Ticker{
+				CurrencyPair: connecttypes.CurrencyPair{
+					Base:  "BITCOIN",
+					Quote: "USDT",
+				},
+				Decimals:         8,
+				MinProviderCount: 1,
+				PowerThreshold:   powerThreshold("0.75"),
+			},
+			exp: false,
+		},
+		{
+			name: "unset power threshold",
+			ticker: types.Ticker{
+				CurrencyPair: connecttypes.CurrencyPair{
+					Base:  "BITCOIN",
+					Quote: "USDT",
+				},
+				Decimals:         8,
+				MinProviderCount: 1,
+				PowerThreshold:   powerThreshold("0.5"),
+			},
+			other: types.Ticker{
+				CurrencyPair: connecttypes.CurrencyPair{
+					Base:  "BITCOIN",
+					Quote: "USDT",
+				},
+				Decimals:         8,
+				MinProviderCount: 1,
+				PowerThreshold:   nil,
+			},
+			exp: false,
+		},
+		{
+			name: "different max price ages",
+			ticker: types.Ticker{
+				CurrencyPair: connecttypes.CurrencyPair{
+					Base:  "BITCOIN",
+					Quote: "USDT",
+				},
+				Decimals:           8,
+				MinProviderCount:   1,
+				MaxPriceAgeBlocks:  10,
+				MaxPriceAgeSeconds: 60,
+			},
+			other: types.Ticker{
+				CurrencyPair: connecttypes.CurrencyPair{
+					Base:  "BITCOIN",
+					Quote: "USDT",
+				},
+				Decimals:           8,
+				MinProviderCount:   1,
+				MaxPriceAgeBlocks:  10,
+				MaxPriceAgeSeconds: 30,
+			},
+			exp: false,
+		},
 	}
 
 	for _, tc := range cases {
@@ -310,3 +467,8 @@ func TestTickerEqual(t *testing.T) {
 		})
 	}
 }
+
+func powerThreshold(s string) *math.LegacyDec {
+	threshold := math.LegacyMustNewDecFromStr(s)
+	return &threshold
+}
@@ -1,6 +1,9 @@
 package tickermetadata
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"fmt"
+)
 
 type AggregatorID struct {
 	// Venue is the name of the aggregator for which the ID is valid.
@@ -18,6 +21,30 @@ func NewAggregatorID(venue, id string) AggregatorID {
 	}
 }
 
+// ValidateBasic checks that the venue and ID of the AggregatorID are non-empty.
+func (m AggregatorID) ValidateBasic() error {
+	if len(m.Venue) == 0 {
+		return fmt.Errorf("aggregator venue must not be empty")
+	}
+
+	if len(m.ID) == 0 {
+		return fmt.Errorf("aggregator ID for venue %s must not be empty", m.Venue)
+	}
+
+	return nil
+}
+
+// validateAggregateIDs checks that each of the given AggregatorIDs is valid.
+func validateAggregateIDs(ids []AggregatorID) error {
+	for _, id := range ids {
+		if err := id.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalAggregatorID returns the JSON byte encoding of the AggregatorID.
 func MarshalAggregatorID(m AggregatorID) ([]byte, error) {
 	return json.Marshal(m)
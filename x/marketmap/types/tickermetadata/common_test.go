@@ -28,3 +28,9 @@ func Test_UnmarshalAggregatorID(t *testing.T) {
 		require.Equal(t, tickermetadata.NewAggregatorID("coingecko", "id"), elem)
 	})
 }
+
+func Test_ValidateAggregatorID(t *testing.T) {
+	require.NoError(t, tickermetadata.NewAggregatorID("coingecko", "id").ValidateBasic())
+	require.Error(t, tickermetadata.NewAggregatorID("", "id").ValidateBasic())
+	require.Error(t, tickermetadata.NewAggregatorID("coingecko", "").ValidateBasic())
+}
@@ -7,6 +7,8 @@ type CoreMetadata struct {
 	// AggregateIDs contains a list of AggregatorIDs associated with the ticker.
 	// This field may not be populated if no aggregator currently indexes this Ticker.
 	AggregateIDs []AggregatorID `json:"aggregate_ids"`
+	// Tags is an optional list of tags of the ticker, which market authority scopes may grant permissions on.
+	Tags []string `json:"tags,omitempty"`
 }
 
 // NewCoreMetadata returns a new CoreMetadata instance.
@@ -16,6 +18,11 @@ func NewCoreMetadata(aggregateIDs []AggregatorID) CoreMetadata {
 	}
 }
 
+// ValidateBasic checks that each of the AggregateIDs of the CoreMetadata is valid.
+func (m CoreMetadata) ValidateBasic() error {
+	return validateAggregateIDs(m.AggregateIDs)
+}
+
 // MarshalCoreMetadata returns the JSON byte encoding of the CoreMetadata.
 func MarshalCoreMetadata(m CoreMetadata) ([]byte, error) {
 	return json.Marshal(m)
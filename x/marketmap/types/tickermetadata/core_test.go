@@ -49,3 +49,13 @@ func Test_UnmarshalCoreMetadata(t *testing.T) {
 		), elem)
 	})
 }
+
+func Test_ValidateCoreMetadata(t *testing.T) {
+	require.NoError(t, tickermetadata.NewCoreMetadata(nil).ValidateBasic())
+	require.NoError(t, tickermetadata.NewCoreMetadata(
+		[]tickermetadata.AggregatorID{tickermetadata.NewAggregatorID("coingecko", "id")},
+	).ValidateBasic())
+	require.Error(t, tickermetadata.NewCoreMetadata(
+		[]tickermetadata.AggregatorID{tickermetadata.NewAggregatorID("coingecko", "")},
+	).ValidateBasic())
+}
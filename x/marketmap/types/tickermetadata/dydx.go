@@ -20,6 +20,8 @@ type DyDx struct {
 	// launched as a cross-margin market (instead of isolated margin).
 	// If omitted, it is set to false by default.
 	CrossLaunch bool `json:"cross_launch,omitempty"`
+	// Tags is an optional list of tags of the ticker, which market authority scopes may grant permissions on.
+	Tags []string `json:"tags,omitempty"`
 }
 
 // NewDyDx returns a new DyDx instance.
@@ -32,6 +34,11 @@ func NewDyDx(referencePrice, liquidity uint64, aggregateIDs []AggregatorID, cros
 	}
 }
 
+// ValidateBasic checks that each of the AggregateIDs of the DyDx is valid.
+func (m DyDx) ValidateBasic() error {
+	return validateAggregateIDs(m.AggregateIDs)
+}
+
 // MarshalDyDx returns the JSON byte encoding of the DyDx.
 func MarshalDyDx(m DyDx) ([]byte, error) {
 	return json.Marshal(m)
@@ -87,3 +87,10 @@ func Test_UnmarshalDyDx(t *testing.T) {
 		), elem)
 	})
 }
+
+func Test_ValidateDyDx(t *testing.T) {
+	require.NoError(t, tickermetadata.NewDyDx(100, 10, nil, false).ValidateBasic())
+	require.Error(t, tickermetadata.NewDyDx(
+		100, 10, []tickermetadata.AggregatorID{tickermetadata.NewAggregatorID("", "id")}, false,
+	).ValidateBasic())
+}
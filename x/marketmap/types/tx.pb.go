@@ -620,980 +620,3515 @@ func (m *MsgRemoveMarketsResponse) GetDeletedMarkets() []string {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*MsgUpsertMarkets)(nil), "connect.marketmap.v2.MsgUpsertMarkets")
-	proto.RegisterType((*MsgUpsertMarketsResponse)(nil), "connect.marketmap.v2.MsgUpsertMarketsResponse")
-	proto.RegisterMapType((map[string]bool)(nil), "connect.marketmap.v2.MsgUpsertMarketsResponse.MarketUpdatesEntry")
-	proto.RegisterType((*MsgCreateMarkets)(nil), "connect.marketmap.v2.MsgCreateMarkets")
-	proto.RegisterType((*MsgCreateMarketsResponse)(nil), "connect.marketmap.v2.MsgCreateMarketsResponse")
-	proto.RegisterType((*MsgUpdateMarkets)(nil), "connect.marketmap.v2.MsgUpdateMarkets")
-	proto.RegisterType((*MsgUpdateMarketsResponse)(nil), "connect.marketmap.v2.MsgUpdateMarketsResponse")
-	proto.RegisterType((*MsgParams)(nil), "connect.marketmap.v2.MsgParams")
-	proto.RegisterType((*MsgParamsResponse)(nil), "connect.marketmap.v2.MsgParamsResponse")
-	proto.RegisterType((*MsgRemoveMarketAuthorities)(nil), "connect.marketmap.v2.MsgRemoveMarketAuthorities")
-	proto.RegisterType((*MsgRemoveMarketAuthoritiesResponse)(nil), "connect.marketmap.v2.MsgRemoveMarketAuthoritiesResponse")
-	proto.RegisterType((*MsgRemoveMarkets)(nil), "connect.marketmap.v2.MsgRemoveMarkets")
-	proto.RegisterType((*MsgRemoveMarketsResponse)(nil), "connect.marketmap.v2.MsgRemoveMarketsResponse")
+// MsgScheduleMarketChange defines a message carrying a change of a market to be
+// executed at a target block height or time.
+type MsgScheduleMarketChange struct {
+	// Authority is the signer of this transaction. This authority must be
+	// authorized by the module to execute the message.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Change is the scheduled change. Its ID is assigned by the module and must
+	// be left unset.
+	Change ScheduledMarketChange `protobuf:"bytes,2,opt,name=change,proto3" json:"change"`
 }
 
-func init() { proto.RegisterFile("connect/marketmap/v2/tx.proto", fileDescriptor_37df9476ca9a2f81) }
-
-var fileDescriptor_37df9476ca9a2f81 = []byte{
-	// 744 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x4f, 0x13, 0x4f,
-	0x14, 0xef, 0x14, 0xca, 0xf7, 0xdb, 0xa7, 0x2d, 0xb0, 0x36, 0x61, 0xdd, 0x68, 0x8b, 0x0d, 0x29,
-	0x48, 0xc2, 0x2e, 0xd6, 0xc4, 0x90, 0xea, 0xc1, 0x96, 0x78, 0xd0, 0xa4, 0x89, 0x59, 0x43, 0x62,
-	0xbc, 0x34, 0x4b, 0x77, 0xb2, 0x6c, 0x60, 0x7f, 0x64, 0x67, 0xba, 0x81, 0x9b, 0xd1, 0x78, 0xf1,
-	0xa2, 0xf1, 0xe4, 0x51, 0xff, 0x03, 0x0e, 0xfe, 0x0b, 0x26, 0x1c, 0x09, 0x27, 0x4f, 0xc6, 0xc0,
-	0x01, 0xff, 0x0c, 0xd3, 0x9d, 0xe9, 0xd2, 0x81, 0x6e, 0x69, 0xd1, 0x0b, 0xcc, 0xbe, 0xf7, 0x79,
-	0xef, 0x7d, 0xde, 0x8f, 0x79, 0x53, 0xb8, 0xdd, 0xf6, 0x5c, 0x17, 0xb7, 0xa9, 0xe6, 0x18, 0xc1,
-	0x36, 0xa6, 0x8e, 0xe1, 0x6b, 0x61, 0x55, 0xa3, 0xbb, 0xaa, 0x1f, 0x78, 0xd4, 0x93, 0x0a, 0x5c,
-	0xad, 0xc6, 0x6a, 0x35, 0xac, 0x2a, 0x73, 0x6d, 0x8f, 0x38, 0x1e, 0xd1, 0x1c, 0x62, 0x69, 0xe1,
-	0xbd, 0xee, 0x3f, 0x06, 0x57, 0x0a, 0x96, 0x67, 0x79, 0xd1, 0x51, 0xeb, 0x9e, 0xb8, 0xf4, 0x26,
-	0x83, 0xb7, 0x98, 0x82, 0x7d, 0x70, 0xd5, 0xac, 0xe1, 0xd8, 0xae, 0xa7, 0x45, 0x7f, 0xb9, 0xe8,
-	0xce, 0x40, 0x46, 0xec, 0x63, 0x28, 0xc4, 0x37, 0x02, 0xc3, 0xe1, 0x8e, 0xcb, 0xdf, 0x11, 0xcc,
-	0x34, 0x89, 0xb5, 0xe1, 0x13, 0x1c, 0xd0, 0x66, 0x04, 0x23, 0xd2, 0x03, 0xc8, 0x1a, 0x1d, 0xba,
-	0xe5, 0x05, 0x36, 0xdd, 0x93, 0xd1, 0x3c, 0x5a, 0xca, 0x36, 0xe4, 0xa3, 0x6f, 0x2b, 0x05, 0x4e,
-	0xa9, 0x6e, 0x9a, 0x01, 0x26, 0xe4, 0x05, 0x0d, 0x6c, 0xd7, 0xd2, 0xcf, 0xa0, 0xd2, 0x23, 0xf8,
-	0x8f, 0x45, 0x22, 0x72, 0x7a, 0x7e, 0x62, 0xe9, 0x5a, 0xf5, 0x96, 0x3a, 0xa8, 0x2e, 0x2a, 0x8b,
-	0xd3, 0x98, 0x3c, 0xf8, 0x59, 0x4a, 0xe9, 0x3d, 0x93, 0xda, 0xc3, 0xdf, 0x5f, 0x4a, 0xa9, 0x37,
-	0xa7, 0xfb, 0xcb, 0x67, 0x1e, 0xdf, 0x9f, 0xee, 0x2f, 0x2f, 0xf4, 0x92, 0xd8, 0xed, 0x4b, 0xe3,
-	0x3c, 0xe5, 0xf2, 0x21, 0x02, 0xf9, 0xbc, 0x50, 0xc7, 0xc4, 0xf7, 0x5c, 0x82, 0xa5, 0x1d, 0xc8,
-	0x33, 0xd3, 0x56, 0xc7, 0x37, 0x0d, 0x8a, 0x89, 0x8c, 0x22, 0x7a, 0xf5, 0x04, 0x7a, 0x09, 0x7e,
-	0x38, 0xef, 0x0d, 0xe6, 0xe3, 0x89, 0x4b, 0x83, 0xbd, 0x46, 0x5a, 0x46, 0x7a, 0xce, 0xe9, 0x97,
-	0x2b, 0x8f, 0x41, 0xba, 0x08, 0x94, 0x66, 0x60, 0x62, 0x1b, 0xf3, 0x6a, 0xea, 0xdd, 0xa3, 0x54,
-	0x80, 0x4c, 0x68, 0xec, 0x74, 0xb0, 0x9c, 0x9e, 0x47, 0x4b, 0xff, 0xeb, 0xec, 0xa3, 0x96, 0x5e,
-	0x43, 0xb5, 0xc9, 0xcf, 0x5f, 0x4b, 0xa8, 0x7c, 0xc4, 0x5a, 0xb3, 0x1e, 0x60, 0x83, 0xe2, 0xbf,
-	0x6d, 0xcd, 0x53, 0xc8, 0xb7, 0x23, 0x47, 0xad, 0xf1, 0x3b, 0x94, 0x6b, 0xf7, 0x53, 0x18, 0xb7,
-	0x4f, 0x02, 0xff, 0xb2, 0x12, 0xb5, 0x49, 0x90, 0xf5, 0xca, 0xdb, 0x4b, 0x98, 0x95, 0xed, 0x1f,
-	0x24, 0xcc, 0x9a, 0x7d, 0x95, 0x84, 0x3b, 0xfd, 0x14, 0xc6, 0x1f, 0x4c, 0xf3, 0x42, 0xc2, 0x82,
-	0x2c, 0x4e, 0xf8, 0x03, 0x82, 0x6c, 0x93, 0x58, 0xcf, 0xa3, 0x0b, 0x29, 0xd5, 0x60, 0x8a, 0x5d,
-	0xcd, 0x28, 0xcd, 0x44, 0xa6, 0x0c, 0xcd, 0x99, 0x72, 0x0b, 0xb1, 0x4a, 0xe9, 0x91, 0xab, 0x54,
-	0xcb, 0x8b, 0x69, 0x95, 0x6f, 0xc0, 0x6c, 0x4c, 0x28, 0xa6, 0xf9, 0x16, 0x81, 0xd2, 0x24, 0x96,
-	0x8e, 0x1d, 0x2f, 0xe4, 0x39, 0xd4, 0xb9, 0x85, 0x8d, 0x89, 0x74, 0x17, 0x66, 0x82, 0x48, 0xd5,
-	0x32, 0x58, 0x18, 0x7e, 0xbf, 0xb2, 0xfa, 0x34, 0x93, 0xd7, 0x7b, 0x62, 0x49, 0x85, 0x8c, 0x61,
-	0x3a, 0xb6, 0x7b, 0x29, 0x45, 0x06, 0xab, 0x41, 0x97, 0x1e, 0x3b, 0x97, 0x17, 0xa0, 0x9c, 0x4c,
-	0x22, 0xe6, 0x4a, 0xa3, 0x11, 0xea, 0x47, 0x5d, 0x7d, 0x84, 0x64, 0x71, 0x9d, 0x65, 0xcf, 0x56,
-	0xd5, 0xf9, 0xb2, 0xad, 0x47, 0x4d, 0x16, 0xa2, 0xc6, 0xcb, 0x67, 0x11, 0xa6, 0x4d, 0xbc, 0x83,
-	0x29, 0x36, 0xe3, 0x49, 0x64, 0xd5, 0xc9, 0x73, 0x31, 0x37, 0xa8, 0x7e, 0xca, 0xc0, 0x44, 0x93,
-	0x58, 0x92, 0x05, 0x39, 0xf1, 0xce, 0x57, 0x12, 0xd7, 0x94, 0x80, 0x53, 0xd4, 0xd1, 0x70, 0x31,
-	0x33, 0x0b, 0x72, 0xe2, 0x5d, 0xab, 0x0c, 0xd9, 0x87, 0xe6, 0x48, 0x81, 0x06, 0xce, 0xb9, 0xf4,
-	0x12, 0xae, 0x33, 0x05, 0x9f, 0xf4, 0x52, 0xa2, 0x3d, 0x03, 0x28, 0x8b, 0x97, 0x00, 0x62, 0xcf,
-	0xef, 0x10, 0xcc, 0x25, 0xcd, 0xe5, 0x6a, 0xa2, 0x93, 0x04, 0x0b, 0x65, 0x6d, 0x5c, 0x0b, 0xb1,
-	0x94, 0xfd, 0x4f, 0x68, 0x65, 0xb4, 0xa7, 0x65, 0x68, 0x29, 0x07, 0x3d, 0x65, 0x16, 0xe4, 0xc4,
-	0xe1, 0xae, 0x8c, 0xc4, 0x79, 0x58, 0xa0, 0x81, 0x63, 0xab, 0x64, 0x5e, 0x9f, 0xee, 0x2f, 0xa3,
-	0xc6, 0xb3, 0x83, 0xe3, 0x22, 0x3a, 0x3c, 0x2e, 0xa2, 0x5f, 0xc7, 0x45, 0xf4, 0xf1, 0xa4, 0x98,
-	0x3a, 0x3c, 0x29, 0xa6, 0x7e, 0x9c, 0x14, 0x53, 0xaf, 0x56, 0x2d, 0x9b, 0x6e, 0x75, 0x36, 0xd5,
-	0xb6, 0xe7, 0x68, 0x64, 0xdb, 0xf6, 0x57, 0x1c, 0x1c, 0x6a, 0xbd, 0x95, 0x18, 0x56, 0x85, 0xad,
-	0x48, 0xf7, 0x7c, 0x4c, 0x36, 0xa7, 0xa2, 0x9f, 0x1c, 0xf7, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff,
-	0x23, 0xde, 0x0a, 0x2d, 0x4c, 0x09, 0x00, 0x00,
+func (m *MsgScheduleMarketChange) Reset()         { *m = MsgScheduleMarketChange{} }
+func (m *MsgScheduleMarketChange) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleMarketChange) ProtoMessage()    {}
+func (*MsgScheduleMarketChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37df9476ca9a2f81, []int{12}
+}
+func (m *MsgScheduleMarketChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleMarketChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleMarketChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleMarketChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleMarketChange.Merge(m, src)
+}
+func (m *MsgScheduleMarketChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleMarketChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleMarketChange.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgScheduleMarketChange proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// CreateMarkets creates markets from the given message.
-	CreateMarkets(ctx context.Context, in *MsgCreateMarkets, opts ...grpc.CallOption) (*MsgCreateMarketsResponse, error)
-	// UpdateMarkets updates markets from the given message.
-	UpdateMarkets(ctx context.Context, in *MsgUpdateMarkets, opts ...grpc.CallOption) (*MsgUpdateMarketsResponse, error)
-	// UpdateParams defines a method for updating the x/marketmap module
-	// parameters.
-	UpdateParams(ctx context.Context, in *MsgParams, opts ...grpc.CallOption) (*MsgParamsResponse, error)
-	// RemoveMarketAuthorities defines a method for removing market authorities
-	// from the x/marketmap module. the signer must be the admin.
-	RemoveMarketAuthorities(ctx context.Context, in *MsgRemoveMarketAuthorities, opts ...grpc.CallOption) (*MsgRemoveMarketAuthoritiesResponse, error)
-	// UpsertMarkets wraps both Create / Update markets into a single message.
-	// Specifically if a market does not exist it will be created, otherwise it
-	// will be updated. The response will be a map between ticker -> updated.
-	UpsertMarkets(ctx context.Context, in *MsgUpsertMarkets, opts ...grpc.CallOption) (*MsgUpsertMarketsResponse, error)
-	// RemoveMarkets removes the given markets from the marketmap if:
-	// - they exist in the map
-	// - they are disabled
-	RemoveMarkets(ctx context.Context, in *MsgRemoveMarkets, opts ...grpc.CallOption) (*MsgRemoveMarketsResponse, error)
+func (m *MsgScheduleMarketChange) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgScheduleMarketChange) GetChange() ScheduledMarketChange {
+	if m != nil {
+		return m.Change
+	}
+	return ScheduledMarketChange{}
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+// MsgScheduleMarketChangeResponse is the response message for
+// MsgScheduleMarketChange.
+type MsgScheduleMarketChangeResponse struct {
+	// ID is the identifier assigned to the scheduled change.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (c *msgClient) CreateMarkets(ctx context.Context, in *MsgCreateMarkets, opts ...grpc.CallOption) (*MsgCreateMarketsResponse, error) {
-	out := new(MsgCreateMarketsResponse)
-	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/CreateMarkets", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgScheduleMarketChangeResponse) Reset()         { *m = MsgScheduleMarketChangeResponse{} }
+func (m *MsgScheduleMarketChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleMarketChangeResponse) ProtoMessage()    {}
+func (*MsgScheduleMarketChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37df9476ca9a2f81, []int{13}
+}
+func (m *MsgScheduleMarketChangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleMarketChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleMarketChangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgScheduleMarketChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleMarketChangeResponse.Merge(m, src)
+}
+func (m *MsgScheduleMarketChangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleMarketChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleMarketChangeResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateMarkets(ctx context.Context, in *MsgUpdateMarkets, opts ...grpc.CallOption) (*MsgUpdateMarketsResponse, error) {
-	out := new(MsgUpdateMarketsResponse)
-	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/UpdateMarkets", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgScheduleMarketChangeResponse proto.InternalMessageInfo
+
+func (m *MsgScheduleMarketChangeResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgParams, opts ...grpc.CallOption) (*MsgParamsResponse, error) {
-	out := new(MsgParamsResponse)
-	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgCancelScheduledMarketChange defines a message cancelling a pending
+// scheduled market change.
+type MsgCancelScheduledMarketChange struct {
+	// Authority is the signer of this transaction. This authority must be
+	// authorized by the module to execute the message.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// ID is the identifier of the scheduled change to cancel.
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (c *msgClient) RemoveMarketAuthorities(ctx context.Context, in *MsgRemoveMarketAuthorities, opts ...grpc.CallOption) (*MsgRemoveMarketAuthoritiesResponse, error) {
-	out := new(MsgRemoveMarketAuthoritiesResponse)
-	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/RemoveMarketAuthorities", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCancelScheduledMarketChange) Reset()         { *m = MsgCancelScheduledMarketChange{} }
+func (m *MsgCancelScheduledMarketChange) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledMarketChange) ProtoMessage()    {}
+func (*MsgCancelScheduledMarketChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37df9476ca9a2f81, []int{14}
+}
+func (m *MsgCancelScheduledMarketChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduledMarketChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledMarketChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgCancelScheduledMarketChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledMarketChange.Merge(m, src)
+}
+func (m *MsgCancelScheduledMarketChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduledMarketChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledMarketChange.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpsertMarkets(ctx context.Context, in *MsgUpsertMarkets, opts ...grpc.CallOption) (*MsgUpsertMarketsResponse, error) {
-	out := new(MsgUpsertMarketsResponse)
-	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/UpsertMarkets", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCancelScheduledMarketChange proto.InternalMessageInfo
+
+func (m *MsgCancelScheduledMarketChange) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) RemoveMarkets(ctx context.Context, in *MsgRemoveMarkets, opts ...grpc.CallOption) (*MsgRemoveMarketsResponse, error) {
-	out := new(MsgRemoveMarketsResponse)
-	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/RemoveMarkets", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCancelScheduledMarketChange) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return 0
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// CreateMarkets creates markets from the given message.
-	CreateMarkets(context.Context, *MsgCreateMarkets) (*MsgCreateMarketsResponse, error)
-	// UpdateMarkets updates markets from the given message.
-	UpdateMarkets(context.Context, *MsgUpdateMarkets) (*MsgUpdateMarketsResponse, error)
-	// UpdateParams defines a method for updating the x/marketmap module
-	// parameters.
-	UpdateParams(context.Context, *MsgParams) (*MsgParamsResponse, error)
-	// RemoveMarketAuthorities defines a method for removing market authorities
-	// from the x/marketmap module. the signer must be the admin.
-	RemoveMarketAuthorities(context.Context, *MsgRemoveMarketAuthorities) (*MsgRemoveMarketAuthoritiesResponse, error)
-	// UpsertMarkets wraps both Create / Update markets into a single message.
-	// Specifically if a market does not exist it will be created, otherwise it
-	// will be updated. The response will be a map between ticker -> updated.
-	UpsertMarkets(context.Context, *MsgUpsertMarkets) (*MsgUpsertMarketsResponse, error)
-	// RemoveMarkets removes the given markets from the marketmap if:
-	// - they exist in the map
-	// - they are disabled
-	RemoveMarkets(context.Context, *MsgRemoveMarkets) (*MsgRemoveMarketsResponse, error)
+// MsgCancelScheduledMarketChangeResponse is the response message for
+// MsgCancelScheduledMarketChange.
+type MsgCancelScheduledMarketChangeResponse struct {
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgCancelScheduledMarketChangeResponse) Reset() {
+	*m = MsgCancelScheduledMarketChangeResponse{}
 }
-
-func (*UnimplementedMsgServer) CreateMarkets(ctx context.Context, req *MsgCreateMarkets) (*MsgCreateMarketsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateMarkets not implemented")
+func (m *MsgCancelScheduledMarketChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledMarketChangeResponse) ProtoMessage()    {}
+func (*MsgCancelScheduledMarketChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37df9476ca9a2f81, []int{15}
 }
-func (*UnimplementedMsgServer) UpdateMarkets(ctx context.Context, req *MsgUpdateMarkets) (*MsgUpdateMarketsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateMarkets not implemented")
+func (m *MsgCancelScheduledMarketChangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgParams) (*MsgParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgCancelScheduledMarketChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledMarketChangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) RemoveMarketAuthorities(ctx context.Context, req *MsgRemoveMarketAuthorities) (*MsgRemoveMarketAuthoritiesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveMarketAuthorities not implemented")
+func (m *MsgCancelScheduledMarketChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledMarketChangeResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) UpsertMarkets(ctx context.Context, req *MsgUpsertMarkets) (*MsgUpsertMarketsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpsertMarkets not implemented")
+func (m *MsgCancelScheduledMarketChangeResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) RemoveMarkets(ctx context.Context, req *MsgRemoveMarkets) (*MsgRemoveMarketsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveMarkets not implemented")
+func (m *MsgCancelScheduledMarketChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledMarketChangeResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgCancelScheduledMarketChangeResponse proto.InternalMessageInfo
+
+// MsgGrantMarketAuthorityScope defines a message granting a scope to a market
+// authority.
+type MsgGrantMarketAuthorityScope struct {
+	// Admin is the signer of this transaction. It must be the admin of the
+	// x/marketmap module.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// Scope is the granted scope.
+	Scope MarketAuthorityScope `protobuf:"bytes,2,opt,name=scope,proto3" json:"scope"`
 }
 
-func _Msg_CreateMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateMarkets)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateMarkets(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/connect.marketmap.v2.Msg/CreateMarkets",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateMarkets(ctx, req.(*MsgCreateMarkets))
+func (m *MsgGrantMarketAuthorityScope) Reset()         { *m = MsgGrantMarketAuthorityScope{} }
+func (m *MsgGrantMarketAuthorityScope) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantMarketAuthorityScope) ProtoMessage()    {}
+func (*MsgGrantMarketAuthorityScope) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37df9476ca9a2f81, []int{16}
+}
+func (m *MsgGrantMarketAuthorityScope) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantMarketAuthorityScope) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantMarketAuthorityScope.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgGrantMarketAuthorityScope) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantMarketAuthorityScope.Merge(m, src)
+}
+func (m *MsgGrantMarketAuthorityScope) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantMarketAuthorityScope) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantMarketAuthorityScope.DiscardUnknown(m)
 }
 
-func _Msg_UpdateMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateMarkets)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateMarkets(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/connect.marketmap.v2.Msg/UpdateMarkets",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateMarkets(ctx, req.(*MsgUpdateMarkets))
+var xxx_messageInfo_MsgGrantMarketAuthorityScope proto.InternalMessageInfo
+
+func (m *MsgGrantMarketAuthorityScope) GetAdmin() string {
+	if m != nil {
+		return m.Admin
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
+func (m *MsgGrantMarketAuthorityScope) GetScope() MarketAuthorityScope {
+	if m != nil {
+		return m.Scope
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/connect.marketmap.v2.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgParams))
-	}
-	return interceptor(ctx, in, info, handler)
+	return MarketAuthorityScope{}
 }
 
-func _Msg_RemoveMarketAuthorities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveMarketAuthorities)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveMarketAuthorities(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/connect.marketmap.v2.Msg/RemoveMarketAuthorities",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveMarketAuthorities(ctx, req.(*MsgRemoveMarketAuthorities))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgGrantMarketAuthorityScopeResponse is the response message for
+// MsgGrantMarketAuthorityScope.
+type MsgGrantMarketAuthorityScopeResponse struct {
 }
 
-func _Msg_UpsertMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpsertMarkets)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpsertMarkets(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/connect.marketmap.v2.Msg/UpsertMarkets",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpsertMarkets(ctx, req.(*MsgUpsertMarkets))
+func (m *MsgGrantMarketAuthorityScopeResponse) Reset()         { *m = MsgGrantMarketAuthorityScopeResponse{} }
+func (m *MsgGrantMarketAuthorityScopeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantMarketAuthorityScopeResponse) ProtoMessage()    {}
+func (*MsgGrantMarketAuthorityScopeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37df9476ca9a2f81, []int{17}
+}
+func (m *MsgGrantMarketAuthorityScopeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantMarketAuthorityScopeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantMarketAuthorityScopeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgGrantMarketAuthorityScopeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantMarketAuthorityScopeResponse.Merge(m, src)
+}
+func (m *MsgGrantMarketAuthorityScopeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantMarketAuthorityScopeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantMarketAuthorityScopeResponse.DiscardUnknown(m)
 }
 
-func _Msg_RemoveMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveMarkets)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveMarkets(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/connect.marketmap.v2.Msg/RemoveMarkets",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveMarkets(ctx, req.(*MsgRemoveMarkets))
+var xxx_messageInfo_MsgGrantMarketAuthorityScopeResponse proto.InternalMessageInfo
+
+// MsgRevokeMarketAuthorityScope defines a message revoking the scope of a
+// market authority.
+type MsgRevokeMarketAuthorityScope struct {
+	// Admin is the signer of this transaction. It must be the admin of the
+	// x/marketmap module.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// Authority is the address of the scoped market authority whose scope is
+	// revoked.
+	Authority string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgRevokeMarketAuthorityScope) Reset()         { *m = MsgRevokeMarketAuthorityScope{} }
+func (m *MsgRevokeMarketAuthorityScope) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeMarketAuthorityScope) ProtoMessage()    {}
+func (*MsgRevokeMarketAuthorityScope) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37df9476ca9a2f81, []int{18}
+}
+func (m *MsgRevokeMarketAuthorityScope) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeMarketAuthorityScope) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeMarketAuthorityScope.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgRevokeMarketAuthorityScope) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeMarketAuthorityScope.Merge(m, src)
+}
+func (m *MsgRevokeMarketAuthorityScope) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeMarketAuthorityScope) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeMarketAuthorityScope.DiscardUnknown(m)
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "connect.marketmap.v2.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateMarkets",
-			Handler:    _Msg_CreateMarkets_Handler,
-		},
-		{
-			MethodName: "UpdateMarkets",
-			Handler:    _Msg_UpdateMarkets_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "RemoveMarketAuthorities",
-			Handler:    _Msg_RemoveMarketAuthorities_Handler,
-		},
-		{
-			MethodName: "UpsertMarkets",
-			Handler:    _Msg_UpsertMarkets_Handler,
-		},
-		{
-			MethodName: "RemoveMarkets",
-			Handler:    _Msg_RemoveMarkets_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "connect/marketmap/v2/tx.proto",
+var xxx_messageInfo_MsgRevokeMarketAuthorityScope proto.InternalMessageInfo
+
+func (m *MsgRevokeMarketAuthorityScope) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
 }
 
-func (m *MsgUpsertMarkets) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRevokeMarketAuthorityScope) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpsertMarkets) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRevokeMarketAuthorityScopeResponse is the response message for
+// MsgRevokeMarketAuthorityScope.
+type MsgRevokeMarketAuthorityScopeResponse struct {
 }
 
-func (m *MsgUpsertMarkets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Markets) > 0 {
-		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *MsgRevokeMarketAuthorityScopeResponse) Reset()         { *m = MsgRevokeMarketAuthorityScopeResponse{} }
+func (m *MsgRevokeMarketAuthorityScopeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeMarketAuthorityScopeResponse) ProtoMessage()    {}
+func (*MsgRevokeMarketAuthorityScopeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37df9476ca9a2f81, []int{19}
+}
+func (m *MsgRevokeMarketAuthorityScopeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeMarketAuthorityScopeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeMarketAuthorityScopeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgUpsertMarketsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgRevokeMarketAuthorityScopeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeMarketAuthorityScopeResponse.Merge(m, src)
+}
+func (m *MsgRevokeMarketAuthorityScopeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeMarketAuthorityScopeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeMarketAuthorityScopeResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpsertMarketsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRevokeMarketAuthorityScopeResponse proto.InternalMessageInfo
+
+// MsgUpsertProviders defines a message registering providers in the provider
+// registry.
+type MsgUpsertProviders struct {
+	// Authority is the signer of this transaction. This authority must be a
+	// market authority of the module.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Providers are the providers to register.
+	Providers []ProviderInfo `protobuf:"bytes,2,rep,name=providers,proto3" json:"providers"`
 }
 
-func (m *MsgUpsertMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MarketUpdates) > 0 {
-		keysForMarketUpdates := make([]string, 0, len(m.MarketUpdates))
-		for k := range m.MarketUpdates {
-			keysForMarketUpdates = append(keysForMarketUpdates, string(k))
-		}
-		github_com_cosmos_gogoproto_sortkeys.Strings(keysForMarketUpdates)
-		for iNdEx := len(keysForMarketUpdates) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.MarketUpdates[string(keysForMarketUpdates[iNdEx])]
-			baseI := i
-			i--
-			if v {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x10
-			i -= len(keysForMarketUpdates[iNdEx])
-			copy(dAtA[i:], keysForMarketUpdates[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(keysForMarketUpdates[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintTx(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
+func (m *MsgUpsertProviders) Reset()         { *m = MsgUpsertProviders{} }
+func (m *MsgUpsertProviders) String() string { return proto.CompactTextString(m) }
+func (*MsgUpsertProviders) ProtoMessage()    {}
+func (*MsgUpsertProviders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37df9476ca9a2f81, []int{20}
+}
+func (m *MsgUpsertProviders) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpsertProviders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpsertProviders.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgUpsertProviders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpsertProviders.Merge(m, src)
+}
+func (m *MsgUpsertProviders) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpsertProviders) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpsertProviders.DiscardUnknown(m)
 }
 
-func (m *MsgCreateMarkets) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpsertProviders proto.InternalMessageInfo
+
+func (m *MsgUpsertProviders) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateMarkets) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpsertProviders) GetProviders() []ProviderInfo {
+	if m != nil {
+		return m.Providers
+	}
+	return nil
 }
 
-func (m *MsgCreateMarkets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CreateMarkets) > 0 {
-		for iNdEx := len(m.CreateMarkets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CreateMarkets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// MsgUpsertProvidersResponse is the response message for MsgUpsertProviders.
+type MsgUpsertProvidersResponse struct {
 }
 
-func (m *MsgCreateMarketsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpsertProvidersResponse) Reset()         { *m = MsgUpsertProvidersResponse{} }
+func (m *MsgUpsertProvidersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpsertProvidersResponse) ProtoMessage()    {}
+func (*MsgUpsertProvidersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37df9476ca9a2f81, []int{21}
+}
+func (m *MsgUpsertProvidersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpsertProvidersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpsertProvidersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgCreateMarketsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpsertProvidersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpsertProvidersResponse.Merge(m, src)
 }
-
-func (m *MsgCreateMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpsertProvidersResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgUpdateMarkets) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpsertProvidersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpsertProvidersResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateMarkets) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpsertProvidersResponse proto.InternalMessageInfo
+
+// MsgRemoveProviders defines a message removing providers from the provider
+// registry.
+type MsgRemoveProviders struct {
+	// Authority is the signer of this transaction. This authority must be a
+	// market authority of the module.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Names are the names of the providers to remove.
+	Names []string `protobuf:"bytes,2,rep,name=names,proto3" json:"names,omitempty"`
 }
 
-func (m *MsgUpdateMarkets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UpdateMarkets) > 0 {
-		for iNdEx := len(m.UpdateMarkets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UpdateMarkets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *MsgRemoveProviders) Reset()         { *m = MsgRemoveProviders{} }
+func (m *MsgRemoveProviders) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveProviders) ProtoMessage()    {}
+func (*MsgRemoveProviders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37df9476ca9a2f81, []int{22}
+}
+func (m *MsgRemoveProviders) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveProviders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveProviders.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgUpdateMarketsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgRemoveProviders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveProviders.Merge(m, src)
 }
-
-func (m *MsgUpdateMarketsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRemoveProviders) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveProviders) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveProviders.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgRemoveProviders proto.InternalMessageInfo
+
+func (m *MsgRemoveProviders) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-func (m *MsgParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveProviders) GetNames() []string {
+	if m != nil {
+		return m.Names
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRemoveProvidersResponse is the response message for MsgRemoveProviders.
+type MsgRemoveProvidersResponse struct {
 }
 
-func (m *MsgParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgRemoveProvidersResponse) Reset()         { *m = MsgRemoveProvidersResponse{} }
+func (m *MsgRemoveProvidersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveProvidersResponse) ProtoMessage()    {}
+func (*MsgRemoveProvidersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37df9476ca9a2f81, []int{23}
+}
+func (m *MsgRemoveProvidersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveProvidersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveProvidersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *MsgRemoveProvidersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveProvidersResponse.Merge(m, src)
+}
+func (m *MsgRemoveProvidersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveProvidersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveProvidersResponse.DiscardUnknown(m)
 }
 
-func (m *MsgParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_MsgRemoveProvidersResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpsertMarkets)(nil), "connect.marketmap.v2.MsgUpsertMarkets")
+	proto.RegisterType((*MsgUpsertMarketsResponse)(nil), "connect.marketmap.v2.MsgUpsertMarketsResponse")
+	proto.RegisterMapType((map[string]bool)(nil), "connect.marketmap.v2.MsgUpsertMarketsResponse.MarketUpdatesEntry")
+	proto.RegisterType((*MsgCreateMarkets)(nil), "connect.marketmap.v2.MsgCreateMarkets")
+	proto.RegisterType((*MsgCreateMarketsResponse)(nil), "connect.marketmap.v2.MsgCreateMarketsResponse")
+	proto.RegisterType((*MsgUpdateMarkets)(nil), "connect.marketmap.v2.MsgUpdateMarkets")
+	proto.RegisterType((*MsgUpdateMarketsResponse)(nil), "connect.marketmap.v2.MsgUpdateMarketsResponse")
+	proto.RegisterType((*MsgParams)(nil), "connect.marketmap.v2.MsgParams")
+	proto.RegisterType((*MsgParamsResponse)(nil), "connect.marketmap.v2.MsgParamsResponse")
+	proto.RegisterType((*MsgRemoveMarketAuthorities)(nil), "connect.marketmap.v2.MsgRemoveMarketAuthorities")
+	proto.RegisterType((*MsgRemoveMarketAuthoritiesResponse)(nil), "connect.marketmap.v2.MsgRemoveMarketAuthoritiesResponse")
+	proto.RegisterType((*MsgRemoveMarkets)(nil), "connect.marketmap.v2.MsgRemoveMarkets")
+	proto.RegisterType((*MsgRemoveMarketsResponse)(nil), "connect.marketmap.v2.MsgRemoveMarketsResponse")
+	proto.RegisterType((*MsgScheduleMarketChange)(nil), "connect.marketmap.v2.MsgScheduleMarketChange")
+	proto.RegisterType((*MsgScheduleMarketChangeResponse)(nil), "connect.marketmap.v2.MsgScheduleMarketChangeResponse")
+	proto.RegisterType((*MsgCancelScheduledMarketChange)(nil), "connect.marketmap.v2.MsgCancelScheduledMarketChange")
+	proto.RegisterType((*MsgCancelScheduledMarketChangeResponse)(nil), "connect.marketmap.v2.MsgCancelScheduledMarketChangeResponse")
+	proto.RegisterType((*MsgGrantMarketAuthorityScope)(nil), "connect.marketmap.v2.MsgGrantMarketAuthorityScope")
+	proto.RegisterType((*MsgGrantMarketAuthorityScopeResponse)(nil), "connect.marketmap.v2.MsgGrantMarketAuthorityScopeResponse")
+	proto.RegisterType((*MsgRevokeMarketAuthorityScope)(nil), "connect.marketmap.v2.MsgRevokeMarketAuthorityScope")
+	proto.RegisterType((*MsgRevokeMarketAuthorityScopeResponse)(nil), "connect.marketmap.v2.MsgRevokeMarketAuthorityScopeResponse")
+	proto.RegisterType((*MsgUpsertProviders)(nil), "connect.marketmap.v2.MsgUpsertProviders")
+	proto.RegisterType((*MsgUpsertProvidersResponse)(nil), "connect.marketmap.v2.MsgUpsertProvidersResponse")
+	proto.RegisterType((*MsgRemoveProviders)(nil), "connect.marketmap.v2.MsgRemoveProviders")
+	proto.RegisterType((*MsgRemoveProvidersResponse)(nil), "connect.marketmap.v2.MsgRemoveProvidersResponse")
 }
 
-func (m *MsgParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() { proto.RegisterFile("connect/marketmap/v2/tx.proto", fileDescriptor_37df9476ca9a2f81) }
+
+var fileDescriptor_37df9476ca9a2f81 = []byte{
+	// 1156 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4b, 0x6f, 0xdb, 0x46,
+	0x10, 0xf6, 0xca, 0x8f, 0x56, 0xe3, 0xfa, 0x11, 0x56, 0x80, 0x19, 0xd6, 0x91, 0x1d, 0xc6, 0x91,
+	0x55, 0x17, 0x96, 0x1c, 0x25, 0x29, 0x02, 0xc6, 0x05, 0x6a, 0x1b, 0x4d, 0x91, 0x02, 0x02, 0x02,
+	0x19, 0x01, 0x8a, 0x5e, 0x0c, 0x46, 0xdc, 0xd2, 0x84, 0xcd, 0x07, 0xb8, 0x94, 0x10, 0x01, 0x3d,
+	0x14, 0x2d, 0x7a, 0x69, 0x81, 0x3e, 0x6e, 0x3d, 0xd6, 0x40, 0x7f, 0x80, 0x0f, 0x3d, 0xf7, 0x56,
+	0x20, 0x87, 0x1e, 0x8c, 0xf4, 0x52, 0xf4, 0x50, 0x14, 0xf6, 0xc1, 0xfd, 0x19, 0x81, 0xb8, 0xcb,
+	0x95, 0x48, 0x73, 0x69, 0x49, 0xce, 0xc5, 0x26, 0x87, 0xdf, 0xcc, 0x7c, 0xdf, 0xee, 0xec, 0xec,
+	0xd8, 0x70, 0xa3, 0xe9, 0x3a, 0x0e, 0x6e, 0x06, 0x55, 0x5b, 0xf7, 0x0f, 0x70, 0x60, 0xeb, 0x5e,
+	0xb5, 0x5d, 0xab, 0x06, 0xcf, 0x2b, 0x9e, 0xef, 0x06, 0xae, 0x54, 0x60, 0x9f, 0x2b, 0xfc, 0x73,
+	0xa5, 0x5d, 0x53, 0x16, 0x9a, 0x2e, 0xb1, 0x5d, 0x52, 0xb5, 0x89, 0x59, 0x6d, 0xdf, 0xe9, 0xfe,
+	0xa2, 0x70, 0xa5, 0x60, 0xba, 0xa6, 0x1b, 0x3e, 0x56, 0xbb, 0x4f, 0xcc, 0x7a, 0x9d, 0xc2, 0xf7,
+	0xe8, 0x07, 0xfa, 0xc2, 0x3e, 0x5d, 0xd3, 0x6d, 0xcb, 0x71, 0xab, 0xe1, 0x4f, 0x66, 0xba, 0x99,
+	0xca, 0x88, 0xbe, 0x64, 0x42, 0x3c, 0xdd, 0xd7, 0xed, 0x28, 0xf0, 0xad, 0x54, 0x88, 0x8f, 0x4d,
+	0x8b, 0x04, 0x7e, 0x27, 0x13, 0x44, 0x9a, 0xfb, 0xd8, 0x68, 0x1d, 0x62, 0x06, 0x5a, 0x16, 0x80,
+	0x5c, 0x8f, 0x21, 0xd4, 0x3f, 0x10, 0xcc, 0xd7, 0x89, 0xf9, 0xd4, 0x23, 0xd8, 0x0f, 0xea, 0x21,
+	0x8a, 0x48, 0xef, 0x43, 0x5e, 0x6f, 0x05, 0xfb, 0xae, 0x6f, 0x05, 0x1d, 0x19, 0x2d, 0xa3, 0x72,
+	0x7e, 0x5b, 0x7e, 0xf9, 0xdb, 0x7a, 0x81, 0xc9, 0xdf, 0x32, 0x0c, 0x1f, 0x13, 0xb2, 0x1b, 0xf8,
+	0x96, 0x63, 0x36, 0x7a, 0x50, 0x69, 0x13, 0xde, 0xa0, 0x89, 0x88, 0x9c, 0x5b, 0x1e, 0x2f, 0x4f,
+	0xd7, 0x16, 0x2b, 0x69, 0x7b, 0x50, 0xa1, 0x79, 0xb6, 0x27, 0x5e, 0xfc, 0xbb, 0x34, 0xd6, 0x88,
+	0x5c, 0xb4, 0x87, 0xff, 0xff, 0xb2, 0x34, 0xf6, 0xd5, 0xf9, 0xf1, 0x5a, 0x2f, 0xe2, 0xb7, 0xe7,
+	0xc7, 0x6b, 0x2b, 0x91, 0x86, 0xe7, 0x7d, 0x2a, 0x92, 0x94, 0xd5, 0x13, 0x04, 0x72, 0xd2, 0xd8,
+	0xc0, 0xc4, 0x73, 0x1d, 0x82, 0xa5, 0x43, 0x98, 0xa5, 0xae, 0x7b, 0x2d, 0xcf, 0xd0, 0x03, 0x4c,
+	0x64, 0x14, 0xd2, 0xdb, 0x12, 0xd0, 0x13, 0xc4, 0x61, 0xbc, 0x9f, 0xd2, 0x18, 0x1f, 0x39, 0x81,
+	0xdf, 0xd9, 0xce, 0xc9, 0xa8, 0x31, 0x63, 0xf7, 0xdb, 0x95, 0x0f, 0x41, 0xba, 0x08, 0x94, 0xe6,
+	0x61, 0xfc, 0x00, 0xb3, 0xd5, 0x6c, 0x74, 0x1f, 0xa5, 0x02, 0x4c, 0xb6, 0xf5, 0xc3, 0x16, 0x96,
+	0x73, 0xcb, 0xa8, 0xfc, 0x66, 0x83, 0xbe, 0x68, 0xb9, 0x07, 0x48, 0x9b, 0xf8, 0xf9, 0x68, 0x09,
+	0xa9, 0x2f, 0xe9, 0xd6, 0xec, 0xf8, 0x58, 0x0f, 0xf0, 0x55, 0xb7, 0xe6, 0x31, 0xcc, 0x36, 0xc3,
+	0x40, 0x7b, 0xc3, 0xef, 0xd0, 0x4c, 0xb3, 0x9f, 0xc2, 0xb0, 0xfb, 0x14, 0xe3, 0xaf, 0x2a, 0x20,
+	0x27, 0x6d, 0xd1, 0xf2, 0x46, 0x82, 0xe9, 0xb2, 0xbd, 0x06, 0xc1, 0x74, 0xb3, 0x47, 0x11, 0xdc,
+	0xf2, 0x8c, 0xd1, 0x05, 0xc7, 0xf8, 0x33, 0xc1, 0x31, 0x1b, 0x17, 0xfc, 0x03, 0x82, 0x7c, 0x9d,
+	0x98, 0x4f, 0xc2, 0xc3, 0x2f, 0x69, 0x30, 0x45, 0xdb, 0x40, 0x28, 0x53, 0xc8, 0x94, 0xa2, 0x19,
+	0x53, 0xe6, 0x11, 0x5f, 0xa5, 0xdc, 0xc0, 0xab, 0xa4, 0xcd, 0xc6, 0x65, 0xa9, 0x6f, 0xc3, 0x35,
+	0x4e, 0x88, 0xd3, 0xfc, 0x1a, 0x81, 0x52, 0x27, 0x66, 0x03, 0xdb, 0x6e, 0x9b, 0x69, 0xd8, 0x62,
+	0x1e, 0x16, 0x26, 0xd2, 0xbb, 0x30, 0xef, 0x87, 0x9f, 0xf6, 0x74, 0x9a, 0x86, 0x9d, 0xaf, 0x7c,
+	0x63, 0x8e, 0xda, 0xb7, 0x22, 0xb3, 0x54, 0x81, 0x49, 0xdd, 0xb0, 0x2d, 0xe7, 0x52, 0x8a, 0x14,
+	0xa6, 0x41, 0x97, 0x1e, 0x7d, 0x56, 0x57, 0x40, 0x15, 0x93, 0xe0, 0x5c, 0x03, 0x98, 0x4f, 0xa0,
+	0x46, 0x2f, 0x21, 0x39, 0xde, 0xce, 0xf2, 0xbd, 0x56, 0x95, 0x5c, 0xb6, 0x1d, 0x90, 0x93, 0x59,
+	0x79, 0xf3, 0x59, 0x85, 0x39, 0x03, 0x1f, 0xe2, 0x00, 0x1b, 0xbc, 0x12, 0xe9, 0xea, 0xcc, 0x32,
+	0x73, 0x54, 0x29, 0xff, 0x20, 0x58, 0xa8, 0x13, 0x73, 0x97, 0xb5, 0x70, 0x6a, 0xde, 0xd9, 0xd7,
+	0x1d, 0x13, 0x5f, 0xe1, 0x14, 0x4c, 0x35, 0xc3, 0x08, 0xe1, 0x8a, 0x4f, 0xd7, 0xde, 0x4b, 0xaf,
+	0xa9, 0x28, 0xa7, 0xd1, 0x9f, 0x34, 0x2a, 0x31, 0x1a, 0x40, 0xdb, 0x4c, 0x3f, 0x05, 0xb7, 0x05,
+	0xa7, 0x20, 0x0a, 0x49, 0x63, 0xa9, 0x77, 0x60, 0x49, 0xa0, 0x8d, 0x2f, 0xd4, 0x2c, 0xe4, 0x2c,
+	0x23, 0x14, 0x37, 0xd1, 0xc8, 0x59, 0x86, 0xfa, 0x2b, 0x82, 0x62, 0xb7, 0x57, 0xe8, 0x4e, 0x13,
+	0x1f, 0xa6, 0x32, 0x1c, 0x79, 0x59, 0x68, 0xaa, 0x5c, 0x94, 0x4a, 0xd3, 0xd2, 0xb5, 0xdd, 0x12,
+	0xb5, 0xb4, 0x90, 0x12, 0x53, 0x56, 0x86, 0x52, 0x36, 0x4b, 0x5e, 0x9b, 0x7f, 0x22, 0x58, 0xac,
+	0x13, 0xf3, 0x63, 0x5f, 0x77, 0x82, 0x78, 0x05, 0x77, 0x76, 0xbb, 0x57, 0x72, 0xef, 0x78, 0xa0,
+	0x81, 0x8e, 0x87, 0xf4, 0x08, 0x26, 0xc3, 0xbb, 0x9c, 0x6d, 0xee, 0x5a, 0x56, 0x6b, 0x8b, 0xa7,
+	0x62, 0x7b, 0x4b, 0xdd, 0xb5, 0x7b, 0x91, 0x7c, 0x1a, 0xb7, 0x2b, 0xfd, 0xa6, 0x40, 0x7a, 0x48,
+	0x3e, 0x0c, 0xa1, 0x96, 0x60, 0x25, 0x4b, 0x0d, 0x97, 0xfd, 0x3b, 0x82, 0x1b, 0xe1, 0xe9, 0x68,
+	0xbb, 0x07, 0xf8, 0xb5, 0xe8, 0x1e, 0xb5, 0xdb, 0xdd, 0xbf, 0xa8, 0x53, 0x15, 0xe8, 0xa4, 0x6c,
+	0xa9, 0xd0, 0x55, 0xb8, 0x9d, 0xc9, 0x9f, 0x2b, 0xfd, 0x0b, 0x81, 0xc4, 0x87, 0x87, 0x27, 0xbe,
+	0xdb, 0xb6, 0x0c, 0xec, 0x8f, 0xde, 0x7f, 0x1e, 0x41, 0xde, 0x8b, 0x82, 0xb0, 0xdb, 0x4b, 0x15,
+	0xdc, 0x09, 0x0c, 0xf6, 0xd8, 0xf9, 0xdc, 0x65, 0x5b, 0xdb, 0x73, 0xd5, 0x3e, 0x48, 0xaf, 0xee,
+	0x52, 0xe6, 0x60, 0xc5, 0xe9, 0xab, 0x8b, 0xa0, 0x5c, 0xb4, 0x72, 0xcd, 0x47, 0x54, 0x33, 0xed,
+	0x7d, 0x57, 0xd7, 0x5c, 0x80, 0x49, 0x47, 0xb7, 0x71, 0xd4, 0x71, 0xe9, 0xcb, 0xb0, 0x0a, 0x12,
+	0x64, 0x98, 0x82, 0x84, 0x35, 0x52, 0x50, 0x3b, 0x9a, 0x86, 0xf1, 0x3a, 0x31, 0x25, 0x13, 0x66,
+	0xe2, 0xb3, 0x56, 0x49, 0x38, 0x1e, 0xc6, 0x70, 0x4a, 0x65, 0x30, 0x1c, 0x6f, 0x74, 0x26, 0xcc,
+	0xc4, 0x67, 0x9c, 0x52, 0xc6, 0x1c, 0x6a, 0x0c, 0x94, 0x28, 0x75, 0xbe, 0x90, 0x3e, 0x85, 0xb7,
+	0xe8, 0x07, 0x36, 0x61, 0x2c, 0x09, 0xfd, 0x29, 0x40, 0x59, 0xbd, 0x04, 0xc0, 0x23, 0x7f, 0x83,
+	0x60, 0x41, 0x34, 0x0f, 0x6c, 0x08, 0x83, 0x08, 0x3c, 0x94, 0x07, 0xc3, 0x7a, 0xc4, 0x97, 0xb2,
+	0xff, 0x4f, 0x97, 0xd2, 0x60, 0x23, 0xbd, 0x52, 0x19, 0x0c, 0xd7, 0x9f, 0x28, 0x3e, 0x54, 0x94,
+	0x06, 0xe2, 0x9c, 0x95, 0x28, 0x7d, 0x5c, 0xf8, 0x02, 0x0a, 0xa9, 0x13, 0xc0, 0xba, 0x30, 0x4e,
+	0x1a, 0x5c, 0xb9, 0x3f, 0x14, 0x9c, 0x67, 0xff, 0x09, 0xc1, 0x3b, 0x59, 0x17, 0xee, 0x3d, 0x71,
+	0xa9, 0x8b, 0xbd, 0x94, 0xcd, 0x51, 0xbc, 0x38, 0xa7, 0xef, 0x10, 0x5c, 0x17, 0xdf, 0x99, 0x35,
+	0x61, 0x6c, 0xa1, 0x8f, 0xa2, 0x0d, 0xef, 0xc3, 0xd9, 0x7c, 0x8f, 0x40, 0xc9, 0xb8, 0xca, 0xee,
+	0x66, 0x6c, 0xb7, 0xc8, 0x49, 0x79, 0x38, 0x82, 0x13, 0x27, 0x64, 0xc3, 0x5c, 0xf2, 0xc2, 0x29,
+	0x5f, 0x52, 0xdc, 0x1c, 0xa9, 0x6c, 0x0c, 0x8a, 0xec, 0x4f, 0x97, 0xec, 0xf5, 0xe5, 0x4b, 0x4a,
+	0x7c, 0x90, 0x74, 0x82, 0xe6, 0xac, 0x4c, 0x7e, 0x79, 0x7e, 0xbc, 0x86, 0xb6, 0x3f, 0x79, 0x71,
+	0x5a, 0x44, 0x27, 0xa7, 0x45, 0xf4, 0xdf, 0x69, 0x11, 0xfd, 0x78, 0x56, 0x1c, 0x3b, 0x39, 0x2b,
+	0x8e, 0xfd, 0x7d, 0x56, 0x1c, 0xfb, 0x6c, 0xc3, 0xb4, 0x82, 0xfd, 0xd6, 0xb3, 0x4a, 0xd3, 0xb5,
+	0xab, 0xe4, 0xc0, 0xf2, 0xd6, 0x6d, 0xdc, 0xae, 0x46, 0xf7, 0x42, 0xbb, 0x16, 0xbb, 0x1a, 0x82,
+	0x8e, 0x87, 0xc9, 0xb3, 0xa9, 0xf0, 0x3f, 0x1f, 0x77, 0x5f, 0x0d, 0x00, 0xe9, 0xa4, 0xb7, 0xfb,
+	0x3f, 0x12, 0x00, 0x00,
 }
 
-func (m *MsgParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreateMarkets creates markets from the given message.
+	CreateMarkets(ctx context.Context, in *MsgCreateMarkets, opts ...grpc.CallOption) (*MsgCreateMarketsResponse, error)
+	// UpdateMarkets updates markets from the given message.
+	UpdateMarkets(ctx context.Context, in *MsgUpdateMarkets, opts ...grpc.CallOption) (*MsgUpdateMarketsResponse, error)
+	// UpdateParams defines a method for updating the x/marketmap module
+	// parameters.
+	UpdateParams(ctx context.Context, in *MsgParams, opts ...grpc.CallOption) (*MsgParamsResponse, error)
+	// RemoveMarketAuthorities defines a method for removing market authorities
+	// from the x/marketmap module. the signer must be the admin.
+	RemoveMarketAuthorities(ctx context.Context, in *MsgRemoveMarketAuthorities, opts ...grpc.CallOption) (*MsgRemoveMarketAuthoritiesResponse, error)
+	// UpsertMarkets wraps both Create / Update markets into a single message.
+	// Specifically if a market does not exist it will be created, otherwise it
+	// will be updated. The response will be a map between ticker -> updated.
+	UpsertMarkets(ctx context.Context, in *MsgUpsertMarkets, opts ...grpc.CallOption) (*MsgUpsertMarketsResponse, error)
+	// RemoveMarkets removes the given markets from the marketmap if:
+	// - they exist in the map
+	// - they are disabled
+	RemoveMarkets(ctx context.Context, in *MsgRemoveMarkets, opts ...grpc.CallOption) (*MsgRemoveMarketsResponse, error)
+	// ScheduleMarketChange schedules a change (enable, disable or update) of a
+	// market at a target block height or time.
+	ScheduleMarketChange(ctx context.Context, in *MsgScheduleMarketChange, opts ...grpc.CallOption) (*MsgScheduleMarketChangeResponse, error)
+	// CancelScheduledMarketChange cancels a pending scheduled market change.
+	CancelScheduledMarketChange(ctx context.Context, in *MsgCancelScheduledMarketChange, opts ...grpc.CallOption) (*MsgCancelScheduledMarketChangeResponse, error)
+	// GrantMarketAuthorityScope grants a scoped market authority, replacing any
+	// scope previously granted to the same authority. The signer must be the
+	// admin.
+	GrantMarketAuthorityScope(ctx context.Context, in *MsgGrantMarketAuthorityScope, opts ...grpc.CallOption) (*MsgGrantMarketAuthorityScopeResponse, error)
+	// RevokeMarketAuthorityScope revokes the scope of a scoped market authority.
+	// The signer must be the admin.
+	RevokeMarketAuthorityScope(ctx context.Context, in *MsgRevokeMarketAuthorityScope, opts ...grpc.CallOption) (*MsgRevokeMarketAuthorityScopeResponse, error)
+	// UpsertProviders registers the given providers in the provider registry,
+	// replacing any provider already registered under the same name. The signer
+	// must be a market authority.
+	UpsertProviders(ctx context.Context, in *MsgUpsertProviders, opts ...grpc.CallOption) (*MsgUpsertProvidersResponse, error)
+	// RemoveProviders removes the given providers from the provider registry if
+	// no market uses them. The signer must be a market authority.
+	RemoveProviders(ctx context.Context, in *MsgRemoveProviders, opts ...grpc.CallOption) (*MsgRemoveProvidersResponse, error)
 }
 
-func (m *MsgRemoveMarketAuthorities) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateMarkets(ctx context.Context, in *MsgCreateMarkets, opts ...grpc.CallOption) (*MsgCreateMarketsResponse, error) {
+	out := new(MsgCreateMarketsResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/CreateMarkets", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRemoveMarketAuthorities) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateMarkets(ctx context.Context, in *MsgUpdateMarkets, opts ...grpc.CallOption) (*MsgUpdateMarketsResponse, error) {
+	out := new(MsgUpdateMarketsResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/UpdateMarkets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRemoveMarketAuthorities) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0x12
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgParams, opts ...grpc.CallOption) (*MsgParamsResponse, error) {
+	out := new(MsgParamsResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.RemoveAddresses) > 0 {
-		for iNdEx := len(m.RemoveAddresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RemoveAddresses[iNdEx])
-			copy(dAtA[i:], m.RemoveAddresses[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.RemoveAddresses[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	return out, nil
+}
+
+func (c *msgClient) RemoveMarketAuthorities(ctx context.Context, in *MsgRemoveMarketAuthorities, opts ...grpc.CallOption) (*MsgRemoveMarketAuthoritiesResponse, error) {
+	out := new(MsgRemoveMarketAuthoritiesResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/RemoveMarketAuthorities", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgRemoveMarketAuthoritiesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpsertMarkets(ctx context.Context, in *MsgUpsertMarkets, opts ...grpc.CallOption) (*MsgUpsertMarketsResponse, error) {
+	out := new(MsgUpsertMarketsResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/UpsertMarkets", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRemoveMarketAuthoritiesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RemoveMarkets(ctx context.Context, in *MsgRemoveMarkets, opts ...grpc.CallOption) (*MsgRemoveMarketsResponse, error) {
+	out := new(MsgRemoveMarketsResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/RemoveMarkets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRemoveMarketAuthoritiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) ScheduleMarketChange(ctx context.Context, in *MsgScheduleMarketChange, opts ...grpc.CallOption) (*MsgScheduleMarketChangeResponse, error) {
+	out := new(MsgScheduleMarketChangeResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/ScheduleMarketChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRemoveMarkets) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CancelScheduledMarketChange(ctx context.Context, in *MsgCancelScheduledMarketChange, opts ...grpc.CallOption) (*MsgCancelScheduledMarketChangeResponse, error) {
+	out := new(MsgCancelScheduledMarketChangeResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/CancelScheduledMarketChange", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRemoveMarkets) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) GrantMarketAuthorityScope(ctx context.Context, in *MsgGrantMarketAuthorityScope, opts ...grpc.CallOption) (*MsgGrantMarketAuthorityScopeResponse, error) {
+	out := new(MsgGrantMarketAuthorityScopeResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/GrantMarketAuthorityScope", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRemoveMarkets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Markets) > 0 {
-		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Markets[iNdEx])
-			copy(dAtA[i:], m.Markets[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Markets[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+func (c *msgClient) RevokeMarketAuthorityScope(ctx context.Context, in *MsgRevokeMarketAuthorityScope, opts ...grpc.CallOption) (*MsgRevokeMarketAuthorityScopeResponse, error) {
+	out := new(MsgRevokeMarketAuthorityScopeResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/RevokeMarketAuthorityScope", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) UpsertProviders(ctx context.Context, in *MsgUpsertProviders, opts ...grpc.CallOption) (*MsgUpsertProvidersResponse, error) {
+	out := new(MsgUpsertProvidersResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/UpsertProviders", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgRemoveMarketsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RemoveProviders(ctx context.Context, in *MsgRemoveProviders, opts ...grpc.CallOption) (*MsgRemoveProvidersResponse, error) {
+	out := new(MsgRemoveProvidersResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/RemoveProviders", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRemoveMarketsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// CreateMarkets creates markets from the given message.
+	CreateMarkets(context.Context, *MsgCreateMarkets) (*MsgCreateMarketsResponse, error)
+	// UpdateMarkets updates markets from the given message.
+	UpdateMarkets(context.Context, *MsgUpdateMarkets) (*MsgUpdateMarketsResponse, error)
+	// UpdateParams defines a method for updating the x/marketmap module
+	// parameters.
+	UpdateParams(context.Context, *MsgParams) (*MsgParamsResponse, error)
+	// RemoveMarketAuthorities defines a method for removing market authorities
+	// from the x/marketmap module. the signer must be the admin.
+	RemoveMarketAuthorities(context.Context, *MsgRemoveMarketAuthorities) (*MsgRemoveMarketAuthoritiesResponse, error)
+	// UpsertMarkets wraps both Create / Update markets into a single message.
+	// Specifically if a market does not exist it will be created, otherwise it
+	// will be updated. The response will be a map between ticker -> updated.
+	UpsertMarkets(context.Context, *MsgUpsertMarkets) (*MsgUpsertMarketsResponse, error)
+	// RemoveMarkets removes the given markets from the marketmap if:
+	// - they exist in the map
+	// - they are disabled
+	RemoveMarkets(context.Context, *MsgRemoveMarkets) (*MsgRemoveMarketsResponse, error)
+	// ScheduleMarketChange schedules a change (enable, disable or update) of a
+	// market at a target block height or time.
+	ScheduleMarketChange(context.Context, *MsgScheduleMarketChange) (*MsgScheduleMarketChangeResponse, error)
+	// CancelScheduledMarketChange cancels a pending scheduled market change.
+	CancelScheduledMarketChange(context.Context, *MsgCancelScheduledMarketChange) (*MsgCancelScheduledMarketChangeResponse, error)
+	// GrantMarketAuthorityScope grants a scoped market authority, replacing any
+	// scope previously granted to the same authority. The signer must be the
+	// admin.
+	GrantMarketAuthorityScope(context.Context, *MsgGrantMarketAuthorityScope) (*MsgGrantMarketAuthorityScopeResponse, error)
+	// RevokeMarketAuthorityScope revokes the scope of a scoped market authority.
+	// The signer must be the admin.
+	RevokeMarketAuthorityScope(context.Context, *MsgRevokeMarketAuthorityScope) (*MsgRevokeMarketAuthorityScopeResponse, error)
+	// UpsertProviders registers the given providers in the provider registry,
+	// replacing any provider already registered under the same name. The signer
+	// must be a market authority.
+	UpsertProviders(context.Context, *MsgUpsertProviders) (*MsgUpsertProvidersResponse, error)
+	// RemoveProviders removes the given providers from the provider registry if
+	// no market uses them. The signer must be a market authority.
+	RemoveProviders(context.Context, *MsgRemoveProviders) (*MsgRemoveProvidersResponse, error)
 }
 
-func (m *MsgRemoveMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DeletedMarkets) > 0 {
-		for iNdEx := len(m.DeletedMarkets) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.DeletedMarkets[iNdEx])
-			copy(dAtA[i:], m.DeletedMarkets[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.DeletedMarkets[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (*UnimplementedMsgServer) CreateMarkets(ctx context.Context, req *MsgCreateMarkets) (*MsgCreateMarketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMarkets not implemented")
 }
-func (m *MsgUpsertMarkets) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Markets) > 0 {
-		for _, e := range m.Markets {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+func (*UnimplementedMsgServer) UpdateMarkets(ctx context.Context, req *MsgUpdateMarkets) (*MsgUpdateMarketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMarkets not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgParams) (*MsgParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) RemoveMarketAuthorities(ctx context.Context, req *MsgRemoveMarketAuthorities) (*MsgRemoveMarketAuthoritiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMarketAuthorities not implemented")
+}
+func (*UnimplementedMsgServer) UpsertMarkets(ctx context.Context, req *MsgUpsertMarkets) (*MsgUpsertMarketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertMarkets not implemented")
+}
+func (*UnimplementedMsgServer) RemoveMarkets(ctx context.Context, req *MsgRemoveMarkets) (*MsgRemoveMarketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMarkets not implemented")
+}
+func (*UnimplementedMsgServer) ScheduleMarketChange(ctx context.Context, req *MsgScheduleMarketChange) (*MsgScheduleMarketChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleMarketChange not implemented")
+}
+func (*UnimplementedMsgServer) CancelScheduledMarketChange(ctx context.Context, req *MsgCancelScheduledMarketChange) (*MsgCancelScheduledMarketChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledMarketChange not implemented")
+}
+func (*UnimplementedMsgServer) GrantMarketAuthorityScope(ctx context.Context, req *MsgGrantMarketAuthorityScope) (*MsgGrantMarketAuthorityScopeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantMarketAuthorityScope not implemented")
+}
+func (*UnimplementedMsgServer) RevokeMarketAuthorityScope(ctx context.Context, req *MsgRevokeMarketAuthorityScope) (*MsgRevokeMarketAuthorityScopeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeMarketAuthorityScope not implemented")
+}
+func (*UnimplementedMsgServer) UpsertProviders(ctx context.Context, req *MsgUpsertProviders) (*MsgUpsertProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertProviders not implemented")
+}
+func (*UnimplementedMsgServer) RemoveProviders(ctx context.Context, req *MsgRemoveProviders) (*MsgRemoveProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveProviders not implemented")
 }
 
-func (m *MsgUpsertMarketsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.MarketUpdates) > 0 {
-		for k, v := range m.MarketUpdates {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovTx(uint64(len(k))) + 1 + 1
-			n += mapEntrySize + 1 + sovTx(uint64(mapEntrySize))
-		}
-	}
-	return n
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgCreateMarkets) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CreateMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateMarkets)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CreateMarkets(ctx, in)
 	}
-	if len(m.CreateMarkets) > 0 {
-		for _, e := range m.CreateMarkets {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Msg/CreateMarkets",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateMarkets(ctx, req.(*MsgCreateMarkets))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateMarketsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func _Msg_UpdateMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateMarkets)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateMarkets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Msg/UpdateMarkets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateMarkets(ctx, req.(*MsgUpdateMarkets))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateMarkets) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
 	}
-	if len(m.UpdateMarkets) > 0 {
-		for _, e := range m.UpdateMarkets {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Msg/UpdateParams",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateMarketsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RemoveMarketAuthorities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveMarketAuthorities)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveMarketAuthorities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Msg/RemoveMarketAuthorities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveMarketAuthorities(ctx, req.(*MsgRemoveMarketAuthorities))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgParams) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpsertMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpsertMarkets)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpsertMarkets(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Msg/UpsertMarkets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpsertMarkets(ctx, req.(*MsgUpsertMarkets))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RemoveMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveMarkets)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveMarkets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Msg/RemoveMarkets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveMarkets(ctx, req.(*MsgRemoveMarkets))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRemoveMarketAuthorities) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ScheduleMarketChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleMarketChange)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.RemoveAddresses) > 0 {
-		for _, s := range m.RemoveAddresses {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleMarketChange(ctx, in)
 	}
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Msg/ScheduleMarketChange",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleMarketChange(ctx, req.(*MsgScheduleMarketChange))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRemoveMarketAuthoritiesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CancelScheduledMarketChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelScheduledMarketChange)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).CancelScheduledMarketChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Msg/CancelScheduledMarketChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelScheduledMarketChange(ctx, req.(*MsgCancelScheduledMarketChange))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRemoveMarkets) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_GrantMarketAuthorityScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantMarketAuthorityScope)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).GrantMarketAuthorityScope(ctx, in)
 	}
-	if len(m.Markets) > 0 {
-		for _, s := range m.Markets {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Msg/GrantMarketAuthorityScope",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantMarketAuthorityScope(ctx, req.(*MsgGrantMarketAuthorityScope))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeMarketAuthorityScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeMarketAuthorityScope)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeMarketAuthorityScope(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Msg/RevokeMarketAuthorityScope",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeMarketAuthorityScope(ctx, req.(*MsgRevokeMarketAuthorityScope))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpsertProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpsertProviders)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpsertProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Msg/UpsertProviders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpsertProviders(ctx, req.(*MsgUpsertProviders))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveProviders)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Msg/RemoveProviders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveProviders(ctx, req.(*MsgRemoveProviders))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "connect.marketmap.v2.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateMarkets",
+			Handler:    _Msg_CreateMarkets_Handler,
+		},
+		{
+			MethodName: "UpdateMarkets",
+			Handler:    _Msg_UpdateMarkets_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "RemoveMarketAuthorities",
+			Handler:    _Msg_RemoveMarketAuthorities_Handler,
+		},
+		{
+			MethodName: "UpsertMarkets",
+			Handler:    _Msg_UpsertMarkets_Handler,
+		},
+		{
+			MethodName: "RemoveMarkets",
+			Handler:    _Msg_RemoveMarkets_Handler,
+		},
+		{
+			MethodName: "ScheduleMarketChange",
+			Handler:    _Msg_ScheduleMarketChange_Handler,
+		},
+		{
+			MethodName: "CancelScheduledMarketChange",
+			Handler:    _Msg_CancelScheduledMarketChange_Handler,
+		},
+		{
+			MethodName: "GrantMarketAuthorityScope",
+			Handler:    _Msg_GrantMarketAuthorityScope_Handler,
+		},
+		{
+			MethodName: "RevokeMarketAuthorityScope",
+			Handler:    _Msg_RevokeMarketAuthorityScope_Handler,
+		},
+		{
+			MethodName: "UpsertProviders",
+			Handler:    _Msg_UpsertProviders_Handler,
+		},
+		{
+			MethodName: "RemoveProviders",
+			Handler:    _Msg_RemoveProviders_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "connect/marketmap/v2/tx.proto",
+}
+
+func (m *MsgUpsertMarkets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpsertMarkets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpsertMarkets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpsertMarketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpsertMarketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpsertMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MarketUpdates) > 0 {
+		keysForMarketUpdates := make([]string, 0, len(m.MarketUpdates))
+		for k := range m.MarketUpdates {
+			keysForMarketUpdates = append(keysForMarketUpdates, string(k))
+		}
+		github_com_cosmos_gogoproto_sortkeys.Strings(keysForMarketUpdates)
+		for iNdEx := len(keysForMarketUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.MarketUpdates[string(keysForMarketUpdates[iNdEx])]
+			baseI := i
+			i--
+			if v {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+			i -= len(keysForMarketUpdates[iNdEx])
+			copy(dAtA[i:], keysForMarketUpdates[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(keysForMarketUpdates[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintTx(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateMarkets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateMarkets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateMarkets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CreateMarkets) > 0 {
+		for iNdEx := len(m.CreateMarkets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CreateMarkets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateMarketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateMarketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMarkets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMarkets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMarkets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpdateMarkets) > 0 {
+		for iNdEx := len(m.UpdateMarkets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UpdateMarkets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMarketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMarketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveMarketAuthorities) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveMarketAuthorities) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveMarketAuthorities) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RemoveAddresses) > 0 {
+		for iNdEx := len(m.RemoveAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemoveAddresses[iNdEx])
+			copy(dAtA[i:], m.RemoveAddresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.RemoveAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveMarketAuthoritiesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveMarketAuthoritiesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveMarketAuthoritiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveMarkets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveMarkets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveMarkets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Markets[iNdEx])
+			copy(dAtA[i:], m.Markets[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Markets[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveMarketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveMarketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeletedMarkets) > 0 {
+		for iNdEx := len(m.DeletedMarkets) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeletedMarkets[iNdEx])
+			copy(dAtA[i:], m.DeletedMarkets[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.DeletedMarkets[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleMarketChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleMarketChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleMarketChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Change.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleMarketChangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleMarketChangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleMarketChangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScheduledMarketChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledMarketChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledMarketChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScheduledMarketChangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledMarketChangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledMarketChangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantMarketAuthorityScope) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantMarketAuthorityScope) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantMarketAuthorityScope) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantMarketAuthorityScopeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantMarketAuthorityScopeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantMarketAuthorityScopeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeMarketAuthorityScope) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeMarketAuthorityScope) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeMarketAuthorityScope) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeMarketAuthorityScopeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeMarketAuthorityScopeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeMarketAuthorityScopeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpsertProviders) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpsertProviders) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpsertProviders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Providers) > 0 {
+		for iNdEx := len(m.Providers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Providers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpsertProvidersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpsertProvidersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpsertProvidersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveProviders) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveProviders) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveProviders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Names) > 0 {
+		for iNdEx := len(m.Names) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Names[iNdEx])
+			copy(dAtA[i:], m.Names[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Names[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveProvidersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveProvidersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveProvidersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpsertMarkets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Markets) > 0 {
+		for _, e := range m.Markets {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpsertMarketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MarketUpdates) > 0 {
+		for k, v := range m.MarketUpdates {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovTx(uint64(len(k))) + 1 + 1
+			n += mapEntrySize + 1 + sovTx(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateMarkets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.CreateMarkets) > 0 {
+		for _, e := range m.CreateMarkets {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateMarketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateMarkets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.UpdateMarkets) > 0 {
+		for _, e := range m.UpdateMarkets {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateMarketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveMarketAuthorities) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RemoveAddresses) > 0 {
+		for _, s := range m.RemoveAddresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveMarketAuthoritiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveMarkets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Markets) > 0 {
+		for _, s := range m.Markets {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRemoveMarketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DeletedMarkets) > 0 {
+		for _, s := range m.DeletedMarkets {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgScheduleMarketChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Change.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgScheduleMarketChangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelScheduledMarketChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelScheduledMarketChangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGrantMarketAuthorityScope) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Scope.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgGrantMarketAuthorityScopeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeMarketAuthorityScope) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeMarketAuthorityScopeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpsertProviders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Providers) > 0 {
+		for _, e := range m.Providers {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpsertProvidersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveProviders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Names) > 0 {
+		for _, s := range m.Names {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRemoveProvidersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpsertMarkets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpsertMarkets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpsertMarkets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Markets = append(m.Markets, Market{})
+			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpsertMarketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpsertMarketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpsertMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MarketUpdates == nil {
+				m.MarketUpdates = make(map[string]bool)
+			}
+			var mapkey string
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthTx
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthTx
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTx(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTx
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MarketUpdates[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateMarkets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateMarkets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateMarkets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateMarkets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreateMarkets = append(m.CreateMarkets, Market{})
+			if err := m.CreateMarkets[len(m.CreateMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateMarketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateMarketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMarkets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMarkets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMarkets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateMarkets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdateMarkets = append(m.UpdateMarkets, Market{})
+			if err := m.UpdateMarkets[len(m.UpdateMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMarketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMarketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveMarketAuthorities) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveMarketAuthorities: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveMarketAuthorities: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoveAddresses = append(m.RemoveAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveMarketAuthoritiesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveMarketAuthoritiesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveMarketAuthoritiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveMarkets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveMarkets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveMarkets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Markets = append(m.Markets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveMarketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveMarketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedMarkets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeletedMarkets = append(m.DeletedMarkets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *MsgRemoveMarketsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.DeletedMarkets) > 0 {
-		for _, s := range m.DeletedMarkets {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUpsertMarkets) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleMarketChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1616,10 +4151,10 @@ func (m *MsgUpsertMarkets) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpsertMarkets: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleMarketChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpsertMarkets: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleMarketChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1656,7 +4191,7 @@ func (m *MsgUpsertMarkets) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Change", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1683,8 +4218,7 @@ func (m *MsgUpsertMarkets) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Markets = append(m.Markets, Market{})
-			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Change.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1709,7 +4243,7 @@ func (m *MsgUpsertMarkets) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpsertMarketsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleMarketChangeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1732,17 +4266,17 @@ func (m *MsgUpsertMarketsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpsertMarketsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleMarketChangeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpsertMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleMarketChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketUpdates", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1752,107 +4286,11 @@ func (m *MsgUpsertMarketsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.MarketUpdates == nil {
-				m.MarketUpdates = make(map[string]bool)
-			}
-			var mapkey string
-			var mapvalue bool
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthTx
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthTx
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapvaluetemp int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvaluetemp |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					mapvalue = bool(mapvaluetemp != 0)
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipTx(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthTx
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.MarketUpdates[mapkey] = mapvalue
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1874,7 +4312,7 @@ func (m *MsgUpsertMarketsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateMarkets) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelScheduledMarketChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1897,10 +4335,10 @@ func (m *MsgCreateMarkets) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateMarkets: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelScheduledMarketChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateMarkets: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelScheduledMarketChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1936,10 +4374,10 @@ func (m *MsgCreateMarkets) Unmarshal(dAtA []byte) error {
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateMarkets", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1949,26 +4387,11 @@ func (m *MsgCreateMarkets) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CreateMarkets = append(m.CreateMarkets, Market{})
-			if err := m.CreateMarkets[len(m.CreateMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1990,7 +4413,7 @@ func (m *MsgCreateMarkets) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateMarketsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelScheduledMarketChangeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2013,10 +4436,10 @@ func (m *MsgCreateMarketsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateMarketsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelScheduledMarketChangeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelScheduledMarketChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2040,7 +4463,7 @@ func (m *MsgCreateMarketsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateMarkets) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantMarketAuthorityScope) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2063,15 +4486,15 @@ func (m *MsgUpdateMarkets) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateMarkets: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantMarketAuthorityScope: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateMarkets: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantMarketAuthorityScope: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2099,11 +4522,11 @@ func (m *MsgUpdateMarkets) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateMarkets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2130,8 +4553,7 @@ func (m *MsgUpdateMarkets) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdateMarkets = append(m.UpdateMarkets, Market{})
-			if err := m.UpdateMarkets[len(m.UpdateMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2156,7 +4578,7 @@ func (m *MsgUpdateMarkets) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateMarketsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantMarketAuthorityScopeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2179,10 +4601,10 @@ func (m *MsgUpdateMarketsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateMarketsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantMarketAuthorityScopeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantMarketAuthorityScopeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2206,7 +4628,7 @@ func (m *MsgUpdateMarketsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgParams) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeMarketAuthorityScope) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2229,17 +4651,17 @@ func (m *MsgParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeMarketAuthorityScope: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeMarketAuthorityScope: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2249,24 +4671,23 @@ func (m *MsgParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -2321,7 +4742,7 @@ func (m *MsgParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeMarketAuthorityScopeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2344,10 +4765,10 @@ func (m *MsgParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeMarketAuthorityScopeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeMarketAuthorityScopeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2371,7 +4792,7 @@ func (m *MsgParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveMarketAuthorities) Unmarshal(dAtA []byte) error {
+func (m *MsgUpsertProviders) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2394,15 +4815,15 @@ func (m *MsgRemoveMarketAuthorities) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveMarketAuthorities: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpsertProviders: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveMarketAuthorities: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpsertProviders: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoveAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2430,13 +4851,13 @@ func (m *MsgRemoveMarketAuthorities) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemoveAddresses = append(m.RemoveAddresses, string(dAtA[iNdEx:postIndex]))
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2446,23 +4867,25 @@ func (m *MsgRemoveMarketAuthorities) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			m.Providers = append(m.Providers, ProviderInfo{})
+			if err := m.Providers[len(m.Providers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2485,7 +4908,7 @@ func (m *MsgRemoveMarketAuthorities) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveMarketAuthoritiesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpsertProvidersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2508,10 +4931,10 @@ func (m *MsgRemoveMarketAuthoritiesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveMarketAuthoritiesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpsertProvidersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveMarketAuthoritiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpsertProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2535,7 +4958,7 @@ func (m *MsgRemoveMarketAuthoritiesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveMarkets) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveProviders) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2558,10 +4981,10 @@ func (m *MsgRemoveMarkets) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveMarkets: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveProviders: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveMarkets: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveProviders: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2598,7 +5021,7 @@ func (m *MsgRemoveMarkets) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2626,7 +5049,7 @@ func (m *MsgRemoveMarkets) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Markets = append(m.Markets, string(dAtA[iNdEx:postIndex]))
+			m.Names = append(m.Names, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2649,7 +5072,7 @@ func (m *MsgRemoveMarkets) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveMarketsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveProvidersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2672,44 +5095,12 @@ func (m *MsgRemoveMarketsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveMarketsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveProvidersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeletedMarkets", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DeletedMarkets = append(m.DeletedMarkets, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
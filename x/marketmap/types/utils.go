@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"sort"
 )
 
 // ReadMarketMapFromFile reads a market map configuration from a file at the given path.
@@ -29,6 +30,48 @@ func ReadMarketMapFromFile(path string) (MarketMap, error) {
 	return config, nil
 }
 
+// ReadMarketsFromFile reads the markets of a market map configuration from a file at the given path, sorted by
+// ticker. Unlike ReadMarketMapFromFile, the configuration may be a partial market map, i.e. its markets may be
+// normalized by markets that are not part of it, so each market is only validated individually.
+func ReadMarketsFromFile(path string) ([]Market, error) {
+	var config MarketMap
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading config file: %w", err)
+	}
+
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("error unmarshalling config JSON: %w", err)
+	}
+
+	if len(config.Markets) == 0 {
+		return nil, fmt.Errorf("no markets found in config file %s", path)
+	}
+
+	tickers := make([]string, 0, len(config.Markets))
+	for ticker := range config.Markets {
+		tickers = append(tickers, ticker)
+	}
+	sort.Strings(tickers)
+
+	markets := make([]Market, 0, len(tickers))
+	for _, ticker := range tickers {
+		market := config.Markets[ticker]
+		if ticker != market.Ticker.String() {
+			return nil, fmt.Errorf("market key %s does not match ticker %s", ticker, market.Ticker.String())
+		}
+
+		if err := market.ValidateBasic(); err != nil {
+			return nil, fmt.Errorf("invalid market %s: %w", ticker, err)
+		}
+
+		markets = append(markets, market)
+	}
+
+	return markets, nil
+}
+
 // WriteMarketMapToFile writes a market map configuration to a file at the given path.
 func WriteMarketMapToFile(config MarketMap, path string) error {
 	f, err := os.Create(path)
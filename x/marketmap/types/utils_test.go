@@ -0,0 +1,77 @@
+package types_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+func TestReadMarketsFromFile(t *testing.T) {
+	write := func(t *testing.T, mm types.MarketMap) string {
+		t.Helper()
+
+		path := filepath.Join(t.TempDir(), "markets.json")
+		require.NoError(t, types.WriteMarketMapToFile(mm, path))
+		return path
+	}
+
+	t.Run("reads a partial market map sorted by ticker", func(t *testing.T) {
+		// btcusd is normalized by usdtusd, which is not part of the file
+		path := write(t, types.MarketMap{
+			Markets: map[string]types.Market{
+				ethusdt.Ticker.String(): ethusdt,
+				btcusd.Ticker.String():  btcusd,
+			},
+		})
+
+		_, err := types.ReadMarketMapFromFile(path)
+		require.Error(t, err)
+
+		markets, err := types.ReadMarketsFromFile(path)
+		require.NoError(t, err)
+		require.Equal(t, []types.Market{btcusd, ethusdt}, markets)
+	})
+
+	t.Run("fails for a missing file", func(t *testing.T) {
+		_, err := types.ReadMarketsFromFile(filepath.Join(t.TempDir(), "missing.json"))
+		require.Error(t, err)
+	})
+
+	t.Run("fails for malformed json", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "markets.json")
+		require.NoError(t, os.WriteFile(path, []byte(`{"markets":`), 0o600))
+
+		_, err := types.ReadMarketsFromFile(path)
+		require.Error(t, err)
+	})
+
+	t.Run("fails without markets", func(t *testing.T) {
+		_, err := types.ReadMarketsFromFile(write(t, types.MarketMap{}))
+		require.Error(t, err)
+	})
+
+	t.Run("fails if a key does not match its ticker", func(t *testing.T) {
+		_, err := types.ReadMarketsFromFile(write(t, types.MarketMap{
+			Markets: map[string]types.Market{
+				"ETH/USD": btcusd,
+			},
+		}))
+		require.Error(t, err)
+	})
+
+	t.Run("fails for an invalid market", func(t *testing.T) {
+		invalid := btcusd
+		invalid.Ticker.Decimals = 0
+
+		_, err := types.ReadMarketsFromFile(write(t, types.MarketMap{
+			Markets: map[string]types.Market{
+				invalid.Ticker.String(): invalid,
+			},
+		}))
+		require.Error(t, err)
+	})
+}
@@ -3,6 +3,8 @@ package types
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 )
 
 // MarketValidationHook is a hook that is called for stateful validation of a market before
@@ -45,3 +47,130 @@ func DefaultDeleteMarketValidationHook() MarketValidationHook {
 		return nil
 	}
 }
+
+// MarketGetter is the interface used by stateful validation hooks to read markets from state. It is implemented by
+// the x/marketmap keeper.
+type MarketGetter interface {
+	GetMarket(ctx context.Context, tickerStr string) (Market, error)
+	GetAllMarkets(ctx context.Context) (map[string]Market, error)
+}
+
+// MinProviderVenuesValidationHook returns a MarketValidationHook that checks that the provider configs of the given
+// market span at least minVenues distinct venues. Providers of the same venue, e.g. coinbase_api and coinbase_ws,
+// count as a single venue.
+func MinProviderVenuesValidationHook(minVenues uint64) MarketValidationHook {
+	return func(_ context.Context, market Market) error {
+		venues := make(map[string]struct{}, len(market.ProviderConfigs))
+		for _, providerConfig := range market.ProviderConfigs {
+			venues[ProviderVenue(providerConfig.Name)] = struct{}{}
+		}
+
+		if uint64(len(venues)) < minVenues {
+			return fmt.Errorf("market must have providers from at least %d venues; got %d", minVenues, len(venues))
+		}
+
+		return nil
+	}
+}
+
+// MinProviderCountValidationHook returns a MarketValidationHook that checks that the provider configs of the given
+// market have at least Ticker.MinProviderCount distinct provider names. Unlike Market.ValidateBasic, provider configs
+// of the same provider with different off-chain tickers count as a single provider.
+func MinProviderCountValidationHook() MarketValidationHook {
+	return func(_ context.Context, market Market) error {
+		providers := make(map[string]struct{}, len(market.ProviderConfigs))
+		for _, providerConfig := range market.ProviderConfigs {
+			providers[providerConfig.Name] = struct{}{}
+		}
+
+		if uint64(len(providers)) < market.Ticker.MinProviderCount {
+			return fmt.Errorf(
+				"market must have at least %d distinct providers; got %d",
+				market.Ticker.MinProviderCount, len(providers),
+			)
+		}
+
+		return nil
+	}
+}
+
+// NormalizeByPairsEnabledValidationHook returns a MarketValidationHook that checks that the markets of all the
+// normalize-by pairs of the given market exist, and are enabled if the given market is enabled. If the given market
+// is disabled, the hook also checks that no enabled market normalizes by it, so that it must be used as an update hook
+// to cover markets disabled by DisableMarket or UpdateMarket.
+func NormalizeByPairsEnabledValidationHook(getter MarketGetter) MarketValidationHook {
+	return func(ctx context.Context, market Market) error {
+		for _, providerConfig := range market.ProviderConfigs {
+			if providerConfig.NormalizeByPair == nil {
+				continue
+			}
+
+			normalizeMarket, err := getter.GetMarket(ctx, providerConfig.NormalizeByPair.String())
+			if err != nil {
+				return fmt.Errorf("unable to get normalize market %s: %w", providerConfig.NormalizeByPair.String(), err)
+			}
+
+			if market.Ticker.Enabled && !normalizeMarket.Ticker.Enabled {
+				return fmt.Errorf("normalize market %s is not enabled", providerConfig.NormalizeByPair.String())
+			}
+		}
+
+		if market.Ticker.Enabled {
+			return nil
+		}
+
+		markets, err := getter.GetAllMarkets(ctx)
+		if err != nil {
+			return fmt.Errorf("unable to get markets: %w", err)
+		}
+
+		tickers := make([]string, 0, len(markets))
+		for ticker := range markets {
+			tickers = append(tickers, ticker)
+		}
+		sort.Strings(tickers)
+
+		tickerStr := market.Ticker.String()
+		for _, ticker := range tickers {
+			other := markets[ticker]
+			if !other.Ticker.Enabled || other.Ticker.String() == tickerStr {
+				continue
+			}
+
+			for _, providerConfig := range other.ProviderConfigs {
+				if providerConfig.NormalizeByPair != nil && providerConfig.NormalizeByPair.String() == tickerStr {
+					return fmt.Errorf("enabled market %s normalizes by the market", other.Ticker.String())
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+// DecimalsRangeValidationHook returns a MarketValidationHook that checks that the decimals of the ticker of the given
+// market are between minDecimals and maxDecimals, inclusive.
+func DecimalsRangeValidationHook(minDecimals, maxDecimals uint64) MarketValidationHook {
+	return func(_ context.Context, market Market) error {
+		if market.Ticker.Decimals < minDecimals || market.Ticker.Decimals > maxDecimals {
+			return fmt.Errorf(
+				"decimals must be between %d and %d; got %d",
+				minDecimals, maxDecimals, market.Ticker.Decimals,
+			)
+		}
+
+		return nil
+	}
+}
+
+// ProviderVenue returns the venue of the provider with the given name, i.e. the name without its _api or _ws
+// suffix and anything following it.
+func ProviderVenue(name string) string {
+	for _, suffix := range []string{"_api", "_ws"} {
+		if i := strings.LastIndex(name, suffix); i > 0 {
+			return name[:i]
+		}
+	}
+
+	return name
+}
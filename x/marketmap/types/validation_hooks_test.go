@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -62,3 +63,124 @@ func TestDefaultDeleteMarketValidationHooks_ValidateMarket(t *testing.T) {
 		})
 	}
 }
+
+type mockMarketGetter map[string]types.Market
+
+func (m mockMarketGetter) GetMarket(_ context.Context, tickerStr string) (types.Market, error) {
+	market, ok := m[tickerStr]
+	if !ok {
+		return types.Market{}, fmt.Errorf("market %s not found", tickerStr)
+	}
+
+	return market, nil
+}
+
+func (m mockMarketGetter) GetAllMarkets(_ context.Context) (map[string]types.Market, error) {
+	return m, nil
+}
+
+func TestBuiltinMarketValidationHooks(t *testing.T) {
+	usdtusd := connecttypes.NewCurrencyPair("USDT", "USD")
+	usdcusd := connecttypes.NewCurrencyPair("USDC", "USD")
+
+	market := types.Market{
+		Ticker: types.Ticker{
+			CurrencyPair:     connecttypes.NewCurrencyPair("BTC", "USDT"),
+			Decimals:         8,
+			MinProviderCount: 2,
+		},
+		ProviderConfigs: []types.ProviderConfig{
+			{
+				Name:           "coinbase_api",
+				OffChainTicker: "BTC-USDT",
+			},
+			{
+				Name:           "coinbase_ws",
+				OffChainTicker: "BTC-USDT",
+			},
+			{
+				Name:            "kraken_api",
+				OffChainTicker:  "XXBTZUSD",
+				NormalizeByPair: &usdtusd,
+			},
+		},
+	}
+
+	getter := mockMarketGetter{
+		usdtusd.String(): {Ticker: types.Ticker{CurrencyPair: usdtusd, Enabled: true}},
+		usdcusd.String(): {Ticker: types.Ticker{CurrencyPair: usdcusd, Enabled: false}},
+	}
+
+	enabledMarket := market
+	enabledMarket.Ticker.Enabled = true
+	withDependent := func(dependent types.Market) mockMarketGetter {
+		g := mockMarketGetter{dependent.Ticker.String(): dependent}
+		for ticker, m := range getter {
+			g[ticker] = m
+		}
+		return g
+	}
+	disabledUSDT := types.Market{Ticker: types.Ticker{CurrencyPair: usdtusd, Enabled: false}}
+
+	withNormalizeBy := func(cp connecttypes.CurrencyPair) types.Market {
+		m := market
+		m.ProviderConfigs = append([]types.ProviderConfig{}, market.ProviderConfigs...)
+		m.ProviderConfigs[2].NormalizeByPair = &cp
+		return m
+	}
+
+	enabled := func(m types.Market) types.Market {
+		m.Ticker.Enabled = true
+		return m
+	}
+
+	singleProvider := market
+	singleProvider.ProviderConfigs = []types.ProviderConfig{
+		{
+			Name:           "coinbase_api",
+			OffChainTicker: "BTC-USDT",
+		},
+		{
+			Name:           "coinbase_api",
+			OffChainTicker: "BTC-USD",
+		},
+	}
+
+	tests := []struct {
+		name    string
+		hook    types.MarketValidationHook
+		market  types.Market
+		wantErr bool
+	}{
+		{"enough venues", types.MinProviderVenuesValidationHook(2), market, false},
+		{"not enough venues", types.MinProviderVenuesValidationHook(3), market, true},
+		{"enough distinct providers", types.MinProviderCountValidationHook(), market, false},
+		{"not enough distinct providers", types.MinProviderCountValidationHook(), singleProvider, true},
+		{"normalize market enabled", types.NormalizeByPairsEnabledValidationHook(getter), market, false},
+		{"normalize market disabled", types.NormalizeByPairsEnabledValidationHook(getter), enabled(withNormalizeBy(usdcusd)), true},
+		{"normalize market of disabled market disabled", types.NormalizeByPairsEnabledValidationHook(getter), withNormalizeBy(usdcusd), false},
+		{"normalize market missing", types.NormalizeByPairsEnabledValidationHook(getter), withNormalizeBy(connecttypes.NewCurrencyPair("DAI", "USD")), true},
+		{"disabling market normalized by enabled market", types.NormalizeByPairsEnabledValidationHook(withDependent(enabledMarket)), disabledUSDT, true},
+		{"disabling market normalized by disabled market", types.NormalizeByPairsEnabledValidationHook(withDependent(market)), disabledUSDT, false},
+		{"decimals in range", types.DecimalsRangeValidationHook(6, 8), market, false},
+		{"decimals out of range", types.DecimalsRangeValidationHook(9, 18), market, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := types.MarketValidationHooks{tt.hook}.ValidateMarket(context.Background(), tt.market)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestProviderVenue(t *testing.T) {
+	require.Equal(t, "coinbase", types.ProviderVenue("coinbase_api"))
+	require.Equal(t, "coinbase", types.ProviderVenue("coinbase_ws"))
+	require.Equal(t, "crypto_dot_com", types.ProviderVenue("crypto_dot_com_ws"))
+	require.Equal(t, "uniswapv3", types.ProviderVenue("uniswapv3_api-ethereum"))
+	require.Equal(t, "static-mock-provider", types.ProviderVenue("static-mock-provider"))
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/spf13/cobra"
 
 	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
@@ -26,6 +27,7 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetPriceCmd(),
 		GetAllCurrencyPairsCmd(),
+		GetCurrencyPairTombstonesCmd(),
 	)
 
 	return cmd
@@ -95,3 +97,62 @@ func GetAllCurrencyPairsCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCurrencyPairTombstonesCmd returns the cli-command that queries for the tombstones of all removed CurrencyPairs that are
+// retained by the module. This is essentially a wrapper around the module's QueryClient.
+func GetCurrencyPairTombstonesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tombstones",
+		Short: "Query for the tombstones of the currency-pairs removed from the module",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			// get the context
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// create a new query client
+			qc := types.NewQueryClient(clientCtx)
+
+			var pageReq *query.PageRequest
+			if paginationFlagsChanged(cmd) {
+				if pageReq, err = client.ReadPageRequest(cmd.Flags()); err != nil {
+					return err
+				}
+			}
+
+			// query for the tombstones, all of them unless pagination flags are given
+			res, err := qc.GetCurrencyPairTombstones(cmd.Context(), &types.GetCurrencyPairTombstonesRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "tombstones")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// paginationFlagsChanged returns true if any of the pagination flags of the command is set, in which case the
+// query is paginated. Otherwise all results are returned.
+func paginationFlagsChanged(cmd *cobra.Command) bool {
+	for _, flag := range []string{
+		flags.FlagPage,
+		flags.FlagPageKey,
+		flags.FlagOffset,
+		flags.FlagLimit,
+		flags.FlagCountTotal,
+		flags.FlagReverse,
+	} {
+		if cmd.Flags().Changed(flag) {
+			return true
+		}
+	}
+
+	return false
+}
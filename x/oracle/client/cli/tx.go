@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+
+	connectcli "github.com/skip-mev/connect/v2/pkg/cli"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+// FlagAuthority is the address of the authority (i.e. governance) that the messages are executed by. It defaults to
+// the --from address.
+const FlagAuthority = connectcli.FlagAuthority
+
+// GetTxCmd returns the parent command for all x/oracle cli tx commands. All messages can only be executed by the
+// module's authority. Use --generate-only (with --authority set to the governance module account) to generate the
+// messages of a governance proposal.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Transaction commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdAddCurrencyPairs(),
+		CmdRemoveCurrencyPairs(),
+	)
+
+	return cmd
+}
+
+// CmdAddCurrencyPairs returns the command that adds the given currency pairs to the module's state.
+func CmdAddCurrencyPairs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-currency-pairs [base/quote]...",
+		Short: "Add the given currency pairs to the oracle module",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cps := make([]connecttypes.CurrencyPair, 0, len(args))
+			for _, arg := range args {
+				cp, err := connecttypes.CurrencyPairFromString(arg)
+				if err != nil {
+					return err
+				}
+				cps = append(cps, cp)
+			}
+
+			authority, err := connectcli.GetAuthority(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddCurrencyPairs(authority, cps)
+			return connectcli.ValidateAndBroadcast(cmd, clientCtx, &msg)
+		},
+	}
+
+	connectcli.AddTxFlags(cmd)
+	return cmd
+}
+
+// CmdRemoveCurrencyPairs returns the command that removes the given currency pairs from the module's state.
+func CmdRemoveCurrencyPairs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-currency-pairs [base/quote]...",
+		Short: "Remove the given currency pairs from the oracle module",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := connectcli.GetAuthority(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveCurrencyPairs(authority, args)
+			return connectcli.ValidateAndBroadcast(cmd, clientCtx, &msg)
+		},
+	}
+
+	connectcli.AddTxFlags(cmd)
+	return cmd
+}
@@ -0,0 +1,57 @@
+package cli_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/stretchr/testify/require"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/x/oracle"
+	"github.com/skip-mev/connect/v2/x/oracle/client/cli"
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+func TestAddCurrencyPairsGenerateOnly(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(oracle.AppModuleBasic{})
+	clientCtx := client.Context{}.
+		WithTxConfig(encCfg.TxConfig).
+		WithCodec(encCfg.Codec).
+		WithInterfaceRegistry(encCfg.InterfaceRegistry)
+
+	from := sdk.AccAddress("from")
+	authority := sdk.AccAddress("authority")
+
+	cmd := cli.CmdAddCurrencyPairs()
+	cmd.SetArgs([]string{
+		"BTC/USD",
+		"ETH/USD",
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, from),
+		fmt.Sprintf("--%s=%s", cli.FlagAuthority, authority),
+		fmt.Sprintf("--%s", flags.FlagGenerateOnly),
+		fmt.Sprintf("--%s=test-chain", flags.FlagChainID),
+	})
+	_, out := testutil.ApplyMockIO(cmd)
+	clientCtx = clientCtx.WithOutput(out)
+
+	require.NoError(t, cmd.ExecuteContext(context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)))
+
+	tx, err := encCfg.TxConfig.TxJSONDecoder()(out.Bytes())
+	require.NoError(t, err)
+
+	msgs := tx.GetMsgs()
+	require.Len(t, msgs, 1)
+	require.Equal(t, &types.MsgAddCurrencyPairs{
+		Authority: authority.String(),
+		CurrencyPairs: []connecttypes.CurrencyPair{
+			connecttypes.NewCurrencyPair("BTC", "USD"),
+			connecttypes.NewCurrencyPair("ETH", "USD"),
+		},
+	}, msgs[0])
+}
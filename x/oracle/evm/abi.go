@@ -0,0 +1,111 @@
+package evm
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+const (
+	// GetPriceMethod is the name of the method returning the price of a currency pair.
+	GetPriceMethod = "getPrice"
+
+	// GetAllCurrencyPairsMethod is the name of the method returning all currency pairs tracked by x/oracle.
+	GetAllCurrencyPairsMethod = "getAllCurrencyPairs"
+)
+
+// OracleABI is the Solidity ABI of the x/oracle adapter, i.e. of the following interface:
+//
+//	interface IConnectOracle {
+//	    function getPrice(string calldata pair)
+//	        external view returns (uint256 price, uint64 decimals, uint64 timestamp, uint64 nonce);
+//	    function getAllCurrencyPairs() external view returns (string[] memory pairs);
+//	}
+//
+// Currency pairs are represented in the format BASE/QUOTE, and timestamps in seconds since the unix epoch.
+const OracleABI = `[
+	{
+		"type": "function",
+		"name": "getPrice",
+		"stateMutability": "view",
+		"inputs": [
+			{"name": "pair", "type": "string"}
+		],
+		"outputs": [
+			{"name": "price", "type": "uint256"},
+			{"name": "decimals", "type": "uint64"},
+			{"name": "timestamp", "type": "uint64"},
+			{"name": "nonce", "type": "uint64"}
+		]
+	},
+	{
+		"type": "function",
+		"name": "getAllCurrencyPairs",
+		"stateMutability": "view",
+		"inputs": [],
+		"outputs": [
+			{"name": "pairs", "type": "string[]"}
+		]
+	}
+]`
+
+// oracleABI is the parsed OracleABI.
+var oracleABI = mustParseABI(OracleABI)
+
+// ABI returns the parsed OracleABI.
+func ABI() abi.ABI {
+	return oracleABI
+}
+
+// Price is the decoded output of the getPrice method.
+type Price struct {
+	// Price is the price of the currency pair, scaled by Decimals.
+	Price *big.Int
+	// Decimals is the number of decimals the price is represented in.
+	Decimals uint64
+	// Timestamp is the timestamp, in seconds since the unix epoch, of the block in which the price was reported.
+	Timestamp uint64
+	// Nonce is the number of times the price of the currency pair has been updated.
+	Nonce uint64
+}
+
+// PackGetPrice returns the calldata of a getPrice call for the given currency pair.
+func PackGetPrice(pair string) ([]byte, error) {
+	return oracleABI.Pack(GetPriceMethod, pair)
+}
+
+// UnpackGetPrice decodes the output of a getPrice call.
+func UnpackGetPrice(bz []byte) (Price, error) {
+	var price Price
+	if err := oracleABI.UnpackIntoInterface(&price, GetPriceMethod, bz); err != nil {
+		return Price{}, fmt.Errorf("failed to unpack %s output: %w", GetPriceMethod, err)
+	}
+
+	return price, nil
+}
+
+// PackGetAllCurrencyPairs returns the calldata of a getAllCurrencyPairs call.
+func PackGetAllCurrencyPairs() ([]byte, error) {
+	return oracleABI.Pack(GetAllCurrencyPairsMethod)
+}
+
+// UnpackGetAllCurrencyPairs decodes the output of a getAllCurrencyPairs call.
+func UnpackGetAllCurrencyPairs(bz []byte) ([]string, error) {
+	out, err := oracleABI.Methods[GetAllCurrencyPairsMethod].Outputs.UnpackValues(bz)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unpack %s output: %w", GetAllCurrencyPairsMethod, err)
+	}
+
+	return *abi.ConvertType(out[0], new([]string)).(*[]string), nil
+}
+
+func mustParseABI(def string) abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(def))
+	if err != nil {
+		panic(fmt.Sprintf("failed to parse oracle abi: %s", err))
+	}
+
+	return parsed
+}
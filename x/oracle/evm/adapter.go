@@ -0,0 +1,108 @@
+package evm
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+// OracleKeeper is the expected x/oracle keeper interface of the Adapter.
+type OracleKeeper interface {
+	GetPriceWithNonceForCurrencyPair(ctx context.Context, cp connecttypes.CurrencyPair) (types.QuotePriceWithNonce, error)
+	GetDecimalsForCurrencyPair(ctx context.Context, cp connecttypes.CurrencyPair) (uint64, error)
+	GetAllCurrencyPairs(ctx context.Context) []connecttypes.CurrencyPair
+}
+
+// Adapter exposes the x/oracle keeper reads behind the Solidity-compatible OracleABI. It is intended to back an
+// EVM precompile: the precompile forwards the calldata of a call to Run, and returns its output, or reverts if Run
+// fails. All methods of the adapter are read-only.
+type Adapter struct {
+	k OracleKeeper
+}
+
+// NewAdapter returns a new Adapter reading from the given x/oracle keeper.
+func NewAdapter(k OracleKeeper) *Adapter {
+	if k == nil {
+		panic("oracle keeper cannot be nil")
+	}
+
+	return &Adapter{
+		k: k,
+	}
+}
+
+// Run executes the call encoded in the given calldata, i.e. the 4-byte method selector followed by the ABI encoded
+// arguments, and returns the ABI encoded output.
+func (a *Adapter) Run(ctx sdk.Context, input []byte) ([]byte, error) {
+	if len(input) < 4 {
+		return nil, fmt.Errorf("invalid input length %d: missing method selector", len(input))
+	}
+
+	method, err := oracleABI.MethodById(input[:4])
+	if err != nil {
+		return nil, err
+	}
+
+	args, err := method.Inputs.Unpack(input[4:])
+	if err != nil {
+		return nil, fmt.Errorf("failed to unpack %s arguments: %w", method.Name, err)
+	}
+
+	switch method.Name {
+	case GetPriceMethod:
+		return a.getPrice(ctx, method, args)
+	case GetAllCurrencyPairsMethod:
+		return a.getAllCurrencyPairs(ctx, method)
+	default:
+		return nil, fmt.Errorf("unsupported method %s", method.Name)
+	}
+}
+
+func (a *Adapter) getPrice(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	pair, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid pair argument type %T", args[0])
+	}
+
+	cp, err := connecttypes.CurrencyPairFromString(pair)
+	if err != nil {
+		return nil, fmt.Errorf("invalid currency pair: %w", err)
+	}
+
+	qpn, err := a.k.GetPriceWithNonceForCurrencyPair(ctx, cp)
+	if err != nil {
+		return nil, fmt.Errorf("no price / nonce reported for CurrencyPair: %s, the module is not tracking this CurrencyPair", cp.String())
+	}
+
+	if qpn.Price.IsNil() {
+		return nil, types.NewQuotePriceNotExistError(cp)
+	}
+
+	decimals, err := a.k.GetDecimalsForCurrencyPair(ctx, cp)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(
+		qpn.Price.BigInt(),
+		decimals,
+		uint64(max(qpn.BlockTimestamp.Unix(), 0)), //nolint:gosec
+		qpn.Nonce(),
+	)
+}
+
+func (a *Adapter) getAllCurrencyPairs(ctx sdk.Context, method *abi.Method) ([]byte, error) {
+	cps := a.k.GetAllCurrencyPairs(ctx)
+
+	pairs := make([]string, 0, len(cps))
+	for _, cp := range cps {
+		pairs = append(pairs, cp.String())
+	}
+
+	return method.Outputs.Pack(pairs)
+}
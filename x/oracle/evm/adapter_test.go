@@ -0,0 +1,165 @@
+package evm_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	marketmaptypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+	"github.com/skip-mev/connect/v2/x/oracle/evm"
+	"github.com/skip-mev/connect/v2/x/oracle/keeper"
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+	"github.com/skip-mev/connect/v2/x/oracle/types/mocks"
+)
+
+var (
+	btcusd = connecttypes.NewCurrencyPair("BTC", "USD")
+	ethusd = connecttypes.NewCurrencyPair("ETH", "USD")
+)
+
+type AdapterTestSuite struct {
+	suite.Suite
+
+	ctx                 sdk.Context
+	oracleKeeper        *keeper.Keeper
+	mockMarketMapKeeper *mocks.MarketMapKeeper
+	adapter             *evm.Adapter
+	updatedAt           time.Time
+}
+
+func TestAdapterTestSuite(t *testing.T) {
+	suite.Run(t, new(AdapterTestSuite))
+}
+
+func (s *AdapterTestSuite) SetupTest() {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ss := runtime.NewKVStoreService(key)
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	s.mockMarketMapKeeper = mocks.NewMarketMapKeeper(s.T())
+	k := keeper.NewKeeper(ss, encCfg.Codec, s.mockMarketMapKeeper, sdk.AccAddress("authority"))
+	s.oracleKeeper = &k
+	s.ctx = testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_key"))
+	s.adapter = evm.NewAdapter(s.oracleKeeper)
+
+	s.oracleKeeper.InitGenesis(s.ctx, *types.DefaultGenesisState())
+
+	// BTC/USD has been updated twice, ETH/USD has no price
+	s.updatedAt = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i, price := range []uint64{6_400_000_000_000, 6_500_000_000_000} {
+		s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, btcusd, types.QuotePrice{
+			Price:          sdkmath.NewIntFromUint64(price),
+			BlockTimestamp: s.updatedAt,
+			BlockHeight:    uint64(10 + i),
+		}))
+	}
+	s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, ethusd))
+}
+
+func (s *AdapterTestSuite) TestNewAdapter() {
+	s.Require().Panics(func() {
+		evm.NewAdapter(nil)
+	})
+}
+
+func (s *AdapterTestSuite) TestMethodSelectors() {
+	for method, signature := range map[string]string{
+		evm.GetPriceMethod:            "getPrice(string)",
+		evm.GetAllCurrencyPairsMethod: "getAllCurrencyPairs()",
+	} {
+		s.Require().Equal(crypto.Keccak256([]byte(signature))[:4], evm.ABI().Methods[method].ID)
+	}
+}
+
+func (s *AdapterTestSuite) TestGetPrice() {
+	s.Run("returns the price of a currency pair", func() {
+		s.mockMarketMapKeeper.On("GetMarket", mock.Anything, btcusd.String()).Return(marketmaptypes.Market{
+			Ticker: marketmaptypes.Ticker{
+				CurrencyPair:     btcusd,
+				Decimals:         8,
+				MinProviderCount: 1,
+			},
+		}, nil).Once()
+
+		input, err := evm.PackGetPrice("BTC/USD")
+		s.Require().NoError(err)
+
+		output, err := s.adapter.Run(s.ctx, input)
+		s.Require().NoError(err)
+
+		price, err := evm.UnpackGetPrice(output)
+		s.Require().NoError(err)
+		s.Require().Equal(evm.Price{
+			Price:     big.NewInt(6_500_000_000_000),
+			Decimals:  8,
+			Timestamp: uint64(s.updatedAt.Unix()),
+			Nonce:     1,
+		}, price)
+	})
+
+	s.Run("fails for a currency pair without a price", func() {
+		input, err := evm.PackGetPrice("ETH/USD")
+		s.Require().NoError(err)
+
+		_, err = s.adapter.Run(s.ctx, input)
+		s.Require().ErrorAs(err, &types.QuotePriceNotExistError{})
+	})
+
+	s.Run("fails for an untracked currency pair", func() {
+		input, err := evm.PackGetPrice("SOL/USD")
+		s.Require().NoError(err)
+
+		_, err = s.adapter.Run(s.ctx, input)
+		s.Require().Error(err)
+	})
+
+	s.Run("fails for an invalid currency pair", func() {
+		input, err := evm.PackGetPrice("BTCUSD")
+		s.Require().NoError(err)
+
+		_, err = s.adapter.Run(s.ctx, input)
+		s.Require().Error(err)
+	})
+
+	s.Run("fails for malformed arguments", func() {
+		input, err := evm.PackGetPrice("BTC/USD")
+		s.Require().NoError(err)
+
+		_, err = s.adapter.Run(s.ctx, input[:len(input)-33])
+		s.Require().Error(err)
+	})
+}
+
+func (s *AdapterTestSuite) TestGetAllCurrencyPairs() {
+	input, err := evm.PackGetAllCurrencyPairs()
+	s.Require().NoError(err)
+
+	output, err := s.adapter.Run(s.ctx, input)
+	s.Require().NoError(err)
+
+	pairs, err := evm.UnpackGetAllCurrencyPairs(output)
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"BTC/USD", "ETH/USD"}, pairs)
+}
+
+func (s *AdapterTestSuite) TestInvalidInput() {
+	s.Run("fails without a method selector", func() {
+		_, err := s.adapter.Run(s.ctx, []byte{0x01, 0x02})
+		s.Require().Error(err)
+	})
+
+	s.Run("fails for an unknown method selector", func() {
+		_, err := s.adapter.Run(s.ctx, crypto.Keccak256([]byte("setPrice(string,uint256)"))[:4])
+		s.Require().Error(err)
+	})
+}
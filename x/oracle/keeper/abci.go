@@ -5,7 +5,12 @@ import (
 )
 
 // BeginBlocker is called at the beginning of every block.  It resets the count of
-// removed currency pairs.
+// removed currency pairs, and prunes the tombstones of removed currency pairs that
+// are older than the tombstone retention period.
 func (k *Keeper) BeginBlocker(ctx context.Context) error {
-	return k.numRemoves.Set(ctx, 0)
+	if err := k.numRemoves.Set(ctx, 0); err != nil {
+		return err
+	}
+
+	return k.PruneCurrencyPairTombstones(ctx)
 }
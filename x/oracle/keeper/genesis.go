@@ -26,6 +26,16 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) {
 		}
 	}
 
+	// initialize the tombstones of removed CurrencyPairs. The exported removal heights are meaningless on a chain started
+	// from an exported genesis, so the tombstones are retained for the full retention period from the genesis height.
+	height := uint64(max(ctx.BlockHeight(), 0)) //nolint:gosec
+	for _, tombstone := range gs.Tombstones {
+		tombstone.RemovalHeight = height
+		if err := k.tombstones.Set(ctx, tombstone.Id, tombstone); err != nil {
+			panic(fmt.Errorf("error in genesis: %w", err))
+		}
+	}
+
 	// set the next ID to state
 	if err := k.nextCurrencyPairID.Set(ctx, gs.NextId); err != nil {
 		panic(fmt.Errorf("error in genesis: %w", err))
@@ -69,5 +79,10 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	gs.Tombstones, err = k.GetAllCurrencyPairTombstones(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return gs
 }
@@ -37,6 +37,7 @@ func (q queryServer) GetAllCurrencyPairs(ctx context.Context, _ *types.GetAllCur
 // GetPrice gets the QuotePrice and the nonce for the QuotePrice for a given CurrencyPair. The request contains a
 // CurrencyPairSelector (either the stringified CurrencyPair, or the CurrencyPair itself). If the request is nil this method fails.
 // If the selector is an incorrectly formatted string this method fails. If the QuotePrice / Nonce do not exist for this CurrencyPair, this method fails.
+// The response also reports whether the QuotePrice is stale with respect to the maximum price age configured on the CurrencyPair's market.
 func (q queryServer) GetPrice(ctx context.Context, req *types.GetPriceRequest) (_ *types.GetPriceResponse, err error) {
 	// fail on nil requests
 	if req == nil {
@@ -52,8 +53,8 @@ func (q queryServer) GetPrice(ctx context.Context, req *types.GetPriceRequest) (
 		return nil, fmt.Errorf("invalid currency pair: %w", err)
 	}
 
-	// get the QuotePrice + nonce for the given CurrencyPair
-	qpn, err := q.k.GetPriceWithNonceForCurrencyPair(ctx, cp)
+	// get the QuotePrice + nonce for the given CurrencyPair, whether the QuotePrice is stale, and its decimals
+	qpn, stale, decimals, err := q.k.GetPriceWithStalenessAndDecimalsForCurrencyPair(ctx, cp)
 	if err != nil {
 		return nil, fmt.Errorf("no price / nonce reported for CurrencyPair: %s, the module is not tracking this CurrencyPair", cp.String())
 	}
@@ -63,17 +64,13 @@ func (q queryServer) GetPrice(ctx context.Context, req *types.GetPriceRequest) (
 		return nil, fmt.Errorf("no ID found for CurrencyPair: %s", cp.String())
 	}
 
-	decimals, err := q.k.GetDecimalsForCurrencyPair(ctx, cp)
-	if err != nil {
-		return nil, err
-	}
-
 	// return the QuotePrice + Nonce
 	return &types.GetPriceResponse{
 		Price:    &qpn.QuotePrice,
 		Nonce:    qpn.Nonce(),
 		Decimals: decimals,
 		Id:       id,
+		Stale:    stale,
 	}, nil
 }
 
@@ -93,8 +90,8 @@ func (q queryServer) GetPrices(ctx context.Context, req *types.GetPricesRequest)
 			return nil, fmt.Errorf("error unmarshalling CurrencyPairID: %w", err)
 		}
 
-		// get the QuotePrice + nonce for the given CurrencyPair
-		qpn, err := q.k.GetPriceWithNonceForCurrencyPair(ctx, cp)
+		// get the QuotePrice + nonce for the given CurrencyPair, whether the QuotePrice is stale, and its decimals
+		qpn, stale, decimals, err := q.k.GetPriceWithStalenessAndDecimalsForCurrencyPair(ctx, cp)
 		if err != nil {
 			return nil, fmt.Errorf("no price / nonce reported for CurrencyPair: %v, the module is not tracking this CurrencyPair", cp)
 		}
@@ -104,16 +101,12 @@ func (q queryServer) GetPrices(ctx context.Context, req *types.GetPricesRequest)
 			return nil, fmt.Errorf("no ID found for CurrencyPair: %v", cp)
 		}
 
-		decimals, err := q.k.GetDecimalsForCurrencyPair(ctx, cp)
-		if err != nil {
-			return nil, err
-		}
-
 		prices = append(prices, types.GetPriceResponse{
 			Price:    &qpn.QuotePrice,
 			Nonce:    qpn.Nonce(),
 			Decimals: decimals,
 			Id:       id,
+			Stale:    stale,
 		})
 	}
 
@@ -141,3 +134,19 @@ func (q queryServer) GetCurrencyPairMappingList(ctx context.Context, _ *types.Ge
 
 	return &types.GetCurrencyPairMappingListResponse{Mappings: pairs}, nil
 }
+
+// GetCurrencyPairTombstones returns the tombstones of the removed currency pairs, ordered by ID. The tombstones are
+// paginated if the request is.
+func (q queryServer) GetCurrencyPairTombstones(ctx context.Context, req *types.GetCurrencyPairTombstonesRequest) (*types.GetCurrencyPairTombstonesResponse, error) {
+	// fail on nil requests
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	tombstones, pageRes, err := q.k.GetCurrencyPairTombstones(ctx, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetCurrencyPairTombstonesResponse{Tombstones: tombstones, Pagination: pageRes}, nil
+}
@@ -171,6 +171,33 @@ func (s *KeeperTestSuite) TestGetPrice() {
 			},
 			true,
 		},
+		{
+			"if the price is older than the max price age of the market, the price is returned as stale - pass",
+			func() {
+				s.ctx = s.ctx.WithBlockHeight(10)
+				s.mockMarketMapKeeper.On("GetMarket", mock.Anything, mock.Anything).Return(marketmaptypes.Market{
+					Ticker: marketmaptypes.Ticker{
+						CurrencyPair:      connecttypes.CurrencyPair{Base: "AA", Quote: "ETHEREUM"},
+						Decimals:          18,
+						MinProviderCount:  3,
+						MaxPriceAgeBlocks: 5,
+					},
+				}, nil).Once()
+			},
+			&types.GetPriceRequest{
+				CurrencyPair: "AA/ETHEREUM",
+			},
+			&types.GetPriceResponse{
+				Nonce: 12,
+				Price: &types.QuotePrice{
+					Price: sdkmath.NewInt(100),
+				},
+				Decimals: uint64(18),
+				Id:       2,
+				Stale:    true,
+			},
+			true,
+		},
 	}
 
 	qs := keeper.NewQueryServer(s.oracleKeeper)
@@ -202,6 +229,9 @@ func (s *KeeperTestSuite) TestGetPrice() {
 
 			// check id
 			s.Require().Equal(tc.res.Id, res.Id)
+
+			// check staleness
+			s.Require().Equal(tc.res.Stale, res.Stale)
 		})
 	}
 }
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"errors"
+	"time"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/collections/indexes"
@@ -11,6 +12,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	marketmaptypes "github.com/skip-mev/connect/v2/x/marketmap/types"
 	"github.com/skip-mev/connect/v2/x/oracle/types"
 )
 
@@ -46,6 +48,28 @@ func newOracleIndices(sb *collections.SchemaBuilder) *oracleIndices {
 	}
 }
 
+type tombstoneIndices struct {
+	// removalHeight is a multi-index on the removal heights of the tombstones, i.e. removal height -> ID -> tombstone
+	removalHeight *indexes.Multi[uint64, uint64, types.CurrencyPairTombstone]
+}
+
+func (t *tombstoneIndices) IndexesList() []collections.Index[uint64, types.CurrencyPairTombstone] {
+	return []collections.Index[uint64, types.CurrencyPairTombstone]{
+		t.removalHeight,
+	}
+}
+
+func newTombstoneIndices(sb *collections.SchemaBuilder) *tombstoneIndices {
+	return &tombstoneIndices{
+		removalHeight: indexes.NewMulti[uint64, uint64, types.CurrencyPairTombstone](
+			sb, types.TombstoneRemovalHeightIndexKeyPrefix, "tombstone_removal_height_idx", collections.Uint64Key, collections.Uint64Key,
+			func(_ uint64, tombstone types.CurrencyPairTombstone) (uint64, error) {
+				return tombstone.RemovalHeight, nil
+			},
+		),
+	}
+}
+
 // Keeper is the base keeper for the x/oracle module.
 type Keeper struct {
 	storeService store.KVStoreService
@@ -68,6 +92,12 @@ type Keeper struct {
 	// numCPs is the number of CPs.
 	numCPs collections.Item[uint64]
 
+	// tombstones is keyed by ID and contains the tombstones of removed CPs, indexed by their removal height.
+	tombstones *collections.IndexedMap[uint64, types.CurrencyPairTombstone, *tombstoneIndices]
+
+	// tombstoneRetentionBlocks is the number of blocks for which tombstones are retained, zero retains them forever.
+	tombstoneRetentionBlocks uint64
+
 	// module authority
 	authority sdk.AccAddress
 }
@@ -78,6 +108,7 @@ func NewKeeper(
 	cdc codec.BinaryCodec,
 	mmKeeper types.MarketMapKeeper,
 	authority sdk.AccAddress,
+	opts ...Option,
 ) Keeper {
 	// create a new schema builder
 	sb := collections.NewSchemaBuilder(ss)
@@ -99,6 +130,12 @@ func NewKeeper(
 		nextCurrencyPairID: collections.NewSequence(sb, types.CurrencyPairIDKeyPrefix, "currency_pair_id"),
 		currencyPairs:      collections.NewIndexedMap(sb, types.CurrencyPairKeyPrefix, "currency_pair", collections.StringKey, codec.CollValue[types.CurrencyPairState](cdc), indices),
 		idIndex:            idMulti,
+		tombstones:         collections.NewIndexedMap(sb, types.TombstonesKeyPrefix, "tombstones", collections.Uint64Key, codec.CollValue[types.CurrencyPairTombstone](cdc), newTombstoneIndices(sb)),
+	}
+
+	// apply options to default initialized keeper
+	for _, opt := range opts {
+		opt(&k)
 	}
 
 	// create the schema
@@ -112,15 +149,22 @@ func NewKeeper(
 }
 
 // RemoveCurrencyPair removes a given CurrencyPair from state, i.e. removes its nonce + QuotePrice from the module's store.
+// A tombstone recording the ID, last QuotePrice and removal height of the CurrencyPair is stored in its place.
 func (k *Keeper) RemoveCurrencyPair(ctx context.Context, cp connecttypes.CurrencyPair) error {
 	// check if the currency pair exists.
-	if !k.HasCurrencyPair(ctx, cp) {
+	cps, err := k.currencyPairs.Get(ctx, cp.String())
+	if err != nil {
 		return types.NewCurrencyPairNotExistError(cp)
 	}
 
 	if err := k.currencyPairs.Remove(ctx, cp.String()); err != nil {
 		return err
 	}
+
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()) //nolint:gosec
+	if err := k.tombstones.Set(ctx, cps.Id, types.NewCurrencyPairTombstone(cp, cps, height)); err != nil {
+		return err
+	}
 	if err := k.incrementRemovedCPCounter(ctx); err != nil {
 		return err
 	}
@@ -353,20 +397,117 @@ func (k *Keeper) IterateCurrencyPairs(ctx context.Context, cb func(cp connecttyp
 // GetDecimalsForCurrencyPair gets the decimals used for the given currency pair.  If the market map is not enabled
 // with the x/oracle module, the legacy Decimals function is used.
 func (k *Keeper) GetDecimalsForCurrencyPair(ctx context.Context, cp connecttypes.CurrencyPair) (decimals uint64, err error) {
+	market, err := k.getMarket(ctx, cp)
+	if err != nil {
+		return 0, err
+	}
+
+	return decimalsForMarket(cp, market), nil
+}
+
+// GetPriceWithStalenessForCurrencyPair returns the QuotePriceWithNonce for the given CurrencyPair, along with whether
+// the price is stale. A price is stale if it was last updated more blocks or seconds ago than the maximum price age
+// configured on the Ticker of the CurrencyPair's market, or if it was never updated and the Ticker configures a maximum
+// price age. Prices of CurrencyPairs without a market, or without a configured maximum price age, are never stale.
+func (k *Keeper) GetPriceWithStalenessForCurrencyPair(ctx context.Context, cp connecttypes.CurrencyPair) (types.QuotePriceWithNonce, bool, error) {
+	qpn, stale, _, err := k.GetPriceWithStalenessAndDecimalsForCurrencyPair(ctx, cp)
+	return qpn, stale, err
+}
+
+// GetPriceWithStalenessAndDecimalsForCurrencyPair returns the QuotePriceWithNonce for the given CurrencyPair, whether
+// the price is stale (see GetPriceWithStalenessForCurrencyPair), and the decimals used for the CurrencyPair (see
+// GetDecimalsForCurrencyPair). The CurrencyPair's market is only read once.
+func (k *Keeper) GetPriceWithStalenessAndDecimalsForCurrencyPair(
+	ctx context.Context,
+	cp connecttypes.CurrencyPair,
+) (types.QuotePriceWithNonce, bool, uint64, error) {
+	qpn, err := k.GetPriceWithNonceForCurrencyPair(ctx, cp)
+	if err != nil {
+		return types.QuotePriceWithNonce{}, false, 0, err
+	}
+
+	market, err := k.getMarket(ctx, cp)
+	if err != nil {
+		return types.QuotePriceWithNonce{}, false, 0, err
+	}
+
+	return qpn, isPriceStale(ctx, market, qpn.QuotePrice), decimalsForMarket(cp, market), nil
+}
+
+// GetFreshPriceForCurrencyPair returns the QuotePrice for the given CurrencyPair if it is not stale (see
+// GetPriceWithStalenessForCurrencyPair). A StalePriceError is returned if the price is stale, and a
+// QuotePriceNotExistError if no price has been reported for a CurrencyPair without a configured maximum price age.
+func (k *Keeper) GetFreshPriceForCurrencyPair(ctx context.Context, cp connecttypes.CurrencyPair) (types.QuotePrice, error) {
+	qpn, stale, err := k.GetPriceWithStalenessForCurrencyPair(ctx, cp)
+	if err != nil {
+		return types.QuotePrice{}, err
+	}
+
+	if stale {
+		return types.QuotePrice{}, types.NewStalePriceError(cp, qpn.QuotePrice)
+	}
+
+	if qpn.QuotePrice.Price.IsNil() {
+		return types.QuotePrice{}, types.NewQuotePriceNotExistError(cp)
+	}
+
+	return qpn.QuotePrice, nil
+}
+
+// getMarket returns the market of the given CurrencyPair, or nil if the market map is not enabled with the x/oracle
+// module or the CurrencyPair has no market.
+func (k *Keeper) getMarket(ctx context.Context, cp connecttypes.CurrencyPair) (*marketmaptypes.Market, error) {
 	if k.mmKeeper == nil {
-		return uint64(cp.LegacyDecimals()), nil //nolint:gosec
+		return nil, nil
 	}
 
 	market, err := k.mmKeeper.GetMarket(ctx, cp.String())
 	if err != nil {
 		if errors.Is(err, collections.ErrNotFound) {
-			return uint64(cp.LegacyDecimals()), nil //nolint:gosec
+			return nil, nil
 		}
 
-		return 0, err
+		return nil, err
+	}
+
+	return &market, nil
+}
+
+// decimalsForMarket returns the decimals of the given market of the CurrencyPair, falling back to the legacy Decimals
+// function if the CurrencyPair has no market.
+func decimalsForMarket(cp connecttypes.CurrencyPair, market *marketmaptypes.Market) uint64 {
+	if market == nil {
+		return uint64(cp.LegacyDecimals()) //nolint:gosec
+	}
+
+	return market.Ticker.Decimals
+}
+
+// isPriceStale returns true iff the given QuotePrice is older than the maximum price age configured on the given
+// market, as of the block of the given context. Prices without a market are never stale.
+func isPriceStale(ctx context.Context, market *marketmaptypes.Market, qp types.QuotePrice) bool {
+	if market == nil {
+		return false
+	}
+
+	maxBlocks, maxSeconds := market.Ticker.MaxPriceAgeBlocks, market.Ticker.MaxPriceAgeSeconds
+	if maxBlocks == 0 && maxSeconds == 0 {
+		return false
+	}
+
+	// a price that was never reported is stale once a maximum age is configured
+	if qp.Price.IsNil() {
+		return true
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	height := uint64(max(sdkCtx.BlockHeight(), 0)) //nolint:gosec
+	if maxBlocks != 0 && height > qp.BlockHeight && height-qp.BlockHeight > maxBlocks {
+		return true
 	}
 
-	return market.Ticker.Decimals, nil
+	return maxSeconds != 0 && sdkCtx.BlockTime().Sub(qp.BlockTimestamp) > time.Duration(maxSeconds)*time.Second //nolint:gosec
 }
 
 // IncrementRemovedCPCounter increments the counter of removed currency pairs.
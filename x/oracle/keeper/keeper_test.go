@@ -1,19 +1,23 @@
 package keeper_test
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
+	"cosmossdk.io/collections"
 	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	marketmaptypes "github.com/skip-mev/connect/v2/x/marketmap/types"
 	"github.com/skip-mev/connect/v2/x/oracle/keeper"
 	"github.com/skip-mev/connect/v2/x/oracle/types"
 	"github.com/skip-mev/connect/v2/x/oracle/types/mocks"
@@ -451,3 +455,146 @@ func (s *KeeperTestSuite) TestGetCurrencyPairMappingList() {
 		}, mapping)
 	})
 }
+
+func (s *KeeperTestSuite) TestGetPriceWithStalenessForCurrencyPair() {
+	cp := connecttypes.CurrencyPair{Base: "TEST", Quote: "COIN"}
+	updatedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	qp := types.QuotePrice{
+		Price:          sdkmath.NewInt(100),
+		BlockTimestamp: updatedAt,
+		BlockHeight:    10,
+	}
+
+	market := func(maxBlocks, maxSeconds uint64) marketmaptypes.Market {
+		return marketmaptypes.Market{
+			Ticker: marketmaptypes.Ticker{
+				CurrencyPair:       cp,
+				Decimals:           8,
+				MinProviderCount:   1,
+				MaxPriceAgeBlocks:  maxBlocks,
+				MaxPriceAgeSeconds: maxSeconds,
+			},
+		}
+	}
+
+	tcs := []struct {
+		name      string
+		setPrice  bool
+		market    marketmaptypes.Market
+		marketErr error
+		height    int64
+		time      time.Time
+		stale     bool
+	}{
+		{
+			name:      "a price for a currency pair without a market is never stale",
+			setPrice:  true,
+			marketErr: collections.ErrNotFound,
+			height:    1000,
+			time:      updatedAt.Add(time.Hour),
+		},
+		{
+			name:     "a price for a market without a max price age is never stale",
+			setPrice: true,
+			market:   market(0, 0),
+			height:   1000,
+			time:     updatedAt.Add(time.Hour),
+		},
+		{
+			name:     "a price within the max price age in blocks is not stale",
+			setPrice: true,
+			market:   market(5, 0),
+			height:   15,
+			time:     updatedAt.Add(time.Hour),
+		},
+		{
+			name:     "a price older than the max price age in blocks is stale",
+			setPrice: true,
+			market:   market(5, 0),
+			height:   16,
+			time:     updatedAt,
+			stale:    true,
+		},
+		{
+			name:     "a price within the max price age in seconds is not stale",
+			setPrice: true,
+			market:   market(0, 60),
+			height:   1000,
+			time:     updatedAt.Add(time.Minute),
+		},
+		{
+			name:     "a price older than the max price age in seconds is stale",
+			setPrice: true,
+			market:   market(0, 60),
+			height:   11,
+			time:     updatedAt.Add(time.Minute + time.Second),
+			stale:    true,
+		},
+		{
+			name:   "a price that was never reported is stale if the market has a max price age",
+			market: market(5, 60),
+			height: 1,
+			time:   updatedAt,
+			stale:  true,
+		},
+		{
+			name:   "a price that was never reported is not stale if the market has no max price age",
+			market: market(0, 0),
+			height: 1,
+			time:   updatedAt,
+		},
+	}
+
+	for _, tc := range tcs {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			if tc.setPrice {
+				s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, cp, qp))
+			} else {
+				s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+			}
+
+			ctx := s.ctx.WithBlockHeight(tc.height).WithBlockTime(tc.time)
+			s.mockMarketMapKeeper.On("GetMarket", mock.Anything, cp.String()).Return(tc.market, tc.marketErr).Twice()
+
+			qpn, stale, err := s.oracleKeeper.GetPriceWithStalenessForCurrencyPair(ctx, cp)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.stale, stale)
+			if tc.setPrice {
+				checkQuotePriceEqual(s.T(), qp, qpn.QuotePrice)
+			}
+
+			fresh, err := s.oracleKeeper.GetFreshPriceForCurrencyPair(ctx, cp)
+			switch {
+			case tc.stale:
+				s.Require().ErrorAs(err, &types.StalePriceError{})
+			case !tc.setPrice:
+				s.Require().ErrorAs(err, &types.QuotePriceNotExistError{})
+			default:
+				s.Require().NoError(err)
+				checkQuotePriceEqual(s.T(), qp, fresh)
+			}
+		})
+	}
+
+	s.Run("market map errors are returned", func() {
+		s.SetupTest()
+
+		s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, cp, qp))
+		s.mockMarketMapKeeper.On("GetMarket", mock.Anything, cp.String()).Return(marketmaptypes.Market{}, fmt.Errorf("error")).Once()
+
+		_, _, err := s.oracleKeeper.GetPriceWithStalenessForCurrencyPair(s.ctx, cp)
+		s.Require().Error(err)
+	})
+
+	s.Run("prices are never stale without a market map keeper", func() {
+		s.SetupWithNoMMKeeper()
+
+		s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, cp, qp))
+
+		_, stale, err := s.oracleKeeper.GetPriceWithStalenessForCurrencyPair(s.ctx.WithBlockHeight(1000), cp)
+		s.Require().NoError(err)
+		s.Require().False(stale)
+	})
+}
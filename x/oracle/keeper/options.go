@@ -0,0 +1,13 @@
+package keeper
+
+// Option is a type that modifies a keeper during instantiation. These can be passed variadically into NewKeeper
+// to specify keeper behavior.
+type Option func(*Keeper)
+
+// WithTombstoneRetentionBlocks sets the number of blocks for which the tombstones of removed currency pairs are
+// retained. If zero, which is the default, tombstones are retained forever.
+func WithTombstoneRetentionBlocks(blocks uint64) Option {
+	return func(k *Keeper) {
+		k.tombstoneRetentionBlocks = blocks
+	}
+}
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+// GetCurrencyPairTombstone returns the tombstone of the removed CurrencyPair with the given ID. If no tombstone is
+// retained for the ID, collections.ErrNotFound is returned.
+func (k *Keeper) GetCurrencyPairTombstone(ctx context.Context, id uint64) (types.CurrencyPairTombstone, error) {
+	return k.tombstones.Get(ctx, id)
+}
+
+// GetAllCurrencyPairTombstones returns the tombstones of all removed CurrencyPairs that are retained, ordered by ID.
+func (k *Keeper) GetAllCurrencyPairTombstones(ctx context.Context) ([]types.CurrencyPairTombstone, error) {
+	iter, err := k.tombstones.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return iter.Values()
+}
+
+// GetCurrencyPairTombstones returns the tombstones of the removed CurrencyPairs that are retained, ordered by ID. The
+// tombstones are paginated if a page request is given, in which case the page response is returned.
+func (k *Keeper) GetCurrencyPairTombstones(
+	ctx context.Context,
+	pageReq *query.PageRequest,
+) ([]types.CurrencyPairTombstone, *query.PageResponse, error) {
+	if pageReq == nil {
+		tombstones, err := k.GetAllCurrencyPairTombstones(ctx)
+		return tombstones, nil, err
+	}
+
+	return query.CollectionPaginate(
+		ctx,
+		k.tombstones,
+		pageReq,
+		func(_ uint64, tombstone types.CurrencyPairTombstone) (types.CurrencyPairTombstone, error) {
+			return tombstone, nil
+		},
+	)
+}
+
+// PruneCurrencyPairTombstones removes the tombstones of the CurrencyPairs that were removed more than the tombstone
+// retention period ago. Tombstones are retained forever if the retention period is zero.
+func (k *Keeper) PruneCurrencyPairTombstones(ctx context.Context) error {
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()) //nolint:gosec
+	if k.tombstoneRetentionBlocks == 0 || height <= k.tombstoneRetentionBlocks {
+		return nil
+	}
+	cutoff := height - k.tombstoneRetentionBlocks
+
+	// only the tombstones removed at or before the cutoff are iterated, using the removal height index
+	iter, err := k.tombstones.Indexes.removalHeight.Iterate(ctx, collections.NewPrefixUntilPairRange[uint64, uint64](cutoff))
+	if err != nil {
+		return err
+	}
+
+	ids, err := iter.PrimaryKeys()
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		if err := k.tombstones.Remove(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
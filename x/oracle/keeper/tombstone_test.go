@@ -0,0 +1,194 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/x/oracle/keeper"
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+func (s *KeeperTestSuite) TestCurrencyPairTombstones() {
+	cp1 := connecttypes.CurrencyPair{Base: "TEST", Quote: "COIN1"}
+	cp2 := connecttypes.CurrencyPair{Base: "TEST", Quote: "COIN2"}
+	qp := types.QuotePrice{Price: sdkmath.NewInt(100)}
+
+	s.Run("removing a currency pair records a tombstone", func() {
+		s.SetupTest()
+		s.ctx = s.ctx.WithBlockHeight(10)
+
+		s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp1))
+		s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, cp1, qp))
+		id, ok := s.oracleKeeper.GetIDForCurrencyPair(s.ctx, cp1)
+		s.Require().True(ok)
+
+		s.Require().NoError(s.oracleKeeper.RemoveCurrencyPair(s.ctx, cp1))
+
+		tombstone, err := s.oracleKeeper.GetCurrencyPairTombstone(s.ctx, id)
+		s.Require().NoError(err)
+		s.Require().Equal(cp1, tombstone.CurrencyPair)
+		s.Require().Equal(id, tombstone.Id)
+		s.Require().Equal(uint64(10), tombstone.RemovalHeight)
+		s.Require().Equal(uint64(1), tombstone.Nonce)
+		s.Require().NotNil(tombstone.LastPrice)
+		s.Require().Equal(qp.Price, tombstone.LastPrice.Price)
+
+		// removing the currency pair again fails without touching the tombstone
+		s.Require().Error(s.oracleKeeper.RemoveCurrencyPair(s.ctx.WithBlockHeight(11), cp1))
+		tombstones, err := s.oracleKeeper.GetAllCurrencyPairTombstones(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal([]types.CurrencyPairTombstone{tombstone}, tombstones)
+
+		// missing tombstones are not found
+		_, err = s.oracleKeeper.GetCurrencyPairTombstone(s.ctx, id+1)
+		s.Require().ErrorIs(err, collections.ErrNotFound)
+	})
+
+	s.Run("removing a currency pair without a price records a tombstone without a price", func() {
+		s.SetupTest()
+
+		s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp1))
+		id, ok := s.oracleKeeper.GetIDForCurrencyPair(s.ctx, cp1)
+		s.Require().True(ok)
+
+		s.Require().NoError(s.oracleKeeper.RemoveCurrencyPair(s.ctx, cp1))
+
+		tombstone, err := s.oracleKeeper.GetCurrencyPairTombstone(s.ctx, id)
+		s.Require().NoError(err)
+		s.Require().Nil(tombstone.LastPrice)
+		s.Require().Zero(tombstone.Nonce)
+	})
+
+	s.Run("tombstones are retained forever by default", func() {
+		s.SetupTest()
+
+		s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp1))
+		s.Require().NoError(s.oracleKeeper.RemoveCurrencyPair(s.ctx, cp1))
+
+		s.Require().NoError(s.oracleKeeper.BeginBlocker(s.ctx.WithBlockHeight(1_000_000)))
+
+		tombstones, err := s.oracleKeeper.GetAllCurrencyPairTombstones(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Len(tombstones, 1)
+	})
+
+	s.Run("tombstones are pruned after the retention period", func() {
+		key := storetypes.NewKVStoreKey(types.StoreKey)
+		encCfg := moduletestutil.MakeTestEncodingConfig()
+		s.oracleKeeper = keeper.NewKeeper(
+			runtime.NewKVStoreService(key),
+			encCfg.Codec,
+			nil,
+			moduleAuthAddr,
+			keeper.WithTombstoneRetentionBlocks(10),
+		)
+		s.ctx = testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_key"))
+		s.oracleKeeper.InitGenesis(s.ctx, *types.DefaultGenesisState())
+
+		s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp1))
+		s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp2))
+		id1, _ := s.oracleKeeper.GetIDForCurrencyPair(s.ctx, cp1)
+		id2, _ := s.oracleKeeper.GetIDForCurrencyPair(s.ctx, cp2)
+
+		// the tombstones are pruned by removal height, not by ID
+		s.Require().NoError(s.oracleKeeper.RemoveCurrencyPair(s.ctx.WithBlockHeight(5), cp2))
+		s.Require().NoError(s.oracleKeeper.RemoveCurrencyPair(s.ctx.WithBlockHeight(8), cp1))
+
+		// nothing is pruned within the retention period
+		s.Require().NoError(s.oracleKeeper.BeginBlocker(s.ctx.WithBlockHeight(14)))
+		tombstones, err := s.oracleKeeper.GetAllCurrencyPairTombstones(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Len(tombstones, 2)
+
+		// the first tombstone is pruned once it is older than the retention period
+		s.Require().NoError(s.oracleKeeper.BeginBlocker(s.ctx.WithBlockHeight(15)))
+		_, err = s.oracleKeeper.GetCurrencyPairTombstone(s.ctx, id2)
+		s.Require().ErrorIs(err, collections.ErrNotFound)
+		_, err = s.oracleKeeper.GetCurrencyPairTombstone(s.ctx, id1)
+		s.Require().NoError(err)
+
+		s.Require().NoError(s.oracleKeeper.BeginBlocker(s.ctx.WithBlockHeight(18)))
+		tombstones, err = s.oracleKeeper.GetAllCurrencyPairTombstones(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Empty(tombstones)
+	})
+
+	s.Run("tombstones are exported and imported in genesis", func() {
+		s.SetupTest()
+
+		s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp1))
+		s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, cp1, qp))
+		s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp2))
+		s.Require().NoError(s.oracleKeeper.RemoveCurrencyPair(s.ctx.WithBlockHeight(3), cp1))
+
+		gs := s.oracleKeeper.ExportGenesis(s.ctx)
+		s.Require().NoError(gs.Validate())
+		s.Require().Len(gs.Tombstones, 1)
+		s.Require().Equal(cp1, gs.Tombstones[0].CurrencyPair)
+
+		s.SetupTest()
+		s.ctx = s.ctx.WithBlockHeight(1)
+		s.oracleKeeper.InitGenesis(s.ctx, *gs)
+
+		// the removal heights are reset to the genesis height
+		expected := gs.Tombstones[0]
+		expected.RemovalHeight = 1
+
+		tombstones, err := s.oracleKeeper.GetAllCurrencyPairTombstones(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal([]types.CurrencyPairTombstone{expected}, tombstones)
+	})
+
+	s.Run("tombstones are returned by the query server", func() {
+		s.SetupTest()
+		qs := keeper.NewQueryServer(s.oracleKeeper)
+
+		res, err := qs.GetCurrencyPairTombstones(s.ctx, &types.GetCurrencyPairTombstonesRequest{})
+		s.Require().NoError(err)
+		s.Require().Empty(res.Tombstones)
+
+		s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp1))
+		s.Require().NoError(s.oracleKeeper.RemoveCurrencyPair(s.ctx, cp1))
+
+		res, err = qs.GetCurrencyPairTombstones(s.ctx, &types.GetCurrencyPairTombstonesRequest{})
+		s.Require().NoError(err)
+		s.Require().Len(res.Tombstones, 1)
+		s.Require().Equal(cp1, res.Tombstones[0].CurrencyPair)
+		s.Require().Nil(res.Pagination)
+
+		_, err = qs.GetCurrencyPairTombstones(s.ctx, nil)
+		s.Require().Error(err)
+	})
+
+	s.Run("tombstones are paginated by the query server", func() {
+		s.SetupTest()
+		qs := keeper.NewQueryServer(s.oracleKeeper)
+
+		for _, cp := range []connecttypes.CurrencyPair{cp1, cp2} {
+			s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+			s.Require().NoError(s.oracleKeeper.RemoveCurrencyPair(s.ctx, cp))
+		}
+
+		res, err := qs.GetCurrencyPairTombstones(s.ctx, &types.GetCurrencyPairTombstonesRequest{
+			Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+		})
+		s.Require().NoError(err)
+		s.Require().Len(res.Tombstones, 1)
+		s.Require().Equal(cp1, res.Tombstones[0].CurrencyPair)
+		s.Require().Equal(uint64(2), res.Pagination.Total)
+
+		res, err = qs.GetCurrencyPairTombstones(s.ctx, &types.GetCurrencyPairTombstonesRequest{
+			Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+		})
+		s.Require().NoError(err)
+		s.Require().Len(res.Tombstones, 1)
+		s.Require().Equal(cp2, res.Tombstones[0].CurrencyPair)
+		s.Require().Nil(res.Pagination.NextKey)
+	})
+}
@@ -66,9 +66,9 @@ func (amb AppModuleBasic) RegisterGRPCGatewayRoutes(cliCtx client.Context, mux *
 	}
 }
 
-// GetTxCmd is a no-op, as no txs are registered for submission (apart from messages that can only be executed by governance).
+// GetTxCmd returns the x/oracle module base tx cli-command.
 func (amb AppModuleBasic) GetTxCmd() *cobra.Command {
-	return nil
+	return cli.GetTxCmd()
 }
 
 // GetQueryCmd returns the x/oracle module base query cli-command.
@@ -202,6 +202,7 @@ func ProvideModule(in Inputs) Outputs {
 		in.Cdc,
 		in.MarketMapKeeper,
 		authority,
+		keeper.WithTombstoneRetentionBlocks(in.Config.TombstoneRetentionBlocks),
 	)
 
 	m := NewAppModule(in.Cdc, oracleKeeper)
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
 )
@@ -41,3 +42,27 @@ func NewCurrencyPairAlreadyExistsError(cp connecttypes.CurrencyPair) CurrencyPai
 func (e CurrencyPairAlreadyExistsError) Error() string {
 	return fmt.Sprintf("currency pair already exists: %s", e.cp)
 }
+
+// StalePriceError is returned when the price for a CurrencyPair is older than the maximum price age configured
+// on its market.
+type StalePriceError struct {
+	cp              string
+	lastBlockHeight uint64
+	lastBlockTime   time.Time
+}
+
+func NewStalePriceError(cp connecttypes.CurrencyPair, qp QuotePrice) StalePriceError {
+	return StalePriceError{
+		cp:              cp.String(),
+		lastBlockHeight: qp.BlockHeight,
+		lastBlockTime:   qp.BlockTimestamp,
+	}
+}
+
+func (e StalePriceError) Error() string {
+	if e.lastBlockHeight == 0 {
+		return fmt.Sprintf("price for CurrencyPair %s is stale: no price has been reported", e.cp)
+	}
+
+	return fmt.Sprintf("price for CurrencyPair %s is stale: last updated at height %d (%s)", e.cp, e.lastBlockHeight, e.lastBlockTime.UTC())
+}
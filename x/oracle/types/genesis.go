@@ -70,6 +70,25 @@ func (gs *GenesisState) Validate() error {
 		cps[cpg.CurrencyPair.String()] = struct{}{}
 	}
 
+	for _, tombstone := range gs.Tombstones {
+		// validate the tombstone
+		if err := tombstone.ValidateBasic(); err != nil {
+			return err
+		}
+
+		// check if the ID > gs.NextID
+		if tombstone.Id >= gs.NextId {
+			return fmt.Errorf("invalid tombstone id: %v, must be less than next id: %v", tombstone.Id, gs.NextId)
+		}
+
+		// check that the ID is not used by a currency-pair or another tombstone, as IDs are never reused
+		if _, ok := ids[tombstone.Id]; ok {
+			return fmt.Errorf("repeated id: %v", tombstone.Id)
+		}
+
+		ids[tombstone.Id] = struct{}{}
+	}
+
 	return nil
 }
 
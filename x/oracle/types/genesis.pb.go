@@ -40,6 +40,11 @@ type QuotePrice struct {
 	BlockTimestamp time.Time `protobuf:"bytes,2,opt,name=block_timestamp,json=blockTimestamp,proto3,stdtime" json:"block_timestamp"`
 	// BlockHeight is height of block mentioned above
 	BlockHeight uint64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// Spread is an optional measure of the dispersion of the validator prices
+	// that were aggregated into this price (i.e. the stake-weighted
+	// interquartile range). It is unset if the aggregation function used by the
+	// application does not compute a spread.
+	Spread *cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=spread,proto3,customtype=cosmossdk.io/math.Int" json:"spread,omitempty"`
 }
 
 func (m *QuotePrice) Reset()         { *m = QuotePrice{} }
@@ -231,6 +236,92 @@ func (m *CurrencyPairGenesis) GetId() uint64 {
 	return 0
 }
 
+// CurrencyPairTombstone records a CurrencyPair that was removed from the
+// x/oracle module, so that its ID can be told apart from an ID that was never
+// assigned. IDs of removed CurrencyPairs are never reused.
+type CurrencyPairTombstone struct {
+	// CurrencyPair is the removed CurrencyPair
+	CurrencyPair types.CurrencyPair `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair"`
+	// ID is the ID the CurrencyPair had before its removal
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// RemovalHeight is the block height at which the CurrencyPair was removed
+	RemovalHeight uint64 `protobuf:"varint,3,opt,name=removal_height,json=removalHeight,proto3" json:"removal_height,omitempty"`
+	// LastPrice is the last price of the CurrencyPair before its removal, notice
+	// this value can be null in the case that no price existed for it
+	LastPrice *QuotePrice `protobuf:"bytes,4,opt,name=last_price,json=lastPrice,proto3" json:"last_price,omitempty"`
+	// Nonce is the number of updates the CurrencyPair received before its
+	// removal
+	Nonce uint64 `protobuf:"varint,5,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *CurrencyPairTombstone) Reset()         { *m = CurrencyPairTombstone{} }
+func (m *CurrencyPairTombstone) String() string { return proto.CompactTextString(m) }
+func (*CurrencyPairTombstone) ProtoMessage()    {}
+func (*CurrencyPairTombstone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a688f927817fa7da, []int{3}
+}
+func (m *CurrencyPairTombstone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CurrencyPairTombstone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CurrencyPairTombstone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CurrencyPairTombstone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CurrencyPairTombstone.Merge(m, src)
+}
+func (m *CurrencyPairTombstone) XXX_Size() int {
+	return m.Size()
+}
+func (m *CurrencyPairTombstone) XXX_DiscardUnknown() {
+	xxx_messageInfo_CurrencyPairTombstone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CurrencyPairTombstone proto.InternalMessageInfo
+
+func (m *CurrencyPairTombstone) GetCurrencyPair() types.CurrencyPair {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return types.CurrencyPair{}
+}
+
+func (m *CurrencyPairTombstone) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *CurrencyPairTombstone) GetRemovalHeight() uint64 {
+	if m != nil {
+		return m.RemovalHeight
+	}
+	return 0
+}
+
+func (m *CurrencyPairTombstone) GetLastPrice() *QuotePrice {
+	if m != nil {
+		return m.LastPrice
+	}
+	return nil
+}
+
+func (m *CurrencyPairTombstone) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
 // GenesisState is the genesis-state for the x/oracle module, it takes a set of
 // predefined CurrencyPairGeneses
 type GenesisState struct {
@@ -240,13 +331,19 @@ type GenesisState struct {
 	CurrencyPairGenesis []CurrencyPairGenesis `protobuf:"bytes,1,rep,name=currency_pair_genesis,json=currencyPairGenesis,proto3" json:"currency_pair_genesis"`
 	// NextID is the next ID to be used for a CurrencyPair
 	NextId uint64 `protobuf:"varint,2,opt,name=next_id,json=nextId,proto3" json:"next_id,omitempty"`
+	// Tombstones are the tombstones of the removed CurrencyPairs that are still
+	// retained. Block heights are not carried over to a chain started from an
+	// exported genesis, so the removal heights of the tombstones are reset to
+	// the genesis height on import, and the tombstones are retained for the full
+	// retention period from then on.
+	Tombstones []CurrencyPairTombstone `protobuf:"bytes,3,rep,name=tombstones,proto3" json:"tombstones"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a688f927817fa7da, []int{3}
+	return fileDescriptor_a688f927817fa7da, []int{4}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -289,49 +386,63 @@ func (m *GenesisState) GetNextId() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetTombstones() []CurrencyPairTombstone {
+	if m != nil {
+		return m.Tombstones
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QuotePrice)(nil), "connect.oracle.v2.QuotePrice")
 	proto.RegisterType((*CurrencyPairState)(nil), "connect.oracle.v2.CurrencyPairState")
 	proto.RegisterType((*CurrencyPairGenesis)(nil), "connect.oracle.v2.CurrencyPairGenesis")
+	proto.RegisterType((*CurrencyPairTombstone)(nil), "connect.oracle.v2.CurrencyPairTombstone")
 	proto.RegisterType((*GenesisState)(nil), "connect.oracle.v2.GenesisState")
 }
 
 func init() { proto.RegisterFile("connect/oracle/v2/genesis.proto", fileDescriptor_a688f927817fa7da) }
 
 var fileDescriptor_a688f927817fa7da = []byte{
-	// 507 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4f, 0x8f, 0xd2, 0x40,
-	0x18, 0xc6, 0x3b, 0xc0, 0xae, 0x3a, 0xe0, 0x1a, 0xca, 0x6e, 0x44, 0x12, 0x5b, 0x24, 0xc6, 0x90,
-	0x18, 0x66, 0x92, 0x7a, 0xf2, 0x28, 0x1e, 0x56, 0x0e, 0x26, 0xd8, 0xf5, 0xe4, 0xa5, 0x96, 0xe9,
-	0x58, 0x26, 0xd0, 0x4e, 0xd3, 0x0e, 0x64, 0xf7, 0x23, 0x78, 0xdb, 0x0f, 0xe3, 0x17, 0xf0, 0xc6,
-	0x71, 0xe3, 0xc9, 0x68, 0x82, 0x06, 0xbe, 0x88, 0xe9, 0xcc, 0x14, 0x4b, 0x96, 0xc3, 0xde, 0xfa,
-	0xce, 0xbc, 0x7f, 0x9e, 0xdf, 0x33, 0x6f, 0xa1, 0x4d, 0x78, 0x1c, 0x53, 0x22, 0x30, 0x4f, 0x7d,
-	0x32, 0xa7, 0x78, 0xe9, 0xe0, 0x90, 0xc6, 0x34, 0x63, 0x19, 0x4a, 0x52, 0x2e, 0xb8, 0xd9, 0xd4,
-	0x09, 0x48, 0x25, 0xa0, 0xa5, 0xd3, 0x39, 0x0d, 0x79, 0xc8, 0xe5, 0x2d, 0xce, 0xbf, 0x54, 0x62,
-	0xc7, 0x0e, 0x39, 0x0f, 0xe7, 0x14, 0xcb, 0x68, 0xb2, 0xf8, 0x82, 0x05, 0x8b, 0x68, 0x26, 0xfc,
-	0x28, 0xd1, 0x09, 0x4f, 0x08, 0xcf, 0x22, 0x9e, 0x79, 0xaa, 0x52, 0x05, 0xfa, 0xea, 0x79, 0xa1,
-	0x42, 0x5c, 0x25, 0x34, 0xcb, 0x45, 0x90, 0x45, 0x9a, 0xd2, 0x98, 0x5c, 0x79, 0x89, 0xcf, 0x52,
-	0x95, 0xd5, 0xfb, 0x0e, 0x20, 0xfc, 0xb0, 0xe0, 0x82, 0x8e, 0x53, 0x46, 0xa8, 0xf9, 0x06, 0x1e,
-	0x25, 0xf9, 0x47, 0x1b, 0x74, 0x41, 0xff, 0xc1, 0xf0, 0xe5, 0x6a, 0x6d, 0x1b, 0xbf, 0xd6, 0xf6,
-	0x99, 0xea, 0x9c, 0x05, 0x33, 0xc4, 0x38, 0x8e, 0x7c, 0x31, 0x45, 0xa3, 0x58, 0xfc, 0xf8, 0x36,
-	0x80, 0x7a, 0xe4, 0x28, 0x16, 0xae, 0xaa, 0x34, 0xdf, 0xc3, 0x47, 0x93, 0x39, 0x27, 0x33, 0x6f,
-	0xa7, 0xb5, 0x5d, 0xe9, 0x82, 0x7e, 0xdd, 0xe9, 0x20, 0x45, 0x83, 0x0a, 0x1a, 0xf4, 0xb1, 0xc8,
-	0x18, 0xde, 0xcf, 0x07, 0x5d, 0xff, 0xb1, 0x81, 0x7b, 0x22, 0x8b, 0x77, 0x37, 0xe6, 0x33, 0xd8,
-	0x50, 0xed, 0xa6, 0x94, 0x85, 0x53, 0xd1, 0xae, 0x76, 0x41, 0xbf, 0xe6, 0xd6, 0xe5, 0xd9, 0x3b,
-	0x79, 0xd4, 0x13, 0xb0, 0xf9, 0x56, 0xa3, 0x8d, 0x7d, 0x96, 0x5e, 0x08, 0x5f, 0x50, 0xf3, 0x75,
-	0x99, 0xa4, 0xee, 0x3c, 0x45, 0xb7, 0x3c, 0x47, 0xff, 0xb9, 0x87, 0xb5, 0xd5, 0xda, 0x06, 0x05,
-	0xc1, 0x29, 0x3c, 0x8a, 0x79, 0x4c, 0xa8, 0xd4, 0x5d, 0x73, 0x55, 0x60, 0x9e, 0xc0, 0x0a, 0x0b,
-	0xf4, 0xf8, 0x0a, 0x0b, 0x7a, 0xbf, 0x01, 0x6c, 0x95, 0xc7, 0x9e, 0xab, 0x27, 0x36, 0x47, 0xf0,
-	0xe1, 0x9e, 0xd1, 0x5a, 0x80, 0xb5, 0x13, 0x20, 0xdf, 0x23, 0x9f, 0x5f, 0xae, 0x96, 0x0a, 0x0c,
-	0xb7, 0x41, 0x4a, 0x67, 0xe6, 0x05, 0x6c, 0xed, 0xb5, 0xf2, 0x14, 0x51, 0xe5, 0xee, 0x44, 0xcd,
-	0x72, 0xbf, 0xf1, 0x3e, 0x5d, 0xf5, 0x36, 0x5d, 0x6d, 0x47, 0xf7, 0x15, 0xc0, 0x86, 0x26, 0x52,
-	0x7e, 0x7e, 0x86, 0x67, 0xfb, 0x5a, 0xf4, 0x4a, 0xb7, 0x41, 0xb7, 0xda, 0xaf, 0x3b, 0x2f, 0x0e,
-	0xa8, 0x39, 0xe0, 0x8e, 0xc6, 0x6c, 0x91, 0x03, 0xc6, 0x3d, 0x86, 0xf7, 0x62, 0x7a, 0x29, 0x3c,
-	0x16, 0x68, 0xe3, 0x8f, 0xf3, 0x70, 0x14, 0x0c, 0xcf, 0x57, 0x1b, 0x0b, 0xdc, 0x6c, 0x2c, 0xf0,
-	0x77, 0x63, 0x81, 0xeb, 0xad, 0x65, 0xdc, 0x6c, 0x2d, 0xe3, 0xe7, 0xd6, 0x32, 0x3e, 0x0d, 0x42,
-	0x26, 0xa6, 0x8b, 0x09, 0x22, 0x3c, 0xc2, 0xd9, 0x8c, 0x25, 0x83, 0x88, 0x2e, 0x71, 0xb1, 0xf7,
-	0x4b, 0x07, 0x5f, 0x16, 0xbf, 0xa0, 0xf4, 0x7c, 0x72, 0x2c, 0x37, 0xef, 0xd5, 0xbf, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xa6, 0xbb, 0xf6, 0x48, 0xa1, 0x03, 0x00, 0x00,
+	// 596 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xce, 0x3a, 0x6e, 0xa1, 0x93, 0xb6, 0xa8, 0xdb, 0x46, 0x98, 0x48, 0x38, 0x21, 0x02, 0x14,
+	0x09, 0xc5, 0x96, 0xcc, 0x89, 0x23, 0xe9, 0xa1, 0xe4, 0x00, 0x0a, 0x69, 0x4f, 0x5c, 0x82, 0xb3,
+	0x59, 0x1c, 0x2b, 0xb1, 0xd7, 0xf2, 0x6e, 0xa2, 0xf6, 0x05, 0x38, 0xf7, 0x61, 0x78, 0x88, 0x1e,
+	0x2b, 0x4e, 0xfc, 0x48, 0x05, 0x25, 0x8f, 0xc0, 0x0b, 0x20, 0x7b, 0xd7, 0xc6, 0x21, 0x95, 0xc8,
+	0x81, 0xdb, 0xfe, 0xcc, 0xcc, 0xf7, 0x33, 0xa3, 0x81, 0x3a, 0x61, 0x61, 0x48, 0x89, 0xb0, 0x59,
+	0xec, 0x92, 0x29, 0xb5, 0xe7, 0x8e, 0xed, 0xd1, 0x90, 0x72, 0x9f, 0x5b, 0x51, 0xcc, 0x04, 0xc3,
+	0x07, 0x2a, 0xc0, 0x92, 0x01, 0xd6, 0xdc, 0xa9, 0x1d, 0x79, 0xcc, 0x63, 0xe9, 0xaf, 0x9d, 0x9c,
+	0x64, 0x60, 0xad, 0xee, 0x31, 0xe6, 0x4d, 0xa9, 0x9d, 0xde, 0x86, 0xb3, 0x0f, 0xb6, 0xf0, 0x03,
+	0xca, 0x85, 0x1b, 0x44, 0x2a, 0xe0, 0x01, 0x61, 0x3c, 0x60, 0x7c, 0x20, 0x33, 0xe5, 0x45, 0x7d,
+	0x3d, 0xce, 0x58, 0x88, 0x8b, 0x88, 0xf2, 0x84, 0x04, 0x99, 0xc5, 0x31, 0x0d, 0xc9, 0xc5, 0x20,
+	0x72, 0xfd, 0x58, 0x46, 0x35, 0x3f, 0x6a, 0x00, 0x6f, 0x67, 0x4c, 0xd0, 0x5e, 0xec, 0x13, 0x8a,
+	0x5f, 0xc2, 0x56, 0x94, 0x1c, 0x0c, 0xd4, 0x40, 0xad, 0x9d, 0xce, 0xb3, 0xab, 0x9b, 0x7a, 0xe9,
+	0xdb, 0x4d, 0xbd, 0x2a, 0x2b, 0xf3, 0xd1, 0xc4, 0xf2, 0x99, 0x1d, 0xb8, 0x62, 0x6c, 0x75, 0x43,
+	0xf1, 0xf9, 0x53, 0x1b, 0x14, 0x64, 0x37, 0x14, 0x7d, 0x99, 0x89, 0x5f, 0xc3, 0xbd, 0xe1, 0x94,
+	0x91, 0xc9, 0x20, 0xe7, 0x6a, 0x68, 0x0d, 0xd4, 0xaa, 0x38, 0x35, 0x4b, 0xaa, 0xb1, 0x32, 0x35,
+	0xd6, 0x59, 0x16, 0xd1, 0xb9, 0x9b, 0x00, 0x5d, 0xfe, 0xa8, 0xa3, 0xfe, 0x7e, 0x9a, 0x9c, 0xff,
+	0xe0, 0x47, 0xb0, 0x2b, 0xcb, 0x8d, 0xa9, 0xef, 0x8d, 0x85, 0x51, 0x6e, 0xa0, 0x96, 0xde, 0xaf,
+	0xa4, 0x6f, 0xaf, 0xd2, 0x27, 0x7c, 0x0c, 0xdb, 0x3c, 0x8a, 0xa9, 0x3b, 0x32, 0xf4, 0x9c, 0x35,
+	0xda, 0x94, 0xb5, 0x4a, 0x6d, 0x0a, 0x38, 0x38, 0x56, 0xfe, 0xf4, 0x5c, 0x3f, 0x3e, 0x15, 0xae,
+	0xa0, 0xf8, 0x45, 0xd1, 0x8e, 0x8a, 0xf3, 0xd0, 0x5a, 0x6b, 0x9c, 0xf5, 0xc7, 0xbc, 0x8e, 0x9e,
+	0xe0, 0x66, 0x36, 0x1c, 0xc1, 0x56, 0xc8, 0x42, 0x42, 0x53, 0xf1, 0x7a, 0x5f, 0x5e, 0xf0, 0x3e,
+	0x68, 0xfe, 0x48, 0x69, 0xd0, 0xfc, 0x51, 0xf3, 0x3b, 0x82, 0xc3, 0x22, 0xec, 0x89, 0x9c, 0x13,
+	0xdc, 0x85, 0xbd, 0x95, 0x6e, 0x29, 0x02, 0x66, 0x4e, 0x20, 0x6d, 0x6a, 0x82, 0x5f, 0xcc, 0x4e,
+	0x19, 0x94, 0xfa, 0xbb, 0xa4, 0xf0, 0x86, 0x4f, 0xe1, 0x70, 0xa5, 0xd4, 0x40, 0x2a, 0xd2, 0x36,
+	0x57, 0x74, 0x50, 0xac, 0xd7, 0x5b, 0x55, 0x57, 0x5e, 0x57, 0xa7, 0xe7, 0xea, 0x7e, 0x21, 0xa8,
+	0x16, 0xf9, 0x9d, 0xb1, 0x60, 0xc8, 0x05, 0x0b, 0xe9, 0xff, 0xd4, 0x27, 0x41, 0xb5, 0x0c, 0x14,
+	0x3f, 0x81, 0xfd, 0x98, 0x06, 0x6c, 0xee, 0x4e, 0x57, 0x47, 0x66, 0x4f, 0xbd, 0xaa, 0xa1, 0xe9,
+	0x00, 0x4c, 0x5d, 0x2e, 0x94, 0x1b, 0xfa, 0xe6, 0x6e, 0xec, 0x24, 0x69, 0x7f, 0xb9, 0xb0, 0x55,
+	0x70, 0xa1, 0xf9, 0x15, 0xc1, 0xae, 0xea, 0xa3, 0x9c, 0xa2, 0xf7, 0x50, 0x5d, 0xed, 0x80, 0xda,
+	0x06, 0x06, 0x6a, 0x94, 0x5b, 0x15, 0xe7, 0xe9, 0x2d, 0xa8, 0xb7, 0xcc, 0x84, 0x12, 0x7f, 0x48,
+	0xd6, 0xbf, 0xf0, 0x7d, 0xb8, 0x13, 0xd2, 0x73, 0x31, 0xc8, 0x8d, 0xd8, 0x4e, 0xae, 0xdd, 0x11,
+	0x7e, 0x03, 0x20, 0x32, 0xd3, 0xb9, 0x51, 0x4e, 0xf1, 0x5a, 0xff, 0xc0, 0xcb, 0xbb, 0xa4, 0x10,
+	0x0b, 0x15, 0x3a, 0x27, 0x57, 0x0b, 0x13, 0x5d, 0x2f, 0x4c, 0xf4, 0x73, 0x61, 0xa2, 0xcb, 0xa5,
+	0x59, 0xba, 0x5e, 0x9a, 0xa5, 0x2f, 0x4b, 0xb3, 0xf4, 0xae, 0xed, 0xf9, 0x62, 0x3c, 0x1b, 0x5a,
+	0x84, 0x05, 0x36, 0x9f, 0xf8, 0x51, 0x3b, 0xa0, 0x73, 0x3b, 0x5b, 0x41, 0x73, 0xc7, 0x3e, 0xcf,
+	0xb6, 0x61, 0xda, 0xd9, 0xe1, 0x76, 0xba, 0x04, 0x9e, 0xff, 0x1e, 0x00, 0x80, 0x3b, 0x8f, 0xf0,
+	0x2c, 0x05, 0x00, 0x00,
 }
 
 func (m *QuotePrice) Marshal() (dAtA []byte, err error) {
@@ -354,6 +465,18 @@ func (m *QuotePrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Spread != nil {
+		{
+			size := m.Spread.Size()
+			i -= size
+			if _, err := m.Spread.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.BlockHeight != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
 		i--
@@ -480,6 +603,66 @@ func (m *CurrencyPairGenesis) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CurrencyPairTombstone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CurrencyPairTombstone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CurrencyPairTombstone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LastPrice != nil {
+		{
+			size, err := m.LastPrice.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.RemovalHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RemovalHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Id != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.CurrencyPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -500,6 +683,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Tombstones) > 0 {
+		for iNdEx := len(m.Tombstones) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tombstones[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.NextId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.NextId))
 		i--
@@ -546,6 +743,10 @@ func (m *QuotePrice) Size() (n int) {
 	if m.BlockHeight != 0 {
 		n += 1 + sovGenesis(uint64(m.BlockHeight))
 	}
+	if m.Spread != nil {
+		l = m.Spread.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -589,6 +790,30 @@ func (m *CurrencyPairGenesis) Size() (n int) {
 	return n
 }
 
+func (m *CurrencyPairTombstone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrencyPair.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.Id != 0 {
+		n += 1 + sovGenesis(uint64(m.Id))
+	}
+	if m.RemovalHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.RemovalHeight))
+	}
+	if m.LastPrice != nil {
+		l = m.LastPrice.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovGenesis(uint64(m.Nonce))
+	}
+	return n
+}
+
 func (m *GenesisState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -604,6 +829,12 @@ func (m *GenesisState) Size() (n int) {
 	if m.NextId != 0 {
 		n += 1 + sovGenesis(uint64(m.NextId))
 	}
+	if len(m.Tombstones) > 0 {
+		for _, e := range m.Tombstones {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -728,6 +959,42 @@ func (m *QuotePrice) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spread", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.Spread = &v
+			if err := m.Spread.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1030,6 +1297,182 @@ func (m *CurrencyPairGenesis) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CurrencyPairTombstone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CurrencyPairTombstone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CurrencyPairTombstone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovalHeight", wireType)
+			}
+			m.RemovalHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemovalHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastPrice == nil {
+				m.LastPrice = &QuotePrice{}
+			}
+			if err := m.LastPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1112,6 +1555,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstones", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tombstones = append(m.Tombstones, CurrencyPairTombstone{})
+			if err := m.Tombstones[len(m.Tombstones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -129,3 +129,102 @@ func TestGenesisValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestGenesisTombstoneValidation(t *testing.T) {
+	cpgs := []types.CurrencyPairGenesis{
+		{
+			CurrencyPair: connecttypes.CurrencyPair{
+				Base:  "AA",
+				Quote: "BB",
+			},
+			Id: 0,
+		},
+	}
+
+	tcs := []struct {
+		name       string
+		tombstones []types.CurrencyPairTombstone
+		expectPass bool
+	}{
+		{
+			"if the tombstones are valid - pass",
+			[]types.CurrencyPairTombstone{
+				{
+					CurrencyPair: connecttypes.CurrencyPair{
+						Base:  "BB",
+						Quote: "CC",
+					},
+					Id:            1,
+					RemovalHeight: 10,
+				},
+			},
+			true,
+		},
+		{
+			"if a tombstone has an invalid currency-pair - fail",
+			[]types.CurrencyPairTombstone{
+				{
+					CurrencyPair: connecttypes.CurrencyPair{
+						Base: "BB",
+					},
+					Id: 1,
+				},
+			},
+			false,
+		},
+		{
+			"if a tombstone has no last price, but the nonce is non-zero - fail",
+			[]types.CurrencyPairTombstone{
+				{
+					CurrencyPair: connecttypes.CurrencyPair{
+						Base:  "BB",
+						Quote: "CC",
+					},
+					Id:    1,
+					Nonce: 1,
+				},
+			},
+			false,
+		},
+		{
+			"if a tombstone ID is not less than the next ID - fail",
+			[]types.CurrencyPairTombstone{
+				{
+					CurrencyPair: connecttypes.CurrencyPair{
+						Base:  "BB",
+						Quote: "CC",
+					},
+					Id: 2,
+				},
+			},
+			false,
+		},
+		{
+			"if a tombstone ID is used by a currency-pair - fail",
+			[]types.CurrencyPairTombstone{
+				{
+					CurrencyPair: connecttypes.CurrencyPair{
+						Base:  "BB",
+						Quote: "CC",
+					},
+					Id: 0,
+				},
+			},
+			false,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			gs := types.NewGenesisState(cpgs, 2)
+			gs.Tombstones = tc.tombstones
+			err := gs.Validate()
+
+			if tc.expectPass {
+				require.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+			}
+		})
+	}
+}
@@ -33,6 +33,13 @@ var (
 	// NumCPsKeyPrefix is the key-prefix under which the number CPs is stored.
 	NumCPsKeyPrefix = collections.NewPrefix(5)
 
+	// TombstonesKeyPrefix is the key-prefix under which the tombstones of removed CPs are stored.
+	TombstonesKeyPrefix = collections.NewPrefix(6)
+
+	// TombstoneRemovalHeightIndexKeyPrefix is the key-prefix under which the removal height index on the tombstones of
+	// removed CPs is stored.
+	TombstoneRemovalHeightIndexKeyPrefix = collections.NewPrefix(7)
+
 	// CounterCodec is the collections.KeyCodec value used for the counter values.
 	CounterCodec = codec.KeyToValueCodec[uint64](codec.NewUint64Key[uint64]())
 )
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -172,6 +173,10 @@ type GetPriceResponse struct {
 	Decimals uint64 `protobuf:"varint,3,opt,name=decimals,proto3" json:"decimals,omitempty"`
 	// ID represents the identifier for the CurrencyPair.
 	Id uint64 `protobuf:"varint,4,opt,name=id,proto3" json:"id,omitempty"`
+	// stale is true if the price has not been updated within the maximum price
+	// age configured on the market of the CurrencyPair, or if no price has been
+	// reported for a CurrencyPair with a configured maximum price age.
+	Stale bool `protobuf:"varint,5,opt,name=stale,proto3" json:"stale,omitempty"`
 }
 
 func (m *GetPriceResponse) Reset()         { *m = GetPriceResponse{} }
@@ -235,6 +240,13 @@ func (m *GetPriceResponse) GetId() uint64 {
 	return 0
 }
 
+func (m *GetPriceResponse) GetStale() bool {
+	if m != nil {
+		return m.Stale
+	}
+	return false
+}
+
 // GetPricesRequest takes an identifier for the CurrencyPair
 // in the format base/quote.
 type GetPricesRequest struct {
@@ -549,6 +561,112 @@ func (m *GetCurrencyPairMappingListResponse) GetMappings() []CurrencyPairMapping
 	return nil
 }
 
+// GetCurrencyPairTombstonesRequest is the GetCurrencyPairTombstones request
+// type.
+type GetCurrencyPairTombstonesRequest struct {
+	// Pagination paginates the tombstones, ordered by ID. All tombstones are
+	// returned if it is unset.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *GetCurrencyPairTombstonesRequest) Reset()         { *m = GetCurrencyPairTombstonesRequest{} }
+func (m *GetCurrencyPairTombstonesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCurrencyPairTombstonesRequest) ProtoMessage()    {}
+func (*GetCurrencyPairTombstonesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85b187574238e3d2, []int{11}
+}
+func (m *GetCurrencyPairTombstonesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetCurrencyPairTombstonesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetCurrencyPairTombstonesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetCurrencyPairTombstonesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCurrencyPairTombstonesRequest.Merge(m, src)
+}
+func (m *GetCurrencyPairTombstonesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetCurrencyPairTombstonesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCurrencyPairTombstonesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCurrencyPairTombstonesRequest proto.InternalMessageInfo
+
+func (m *GetCurrencyPairTombstonesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// GetCurrencyPairTombstonesResponse is the GetCurrencyPairTombstones response
+// type.
+type GetCurrencyPairTombstonesResponse struct {
+	// tombstones are the tombstones of the removed currency pairs, ordered by
+	// ID.
+	Tombstones []CurrencyPairTombstone `protobuf:"bytes,1,rep,name=tombstones,proto3" json:"tombstones"`
+	// Pagination is the pagination of the tombstones. It is only set for
+	// paginated requests.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *GetCurrencyPairTombstonesResponse) Reset()         { *m = GetCurrencyPairTombstonesResponse{} }
+func (m *GetCurrencyPairTombstonesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCurrencyPairTombstonesResponse) ProtoMessage()    {}
+func (*GetCurrencyPairTombstonesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85b187574238e3d2, []int{12}
+}
+func (m *GetCurrencyPairTombstonesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetCurrencyPairTombstonesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetCurrencyPairTombstonesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetCurrencyPairTombstonesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCurrencyPairTombstonesResponse.Merge(m, src)
+}
+func (m *GetCurrencyPairTombstonesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetCurrencyPairTombstonesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCurrencyPairTombstonesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCurrencyPairTombstonesResponse proto.InternalMessageInfo
+
+func (m *GetCurrencyPairTombstonesResponse) GetTombstones() []CurrencyPairTombstone {
+	if m != nil {
+		return m.Tombstones
+	}
+	return nil
+}
+
+func (m *GetCurrencyPairTombstonesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GetAllCurrencyPairsRequest)(nil), "connect.oracle.v2.GetAllCurrencyPairsRequest")
 	proto.RegisterType((*GetAllCurrencyPairsResponse)(nil), "connect.oracle.v2.GetAllCurrencyPairsResponse")
@@ -562,59 +680,71 @@ func init() {
 	proto.RegisterType((*GetCurrencyPairMappingListRequest)(nil), "connect.oracle.v2.GetCurrencyPairMappingListRequest")
 	proto.RegisterType((*CurrencyPairMapping)(nil), "connect.oracle.v2.CurrencyPairMapping")
 	proto.RegisterType((*GetCurrencyPairMappingListResponse)(nil), "connect.oracle.v2.GetCurrencyPairMappingListResponse")
+	proto.RegisterType((*GetCurrencyPairTombstonesRequest)(nil), "connect.oracle.v2.GetCurrencyPairTombstonesRequest")
+	proto.RegisterType((*GetCurrencyPairTombstonesResponse)(nil), "connect.oracle.v2.GetCurrencyPairTombstonesResponse")
 }
 
 func init() { proto.RegisterFile("connect/oracle/v2/query.proto", fileDescriptor_85b187574238e3d2) }
 
 var fileDescriptor_85b187574238e3d2 = []byte{
-	// 751 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xcd, 0x6e, 0xd3, 0x4a,
-	0x14, 0xce, 0xa4, 0x49, 0xd5, 0x9e, 0xde, 0xfe, 0x4d, 0x7b, 0xaf, 0xa2, 0xdc, 0xc6, 0xe9, 0x75,
-	0x73, 0x51, 0x55, 0x51, 0x9b, 0xa6, 0x05, 0x51, 0x16, 0x48, 0x2d, 0x42, 0xa1, 0xfc, 0x48, 0xad,
-	0x17, 0x2c, 0xd8, 0x44, 0xae, 0x33, 0x84, 0xa1, 0x8e, 0xc7, 0xf5, 0x4c, 0x22, 0xb2, 0x60, 0x83,
-	0xd8, 0x17, 0x89, 0x17, 0x80, 0x67, 0xe0, 0x15, 0x58, 0x74, 0x59, 0x89, 0x0d, 0x2b, 0x84, 0x5a,
-	0x1e, 0x04, 0x65, 0x3c, 0x4e, 0x9d, 0xd6, 0x09, 0xc9, 0xce, 0xf6, 0x39, 0xdf, 0x39, 0xdf, 0x77,
-	0xfe, 0x0c, 0x05, 0x87, 0x79, 0x1e, 0x71, 0x84, 0xc9, 0x02, 0xdb, 0x71, 0x89, 0xd9, 0x2a, 0x9b,
-	0xc7, 0x4d, 0x12, 0xb4, 0x0d, 0x3f, 0x60, 0x82, 0xe1, 0x79, 0x65, 0x36, 0x42, 0xb3, 0xd1, 0x2a,
-	0xe7, 0x17, 0xeb, 0xac, 0xce, 0xa4, 0xd5, 0xec, 0x3c, 0x85, 0x8e, 0xf9, 0xa5, 0x3a, 0x63, 0x75,
-	0x97, 0x98, 0xb6, 0x4f, 0x4d, 0xdb, 0xf3, 0x98, 0xb0, 0x05, 0x65, 0x1e, 0x57, 0xd6, 0xe2, 0xf5,
-	0x2c, 0x75, 0xe2, 0x11, 0x4e, 0x23, 0x87, 0x52, 0xe4, 0x20, 0xda, 0x3e, 0xe1, 0x1d, 0xbb, 0xd3,
-	0x0c, 0x02, 0xe2, 0x39, 0xed, 0xaa, 0x6f, 0xd3, 0x20, 0xf4, 0xd2, 0x97, 0x20, 0x5f, 0x21, 0x62,
-	0xc7, 0x75, 0x1f, 0x28, 0xe3, 0xbe, 0x4d, 0x03, 0x6e, 0x91, 0xe3, 0x26, 0xe1, 0x42, 0x7f, 0x0d,
-	0xff, 0x26, 0x5a, 0xb9, 0xcf, 0x3c, 0x4e, 0xf0, 0x13, 0x98, 0xe9, 0x89, 0xc9, 0x73, 0x68, 0x79,
-	0x6c, 0x75, 0xaa, 0xac, 0x19, 0x91, 0x46, 0x99, 0xdb, 0x68, 0x95, 0x8d, 0x78, 0x80, 0xdd, 0xcc,
-	0xe9, 0x8f, 0x62, 0xca, 0x9a, 0x76, 0xe2, 0x41, 0xf5, 0x3b, 0x30, 0x5b, 0x21, 0x62, 0x3f, 0xa0,
-	0x0e, 0x51, 0xe9, 0xf1, 0x0a, 0x4c, 0xf7, 0xc4, 0xcf, 0xa1, 0x65, 0xb4, 0x3a, 0x69, 0xfd, 0x15,
-	0x07, 0xea, 0x27, 0x08, 0xe6, 0x2e, 0x81, 0x8a, 0xd9, 0x36, 0x64, 0xfd, 0xce, 0x07, 0x89, 0x98,
-	0x2a, 0x17, 0x8c, 0x6b, 0x45, 0x37, 0x0e, 0x9a, 0x4c, 0x10, 0x89, 0x92, 0x7c, 0x90, 0x15, 0x22,
-	0xf0, 0x22, 0x64, 0x3d, 0xe6, 0x39, 0x24, 0x97, 0x5e, 0x46, 0xab, 0x19, 0x2b, 0x7c, 0xc1, 0x79,
-	0x98, 0xa8, 0x11, 0x87, 0x36, 0x6c, 0x97, 0xe7, 0xc6, 0xa4, 0xa1, 0xfb, 0x8e, 0x67, 0x20, 0x4d,
-	0x6b, 0xb9, 0x8c, 0xfc, 0x9a, 0xa6, 0x35, 0xfd, 0xfe, 0x25, 0xa1, 0xa8, 0x92, 0x78, 0x0d, 0xe6,
-	0x7b, 0xa4, 0x54, 0x69, 0x2d, 0xac, 0xd6, 0xa4, 0x35, 0x1b, 0x97, 0xb3, 0x57, 0xe3, 0xfa, 0x73,
-	0x98, 0x8f, 0xe1, 0x95, 0xa2, 0x1d, 0x18, 0x97, 0xfc, 0xa2, 0x1a, 0xaf, 0x24, 0x48, 0xba, 0x5a,
-	0x06, 0x55, 0x68, 0x05, 0xd4, 0x8b, 0x50, 0xa8, 0x10, 0x11, 0xef, 0xc4, 0x33, 0xdb, 0xf7, 0xa9,
-	0x57, 0x8f, 0xda, 0x7d, 0x92, 0x06, 0xad, 0x9f, 0x87, 0xa2, 0xf1, 0x1e, 0xc1, 0xdf, 0xbd, 0x42,
-	0x1a, 0xa1, 0x87, 0xa2, 0xf5, 0x38, 0x99, 0xd6, 0x80, 0x90, 0x46, 0x82, 0xed, 0xa1, 0x27, 0x82,
-	0xb6, 0x62, 0xbf, 0xe0, 0x5c, 0xb7, 0xe7, 0x5f, 0x42, 0xae, 0x1f, 0x0c, 0xcf, 0xc1, 0xd8, 0x11,
-	0x69, 0xcb, 0xce, 0x67, 0xac, 0xce, 0x23, 0xde, 0x82, 0x6c, 0xcb, 0x76, 0x9b, 0x61, 0x4b, 0xff,
-	0x38, 0x9e, 0x56, 0xe8, 0x7c, 0x2f, 0x7d, 0x17, 0xe9, 0x2b, 0xf0, 0x5f, 0x32, 0xfb, 0xa7, 0x94,
-	0x8b, 0xa8, 0x6c, 0x3e, 0x2c, 0x24, 0x78, 0xa8, 0xb1, 0x40, 0xd1, 0x58, 0xe0, 0xbd, 0xab, 0xd3,
-	0x3c, 0x14, 0x1b, 0x55, 0x85, 0xde, 0x99, 0xf7, 0x40, 0x1f, 0x44, 0x4b, 0xf5, 0xea, 0x11, 0x4c,
-	0xa8, 0xe6, 0x44, 0x43, 0x73, 0x23, 0xa1, 0x3b, 0x09, 0x51, 0x54, 0xce, 0x2e, 0xba, 0xfc, 0x79,
-	0x1c, 0xb2, 0x07, 0x9d, 0x1b, 0x86, 0x3f, 0x21, 0x58, 0x48, 0x38, 0x09, 0x78, 0x3d, 0xb9, 0xef,
-	0x7d, 0x0e, 0x4b, 0xde, 0x18, 0xd6, 0x3d, 0x94, 0xa2, 0xaf, 0xbd, 0xfb, 0xf6, 0xeb, 0x63, 0xba,
-	0x84, 0x75, 0x33, 0xe9, 0xec, 0x89, 0xaa, 0xed, 0xba, 0x55, 0x41, 0x9d, 0x23, 0x12, 0x70, 0xdc,
-	0x86, 0x89, 0x68, 0x11, 0xb0, 0x3e, 0x70, 0x4b, 0x42, 0x2e, 0xc3, 0x6c, 0x92, 0x5e, 0x92, 0x04,
-	0x34, 0xbc, 0xd4, 0x87, 0x40, 0x78, 0x3b, 0xde, 0xc2, 0x64, 0x77, 0x73, 0xf1, 0xa0, 0xb8, 0xdd,
-	0x42, 0x94, 0x06, 0x3b, 0xa9, 0xec, 0xff, 0xcb, 0xec, 0x45, 0x5c, 0x18, 0x94, 0x9d, 0xe3, 0x2f,
-	0x08, 0xfe, 0x49, 0x9e, 0x0b, 0x7c, 0x6b, 0x84, 0xbd, 0x0c, 0x99, 0x6d, 0x8c, 0xbc, 0xc9, 0xfa,
-	0x96, 0xa4, 0x69, 0xe0, 0x9b, 0x7d, 0x68, 0x26, 0x1e, 0x0e, 0xfc, 0x15, 0xc9, 0x7f, 0x50, 0x9f,
-	0x69, 0xc6, 0x5b, 0x43, 0xf3, 0x88, 0xed, 0x64, 0xfe, 0xf6, 0x88, 0x28, 0xa5, 0x60, 0x5b, 0x2a,
-	0xd8, 0xc4, 0x1b, 0xa3, 0x28, 0xa8, 0xba, 0x94, 0x8b, 0xdd, 0xca, 0xe9, 0xb9, 0x86, 0xce, 0xce,
-	0x35, 0xf4, 0xf3, 0x5c, 0x43, 0x1f, 0x2e, 0xb4, 0xd4, 0xd9, 0x85, 0x96, 0xfa, 0x7e, 0xa1, 0xa5,
-	0x5e, 0xac, 0xd7, 0xa9, 0x78, 0xd5, 0x3c, 0x34, 0x1c, 0xd6, 0x30, 0xf9, 0x11, 0xf5, 0xd7, 0x1b,
-	0xa4, 0xd5, 0x8d, 0xdf, 0x2a, 0x9b, 0x6f, 0xa2, 0x24, 0xf2, 0x00, 0x1c, 0x8e, 0xcb, 0x3f, 0xf3,
-	0xe6, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xbc, 0xf8, 0xcc, 0xe9, 0x48, 0x08, 0x00, 0x00,
+	// 901 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcd, 0x6f, 0xe3, 0x44,
+	0x14, 0xef, 0xa4, 0xcd, 0xd2, 0xbe, 0x65, 0x3f, 0x3a, 0x5d, 0x50, 0x30, 0xad, 0x9b, 0x75, 0xcb,
+	0x12, 0x55, 0xd4, 0x26, 0x69, 0xf9, 0x58, 0x0e, 0x48, 0xbb, 0x08, 0xc2, 0xf2, 0xa5, 0xae, 0x85,
+	0x38, 0x70, 0x89, 0x1c, 0x67, 0x30, 0xb3, 0x75, 0x3c, 0x5e, 0xcf, 0x24, 0x22, 0x07, 0x2e, 0x88,
+	0x3b, 0x48, 0xfc, 0x03, 0x5c, 0xf8, 0x0b, 0xb8, 0x22, 0x71, 0xe1, 0xb0, 0xc7, 0x4a, 0x5c, 0x38,
+	0x21, 0xd4, 0xf0, 0x87, 0xac, 0x3c, 0x33, 0x4e, 0x9c, 0xc6, 0xf9, 0xba, 0xd9, 0x7e, 0xef, 0xfd,
+	0xde, 0xef, 0xf7, 0xe6, 0xcd, 0x2f, 0x81, 0x3d, 0x9f, 0x45, 0x11, 0xf1, 0x85, 0xc3, 0x12, 0xcf,
+	0x0f, 0x89, 0xd3, 0x6f, 0x38, 0x4f, 0x7b, 0x24, 0x19, 0xd8, 0x71, 0xc2, 0x04, 0xc3, 0xdb, 0x3a,
+	0x6c, 0xab, 0xb0, 0xdd, 0x6f, 0x18, 0x77, 0x02, 0x16, 0x30, 0x19, 0x75, 0xd2, 0x27, 0x95, 0x68,
+	0xec, 0x06, 0x8c, 0x05, 0x21, 0x71, 0xbc, 0x98, 0x3a, 0x5e, 0x14, 0x31, 0xe1, 0x09, 0xca, 0x22,
+	0xae, 0xa3, 0x47, 0x3e, 0xe3, 0x5d, 0xc6, 0x9d, 0xb6, 0xc7, 0x89, 0xc2, 0x77, 0xfa, 0xf5, 0x36,
+	0x11, 0x5e, 0xdd, 0x89, 0xbd, 0x80, 0x46, 0x32, 0x59, 0xe7, 0xee, 0x4f, 0x33, 0x0a, 0x48, 0x44,
+	0x38, 0xcd, 0xc0, 0x0e, 0xb3, 0x04, 0x31, 0x88, 0x09, 0x4f, 0xe3, 0x7e, 0x2f, 0x49, 0x48, 0xe4,
+	0x0f, 0x5a, 0xb1, 0x47, 0x13, 0x95, 0x65, 0xed, 0x82, 0xd1, 0x24, 0xe2, 0x41, 0x18, 0x7e, 0xa0,
+	0x83, 0x67, 0x1e, 0x4d, 0xb8, 0x4b, 0x9e, 0xf6, 0x08, 0x17, 0xd6, 0x13, 0x78, 0xb5, 0x30, 0xca,
+	0x63, 0x16, 0x71, 0x82, 0x3f, 0x85, 0x9b, 0x13, 0x98, 0xbc, 0x82, 0xaa, 0xeb, 0xb5, 0xeb, 0x0d,
+	0xd3, 0xce, 0xe6, 0x21, 0x7b, 0xdb, 0xfd, 0x86, 0x9d, 0x07, 0x78, 0xb8, 0xf1, 0xec, 0xdf, 0xfd,
+	0x35, 0xf7, 0x86, 0x9f, 0x07, 0xb5, 0xde, 0x86, 0x5b, 0x4d, 0x22, 0xce, 0x12, 0xea, 0x13, 0xdd,
+	0x1e, 0x1f, 0xc0, 0x8d, 0x09, 0xfc, 0x0a, 0xaa, 0xa2, 0xda, 0x96, 0xfb, 0x62, 0xbe, 0xd0, 0xfa,
+	0x0d, 0xc1, 0xed, 0x71, 0xa1, 0x66, 0x76, 0x1f, 0xca, 0x71, 0xfa, 0x41, 0x56, 0x5c, 0x6f, 0xec,
+	0xd9, 0x53, 0x07, 0x64, 0x3f, 0xee, 0x31, 0x41, 0x64, 0x95, 0xe4, 0x83, 0x5c, 0x55, 0x81, 0xef,
+	0x40, 0x39, 0x62, 0x91, 0x4f, 0x2a, 0xa5, 0x2a, 0xaa, 0x6d, 0xb8, 0xea, 0x05, 0x1b, 0xb0, 0xd9,
+	0x21, 0x3e, 0xed, 0x7a, 0x21, 0xaf, 0xac, 0xcb, 0xc0, 0xe8, 0x1d, 0xdf, 0x84, 0x12, 0xed, 0x54,
+	0x36, 0xe4, 0xd7, 0x12, 0xed, 0xa4, 0x08, 0x5c, 0x78, 0x21, 0xa9, 0x94, 0xab, 0xa8, 0xb6, 0xe9,
+	0xaa, 0x17, 0xeb, 0xfd, 0x31, 0xcd, 0x6c, 0xbe, 0xf8, 0x08, 0xb6, 0x27, 0x04, 0xb6, 0x68, 0x47,
+	0xcd, 0x70, 0xcb, 0xbd, 0x95, 0x17, 0xf9, 0xa8, 0xc3, 0xad, 0xaf, 0x60, 0x3b, 0x57, 0xaf, 0x75,
+	0x3e, 0x80, 0x6b, 0x92, 0x75, 0x36, 0xf9, 0x83, 0x02, 0xa1, 0x57, 0x87, 0xa3, 0xc7, 0xaf, 0x0b,
+	0xad, 0x7d, 0xd8, 0x6b, 0x12, 0x91, 0x3f, 0x9f, 0xcf, 0xbd, 0x38, 0xa6, 0x51, 0x90, 0x2d, 0xc1,
+	0x4f, 0x25, 0x30, 0x67, 0x65, 0x68, 0x1a, 0x3f, 0x22, 0x78, 0x69, 0x52, 0x48, 0x57, 0x65, 0x68,
+	0x5a, 0x9f, 0x14, 0xd3, 0x9a, 0x03, 0x69, 0x17, 0xc4, 0x3e, 0x8c, 0x44, 0x32, 0xd0, 0xec, 0x77,
+	0xfc, 0xe9, 0xb8, 0xf1, 0x0d, 0x54, 0x66, 0x95, 0xe1, 0xdb, 0xb0, 0x7e, 0x4e, 0x06, 0x72, 0x1f,
+	0x36, 0xdc, 0xf4, 0x11, 0x9f, 0x42, 0xb9, 0xef, 0x85, 0x3d, 0x75, 0xd0, 0x0b, 0x97, 0xd6, 0x55,
+	0xc9, 0xef, 0x95, 0xde, 0x45, 0xd6, 0x01, 0xdc, 0x2d, 0x66, 0xff, 0x19, 0xe5, 0x22, 0x1b, 0x5b,
+	0x0c, 0x3b, 0x05, 0x19, 0x7a, 0x59, 0xd0, 0x68, 0x59, 0x1e, 0x5d, 0xdd, 0xf1, 0xa5, 0xd8, 0xe8,
+	0x29, 0x4c, 0xde, 0x84, 0x08, 0xac, 0x79, 0xb4, 0xf4, 0x59, 0x7d, 0x0c, 0x9b, 0xfa, 0x70, 0xb2,
+	0xa5, 0xb9, 0x57, 0x70, 0x3a, 0x05, 0x28, 0xba, 0xe7, 0xa8, 0xda, 0x7a, 0x02, 0xd5, 0x2b, 0xfd,
+	0xbe, 0x64, 0xdd, 0x36, 0x17, 0x2c, 0x1a, 0x6f, 0xf8, 0x47, 0x00, 0x63, 0xeb, 0xd2, 0xb7, 0xf1,
+	0x9e, 0xad, 0x7c, 0xce, 0x4e, 0x7d, 0xce, 0x56, 0x3e, 0xaa, 0x7d, 0xce, 0x3e, 0xf3, 0x82, 0xec,
+	0xfa, 0xbb, 0xb9, 0x4a, 0xeb, 0x0f, 0x04, 0x77, 0xe7, 0x34, 0xd3, 0xda, 0xbe, 0x00, 0x10, 0xa3,
+	0xaf, 0x5a, 0x5d, 0x6d, 0x81, 0xba, 0x11, 0x8c, 0xd6, 0x97, 0x43, 0xc0, 0xcd, 0x09, 0xf6, 0xea,
+	0x64, 0x5e, 0x5f, 0xc8, 0x5e, 0x91, 0xc9, 0xd3, 0x6f, 0x0c, 0x5f, 0x80, 0xf2, 0xe3, 0x34, 0x15,
+	0xff, 0x8a, 0x60, 0xa7, 0xc0, 0x53, 0xf1, 0x71, 0xf1, 0x15, 0x99, 0xe1, 0xcc, 0x86, 0xbd, 0x6c,
+	0xba, 0x22, 0x63, 0x1d, 0xfd, 0xf0, 0xf7, 0xff, 0xbf, 0x94, 0x0e, 0xb1, 0xe5, 0x14, 0xfd, 0x6e,
+	0x88, 0x96, 0x17, 0x86, 0x2d, 0x41, 0xfd, 0x73, 0x92, 0x70, 0x3c, 0x80, 0xcd, 0xcc, 0x33, 0xb0,
+	0x35, 0xd7, 0x50, 0x14, 0x97, 0x65, 0x4c, 0xc7, 0x3a, 0x94, 0x04, 0x4c, 0xbc, 0x3b, 0x83, 0x80,
+	0x32, 0xdf, 0xef, 0x61, 0x2b, 0xab, 0xe4, 0x78, 0x1e, 0xee, 0x68, 0x10, 0x87, 0xf3, 0x93, 0x74,
+	0xf7, 0xd7, 0x64, 0xf7, 0x7d, 0xbc, 0x37, 0xaf, 0x3b, 0xc7, 0xbf, 0x23, 0x78, 0xb9, 0xf8, 0x0a,
+	0xe1, 0x37, 0x57, 0xb0, 0x30, 0xc5, 0xac, 0xbe, 0xb2, 0xe9, 0x59, 0xa7, 0x92, 0xa6, 0x8d, 0xdf,
+	0x98, 0x41, 0xb3, 0xd0, 0x63, 0xf1, 0x5f, 0x08, 0x8c, 0x62, 0xe0, 0xf4, 0xe2, 0xe3, 0xd3, 0xa5,
+	0x79, 0xe4, 0xec, 0xcb, 0x78, 0x6b, 0xc5, 0x2a, 0xad, 0xe0, 0xbe, 0x54, 0x70, 0x82, 0xeb, 0xab,
+	0x28, 0x68, 0x85, 0x29, 0xcf, 0x3f, 0x11, 0xbc, 0x32, 0xf3, 0x8a, 0xe3, 0x93, 0xc5, 0x7c, 0xa6,
+	0xdc, 0xc7, 0x38, 0x5d, 0xad, 0x48, 0x6b, 0x78, 0x47, 0x6a, 0xa8, 0x63, 0x67, 0x29, 0x0d, 0x63,
+	0xbb, 0x78, 0xd8, 0x7c, 0x76, 0x69, 0xa2, 0x8b, 0x4b, 0x13, 0xfd, 0x77, 0x69, 0xa2, 0x9f, 0x87,
+	0xe6, 0xda, 0xc5, 0xd0, 0x5c, 0xfb, 0x67, 0x68, 0xae, 0x7d, 0x7d, 0x1c, 0x50, 0xf1, 0x6d, 0xaf,
+	0x6d, 0xfb, 0xac, 0xeb, 0xf0, 0x73, 0x1a, 0x1f, 0x77, 0x49, 0x7f, 0x84, 0xde, 0x6f, 0x38, 0xdf,
+	0x65, 0x2d, 0xa4, 0xdb, 0xb7, 0xaf, 0xc9, 0x3f, 0x67, 0x27, 0xcf, 0x07, 0x00, 0xd1, 0x96, 0x13,
+	0xee, 0x77, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -643,6 +773,10 @@ type QueryClient interface {
 	// useful for indexers that have access to the ID of a currency pair, but no
 	// way to get the underlying currency pair from it.
 	GetCurrencyPairMappingList(ctx context.Context, in *GetCurrencyPairMappingListRequest, opts ...grpc.CallOption) (*GetCurrencyPairMappingListResponse, error)
+	// Get the tombstones of the removed CurrencyPairs, ordered by ID. IDs that
+	// are lower than the next ID and belong to neither a CurrencyPair nor a
+	// tombstone were removed before the start of the tombstone retention period.
+	GetCurrencyPairTombstones(ctx context.Context, in *GetCurrencyPairTombstonesRequest, opts ...grpc.CallOption) (*GetCurrencyPairTombstonesResponse, error)
 }
 
 type queryClient struct {
@@ -698,6 +832,15 @@ func (c *queryClient) GetCurrencyPairMappingList(ctx context.Context, in *GetCur
 	return out, nil
 }
 
+func (c *queryClient) GetCurrencyPairTombstones(ctx context.Context, in *GetCurrencyPairTombstonesRequest, opts ...grpc.CallOption) (*GetCurrencyPairTombstonesResponse, error) {
+	out := new(GetCurrencyPairTombstonesResponse)
+	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Query/GetCurrencyPairTombstones", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Get all the currency pairs the x/oracle module is tracking price-data for.
@@ -714,6 +857,10 @@ type QueryServer interface {
 	// useful for indexers that have access to the ID of a currency pair, but no
 	// way to get the underlying currency pair from it.
 	GetCurrencyPairMappingList(context.Context, *GetCurrencyPairMappingListRequest) (*GetCurrencyPairMappingListResponse, error)
+	// Get the tombstones of the removed CurrencyPairs, ordered by ID. IDs that
+	// are lower than the next ID and belong to neither a CurrencyPair nor a
+	// tombstone were removed before the start of the tombstone retention period.
+	GetCurrencyPairTombstones(context.Context, *GetCurrencyPairTombstonesRequest) (*GetCurrencyPairTombstonesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -735,6 +882,9 @@ func (*UnimplementedQueryServer) GetCurrencyPairMapping(ctx context.Context, req
 func (*UnimplementedQueryServer) GetCurrencyPairMappingList(ctx context.Context, req *GetCurrencyPairMappingListRequest) (*GetCurrencyPairMappingListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCurrencyPairMappingList not implemented")
 }
+func (*UnimplementedQueryServer) GetCurrencyPairTombstones(ctx context.Context, req *GetCurrencyPairTombstonesRequest) (*GetCurrencyPairTombstonesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCurrencyPairTombstones not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -830,6 +980,24 @@ func _Query_GetCurrencyPairMappingList_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetCurrencyPairTombstones_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCurrencyPairTombstonesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetCurrencyPairTombstones(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.oracle.v2.Query/GetCurrencyPairTombstones",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetCurrencyPairTombstones(ctx, req.(*GetCurrencyPairTombstonesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "connect.oracle.v2.Query",
@@ -855,6 +1023,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetCurrencyPairMappingList",
 			Handler:    _Query_GetCurrencyPairMappingList_Handler,
 		},
+		{
+			MethodName: "GetCurrencyPairTombstones",
+			Handler:    _Query_GetCurrencyPairTombstones_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "connect/oracle/v2/query.proto",
@@ -970,6 +1142,16 @@ func (m *GetPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Stale {
+		i--
+		if m.Stale {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.Id != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
 		i--
@@ -1235,6 +1417,90 @@ func (m *GetCurrencyPairMappingListResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
+func (m *GetCurrencyPairTombstonesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetCurrencyPairTombstonesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCurrencyPairTombstonesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetCurrencyPairTombstonesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetCurrencyPairTombstonesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCurrencyPairTombstonesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tombstones) > 0 {
+		for iNdEx := len(m.Tombstones) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tombstones[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1302,6 +1568,9 @@ func (m *GetPriceResponse) Size() (n int) {
 	if m.Id != 0 {
 		n += 1 + sovQuery(uint64(m.Id))
 	}
+	if m.Stale {
+		n += 2
+	}
 	return n
 }
 
@@ -1400,6 +1669,38 @@ func (m *GetCurrencyPairMappingListResponse) Size() (n int) {
 	return n
 }
 
+func (m *GetCurrencyPairTombstonesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetCurrencyPairTombstonesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tombstones) > 0 {
+		for _, e := range m.Tombstones {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1744,6 +2045,26 @@ func (m *GetPriceResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stale", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stale = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2382,6 +2703,212 @@ func (m *GetCurrencyPairMappingListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GetCurrencyPairTombstonesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetCurrencyPairTombstonesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetCurrencyPairTombstonesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetCurrencyPairTombstonesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetCurrencyPairTombstonesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetCurrencyPairTombstonesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstones", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tombstones = append(m.Tombstones, CurrencyPairTombstone{})
+			if err := m.Tombstones[len(m.Tombstones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
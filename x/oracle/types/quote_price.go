@@ -24,13 +24,18 @@ func (q *QuotePriceWithNonce) Nonce() uint64 {
 	return q.nonce
 }
 
-// ValidateBasic validates that the QuotePrice is valid, i.e. that the price is non-negative.
+// ValidateBasic validates that the QuotePrice is valid, i.e. that the price and the (optional) spread are non-negative.
 func (qp *QuotePrice) ValidateBasic() error {
 	// Check that the price is non-negative
 	if qp.Price.IsNegative() {
 		return fmt.Errorf("price cannot be negative: %s", qp.Price)
 	}
 
+	// Check that the spread is non-negative if it is set
+	if qp.Spread != nil && qp.Spread.IsNegative() {
+		return fmt.Errorf("spread cannot be negative: %s", qp.Spread)
+	}
+
 	return nil
 }
 
@@ -12,6 +12,9 @@ import (
 )
 
 func TestQuotePrice(t *testing.T) {
+	negativeSpread := math.NewInt(-1)
+	positiveSpread := math.NewInt(1)
+
 	tcs := []struct {
 		name       string
 		quotePrice types.QuotePrice
@@ -44,6 +47,26 @@ func TestQuotePrice(t *testing.T) {
 			},
 			nil,
 		},
+		{
+			"negative spread",
+			types.QuotePrice{
+				Price:          math.NewInt(1),
+				BlockTimestamp: time.Now().UTC(),
+				BlockHeight:    1,
+				Spread:         &negativeSpread,
+			},
+			fmt.Errorf("spread cannot be negative: %s", negativeSpread),
+		},
+		{
+			"positive price with spread",
+			types.QuotePrice{
+				Price:          math.NewInt(1),
+				BlockTimestamp: time.Now().UTC(),
+				BlockHeight:    1,
+				Spread:         &positiveSpread,
+			},
+			nil,
+		},
 	}
 
 	for _, tc := range tcs {
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"fmt"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+)
+
+// NewCurrencyPairTombstone returns a new CurrencyPairTombstone recording the removal of the given CurrencyPair, with
+// the given state, at the given height.
+func NewCurrencyPairTombstone(cp connecttypes.CurrencyPair, cps CurrencyPairState, height uint64) CurrencyPairTombstone {
+	return CurrencyPairTombstone{
+		CurrencyPair:  cp,
+		Id:            cps.Id,
+		RemovalHeight: height,
+		LastPrice:     cps.Price,
+		Nonce:         cps.Nonce,
+	}
+}
+
+// ValidateBasic checks that the CurrencyPairTombstone is valid, i.e. the CurrencyPair is valid, and the nonce is zero
+// if the last QuotePrice is nil.
+func (t *CurrencyPairTombstone) ValidateBasic() error {
+	if err := t.CurrencyPair.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if t.LastPrice == nil && t.Nonce != 0 {
+		return fmt.Errorf("invalid nonce, no price update but non-zero nonce: %v", t.Nonce)
+	}
+
+	return nil
+}
@@ -0,0 +1,184 @@
+package wasm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+// OracleKeeper is the expected x/oracle keeper interface of the QueryPlugin.
+type OracleKeeper interface {
+	GetPriceWithStalenessAndDecimalsForCurrencyPair(ctx context.Context, cp connecttypes.CurrencyPair) (types.QuotePriceWithNonce, bool, uint64, error)
+	GetIDForCurrencyPair(ctx context.Context, cp connecttypes.CurrencyPair) (uint64, bool)
+	GetDecimalsForCurrencyPair(ctx context.Context, cp connecttypes.CurrencyPair) (uint64, error)
+	GetAllCurrencyPairs(ctx context.Context) []connecttypes.CurrencyPair
+}
+
+// QueryPlugin answers the OracleQuery custom queries of smart contracts from the x/oracle keeper.
+type QueryPlugin struct {
+	k OracleKeeper
+}
+
+// NewQueryPlugin returns a new QueryPlugin reading from the given x/oracle keeper.
+func NewQueryPlugin(k OracleKeeper) *QueryPlugin {
+	if k == nil {
+		panic("oracle keeper cannot be nil")
+	}
+
+	return &QueryPlugin{
+		k: k,
+	}
+}
+
+// HandleQuery decodes the given JSON encoded OracleQuery, and returns the JSON encoded response. Its signature
+// matches wasmd's CustomQuerier, so that it can be set as the Custom query plugin of the wasm keeper, or called by
+// an application's custom querier that dispatches between several modules.
+func (q *QueryPlugin) HandleQuery(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+	var query OracleQuery
+
+	dec := json.NewDecoder(bytes.NewReader(request))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&query); err != nil {
+		return nil, fmt.Errorf("invalid oracle query: %w", err)
+	}
+
+	if query.numSet() != 1 {
+		return nil, fmt.Errorf("invalid oracle query: exactly one query must be set")
+	}
+
+	var (
+		res any
+		err error
+	)
+	switch {
+	case query.Price != nil:
+		res, err = q.price(ctx, query.Price.CurrencyPair)
+	case query.Prices != nil:
+		res, err = q.prices(ctx, query.Prices)
+	case query.AllCurrencyPairs != nil:
+		res = q.allCurrencyPairs(ctx)
+	case query.Decimals != nil:
+		res, err = q.decimals(ctx, query.Decimals)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(res)
+}
+
+func (q *QueryPlugin) price(ctx sdk.Context, ticker string) (PriceResponse, error) {
+	cp, err := parseCurrencyPair(ticker)
+	if err != nil {
+		return PriceResponse{}, err
+	}
+
+	qpn, stale, decimals, err := q.k.GetPriceWithStalenessAndDecimalsForCurrencyPair(ctx, cp)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return PriceResponse{}, fmt.Errorf("no price / nonce reported for CurrencyPair: %s, the module is not tracking this CurrencyPair", cp.String())
+		}
+
+		return PriceResponse{}, fmt.Errorf("failed to get the price of CurrencyPair %s: %w", cp.String(), err)
+	}
+
+	id, ok := q.k.GetIDForCurrencyPair(ctx, cp)
+	if !ok {
+		return PriceResponse{}, fmt.Errorf("no ID found for CurrencyPair: %s", cp.String())
+	}
+
+	res := PriceResponse{
+		CurrencyPair: newCurrencyPair(cp),
+		ID:           id,
+		Nonce:        qpn.Nonce(),
+		Decimals:     decimals,
+		Stale:        stale,
+	}
+
+	if !qpn.Price.IsNil() {
+		res.Price = &QuotePrice{
+			Price:          qpn.Price.String(),
+			BlockHeight:    qpn.BlockHeight,
+			BlockTimestamp: uint64(max(qpn.BlockTimestamp.UnixNano(), 0)), //nolint:gosec
+		}
+	}
+
+	return res, nil
+}
+
+func (q *QueryPlugin) prices(ctx sdk.Context, query *PricesQuery) (PricesResponse, error) {
+	prices := make([]PriceResponse, 0, len(query.CurrencyPairs))
+	for _, ticker := range query.CurrencyPairs {
+		price, err := q.price(ctx, ticker)
+		if err != nil {
+			return PricesResponse{}, err
+		}
+
+		prices = append(prices, price)
+	}
+
+	return PricesResponse{
+		Prices: prices,
+	}, nil
+}
+
+func (q *QueryPlugin) allCurrencyPairs(ctx sdk.Context) AllCurrencyPairsResponse {
+	cps := q.k.GetAllCurrencyPairs(ctx)
+
+	res := AllCurrencyPairsResponse{
+		CurrencyPairs: make([]CurrencyPair, 0, len(cps)),
+	}
+	for _, cp := range cps {
+		res.CurrencyPairs = append(res.CurrencyPairs, newCurrencyPair(cp))
+	}
+
+	return res
+}
+
+func (q *QueryPlugin) decimals(ctx sdk.Context, query *DecimalsQuery) (DecimalsResponse, error) {
+	cp, err := parseCurrencyPair(query.CurrencyPair)
+	if err != nil {
+		return DecimalsResponse{}, err
+	}
+
+	if _, ok := q.k.GetIDForCurrencyPair(ctx, cp); !ok {
+		return DecimalsResponse{}, types.NewCurrencyPairNotExistError(cp)
+	}
+
+	decimals, err := q.k.GetDecimalsForCurrencyPair(ctx, cp)
+	if err != nil {
+		return DecimalsResponse{}, err
+	}
+
+	return DecimalsResponse{
+		Decimals: decimals,
+	}, nil
+}
+
+func parseCurrencyPair(ticker string) (connecttypes.CurrencyPair, error) {
+	cp, err := connecttypes.CurrencyPairFromString(ticker)
+	if err != nil {
+		return connecttypes.CurrencyPair{}, fmt.Errorf("invalid currency pair: %w", err)
+	}
+
+	if err := cp.ValidateBasic(); err != nil {
+		return connecttypes.CurrencyPair{}, fmt.Errorf("invalid currency pair: %w", err)
+	}
+
+	return cp, nil
+}
+
+func newCurrencyPair(cp connecttypes.CurrencyPair) CurrencyPair {
+	return CurrencyPair{
+		Base:  cp.Base,
+		Quote: cp.Quote,
+	}
+}
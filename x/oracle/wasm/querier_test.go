@@ -0,0 +1,238 @@
+package wasm_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	marketmaptypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+	"github.com/skip-mev/connect/v2/x/oracle/keeper"
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+	"github.com/skip-mev/connect/v2/x/oracle/types/mocks"
+	"github.com/skip-mev/connect/v2/x/oracle/wasm"
+)
+
+var (
+	btcusd = connecttypes.NewCurrencyPair("BTC", "USD")
+	ethusd = connecttypes.NewCurrencyPair("ETH", "USD")
+)
+
+type QueryPluginTestSuite struct {
+	suite.Suite
+
+	ctx                 sdk.Context
+	oracleKeeper        *keeper.Keeper
+	mockMarketMapKeeper *mocks.MarketMapKeeper
+	plugin              *wasm.QueryPlugin
+	updatedAt           time.Time
+}
+
+func TestQueryPluginTestSuite(t *testing.T) {
+	suite.Run(t, new(QueryPluginTestSuite))
+}
+
+func (s *QueryPluginTestSuite) SetupTest() {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ss := runtime.NewKVStoreService(key)
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	s.mockMarketMapKeeper = mocks.NewMarketMapKeeper(s.T())
+	k := keeper.NewKeeper(ss, encCfg.Codec, s.mockMarketMapKeeper, sdk.AccAddress("authority"))
+	s.oracleKeeper = &k
+	s.ctx = testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_key"))
+	s.plugin = wasm.NewQueryPlugin(s.oracleKeeper)
+
+	s.oracleKeeper.InitGenesis(s.ctx, *types.DefaultGenesisState())
+
+	// BTC/USD has a price, ETH/USD has none
+	s.updatedAt = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, btcusd, types.QuotePrice{
+		Price:          sdkmath.NewIntFromUint64(6_500_000_000_000),
+		BlockTimestamp: s.updatedAt,
+		BlockHeight:    10,
+	}))
+	s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, ethusd))
+
+	s.ctx = s.ctx.WithBlockHeight(12).WithBlockTime(s.updatedAt.Add(12 * time.Second))
+}
+
+func (s *QueryPluginTestSuite) expectMarket(cp connecttypes.CurrencyPair, decimals, maxPriceAgeBlocks uint64) {
+	s.mockMarketMapKeeper.On("GetMarket", mock.Anything, cp.String()).Return(marketmaptypes.Market{
+		Ticker: marketmaptypes.Ticker{
+			CurrencyPair:      cp,
+			Decimals:          decimals,
+			MinProviderCount:  1,
+			MaxPriceAgeBlocks: maxPriceAgeBlocks,
+		},
+	}, nil)
+}
+
+func (s *QueryPluginTestSuite) query(query string, res any) error {
+	bz, err := s.plugin.HandleQuery(s.ctx, json.RawMessage(query))
+	if err != nil {
+		return err
+	}
+
+	s.Require().NoError(json.Unmarshal(bz, res))
+	return nil
+}
+
+func (s *QueryPluginTestSuite) TestNewQueryPlugin() {
+	s.Require().Panics(func() {
+		wasm.NewQueryPlugin(nil)
+	})
+}
+
+func (s *QueryPluginTestSuite) TestInvalidQueries() {
+	for name, query := range map[string]string{
+		"malformed json":          `{"price":`,
+		"unknown query":           `{"market":{"currency_pair":"BTC/USD"}}`,
+		"no query":                `{}`,
+		"several queries":         `{"price":{"currency_pair":"BTC/USD"},"all_currency_pairs":{}}`,
+		"invalid currency pair":   `{"price":{"currency_pair":"BTCUSD"}}`,
+		"untracked currency pair": `{"decimals":{"currency_pair":"SOL/USD"}}`,
+	} {
+		s.Run(name, func() {
+			_, err := s.plugin.HandleQuery(s.ctx, json.RawMessage(query))
+			s.Require().Error(err)
+		})
+	}
+}
+
+func (s *QueryPluginTestSuite) TestPrice() {
+	s.Run("returns the price of a currency pair", func() {
+		s.expectMarket(btcusd, 8, 0)
+
+		var res wasm.PriceResponse
+		s.Require().NoError(s.query(`{"price":{"currency_pair":"BTC/USD"}}`, &res))
+		s.Require().Equal(wasm.PriceResponse{
+			CurrencyPair: wasm.CurrencyPair{Base: "BTC", Quote: "USD"},
+			ID:           0,
+			Price: &wasm.QuotePrice{
+				Price:          "6500000000000",
+				BlockHeight:    10,
+				BlockTimestamp: uint64(s.updatedAt.UnixNano()),
+			},
+			Nonce:    0,
+			Decimals: 8,
+		}, res)
+	})
+
+	s.Run("encodes integers as strings", func() {
+		s.expectMarket(btcusd, 8, 0)
+
+		bz, err := s.plugin.HandleQuery(s.ctx, json.RawMessage(`{"price":{"currency_pair":"BTC/USD"}}`))
+		s.Require().NoError(err)
+		s.Require().JSONEq(`{
+			"currency_pair":{"base":"BTC","quote":"USD"},
+			"id":"0",
+			"price":{"price":"6500000000000","block_height":"10","block_timestamp":"1704067200000000000"},
+			"nonce":"0",
+			"decimals":"8",
+			"stale":false
+		}`, string(bz))
+	})
+
+	s.Run("reports stale prices", func() {
+		s.SetupTest()
+		s.expectMarket(btcusd, 8, 1)
+
+		var res wasm.PriceResponse
+		s.Require().NoError(s.query(`{"price":{"currency_pair":"BTC/USD"}}`, &res))
+		s.Require().True(res.Stale)
+	})
+
+	s.Run("omits the price of a currency pair without a price", func() {
+		s.expectMarket(ethusd, 18, 0)
+
+		var res wasm.PriceResponse
+		s.Require().NoError(s.query(`{"price":{"currency_pair":"ETH/USD"}}`, &res))
+		s.Require().Nil(res.Price)
+		s.Require().Equal(uint64(1), res.ID)
+		s.Require().Equal(uint64(0), res.Nonce)
+		s.Require().Equal(uint64(18), res.Decimals)
+	})
+
+	s.Run("fails for an untracked currency pair", func() {
+		var res wasm.PriceResponse
+		err := s.query(`{"price":{"currency_pair":"SOL/USD"}}`, &res)
+		s.Require().ErrorContains(err, "not tracking")
+	})
+
+	s.Run("returns the error of a failed market lookup", func() {
+		s.SetupTest()
+		lookupErr := errors.New("market lookup failed")
+		s.mockMarketMapKeeper.On("GetMarket", mock.Anything, ethusd.String()).Return(marketmaptypes.Market{}, lookupErr).Once()
+
+		var res wasm.PriceResponse
+		err := s.query(`{"price":{"currency_pair":"ETH/USD"}}`, &res)
+		s.Require().ErrorIs(err, lookupErr)
+		s.Require().NotContains(err.Error(), "not tracking")
+	})
+}
+
+func (s *QueryPluginTestSuite) TestPrices() {
+	s.Run("returns the prices in the order of the query", func() {
+		s.expectMarket(btcusd, 8, 0)
+		s.expectMarket(ethusd, 18, 0)
+
+		var res wasm.PricesResponse
+		s.Require().NoError(s.query(`{"prices":{"currency_pairs":["ETH/USD","BTC/USD"]}}`, &res))
+		s.Require().Len(res.Prices, 2)
+		s.Require().Equal(wasm.CurrencyPair{Base: "ETH", Quote: "USD"}, res.Prices[0].CurrencyPair)
+		s.Require().Equal(wasm.CurrencyPair{Base: "BTC", Quote: "USD"}, res.Prices[1].CurrencyPair)
+	})
+
+	s.Run("returns no prices for an empty query", func() {
+		var res wasm.PricesResponse
+		s.Require().NoError(s.query(`{"prices":{"currency_pairs":[]}}`, &res))
+		s.Require().Empty(res.Prices)
+	})
+
+	s.Run("fails if any currency pair is untracked", func() {
+		s.expectMarket(btcusd, 8, 0)
+
+		var res wasm.PricesResponse
+		s.Require().Error(s.query(`{"prices":{"currency_pairs":["BTC/USD","SOL/USD"]}}`, &res))
+	})
+}
+
+func (s *QueryPluginTestSuite) TestAllCurrencyPairs() {
+	var res wasm.AllCurrencyPairsResponse
+	s.Require().NoError(s.query(`{"all_currency_pairs":{}}`, &res))
+	s.Require().Equal(wasm.AllCurrencyPairsResponse{
+		CurrencyPairs: []wasm.CurrencyPair{
+			{Base: "BTC", Quote: "USD"},
+			{Base: "ETH", Quote: "USD"},
+		},
+	}, res)
+}
+
+func (s *QueryPluginTestSuite) TestDecimals() {
+	s.Run("returns the decimals of the market", func() {
+		s.expectMarket(ethusd, 18, 0)
+
+		var res wasm.DecimalsResponse
+		s.Require().NoError(s.query(`{"decimals":{"currency_pair":"ETH/USD"}}`, &res))
+		s.Require().Equal(uint64(18), res.Decimals)
+	})
+
+	s.Run("falls back to the legacy decimals without a market", func() {
+		s.mockMarketMapKeeper.On("GetMarket", mock.Anything, btcusd.String()).Return(marketmaptypes.Market{}, collections.ErrNotFound).Once()
+
+		var res wasm.DecimalsResponse
+		s.Require().NoError(s.query(`{"decimals":{"currency_pair":"BTC/USD"}}`, &res))
+		s.Require().Equal(uint64(btcusd.LegacyDecimals()), res.Decimals)
+	})
+}
@@ -0,0 +1,23 @@
+package wasm
+
+import (
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+// StargateWhitelist returns the x/oracle gRPC queries that are safe to expose to smart contracts through stargate
+// (gRPC) queries, keyed by their full method path and mapped to the type of their response. The returned map is
+// intended to be passed to wasmd's AcceptListStargateQuerier / AcceptListGrpcQuerier, merged with the application's
+// other accepted queries.
+//
+// Only queries whose responses are deterministic are whitelisted. Notably, GetCurrencyPairMapping is excluded as
+// its response is a map, use GetCurrencyPairMappingList instead.
+func StargateWhitelist() map[string]proto.Message {
+	return map[string]proto.Message{
+		"/connect.oracle.v2.Query/GetAllCurrencyPairs":        &types.GetAllCurrencyPairsResponse{},
+		"/connect.oracle.v2.Query/GetPrice":                   &types.GetPriceResponse{},
+		"/connect.oracle.v2.Query/GetPrices":                  &types.GetPricesResponse{},
+		"/connect.oracle.v2.Query/GetCurrencyPairMappingList": &types.GetCurrencyPairMappingListResponse{},
+	}
+}
@@ -0,0 +1,34 @@
+package wasm_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/skip-mev/connect/v2/x/oracle/wasm"
+)
+
+func TestStargateWhitelist(t *testing.T) {
+	whitelist := wasm.StargateWhitelist()
+	require.NotContains(t, whitelist, "/connect.oracle.v2.Query/GetCurrencyPairMapping")
+
+	for path, res := range whitelist {
+		t.Run(path, func(t *testing.T) {
+			service, method, ok := strings.Cut(strings.TrimPrefix(path, "/"), "/")
+			require.True(t, ok)
+
+			desc, err := proto.HybridResolver.FindDescriptorByName(protoreflect.FullName(service))
+			require.NoError(t, err)
+
+			serviceDesc, ok := desc.(protoreflect.ServiceDescriptor)
+			require.True(t, ok)
+
+			methodDesc := serviceDesc.Methods().ByName(protoreflect.Name(method))
+			require.NotNil(t, methodDesc)
+			require.Equal(t, string(methodDesc.Output().FullName()), proto.MessageName(res))
+		})
+	}
+}
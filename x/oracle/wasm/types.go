@@ -0,0 +1,102 @@
+package wasm
+
+// OracleQuery is the custom query that smart contracts send to read x/oracle prices. Exactly one of its fields
+// must be set. Integers that may exceed 2^53 are encoded as strings, following the CosmWasm JSON conventions.
+type OracleQuery struct {
+	// Price queries the price of a single currency pair.
+	Price *PriceQuery `json:"price,omitempty"`
+
+	// Prices queries the prices of several currency pairs.
+	Prices *PricesQuery `json:"prices,omitempty"`
+
+	// AllCurrencyPairs queries all currency pairs tracked by x/oracle.
+	AllCurrencyPairs *AllCurrencyPairsQuery `json:"all_currency_pairs,omitempty"`
+
+	// Decimals queries the number of decimals the price of a currency pair is represented in.
+	Decimals *DecimalsQuery `json:"decimals,omitempty"`
+}
+
+// numSet returns the number of queries set on the OracleQuery.
+func (q OracleQuery) numSet() int {
+	n := 0
+	for _, set := range []bool{q.Price != nil, q.Prices != nil, q.AllCurrencyPairs != nil, q.Decimals != nil} {
+		if set {
+			n++
+		}
+	}
+
+	return n
+}
+
+// PriceQuery queries the price of the currency pair, in the format BASE/QUOTE.
+type PriceQuery struct {
+	CurrencyPair string `json:"currency_pair"`
+}
+
+// PricesQuery queries the prices of the currency pairs, each in the format BASE/QUOTE.
+type PricesQuery struct {
+	CurrencyPairs []string `json:"currency_pairs"`
+}
+
+// AllCurrencyPairsQuery queries all currency pairs tracked by x/oracle.
+type AllCurrencyPairsQuery struct{}
+
+// DecimalsQuery queries the decimals of the currency pair, in the format BASE/QUOTE.
+type DecimalsQuery struct {
+	CurrencyPair string `json:"currency_pair"`
+}
+
+// PriceResponse is the response to a PriceQuery.
+type PriceResponse struct {
+	// CurrencyPair is the queried currency pair.
+	CurrencyPair CurrencyPair `json:"currency_pair"`
+
+	// ID is the x/oracle identifier of the currency pair.
+	ID uint64 `json:"id,string"`
+
+	// Price is the latest price of the currency pair, and is omitted if no price has been reported.
+	Price *QuotePrice `json:"price,omitempty"`
+
+	// Nonce is the number of times the price of the currency pair has been updated.
+	Nonce uint64 `json:"nonce,string"`
+
+	// Decimals is the number of decimals the price is represented in.
+	Decimals uint64 `json:"decimals,string"`
+
+	// Stale is true if the price is older than the maximum price age configured on the currency pair's market.
+	Stale bool `json:"stale"`
+}
+
+// QuotePrice is a price reported by x/oracle.
+type QuotePrice struct {
+	// Price is the price as a decimal integer, scaled by the currency pair's decimals.
+	Price string `json:"price"`
+
+	// BlockHeight is the height of the block in which the price was reported.
+	BlockHeight uint64 `json:"block_height,string"`
+
+	// BlockTimestamp is the timestamp, in nanoseconds since the unix epoch, of the block in which the price was
+	// reported.
+	BlockTimestamp uint64 `json:"block_timestamp,string"`
+}
+
+// PricesResponse is the response to a PricesQuery, with the prices in the order of the queried currency pairs.
+type PricesResponse struct {
+	Prices []PriceResponse `json:"prices"`
+}
+
+// AllCurrencyPairsResponse is the response to an AllCurrencyPairsQuery.
+type AllCurrencyPairsResponse struct {
+	CurrencyPairs []CurrencyPair `json:"currency_pairs"`
+}
+
+// DecimalsResponse is the response to a DecimalsQuery.
+type DecimalsResponse struct {
+	Decimals uint64 `json:"decimals,string"`
+}
+
+// CurrencyPair is a currency pair tracked by x/oracle.
+type CurrencyPair struct {
+	Base  string `json:"base"`
+	Quote string `json:"quote"`
+}